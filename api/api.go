@@ -2,37 +2,84 @@ package api
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/sthorer/api/api/user"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sthorer/api/api/auth"
 	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/folders"
+	"github.com/sthorer/api/api/health"
 	"github.com/sthorer/api/api/middlewares"
+	"github.com/sthorer/api/api/openapi"
+	"github.com/sthorer/api/api/s3"
+	"github.com/sthorer/api/api/shares"
+	"github.com/sthorer/api/api/stream"
 	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/api/webdav"
 
 	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/logging"
 )
 
 func New(conf *config.Config) *echo.Echo {
 	e := echo.New()
 
+	e.HideBanner = true
+	e.HidePort = true
+	e.HTTPErrorHandler = middlewares.ErrorHandler
+	e.Logger.SetOutput(conf.Log().Writer(logging.LevelWarn))
+
 	e.Validator = &types.Validator{Validator: conf.Validator}
-	e.Use(middleware.Recover())
-	e.Use(middleware.RequestID())
-	e.Use(middleware.Gzip())
-	e.Use(middleware.CORS())
-	e.Use(middleware.Logger())
+	e.Pre(middlewares.TunnelMethods(webdav.Prefix))
+	e.Use(middlewares.RestoreMethod)
 	e.Use(middlewares.Context(conf))
+	e.Use(middlewares.Metrics(e))
+	e.Use(middlewares.Tracing(e))
+	e.Use(middleware.RequestID())
+	e.Use(middlewares.RequestLogger())
+	e.Use(middlewares.Recover())
+	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
+		// Compressing would buffer the event stream, the metrics
+		// handler compresses its own responses and S3 and WebDAV clients
+		// expect the exact files
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/events" || c.Path() == "/metrics" ||
+				strings.HasPrefix(c.Path(), s3.Prefix) || strings.HasPrefix(c.Path(), webdav.Prefix)
+		},
+	}))
+	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		// WebDAV clients are not browsers, and expect their OPTIONS
+		// requests to be answered by the WebDAV handler
+		Skipper: func(c echo.Context) bool {
+			return strings.HasPrefix(c.Path(), webdav.Prefix)
+		},
+		AllowOrigins: conf.CORS.AllowedOrigins,
+	}))
+	if conf.Uploads.MaxRequestSize > 0 {
+		e.Use(middleware.BodyLimit(strconv.FormatInt(int64(conf.Uploads.MaxRequestSize), 10)))
+	}
 
 	e.GET("/", func(c echo.Context) error {
 		return c.NoContent(http.StatusOK)
 	})
 
+	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+
+	health.Apply(e)
 	auth.Apply(e)
 	user.Apply(e, conf)
 	files.Apply(e)
+	shares.Apply(e)
+	folders.Apply(e)
+	stream.Apply(e, conf)
+	s3.Apply(e, conf)
+	webdav.Apply(e, conf)
+	openapi.Apply(e)
 
 	return e
 }
@@ -0,0 +1,284 @@
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/ent"
+)
+
+// Code identifies the kind of an error. Codes are stable: clients may rely
+// on them, while messages are meant for humans and may change.
+type Code string
+
+const (
+	CodeBadRequest         Code = "bad_request"
+	CodeValidationFailed   Code = "validation_failed"
+	CodeUnauthorized       Code = "unauthorized"
+	CodeInvalidCredentials Code = "invalid_credentials"
+	CodeInvalidToken       Code = "invalid_token"
+	CodeForbidden          Code = "forbidden"
+	CodeNotFound           Code = "not_found"
+	CodeMethodNotAllowed   Code = "method_not_allowed"
+	CodeConflict           Code = "conflict"
+	CodeEmailTaken         Code = "email_taken"
+	CodeDuplicateFile      Code = "duplicate_file"
+	CodeNameTaken          Code = "name_taken"
+	CodeFolderNotEmpty     Code = "folder_not_empty"
+	CodeRequestTooLarge    Code = "request_too_large"
+	CodeFileTooLarge       Code = "file_too_large"
+	CodeUnsupportedMedia   Code = "unsupported_media_type"
+	CodeTooManyRequests    Code = "too_many_requests"
+	CodeQuotaExceeded      Code = "quota_exceeded"
+	CodeShareExpired       Code = "share_expired"
+	CodeShareRevoked       Code = "share_revoked"
+	CodeDownloadLimit      Code = "download_limit_reached"
+	CodePassphraseRequired Code = "passphrase_required"
+	CodeInvalidPassphrase  Code = "invalid_passphrase"
+	CodeFileEncrypted      Code = "file_encrypted"
+	CodeUnavailable        Code = "service_unavailable"
+	CodeInternal           Code = "internal_error"
+)
+
+// statusCodes are the codes of errors only known by their HTTP status.
+var statusCodes = map[int]Code{
+	http.StatusBadRequest:            CodeBadRequest,
+	http.StatusUnauthorized:          CodeUnauthorized,
+	http.StatusForbidden:             CodeForbidden,
+	http.StatusNotFound:              CodeNotFound,
+	http.StatusMethodNotAllowed:      CodeMethodNotAllowed,
+	http.StatusConflict:              CodeConflict,
+	http.StatusRequestEntityTooLarge: CodeRequestTooLarge,
+	http.StatusUnsupportedMediaType:  CodeUnsupportedMedia,
+	http.StatusTooManyRequests:       CodeTooManyRequests,
+	http.StatusInsufficientStorage:   CodeQuotaExceeded,
+	http.StatusServiceUnavailable:    CodeUnavailable,
+	http.StatusInternalServerError:   CodeInternal,
+}
+
+// Error is an error answered to the client.
+type Error struct {
+	// Status is the HTTP status of the response
+	Status int `json:"-"`
+
+	Code    Code   `json:"code"`
+	Message string `json:"message"`
+
+	// Fields lists the invalid request fields of validation errors
+	Fields []*FieldError `json:"fields,omitempty"`
+
+	// Details holds values specific to the code, such as a limit
+	Details map[string]interface{} `json:"details,omitempty"`
+
+	// RequestID is filled in by the error handler
+	RequestID string `json:"request_id,omitempty"`
+
+	// Internal is the underlying error, logged but never answered
+	Internal error `json:"-"`
+}
+
+// FieldError is an invalid request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// New returns an error answered with status and code.
+func New(status int, code Code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+func (e *Error) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Internal)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Internal
+}
+
+// WithInternal returns a copy of e wrapping err.
+func (e *Error) WithInternal(err error) *Error {
+	c := *e
+	c.Internal = err
+	return &c
+}
+
+// WithDetails returns a copy of e with the key/value pairs kv added to its
+// details.
+func (e *Error) WithDetails(kv ...interface{}) *Error {
+	c := *e
+	c.Details = make(map[string]interface{}, len(e.Details)+len(kv)/2)
+	for k, v := range e.Details {
+		c.Details[k] = v
+	}
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		c.Details[fmt.Sprint(kv[i])] = kv[i+1]
+	}
+
+	return &c
+}
+
+func BadRequest(message string) *Error {
+	return New(http.StatusBadRequest, CodeBadRequest, message)
+}
+
+func Unauthorized() *Error {
+	return New(http.StatusUnauthorized, CodeUnauthorized, "authentication required")
+}
+
+func NotFound() *Error {
+	return New(http.StatusNotFound, CodeNotFound, "resource not found")
+}
+
+func Conflict(code Code, message string) *Error {
+	return New(http.StatusConflict, code, message)
+}
+
+func Internal(err error) *Error {
+	return New(http.StatusInternalServerError, CodeInternal, "internal server error").WithInternal(err)
+}
+
+// Invalid returns a validation error for a single field, for checks made
+// outside of the validator.
+func Invalid(field, rule, message string) *Error {
+	err := New(http.StatusBadRequest, CodeValidationFailed, fmt.Sprintf("%s %s", field, message))
+	err.Fields = []*FieldError{{Field: field, Rule: rule, Message: message}}
+	return err
+}
+
+// Validation returns the error answered for err, as returned by the
+// validator: every invalid field is described.
+func Validation(err error) *Error {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return BadRequest(err.Error()).WithInternal(err)
+	}
+
+	fields := make([]*FieldError, 0, len(errs))
+	names := make([]string, 0, len(errs))
+	for _, e := range errs {
+		f := &FieldError{
+			Field:   fieldName(e),
+			Rule:    e.Tag(),
+			Message: describe(e),
+		}
+		fields = append(fields, f)
+		names = append(names, f.Field)
+	}
+
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    CodeValidationFailed,
+		Message: fmt.Sprintf("invalid %s", strings.Join(names, ", ")),
+		Fields:  fields,
+	}
+}
+
+// From returns the error answered for err. Errors other than *Error, HTTP
+// errors, validation errors and well known database errors are internal:
+// their message is not answered.
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		return fromHTTPError(he)
+	}
+
+	var errs validator.ValidationErrors
+	if errors.As(err, &errs) {
+		return Validation(errs)
+	}
+
+	switch {
+	case ent.IsNotFound(err):
+		return NotFound().WithInternal(err)
+	case ent.IsConstraintError(err):
+		return Conflict(CodeConflict, "resource already exists").WithInternal(err)
+	}
+
+	return Internal(err)
+}
+
+func fromHTTPError(he *echo.HTTPError) *Error {
+	code, ok := statusCodes[he.Code]
+	if !ok {
+		code = CodeInternal
+		if he.Code < http.StatusInternalServerError {
+			code = CodeBadRequest
+		}
+	}
+
+	var message string
+	switch m := he.Message.(type) {
+	case string:
+		message = m
+		// Echo's own errors are named after the status
+		if m == http.StatusText(he.Code) {
+			message = strings.ToLower(m)
+		}
+	case error:
+		message = m.Error()
+	default:
+		message = strings.ToLower(http.StatusText(he.Code))
+	}
+
+	e := New(he.Code, code, message)
+	e.Internal = he.Internal
+	return e
+}
+
+// fieldName returns the namespace of the field of e in the request, without
+// the name of the request type.
+func fieldName(e validator.FieldError) string {
+	name := e.Namespace()
+	if i := strings.Index(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+
+	return name
+}
+
+func describe(e validator.FieldError) string {
+	unit := ""
+	switch e.Kind() {
+	case reflect.String:
+		unit = " characters"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		unit = " items"
+	}
+
+	switch e.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "url":
+		return "must be a valid URL"
+	case "uuid":
+		return "must be a UUID"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", strings.Join(strings.Fields(e.Param()), ", "))
+	case "min", "gte":
+		return fmt.Sprintf("must be at least %s%s", e.Param(), unit)
+	case "max", "lte":
+		return fmt.Sprintf("must be at most %s%s", e.Param(), unit)
+	default:
+		return fmt.Sprintf("failed the %s check", e.Tag())
+	}
+}
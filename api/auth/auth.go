@@ -1,7 +1,6 @@
 package auth
 
 import (
-	"context"
 	"net/http"
 	"time"
 
@@ -9,8 +8,11 @@ import (
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
 	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/auditlog"
 )
 
 func Login(ctx echo.Context) error {
@@ -18,29 +20,35 @@ func Login(ctx echo.Context) error {
 
 	var auth types.AuthRequest
 	if err := c.Bind(&auth); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err)
+		return err
 	}
 
 	if err := c.Validate(&auth); err != nil {
 		return c.ValidationError(err)
 	}
 
-	u, err := c.Client.UserLogin(context.Background(), auth.Email, auth.Password)
+	u, err := c.Client.UserLogin(c.Request().Context(), auth.Email, auth.Password)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "invalid email or password")
+		if err == database.ErrInvalidCredentials {
+			return apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, err.Error())
+		}
+
+		return err
 	}
 
 	token, err := c.Config.GenerateJWT(&types.JWTCustomClaims{
 		UserID: u.ID,
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: time.Now().Add(time.Hour * 24 * 7).Unix(),
+			ExpiresAt: time.Now().Add(c.JWT.Expiration).Unix(),
 			IssuedAt:  time.Now().Unix(),
 		},
 	})
 	if err != nil {
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
 
+	c.Audit(&database.AuditEntry{Action: auditlog.ActionLogin, User: u})
+
 	return c.JSON(http.StatusOK, &types.AuthResponse{User: u, Token: token})
 }
 
@@ -49,21 +57,23 @@ func Register(ctx echo.Context) error {
 
 	var auth types.AuthRequest
 	if err := c.Bind(&auth); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err)
+		return err
 	}
 
 	if err := c.Validate(&auth); err != nil {
 		return c.ValidationError(err)
 	}
 
-	u, err := c.Client.UserRegister(context.Background(), auth.Email, auth.Password)
+	u, err := c.Client.UserRegister(c.Request().Context(), auth.Email, auth.Password)
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return echo.NewHTTPError(http.StatusForbidden, "email already registered")
+			return apierror.Conflict(apierror.CodeEmailTaken, "email already registered")
 		}
 
-		return c.NoContent(http.StatusInternalServerError)
+		return err
 	}
 
+	c.Audit(&database.AuditEntry{Action: auditlog.ActionRegister, User: u})
+
 	return c.JSON(http.StatusOK, u)
 }
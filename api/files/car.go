@@ -0,0 +1,247 @@
+package files
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ipfs"
+	"github.com/sthorer/api/media"
+)
+
+// CARContentType is the content type of CAR files.
+const CARContentType = "application/vnd.ipld.car"
+
+// importedRoot is a root of an imported CAR file.
+type importedRoot struct {
+	cid  string
+	car  string
+	stat *ipfs.Stat
+}
+
+// ImportCAR imports the blocks of the CAR files of a multipart form on the
+// IPFS node and pins their roots, recording a file named after its CID for
+// each root, in the folder at the path form field if given, with the tags
+// and metadata form fields.
+func ImportCAR(c echo.Context) error {
+	cc := c.(*types.Context)
+	user := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	form, folder, err := uploadForm(cc, user)
+	if err != nil {
+		return err
+	}
+
+	tags, meta, err := formTags(form)
+	if err != nil {
+		return err
+	}
+
+	var (
+		names []string
+		sizes []int64
+	)
+	for _, formFiles := range form.File {
+		for _, formFile := range formFiles {
+			names = append(names, formFile.Filename)
+			sizes = append(sizes, formFile.Size)
+		}
+	}
+
+	if len(names) == 0 {
+		return apierror.Invalid("file", "required", "must be a CAR file")
+	}
+
+	// CAR files too big are refused before being imported. Their roots
+	// may name blocks already on the node, their DAGs are checked again
+	// once imported
+	if err := CheckLimits(cc, user, names, sizes); err != nil {
+		return err
+	}
+
+	// Roots imported before a failure are unpinned, unless pinned files
+	// have them
+	var roots []*importedRoot
+	discard := func() {
+		for _, root := range roots {
+			Discard(cc, root.cid)
+		}
+	}
+
+	for _, formFiles := range form.File {
+		for _, formFile := range formFiles {
+			imported, err := importCAR(cc, formFile)
+			if err != nil {
+				discard()
+				return err
+			}
+
+			roots = append(roots, imported...)
+		}
+	}
+
+	var (
+		cids     []string
+		dagSizes []int64
+	)
+	for _, root := range roots {
+		cids = append(cids, root.cid)
+		dagSizes = append(dagSizes, root.stat.Size)
+	}
+
+	if err := CheckLimits(cc, user, cids, dagSizes); err != nil {
+		discard()
+		return err
+	}
+
+	for _, root := range roots {
+		exists, err := cc.Client.UploadedFileExists(ctx, user, root.cid)
+		if err != nil {
+			discard()
+			return err
+		}
+
+		if exists {
+			discard()
+			return apierror.Conflict(apierror.CodeDuplicateFile, "a file with the same content is already stored").
+				WithDetails("name", root.car, "hash", root.cid)
+		}
+	}
+
+	var files []*ent.File
+	for _, root := range roots {
+		metadata := map[string]interface{}{
+			"name": root.cid,
+			"size": root.stat.Size,
+			"car":  root.car,
+		}
+
+		// Only the content of UnixFS files can be inspected
+		if root.stat.Type == "file" {
+			sample, err := sampleContent(cc, root.cid)
+			if err != nil {
+				Discard(cc, root.cid)
+				return err
+			}
+			Inspect(metadata, sample, "", "")
+		}
+
+		file, err := cc.Client.NewFile(ctx, user, folder, root.cid, root.cid, root.stat.Size, tags, WithMeta(metadata, meta))
+		if err != nil {
+			Discard(cc, root.cid)
+			return err
+		}
+
+		Pinned(cc, user, file, root.cid)
+
+		files = append(files, file)
+	}
+
+	if err := cc.Client.TouchFolder(ctx, folder); err != nil {
+		return err
+	}
+
+	PublishUsage(cc, user)
+
+	return cc.JSON(http.StatusOK, files)
+}
+
+// importCAR imports the CAR file of formFile, and returns its roots.
+func importCAR(cc *types.Context, formFile *multipart.FileHeader) ([]*importedRoot, error) {
+	ctx := cc.Request().Context()
+	name := formFile.Filename
+	f, err := formFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cids, err := cc.Shell.DagImportContext(ctx, f)
+	if err != nil {
+		// The node refuses invalid CAR files, other failures are its own
+		var se *shell.Error
+		if errors.As(err, &se) {
+			return nil, apierror.BadRequest("invalid CAR file").WithDetails("name", name).WithInternal(err)
+		}
+		return nil, err
+	}
+
+	if len(cids) == 0 {
+		return nil, apierror.Invalid("file", "roots", "must be a CAR file with roots")
+	}
+
+	roots := make([]*importedRoot, len(cids))
+	for i, cid := range cids {
+		stat, err := cc.Shell.StatContext(ctx, cid)
+		if err != nil {
+			for _, cid := range cids {
+				Discard(cc, cid)
+			}
+			return nil, err
+		}
+
+		roots[i] = &importedRoot{cid: cid, car: name, stat: stat}
+	}
+
+	cc.Log().Info("CAR file imported", "name", name, "roots", cids)
+
+	return roots, nil
+}
+
+// sampleContent returns a sample of the beginning of the content of cid.
+func sampleContent(cc *types.Context, cid string) (*media.Sample, error) {
+	r, err := cc.Shell.CatContext(cc.Request().Context(), cid, 0, media.SampleSize)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	sample := &media.Sample{}
+	if _, err := io.Copy(sample, r); err != nil {
+		return nil, err
+	}
+
+	return sample, nil
+}
+
+// ExportCAR streams a CAR file of the DAG of a pinned file of the user,
+// rooted at its CID.
+func ExportCAR(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	content, err := cc.Shell.DagExportContext(cc.Request().Context(), f.Hash)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	res := cc.Response()
+	res.Header().Set("ETag", strconv.Quote(f.Hash))
+	res.Header().Set("Cache-Control", "private, no-cache")
+	res.Header().Set(echo.HeaderContentType, CARContentType)
+	res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": f.Hash + ".car"}))
+
+	res.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(res, content); err != nil {
+		// The response is committed, the client sees a truncated body
+		cc.Log().Warn("failed to stream CAR export", "file_id", f.ID, "error", err)
+	}
+
+	return nil
+}
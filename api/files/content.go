@@ -0,0 +1,59 @@
+package files
+
+import (
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// Download serves the content of a pinned file of the user, decrypted when
+// it is encrypted, with support for range requests. Files encrypted with a
+// passphrase require it in the X-Encryption-Passphrase header.
+func Download(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	key, err := DataKey(cc, f)
+	if err != nil {
+		return err
+	}
+
+	// The content is served from the origin of the API, it must not be
+	// sniffed or run as a page of the API
+	res := cc.Response()
+	res.Header().Set("ETag", strconv.Quote(f.Hash))
+	res.Header().Set("Cache-Control", "private, no-cache")
+	res.Header().Set(echo.HeaderContentType, ContentType(f))
+	res.Header().Set(echo.HeaderXContentTypeOptions, "nosniff")
+	res.Header().Set(echo.HeaderContentSecurityPolicy, "sandbox")
+	if name := DownloadName(f); name != "" {
+		res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": name}))
+	}
+
+	content := NewContent(cc, f, key)
+	defer content.Close()
+
+	http.ServeContent(res, cc.Request(), "", f.PinnedAt, content)
+	return nil
+}
+
+// DownloadName returns the name f is downloaded as, the last segment of the
+// key of objects.
+func DownloadName(f *ent.File) string {
+	if f.Name != "" || f.Key == "" {
+		return f.Name
+	}
+
+	return path.Base(f.Key)
+}
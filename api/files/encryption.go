@@ -0,0 +1,215 @@
+package files
+
+import (
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"os"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/encryption"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/filekey"
+)
+
+// PassphraseHeader is the header holding the passphrase files are
+// encrypted and decrypted with. The passphrase is never stored.
+const PassphraseHeader = "X-Encryption-Passphrase"
+
+// Encryption modes of uploads, set in their encryption field: data keys
+// are wrapped with a key derived from a passphrase of the user, or with
+// the master key of the API.
+const (
+	EncryptionPassphrase = "passphrase"
+	EncryptionKMS        = "kms"
+)
+
+// fileEncryption encrypts the files of an upload, each with a data key of
+// its own wrapped by keys.
+type fileEncryption struct {
+	mode     string
+	provider filekey.Provider
+	keys     encryption.KeyManager
+}
+
+// uploadEncryption returns how the files of form are encrypted, nil when
+// they are pinned as they are.
+func uploadEncryption(cc *types.Context, form *multipart.Form) (*fileEncryption, error) {
+	values := form.Value["encryption"]
+	if len(values) == 0 || values[0] == "" {
+		return nil, nil
+	}
+
+	switch values[0] {
+	case EncryptionPassphrase:
+		passphrase := cc.Request().Header.Get(PassphraseHeader)
+		if passphrase == "" {
+			return nil, apierror.Invalid("encryption", "passphrase", "requires a passphrase in the "+PassphraseHeader+" header")
+		}
+		return &fileEncryption{mode: EncryptionPassphrase, provider: filekey.ProviderPassphrase, keys: encryption.Passphrase(passphrase)}, nil
+	case EncryptionKMS:
+		if cc.KeyManager == nil {
+			return nil, apierror.New(http.StatusServiceUnavailable, apierror.CodeUnavailable, "no encryption master key is configured")
+		}
+		return &fileEncryption{mode: EncryptionKMS, provider: filekey.ProviderKMS, keys: cc.KeyManager}, nil
+	}
+
+	return nil, apierror.Invalid("encryption", "oneof", "must be passphrase or kms")
+}
+
+// newKey returns a new data key, along with the key to record once the
+// file it encrypts is.
+func (e *fileEncryption) newKey(cc *types.Context) (*encryption.DataKey, *database.NewFileKey, error) {
+	key, err := encryption.NewDataKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	wrapped, keyID, err := e.keys.WrapKey(cc.Request().Context(), key.Key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, &database.NewFileKey{
+		Provider:    e.provider,
+		KeyID:       keyID,
+		WrappedKey:  wrapped,
+		SegmentSize: key.SegmentSize,
+	}, nil
+}
+
+// DataKey returns the data key f is encrypted with, unwrapped with the
+// passphrase of the request or the master key of the API, or nil when f is
+// not encrypted.
+func DataKey(cc *types.Context, f *ent.File) (*encryption.DataKey, error) {
+	ctx := cc.Request().Context()
+	k, err := cc.Client.EncryptionKey(ctx, f)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var keys encryption.KeyManager
+	switch k.Provider {
+	case filekey.ProviderPassphrase:
+		passphrase := cc.Request().Header.Get(PassphraseHeader)
+		if passphrase == "" {
+			return nil, apierror.New(http.StatusForbidden, apierror.CodePassphraseRequired, "file is encrypted, its passphrase is required in the "+PassphraseHeader+" header")
+		}
+		keys = encryption.Passphrase(passphrase)
+	case filekey.ProviderKMS:
+		if cc.KeyManager == nil {
+			return nil, apierror.New(http.StatusServiceUnavailable, apierror.CodeUnavailable, "no encryption master key is configured")
+		}
+		keys = cc.KeyManager
+	}
+
+	key, err := keys.UnwrapKey(ctx, k.WrappedKey, k.KeyID)
+	if err == encryption.ErrInvalidKey && k.Provider == filekey.ProviderPassphrase {
+		return nil, apierror.New(http.StatusForbidden, apierror.CodeInvalidPassphrase, "invalid passphrase")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &encryption.DataKey{Key: key, SegmentSize: k.SegmentSize}, nil
+}
+
+// Content streams the content of a pinned file from the IPFS node, from
+// the offset it was seeked to. Encrypted contents are decrypted from the
+// segment holding the offset.
+type Content struct {
+	cc     *types.Context
+	f      *ent.File
+	key    *encryption.DataKey
+	offset int64
+	r      io.Reader
+	closer io.Closer
+}
+
+// NewContent returns the content of f, encrypted with key, nil when it is
+// not encrypted.
+func NewContent(cc *types.Context, f *ent.File, key *encryption.DataKey) *Content {
+	return &Content{cc: cc, f: f, key: key}
+}
+
+func (c *Content) Read(p []byte) (int, error) {
+	if c.offset >= c.f.Size {
+		return 0, io.EOF
+	}
+
+	if c.r == nil {
+		if err := c.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := c.r.Read(p)
+	c.offset += int64(n)
+	return n, err
+}
+
+func (c *Content) open() error {
+	ctx := c.cc.Request().Context()
+	if c.key == nil {
+		r, err := c.cc.Shell.CatContext(ctx, c.f.Hash, c.offset, -1)
+		if err != nil {
+			return err
+		}
+		c.r, c.closer = r, r
+		return nil
+	}
+
+	segment, cipherOffset, skip := c.key.Locate(c.offset)
+	r, err := c.cc.Shell.CatContext(ctx, c.f.Hash, cipherOffset, -1)
+	if err != nil {
+		return err
+	}
+
+	plain, err := c.key.Decrypt(r, segment)
+	if err == nil {
+		_, err = io.CopyN(ioutil.Discard, plain, skip)
+	}
+	if err != nil {
+		r.Close()
+		return err
+	}
+
+	c.r, c.closer = plain, r
+	return nil
+}
+
+func (c *Content) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += c.offset
+	case io.SeekEnd:
+		offset += c.f.Size
+	}
+
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+
+	if offset != c.offset {
+		c.Close()
+	}
+	c.offset = offset
+
+	return offset, nil
+}
+
+// Close closes the stream of the content, which is opened again on the
+// next read.
+func (c *Content) Close() error {
+	if c.closer == nil {
+		return nil
+	}
+
+	err := c.closer.Close()
+	c.r, c.closer = nil, nil
+	return err
+}
@@ -1,65 +1,567 @@
 package files
 
 import (
-	"context"
-	"fmt"
-	"log"
+	"encoding/json"
+	"errors"
+	"html"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/encryption"
 	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/media"
 
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
 
-	"github.com/sthorer/api/config"
-
 	"github.com/labstack/echo/v4"
 )
 
+const defaultSearchLimit = 100
+
 func Upload(c echo.Context) error {
 	cc := c.(*types.Context)
 	user := cc.Get(types.UserKey).(*ent.User)
+	form, folder, err := uploadForm(cc, user)
+	if err != nil {
+		return err
+	}
+
+	return upload(cc, user, form, folder, nil)
+}
+
+// uploadForm returns the multipart form of the request of user, along with
+// the folder at its path field, created if missing, nil for the root.
+func uploadForm(cc *types.Context, user *ent.User) (*multipart.Form, *ent.Folder, error) {
 	form, err := cc.MultipartForm()
+	if err != nil {
+		// The body limit is reported as is
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			return nil, nil, err
+		}
+		return nil, nil, apierror.BadRequest("invalid multipart form").WithInternal(err)
+	}
+
+	// Files are uploaded to the folder at the path given, the root
+	// otherwise
+	var folder *ent.Folder
+	if values := form.Value["path"]; len(values) > 0 {
+		names, err := SplitPath(values[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		folder, err = cc.Client.MakeFolders(cc.Request().Context(), user, names)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return form, folder, nil
+}
+
+// upload pins the files of form, uploaded by user to folder, once they are
+// allowed by policy, if any.
+func upload(cc *types.Context, user *ent.User, form *multipart.Form, folder *ent.Folder, policy *uploadPolicy) error {
+	ctx := cc.Request().Context()
+	tags, meta, err := formTags(form)
 	if err != nil {
 		return err
 	}
 
-	var files []*ent.File
+	var (
+		names []string
+		sizes []int64
+	)
 	for _, formFiles := range form.File {
 		for _, formFile := range formFiles {
-			if formFile.Size > config.FreeUploadLimit {
-				return cc.JSON(http.StatusUnauthorized, "file size is superior to limit")
-			}
+			names = append(names, formFile.Filename)
+			sizes = append(sizes, formFile.Size)
+		}
+	}
 
-			f, err := formFile.Open()
-			if err != nil {
-				return err
-			}
+	if policy != nil {
+		if err := policy.check(form, sizes); err != nil {
+			return err
+		}
+	}
 
-			hash, err := cc.Shell.Add(f)
-			if err != nil {
-				return err
-			}
+	if err := CheckLimits(cc, user, names, sizes); err != nil {
+		return err
+	}
+
+	enc, err := uploadEncryption(cc, form)
+	if err != nil {
+		return err
+	}
 
-			fmt.Println("Headers:", formFile.Header)
-
-			file, err := cc.Client.File.Create().
-				SetHash(hash).
-				SetUser(user).
-				SetSize(formFile.Size).
-				SetMetadata(map[string]interface{}{
-					"name": formFile.Filename,
-					"size": formFile.Size,
-				}).
-				Save(context.Background())
+	// Files are only announced once all of them are stored, those stored
+	// before a failure are discarded so that uploads are all or nothing
+	var (
+		files    []*ent.File
+		uploaded []*multipart.FileHeader
+	)
+	for _, formFiles := range form.File {
+		for _, formFile := range formFiles {
+			file, err := uploadFile(cc, user, folder, formFile, enc, tags, meta)
 			if err != nil {
+				for _, f := range files {
+					discardFile(cc, f)
+				}
 				return err
 			}
 
-			log.Printf("successfuly added %s (hash: %s)\n", file.Metadata["name"], file.Hash)
-
 			files = append(files, file)
+			uploaded = append(uploaded, formFile)
 		}
 	}
 
+	for i, file := range files {
+		Pinned(cc, user, file, uploaded[i].Filename)
+	}
+
+	if err := cc.Client.TouchFolder(ctx, folder); err != nil {
+		return err
+	}
+
+	PublishUsage(cc, user)
+
 	return cc.JSON(http.StatusOK, files)
 }
+
+// uploadFile pins and stores formFile, uploaded by user to folder. The
+// content is discarded when the file cannot be stored.
+func uploadFile(cc *types.Context, user *ent.User, folder *ent.Folder, formFile *multipart.FileHeader, enc *fileEncryption, tags []string, meta map[string]string) (*ent.File, error) {
+	ctx := cc.Request().Context()
+	f, err := formFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	// Encrypted files are inspected before they are encrypted
+	var (
+		r     io.Reader = f
+		size            = formFile.Size
+		plain *media.Sample
+		key   *database.NewFileKey
+	)
+	if enc != nil {
+		var dataKey *encryption.DataKey
+		dataKey, key, err = enc.newKey(cc)
+		if err != nil {
+			return nil, err
+		}
+
+		plain = &media.Sample{}
+		if r, err = dataKey.Encrypt(io.TeeReader(f, plain)); err != nil {
+			return nil, err
+		}
+		size = dataKey.CiphertextSize(size)
+	}
+
+	hash, sample, err := Add(cc, user, formFile.Filename, size, r)
+	if err != nil {
+		return nil, err
+	}
+	if plain != nil {
+		sample = plain
+	}
+
+	exists, err := cc.Client.UploadedFileExists(ctx, user, hash)
+	if err != nil {
+		Discard(cc, hash)
+		return nil, err
+	}
+
+	if exists {
+		Discard(cc, hash)
+		return nil, apierror.Conflict(apierror.CodeDuplicateFile, "a file with the same content is already stored").
+			WithDetails("name", formFile.Filename, "hash", hash)
+	}
+
+	metadata := map[string]interface{}{
+		"name": formFile.Filename,
+		"size": formFile.Size,
+	}
+	Inspect(metadata, sample, formFile.Filename, formFile.Header.Get(echo.HeaderContentType))
+	if enc != nil {
+		// The metadata of media, such as the location of photos,
+		// is not kept out of the ciphertext
+		delete(metadata, database.MediaKey)
+		metadata[database.EncryptionModeKey] = enc.mode
+	}
+
+	file, err := cc.Client.NewFile(ctx, user, folder, FileName(formFile.Filename), hash, formFile.Size, tags, WithMeta(metadata, meta))
+	if err != nil {
+		Discard(cc, hash)
+		return nil, err
+	}
+
+	if key != nil {
+		if _, err := cc.Client.SetEncryptionKey(ctx, file, key); err != nil {
+			discardFile(cc, file)
+			return nil, err
+		}
+	}
+
+	return file, nil
+}
+
+// formTags returns the tags and metadata form fields of form, which apply
+// to every uploaded file.
+func formTags(form *multipart.Form) ([]string, map[string]string, error) {
+	tags, err := Tags(form.Value["tags"])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var meta map[string]string
+	if values := form.Value["metadata"]; len(values) > 0 {
+		if err := json.Unmarshal([]byte(values[0]), &meta); err != nil {
+			return nil, nil, apierror.Invalid("metadata", "json", "must be a JSON object of strings").WithInternal(err)
+		}
+
+		if err := CheckMetadata(meta); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return tags, meta, nil
+}
+
+func Unpin(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	f, err = Remove(cc, u, f)
+	if err != nil {
+		return err
+	}
+
+	PublishUsage(cc, u)
+
+	return cc.JSON(http.StatusOK, f)
+}
+
+// Update replaces the tags of a file when given, and sets or removes the
+// keys of its metadata given with a value or null.
+func Update(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.UpdateFileRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	tags := f.Tags
+	if body.Tags != nil {
+		tags, err = Tags(*body.Tags)
+		if err != nil {
+			return err
+		}
+	}
+
+	meta := Meta(f)
+	for key, value := range body.Metadata {
+		if value == nil {
+			delete(meta, key)
+		} else {
+			meta[key] = *value
+		}
+	}
+
+	if err := CheckMetadata(meta); err != nil {
+		return err
+	}
+
+	f, err = cc.Client.UpdateFile(cc.Request().Context(), f, tags, WithMeta(f.Metadata, meta))
+	if err != nil {
+		return err
+	}
+
+	return cc.JSON(http.StatusOK, f)
+}
+
+// Search lists the pinned files of the user matching every filter of the
+// request, latest first. When the request has a query, the files whose
+// text has its words are listed instead, the most relevant first, with a
+// snippet of their text.
+func Search(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.SearchFilesRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	filter := &database.FileFilter{
+		NamePrefix:   body.NamePrefix,
+		NameContains: body.NameContains,
+		MinSize:      body.MinSize,
+		MaxSize:      body.MaxSize,
+		ContentType:  body.ContentType,
+		MinWidth:     body.MinWidth,
+		MaxWidth:     body.MaxWidth,
+		MinHeight:    body.MinHeight,
+		MaxHeight:    body.MaxHeight,
+		MinDuration:  body.MinDuration,
+		MaxDuration:  body.MaxDuration,
+		MinPages:     body.MinPages,
+		MaxPages:     body.MaxPages,
+		Limit:        body.Limit,
+		Offset:       body.Offset,
+	}
+
+	tags, err := Tags(body.Tags)
+	if err != nil {
+		return err
+	}
+	filter.Tags = tags
+
+	if len(body.Metadata) > 0 {
+		filter.Metadata = make(map[string]string, len(body.Metadata))
+		for _, pair := range body.Metadata {
+			parts := strings.SplitN(pair, "=", 2)
+			if len(parts) != 2 || parts[0] == "" {
+				return apierror.Invalid("meta", "pair", "must be key=value pairs")
+			}
+			filter.Metadata[parts[0]] = parts[1]
+		}
+	}
+
+	if body.Since > 0 {
+		filter.Since = time.Unix(body.Since, 0)
+	}
+
+	if body.Until > 0 {
+		filter.Until = time.Unix(body.Until, 0)
+	}
+
+	if body.TakenSince > 0 {
+		filter.TakenSince = time.Unix(body.TakenSince, 0)
+	}
+
+	if body.TakenUntil > 0 {
+		filter.TakenUntil = time.Unix(body.TakenUntil, 0)
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = defaultSearchLimit
+	}
+
+	res := &types.SearchFilesResponse{
+		Files:  []*types.FileMatch{},
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}
+
+	if body.Query == "" {
+		found, total, err := cc.Client.SearchFiles(cc.Request().Context(), u, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, f := range found {
+			res.Files = append(res.Files, &types.FileMatch{File: f})
+		}
+		res.Total = total
+
+		return cc.JSON(http.StatusOK, res)
+	}
+
+	terms := database.TextTerms(body.Query)
+	if len(terms) == 0 {
+		return apierror.Invalid("q", "words", "must have words")
+	}
+
+	matches, total, err := cc.Client.SearchText(cc.Request().Context(), u, terms, filter)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range matches {
+		res.Files = append(res.Files, &types.FileMatch{
+			File:    m.File,
+			Rank:    m.Rank,
+			Snippet: highlight(m.Snippet),
+		})
+	}
+	res.Total = total
+
+	return cc.JSON(http.StatusOK, res)
+}
+
+// highlighter marks the matched words of snippets, once escaped, with mark
+// elements.
+var highlighter = strings.NewReplacer(database.HighlightStart, "<mark>", database.HighlightEnd, "</mark>")
+
+// highlight returns snippet as HTML, with its matched words highlighted.
+func highlight(snippet string) string {
+	return highlighter.Replace(html.EscapeString(snippet))
+}
+
+// Move moves a file to another path, renaming it. Its content is not pinned
+// again.
+func Move(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	var body types.PathRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	f, err := treeFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	previous, err := f.QueryFolder().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	parent, name, err := Destination(cc, u, body.Path)
+	if err != nil {
+		return err
+	}
+
+	f, err = cc.Client.MoveFile(ctx, f, parent, name)
+	if err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, previous); err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	return fileResponse(cc, f)
+}
+
+// Copy copies a file to another path, sharing its pinned content.
+func Copy(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	var body types.PathRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	f, err := treeFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	if err := CheckLimits(cc, u, []string{f.Name}, []int64{f.Size}); err != nil {
+		return err
+	}
+
+	parent, name, err := Destination(cc, u, body.Path)
+	if err != nil {
+		return err
+	}
+
+	f, err = cc.Client.CopyToFolder(ctx, u, f, parent, name)
+	if err != nil {
+		return err
+	}
+
+	Pinned(cc, u, f, name)
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	PublishUsage(cc, u)
+
+	return fileResponse(cc, f)
+}
+
+// pinnedFile returns the pinned file of the request of u.
+func pinnedFile(cc *types.Context, u *ent.User) (*ent.File, error) {
+	id, err := uuid.Parse(cc.Param("id"))
+	if err != nil {
+		return nil, apierror.NotFound()
+	}
+
+	f, err := cc.Client.File.
+		Query().
+		Where(file.ID(id), file.HasUserWith(user.ID(u.ID)), file.UnpinnedAtIsNil()).
+		Only(cc.Request().Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apierror.NotFound()
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// treeFile returns the pinned file of the request of u, which must not
+// belong to a bucket.
+func treeFile(cc *types.Context, u *ent.User) (*ent.File, error) {
+	id, err := uuid.Parse(cc.Param("id"))
+	if err != nil {
+		return nil, apierror.NotFound()
+	}
+
+	f, err := cc.Client.TreeFile(cc.Request().Context(), u, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apierror.NotFound()
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func fileResponse(cc *types.Context, f *ent.File) error {
+	p, err := cc.Client.FilePath(cc.Request().Context(), f)
+	if err != nil {
+		return err
+	}
+
+	return cc.JSON(http.StatusOK, &types.FileResponse{File: f, Path: p})
+}
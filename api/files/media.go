@@ -0,0 +1,46 @@
+package files
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/media"
+)
+
+// Inspect records in metadata the content type of the content sampled by
+// s, of a file named name and declared as declared by the client, along
+// with the metadata of its media.
+func Inspect(metadata map[string]interface{}, s *media.Sample, name, declared string) {
+	contentType := media.Detect(s.Head, name, declared)
+	metadata[database.ContentTypeKey] = contentType
+
+	if info := media.Probe(s, contentType); info != nil {
+		metadata[database.MediaKey] = info
+	} else {
+		delete(metadata, database.MediaKey)
+	}
+}
+
+// ContentType returns the content type f is served with.
+func ContentType(f *ent.File) string {
+	if f.ContentType != "" {
+		return f.ContentType
+	}
+
+	// Files uploaded before their type was detected may only have the one
+	// declared by their client
+	if ct, ok := f.Metadata[database.ContentTypeKey].(string); ok && ct != "" {
+		return ct
+	}
+
+	name := f.Name
+	if name == "" {
+		name = f.Key
+	}
+	if ct := media.TypeByExtension(name); ct != "" {
+		return ct
+	}
+
+	return echo.MIMEOctetStream
+}
@@ -0,0 +1,101 @@
+package files
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+)
+
+const (
+	maxTags        = 64
+	maxTagLength   = 64
+	maxMetadata    = 64
+	maxValueLength = 255
+)
+
+var metadataKey = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// Tags returns the tags listed by values, which may each hold several tags
+// separated by commas. Tags are lowercased, and sorted without duplicates.
+func Tags(values []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			tag = strings.ToLower(strings.TrimSpace(tag))
+			if tag == "" || seen[tag] {
+				continue
+			}
+
+			if len(tag) > maxTagLength {
+				return nil, apierror.Invalid("tags", "max", "must be tags of at most 64 characters")
+			}
+
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+
+	if len(tags) > maxTags {
+		return nil, apierror.Invalid("tags", "max", "must be at most 64 tags")
+	}
+
+	sort.Strings(tags)
+	return tags, nil
+}
+
+// CheckMetadata returns an error when the metadata of users m is invalid.
+func CheckMetadata(m map[string]string) error {
+	if len(m) > maxMetadata {
+		return apierror.Invalid("metadata", "max", "must have at most 64 keys")
+	}
+
+	for key, value := range m {
+		if !metadataKey.MatchString(key) {
+			return apierror.Invalid("metadata", "key", "must have keys of at most 64 letters, digits, dots, dashes and underscores")
+		}
+
+		if len(value) > maxValueLength {
+			return apierror.Invalid("metadata", "max", "must have values of at most 255 characters")
+		}
+	}
+
+	return nil
+}
+
+// WithMeta returns a copy of the metadata of a file, with the metadata of
+// users replaced by meta.
+func WithMeta(metadata map[string]interface{}, meta map[string]string) map[string]interface{} {
+	c := make(map[string]interface{}, len(metadata)+1)
+	for k, v := range metadata {
+		c[k] = v
+	}
+
+	delete(c, database.MetaKey)
+	if len(meta) > 0 {
+		m := make(map[string]interface{}, len(meta))
+		for k, v := range meta {
+			m[k] = v
+		}
+		c[database.MetaKey] = m
+	}
+
+	return c
+}
+
+// Meta returns the metadata set by users on f.
+func Meta(f *ent.File) map[string]string {
+	meta := make(map[string]string)
+	m, _ := f.Metadata[database.MetaKey].(map[string]interface{})
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			meta[k] = s
+		}
+	}
+
+	return meta
+}
@@ -0,0 +1,112 @@
+package files
+
+import (
+	"path"
+	"strings"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// MaxPathLength is the maximum length of the paths of folders and files.
+const MaxPathLength = 4096
+
+// SplitPath returns the names making p, a path from the root of the tree of
+// a user such as /photos/2020. The root has no names.
+func SplitPath(p string) ([]string, error) {
+	if len(p) > MaxPathLength {
+		return nil, apierror.Invalid("path", "max", "must be a path of at most 4096 characters")
+	}
+
+	p = strings.Trim(path.Clean("/"+p), "/")
+	if p == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(p, "/")
+	for _, name := range names {
+		if len(name) > MaxNameLength {
+			return nil, apierror.Invalid("path", "max", "must be made of names of at most 255 characters")
+		}
+	}
+
+	return names, nil
+}
+
+// Child returns the folder or else the latest pinned file of u named name
+// in parent. A folder hides the files of the same name.
+func Child(cc *types.Context, u *ent.User, parent *ent.Folder, name string) (*ent.Folder, *ent.File, error) {
+	ctx := cc.Request().Context()
+	f, err := cc.Client.UserFolder(ctx, u, parent, name)
+	if err == nil {
+		return f, nil, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	file, err := cc.Client.FolderFile(ctx, u, parent, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return nil, file, nil
+}
+
+// Destination returns the folder which will hold the folder or file moved
+// or copied to p, created if missing, and the name it will have there. The
+// name must not be taken.
+func Destination(cc *types.Context, u *ent.User, p string) (*ent.Folder, string, error) {
+	names, err := SplitPath(p)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(names) == 0 {
+		return nil, "", apierror.Invalid("path", "path", "must not be the root")
+	}
+
+	parent, err := cc.Client.MakeFolders(cc.Request().Context(), u, names[:len(names)-1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := names[len(names)-1]
+	if _, _, err := Child(cc, u, parent, name); err == nil {
+		return nil, "", apierror.Conflict(apierror.CodeNameTaken, "a folder or file already has this path").
+			WithDetails("path", p)
+	} else if !ent.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	return parent, name, nil
+}
+
+// RemoveFolder unpins the files of f and of its descendants before deleting
+// them.
+func RemoveFolder(cc *types.Context, u *ent.User, f *ent.Folder) error {
+	ctx := cc.Request().Context()
+	children, err := cc.Client.FolderChildren(ctx, u, f)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if err := RemoveFolder(cc, u, child); err != nil {
+			return err
+		}
+	}
+
+	pinned, err := cc.Client.FolderFiles(ctx, u, f)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range pinned {
+		if _, err := Remove(cc, u, file); err != nil {
+			return err
+		}
+	}
+
+	return cc.Client.DeleteFolder(ctx, f)
+}
@@ -0,0 +1,222 @@
+package files
+
+import (
+	"io"
+	"net/http"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/events"
+	"github.com/sthorer/api/media"
+	"github.com/sthorer/api/metrics"
+	"github.com/sthorer/api/webhooks"
+)
+
+// MaxNameLength is the maximum length of the names of files, in bytes.
+const MaxNameLength = 255
+
+// The functions below implement the steps shared by every way of pinning
+// and unpinning files, so that the audit log, webhooks, events and metrics
+// do not depend on the API used.
+
+// FileName returns name, shortened to the maximum length of names.
+func FileName(name string) string {
+	if len(name) <= MaxNameLength {
+		return name
+	}
+
+	name = name[:MaxNameLength]
+	for !utf8.ValidString(name) {
+		name = name[:len(name)-1]
+	}
+
+	return name
+}
+
+// CheckLimits returns an error when uploading files of sizes would exceed
+// the upload or storage limit of the plan of u.
+func CheckLimits(cc *types.Context, u *ent.User, names []string, sizes []int64) error {
+	plan := cc.Plan(u.Plan)
+
+	var total int64
+	for i, size := range sizes {
+		if size > int64(plan.UploadLimit) {
+			return apierror.New(http.StatusRequestEntityTooLarge, apierror.CodeFileTooLarge, "file size exceeds the upload limit of the plan").
+				WithDetails("name", names[i], "size", size, "limit", int64(plan.UploadLimit))
+		}
+
+		total += size
+	}
+
+	if plan.StorageLimit > 0 {
+		usage, err := cc.Client.UserUsage(cc.Request().Context(), u)
+		if err != nil {
+			return err
+		}
+
+		if usage.Bytes+total > int64(plan.StorageLimit) {
+			return apierror.New(http.StatusInsufficientStorage, apierror.CodeQuotaExceeded, "storage usage would exceed the storage limit of the plan").
+				WithDetails("usage", usage.Bytes, "size", total, "limit", int64(plan.StorageLimit))
+		}
+	}
+
+	return nil
+}
+
+// Add adds and pins the content of r, named name and of size bytes, or -1
+// when unknown, on the IPFS node and returns its hash, along with a sample
+// of the content to inspect it. Failures are reported to u.
+func Add(cc *types.Context, u *ent.User, name string, size int64, r io.Reader) (string, *media.Sample, error) {
+	ctx := cc.Request().Context()
+	start := time.Now()
+	sample := &media.Sample{}
+	progress := &progressReader{
+		Reader: io.TeeReader(r, sample),
+		total:  size,
+		report: func(read, total int64) {
+			cc.Events.Publish(u.ID, events.UploadProgress, &events.Progress{Name: name, Bytes: read, Total: total})
+		},
+	}
+	hash, err := cc.Shell.AddContext(ctx, progress)
+	if err != nil {
+		metrics.UploadDuration.WithLabelValues(metrics.ResultFailed).Observe(time.Since(start).Seconds())
+		metrics.UploadBytes.WithLabelValues(metrics.ResultFailed).Add(float64(progress.read))
+
+		failure := map[string]interface{}{
+			"name":  name,
+			"size":  size,
+			"error": err.Error(),
+		}
+
+		cc.Events.Publish(u.ID, events.PinFailed, failure)
+		if err := cc.Webhooks.Publish(ctx, u, webhooks.EventPinFailed, failure); err != nil {
+			cc.Log().Error("failed to publish webhook event", "event", webhooks.EventPinFailed, "error", err)
+		}
+
+		return "", nil, err
+	}
+
+	metrics.UploadDuration.WithLabelValues(metrics.ResultPinned).Observe(time.Since(start).Seconds())
+	metrics.UploadBytes.WithLabelValues(metrics.ResultPinned).Add(float64(progress.read))
+
+	return hash, sample, nil
+}
+
+// Discard unpins content added with Add which could not be recorded,
+// unless a pinned file has it.
+func Discard(cc *types.Context, hash string) {
+	ctx := cc.Request().Context()
+	pinned, err := cc.Client.HashPinned(ctx, hash)
+	if err == nil && !pinned {
+		err = cc.Shell.UnpinContext(ctx, hash)
+	}
+
+	if err != nil {
+		cc.Log().Error("failed to discard content", "hash", hash, "error", err)
+	}
+}
+
+// discardFile unpins f, stored but never announced, and its content
+// unless referenced elsewhere. Failures are logged.
+func discardFile(cc *types.Context, f *ent.File) {
+	if _, err := cc.Client.UnpinFile(cc.Request().Context(), f); err != nil {
+		cc.Log().Error("failed to discard file", "file_id", f.ID, "error", err)
+		return
+	}
+
+	Discard(cc, f.Hash)
+}
+
+// Pinned records the upload of f, named name, once it is saved. The upload
+// is done, failures to record or announce it are logged.
+func Pinned(cc *types.Context, u *ent.User, f *ent.File, name string) {
+	cc.Audit(&database.AuditEntry{
+		Action: auditlog.ActionUpload,
+		User:   u,
+		Metadata: map[string]interface{}{
+			"file_id": f.ID,
+			"hash":    f.Hash,
+			"name":    name,
+			"size":    f.Size,
+		},
+	})
+
+	publish(cc, u, webhooks.EventPinned, f)
+
+	cc.Events.Publish(u.ID, events.PinPinned, f)
+	cc.Indexer.Queue()
+	cc.Thumbnailer.Queue()
+
+	cc.Log().Info("file pinned", "file_id", f.ID, "hash", f.Hash, "size", f.Size)
+}
+
+// Remove unpins f. Its content is only unpinned from the IPFS node when no
+// other pinned file shares it.
+func Remove(cc *types.Context, u *ent.User, f *ent.File) (*ent.File, error) {
+	ctx := cc.Request().Context()
+	referenced, err := cc.Client.HashReferenced(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	// The record is updated first, so that it never says pinned content
+	// which is gone. Content left pinned is only wasted space
+	f, err = cc.Client.UnpinFile(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if !referenced {
+		if err := cc.Shell.UnpinContext(ctx, f.Hash); err != nil {
+			cc.Log().Error("failed to unpin content", "file_id", f.ID, "hash", f.Hash, "error", err)
+		}
+	}
+
+	// Thumbnails are not worth failing the unpinning for, the content of
+	// those left pinned is only wasted space
+	hashes, err := cc.Client.UnreferencedThumbnails(ctx, f)
+	if err != nil {
+		cc.Log().Warn("failed to load the thumbnails to unpin", "file_id", f.ID, "error", err)
+	}
+	for _, hash := range hashes {
+		if err := cc.Shell.UnpinContext(ctx, hash); err != nil {
+			cc.Log().Warn("failed to unpin thumbnail", "file_id", f.ID, "hash", hash, "error", err)
+		}
+	}
+
+	cc.Audit(&database.AuditEntry{
+		Action:   auditlog.ActionUnpin,
+		User:     u,
+		Metadata: map[string]interface{}{"file_id": f.ID, "hash": f.Hash},
+	})
+
+	publish(cc, u, webhooks.EventUnpinned, f)
+
+	cc.Events.Publish(u.ID, events.PinUnpinned, f)
+
+	return f, nil
+}
+
+// publish notifies the webhooks of u of event about f. The action is done,
+// failures are logged rather than failing the request.
+func publish(cc *types.Context, u *ent.User, event string, f *ent.File) {
+	if err := cc.Webhooks.Publish(cc.Request().Context(), u, event, f); err != nil {
+		cc.Log().Error("failed to publish webhook event", "event", event, "file_id", f.ID, "error", err)
+	}
+}
+
+// PublishUsage notifies the user of their new storage usage.
+func PublishUsage(cc *types.Context, u *ent.User) {
+	usage, err := cc.Client.UserUsage(cc.Request().Context(), u)
+	if err != nil {
+		cc.Log().Error("failed to compute usage", "error", err)
+		return
+	}
+
+	cc.Events.Publish(u.ID, events.QuotaUpdated, usage)
+}
@@ -0,0 +1,272 @@
+package files
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/token"
+	"github.com/sthorer/api/media"
+)
+
+// defaultPresignedExpiry is the lifetime of pre-signed upload URLs when
+// none is requested.
+const defaultPresignedExpiry = 15 * time.Minute
+
+// presignedFormSize bounds the size of the multipart forms posted to
+// pre-signed upload URLs besides their files: boundaries, headers and
+// fields.
+const presignedFormSize = 1 << 20
+
+// uploadPolicy restricts the files of an upload: their total size, and
+// their content types, such as image/png or image/* for every image.
+type uploadPolicy struct {
+	maxSize      int64
+	contentTypes []string
+}
+
+// Presign mints a pre-signed upload URL, which a client without
+// credentials, such as a browser, can post files to for the user before it
+// expires. The URL is signed with the secret of the API and bound to the
+// token of the request: revoking the token revokes the URL.
+func Presign(c echo.Context) error {
+	cc := c.(*types.Context)
+	t := cc.Get(types.TokenKey).(*ent.Token)
+
+	var body types.PresignUploadRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	expiry := defaultPresignedExpiry
+	if body.ExpiresIn > 0 {
+		expiry = time.Duration(body.ExpiresIn) * time.Second
+		if expiry > cc.Uploads.PresignedExpiry {
+			return apierror.Invalid("expires_in", "max", fmt.Sprintf("must be at most %d seconds", int64(cc.Uploads.PresignedExpiry/time.Second)))
+		}
+	} else if expiry > cc.Uploads.PresignedExpiry {
+		expiry = cc.Uploads.PresignedExpiry
+	}
+
+	query := url.Values{}
+	for _, contentType := range body.ContentTypes {
+		pattern, ok := contentTypePattern(contentType)
+		if !ok {
+			return apierror.Invalid("content_types", "content_type", "must be content types such as image/png, or image/* for every image")
+		}
+		query.Add("content_type", pattern)
+	}
+
+	names, err := SplitPath(body.Path)
+	if err != nil {
+		return err
+	}
+	if len(names) > 0 {
+		query.Set("path", "/"+strings.Join(names, "/"))
+	}
+
+	expiresAt := time.Now().Add(expiry).Truncate(time.Second).UTC()
+	query.Set("token", t.ID.String())
+	query.Set("max_size", strconv.FormatInt(body.MaxSize, 10))
+	query.Set("expires", strconv.FormatInt(expiresAt.Unix(), 10))
+	query.Set("signature", uploadSignature(cc, query))
+
+	cc.Log().Info("upload URL signed", "token_id", t.ID, "expires_at", expiresAt)
+
+	return cc.JSON(http.StatusOK, &types.PresignedUploadResponse{
+		URL:       cc.Scheme() + "://" + cc.Request().Host + "/uploads?" + query.Encode(),
+		ExpiresAt: expiresAt,
+	})
+}
+
+// PresignedUpload pins the files of a multipart form posted to a
+// pre-signed upload URL, like Upload, for the user who signed it, in the
+// folder of the URL.
+func PresignedUpload(c echo.Context) error {
+	cc := c.(*types.Context)
+	ctx := cc.Request().Context()
+
+	// The parameters are copied, not to sign the cached query of the
+	// request without the signature
+	query := url.Values{}
+	for key, values := range cc.QueryParams() {
+		query[key] = values
+	}
+
+	signature := query.Get("signature")
+	query.Del("signature")
+	if !hmac.Equal([]byte(signature), []byte(uploadSignature(cc, query))) {
+		return apierror.New(http.StatusForbidden, apierror.CodeForbidden, "invalid upload URL signature")
+	}
+
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || !time.Now().Before(time.Unix(expires, 0)) {
+		return apierror.New(http.StatusForbidden, apierror.CodeForbidden, "upload URL has expired")
+	}
+
+	maxSize, err := strconv.ParseInt(query.Get("max_size"), 10, 64)
+	if err != nil {
+		return apierror.New(http.StatusForbidden, apierror.CodeForbidden, "invalid upload URL")
+	}
+
+	id, err := uuid.Parse(query.Get("token"))
+	if err != nil {
+		return apierror.New(http.StatusForbidden, apierror.CodeForbidden, "invalid upload URL")
+	}
+
+	t, err := cc.Client.Token.
+		Query().
+		Where(token.ID(id)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apierror.New(http.StatusForbidden, apierror.CodeForbidden, "upload URL has been revoked")
+		}
+		return err
+	}
+
+	cc.Set(types.TokenKey, t)
+	cc.Set(types.UserKey, t.Edges.User)
+
+	// Bodies too big are refused before being staged
+	limit := maxSize + presignedFormSize
+	if cc.Request().ContentLength > limit {
+		return apierror.New(http.StatusRequestEntityTooLarge, apierror.CodeFileTooLarge, "files exceed the size allowed by the upload URL").
+			WithDetails("limit", maxSize)
+	}
+	cc.Request().Body = http.MaxBytesReader(cc.Response(), cc.Request().Body, limit)
+
+	form, err := cc.MultipartForm()
+	if err != nil {
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			return err
+		}
+		return apierror.BadRequest("invalid multipart form").WithInternal(err)
+	}
+
+	if len(form.Value["path"]) > 0 {
+		return apierror.Invalid("path", "signed", "is set by the upload URL")
+	}
+
+	var folder *ent.Folder
+	if p := query.Get("path"); p != "" {
+		names, err := SplitPath(p)
+		if err != nil {
+			return err
+		}
+
+		folder, err = cc.Client.MakeFolders(ctx, t.Edges.User, names)
+		if err != nil {
+			return err
+		}
+	}
+
+	return upload(cc, t.Edges.User, form, folder, &uploadPolicy{
+		maxSize:      maxSize,
+		contentTypes: query["content_type"],
+	})
+}
+
+// uploadSignature returns the signature of query, the parameters of a
+// pre-signed upload URL besides its signature.
+func uploadSignature(cc *types.Context, query url.Values) string {
+	mac := hmac.New(sha256.New, []byte(cc.JWT.Secret))
+	mac.Write([]byte("upload\n" + query.Encode()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// contentTypePattern returns contentType normalized, when it is a content
+// type such as image/png, or a type such as image/*.
+func contentTypePattern(contentType string) (string, bool) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || len(params) > 0 || strings.HasPrefix(mediaType, "*") {
+		return "", false
+	}
+
+	parts := strings.Split(mediaType, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", false
+	}
+
+	return mediaType, true
+}
+
+// check returns an error when the files of form, of sizes bytes, are not
+// allowed by p. Their content types are detected as they are once pinned.
+func (p *uploadPolicy) check(form *multipart.Form, sizes []int64) error {
+	var total int64
+	for _, size := range sizes {
+		total += size
+	}
+
+	if total > p.maxSize {
+		return apierror.New(http.StatusRequestEntityTooLarge, apierror.CodeFileTooLarge, "files exceed the size allowed by the upload URL").
+			WithDetails("size", total, "limit", p.maxSize)
+	}
+
+	if len(p.contentTypes) == 0 {
+		return nil
+	}
+
+	head := make([]byte, media.SampleSize)
+	for _, formFiles := range form.File {
+		for _, formFile := range formFiles {
+			f, err := formFile.Open()
+			if err != nil {
+				return err
+			}
+
+			n, err := io.ReadFull(f, head)
+			f.Close()
+			if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+				return err
+			}
+
+			contentType := media.Detect(head[:n], formFile.Filename, formFile.Header.Get(echo.HeaderContentType))
+			if !p.allows(contentType) {
+				return apierror.New(http.StatusUnsupportedMediaType, apierror.CodeUnsupportedMedia, "content type is not allowed by the upload URL").
+					WithDetails("name", formFile.Filename, "content_type", contentType, "allowed", p.contentTypes)
+			}
+		}
+	}
+
+	return nil
+}
+
+// allows tells whether contentType matches one of the content types of p.
+func (p *uploadPolicy) allows(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, pattern := range p.contentTypes {
+		if pattern == mediaType || strings.HasSuffix(pattern, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(pattern, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,156 @@
+package files_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api"
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/database/databasetest"
+)
+
+const secret = "test secret"
+
+// sign signs query like the API signs upload URLs, keyed with secret.
+func sign(key string, query url.Values) url.Values {
+	signed := url.Values{}
+	for k, v := range query {
+		if k != "signature" {
+			signed[k] = v
+		}
+	}
+
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte("upload\n" + signed.Encode()))
+	signed.Set("signature", hex.EncodeToString(mac.Sum(nil)))
+
+	return signed
+}
+
+func TestPresignedUploadSignature(t *testing.T) {
+	ctx := context.Background()
+	db := databasetest.New(t)
+	u := databasetest.User(t, db, "a@b.co")
+
+	tok, err := db.NewToken(ctx, u, "uploads")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := config.New(config.DefaultSettings())
+	conf.Client = db
+	conf.JWT.Secret = secret
+	e := api.New(conf)
+
+	// The URL is minted by the API
+	req := httptest.NewRequest(http.MethodPost, "/files/presign", strings.NewReader(`{"max_size":1024,"content_types":["image/*"],"path":"/photos"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(u.Email, tok.Secret)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("presign status = %d: %s", rec.Code, rec.Body)
+	}
+
+	var presigned types.PresignedUploadResponse
+	if err := json.Unmarshal(rec.Body.Bytes(), &presigned); err != nil {
+		t.Fatal(err)
+	}
+
+	minted, err := url.Parse(presigned.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := minted.Query()
+
+	// with returns a copy of the query of the minted URL with key set to
+	// value, or deleted when empty
+	with := func(key, value string) url.Values {
+		q := url.Values{}
+		for k, v := range query {
+			q[k] = append([]string(nil), v...)
+		}
+		if value == "" {
+			q.Del(key)
+		} else {
+			q.Set(key, value)
+		}
+		return q
+	}
+	past := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	later := strconv.FormatInt(time.Now().Add(time.Hour*24).Unix(), 10)
+
+	const (
+		accepted  = ""
+		forged    = "invalid upload URL signature"
+		expired   = "upload URL has expired"
+		revoked   = "upload URL has been revoked"
+		malformed = "invalid upload URL"
+	)
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		outcome string
+	}{
+		{"minted URL", query, accepted},
+		{"signed again", sign(secret, query), accepted},
+		{"larger size", with("max_size", "1048576"), forged},
+		{"later expiry", with("expires", later), forged},
+		{"other path", with("path", "/other"), forged},
+		{"other content types", with("content_type", "*/*"), forged},
+		{"no content type restriction", with("content_type", ""), forged},
+		{"other token", with("token", uuid.New().String()), forged},
+		{"no signature", with("signature", ""), forged},
+		{"truncated signature", with("signature", query.Get("signature")[:32]), forged},
+		{"signed with another secret", sign("other secret", query), forged},
+		{"expired", sign(secret, with("expires", past)), expired},
+		{"invalid size", sign(secret, with("max_size", "large")), malformed},
+		{"deleted token", sign(secret, with("token", uuid.New().String())), revoked},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The body is no form: accepted URLs fail once reading it
+			req := httptest.NewRequest(http.MethodPost, "/uploads?"+tt.query.Encode(), strings.NewReader("content"))
+			req.Header.Set("Content-Type", "text/plain")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			var res struct {
+				Error struct {
+					Code    apierror.Code `json:"code"`
+					Message string        `json:"message"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+				t.Fatalf("status %d: %s", rec.Code, rec.Body)
+			}
+
+			if tt.outcome == accepted {
+				if res.Error.Code != apierror.CodeBadRequest {
+					t.Errorf("got %d %s: %s, want the form to be read", rec.Code, res.Error.Code, res.Error.Message)
+				}
+				return
+			}
+
+			if rec.Code != http.StatusForbidden || res.Error.Message != tt.outcome {
+				t.Errorf("got %d %s, want %d %s", rec.Code, res.Error.Message, http.StatusForbidden, tt.outcome)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package files
+
+import (
+	"io"
+	"time"
+)
+
+// progressInterval throttles how often upload progress is reported.
+const progressInterval = time.Millisecond * 250
+
+// progressReader reports the number of bytes read from the wrapped reader,
+// which for uploads is the number of bytes sent to the IPFS node.
+type progressReader struct {
+	io.Reader
+	read   int64
+	total  int64
+	last   time.Time
+	report func(read, total int64)
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += int64(n)
+
+	if now := time.Now(); now.Sub(r.last) >= progressInterval || (n > 0 && r.read == r.total) {
+		r.last = now
+		r.report(r.read, r.total)
+	}
+
+	return n, err
+}
@@ -6,9 +6,26 @@ import (
 )
 
 func Apply(e *echo.Echo) {
+	e.POST("/uploads", PresignedUpload)
+
 	group := e.Group("/files")
 
 	group.Use(middlewares.TokenAuth())
 
 	group.POST("/upload", Upload)
+	group.POST("/presign", Presign)
+	group.POST("/import/car", ImportCAR)
+	group.GET("/search", Search)
+	group.PATCH("/:id", Update)
+	group.DELETE("/:id", Unpin)
+	group.POST("/:id/move", Move)
+	group.POST("/:id/copy", Copy)
+	group.GET("/:id/content", Download)
+	group.HEAD("/:id/content", Download)
+	group.GET("/:id/car", ExportCAR)
+	group.GET("/:id/thumbnail", Thumbnail)
+	group.HEAD("/:id/thumbnail", Thumbnail)
+	group.GET("/:id/shares", ListShares)
+	group.POST("/:id/shares", NewShare)
+	group.DELETE("/:id/shares/:share", RevokeShare)
 }
@@ -0,0 +1,136 @@
+package files
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/share"
+)
+
+// NewShare creates a public link to a pinned file of the user, which
+// anyone knowing it can download the file with.
+func NewShare(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.NewShareRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	if body.ExpiresAt != nil && !body.ExpiresAt.After(time.Now()) {
+		return apierror.Invalid("expires_at", "future", "must be in the future")
+	}
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	// Share links have neither the passphrase nor the authentication
+	// encrypted files are decrypted with
+	encrypted, err := cc.Client.Encrypted(cc.Request().Context(), f)
+	if err != nil {
+		return err
+	}
+	if encrypted {
+		return apierror.Conflict(apierror.CodeFileEncrypted, "encrypted files cannot be shared")
+	}
+
+	s, err := cc.Client.NewShare(cc.Request().Context(), u, f, &database.ShareOptions{
+		Password:     body.Password,
+		ExpiresAt:    body.ExpiresAt,
+		MaxDownloads: body.MaxDownloads,
+	})
+	if err != nil {
+		return err
+	}
+
+	cc.Log().Info("file shared", "file_id", f.ID, "share_id", s.ID)
+
+	return cc.JSON(http.StatusOK, shareResponse(cc, s))
+}
+
+// ListShares lists the share links of a pinned file of the user, latest
+// first, including those which stopped working.
+func ListShares(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	shares, err := f.QueryShares().
+		Order(ent.Desc(share.FieldCreatedAt)).
+		All(cc.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	res := make([]*types.ShareResponse, len(shares))
+	for i, s := range shares {
+		res[i] = shareResponse(cc, s)
+	}
+
+	return cc.JSON(http.StatusOK, res)
+}
+
+// RevokeShare revokes a share link of a pinned file of the user. Revoked
+// links are kept, with their download count.
+func RevokeShare(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(cc.Param("share"))
+	if err != nil {
+		return apierror.NotFound()
+	}
+
+	ctx := cc.Request().Context()
+	s, err := cc.Client.Share.
+		Query().
+		Where(share.ID(id), share.HasFileWith(file.ID(f.ID))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apierror.NotFound()
+		}
+		return err
+	}
+
+	s, err = cc.Client.RevokeShare(ctx, s)
+	if err != nil {
+		return err
+	}
+
+	cc.Log().Info("share revoked", "file_id", f.ID, "share_id", s.ID)
+
+	return cc.JSON(http.StatusOK, shareResponse(cc, s))
+}
+
+func shareResponse(cc *types.Context, s *ent.Share) *types.ShareResponse {
+	return &types.ShareResponse{
+		Share:     s,
+		URL:       cc.Scheme() + "://" + cc.Request().Host + "/shares/" + s.Slug,
+		Protected: s.Password != "",
+	}
+}
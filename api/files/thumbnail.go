@@ -0,0 +1,103 @@
+package files
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/thumbnails"
+)
+
+// Thumbnail serves the thumbnail of a pinned image of the user fitting in
+// the requested size, or the closest one. Thumbnails never change, and are
+// cached by clients until their hash does.
+func Thumbnail(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.ThumbnailRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	if body.Size == 0 {
+		body.Size = cc.Thumbnails.Sizes[0]
+		for _, size := range cc.Thumbnails.Sizes[1:] {
+			if size < body.Size {
+				body.Size = size
+			}
+		}
+	}
+
+	f, err := pinnedFile(cc, u)
+	if err != nil {
+		return err
+	}
+
+	ctx := cc.Request().Context()
+	t, err := cc.Client.FileThumbnail(ctx, f, body.Size)
+	switch {
+	case ent.IsNotFound(err):
+		if thumbnailed(cc, f) {
+			return apierror.New(http.StatusNotFound, apierror.CodeNotFound, "thumbnail is being generated")
+		}
+		return apierror.New(http.StatusNotFound, apierror.CodeNotFound, "file has no thumbnail")
+	case err != nil:
+		return err
+	case t.Hash == "":
+		return apierror.New(http.StatusNotFound, apierror.CodeNotFound, "file has no thumbnail")
+	}
+
+	res := cc.Response()
+	etag := strconv.Quote(t.Hash)
+	res.Header().Set("ETag", etag)
+	res.Header().Set("Cache-Control", "private, max-age=86400")
+	if cc.Request().Header.Get("If-None-Match") == etag {
+		return cc.NoContent(http.StatusNotModified)
+	}
+
+	res.Header().Set(echo.HeaderContentType, t.ContentType)
+	res.Header().Set(echo.HeaderContentLength, strconv.FormatInt(t.Bytes, 10))
+	if cc.Request().Method == http.MethodHead {
+		res.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	content, err := cc.Shell.CatContext(ctx, t.Hash, 0, t.Bytes)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	res.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(res, content); err != nil {
+		// The response is committed, the client sees a truncated body
+		cc.Log().Warn("failed to stream thumbnail", "file_id", f.ID, "error", err)
+	}
+
+	return nil
+}
+
+// thumbnailed tells whether thumbnails are generated for f.
+func thumbnailed(cc *types.Context, f *ent.File) bool {
+	if f.Size > int64(cc.Thumbnails.MaxSize) {
+		return false
+	}
+
+	for _, contentType := range thumbnails.ContentTypes {
+		if f.ContentType == contentType {
+			return true
+		}
+	}
+
+	return false
+}
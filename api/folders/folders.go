@@ -0,0 +1,402 @@
+package folders
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+const defaultContentsLimit = 100
+
+// List lists the content of the folder at the path of the request, the root
+// by default.
+func List(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.FolderContentsRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	names, err := files.SplitPath(body.Path)
+	if err != nil {
+		return err
+	}
+
+	f, err := cc.Client.FolderAt(cc.Request().Context(), u, names)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apierror.NotFound()
+		}
+		return err
+	}
+
+	return contents(cc, u, f, body.Limit, body.Offset)
+}
+
+func ListContents(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+
+	var body types.FolderPageRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	f, err := userFolder(cc, u)
+	if err != nil {
+		return err
+	}
+
+	return contents(cc, u, f, body.Limit, body.Offset)
+}
+
+// NewFolder creates a folder at the path of the request, along with its
+// missing parents.
+func NewFolder(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	var body types.NewFolderRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return cc.ValidationError(err)
+	}
+
+	parent, name, err := files.Destination(cc, u, body.Path)
+	if err != nil {
+		return err
+	}
+
+	f, err := cc.Client.NewFolder(ctx, u, parent, name)
+	if err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	return folderResponse(cc, f)
+}
+
+func GetFolder(c echo.Context) error {
+	cc := c.(*types.Context)
+	f, err := userFolder(cc, cc.Get(types.UserKey).(*ent.User))
+	if err != nil {
+		return err
+	}
+
+	return folderResponse(cc, f)
+}
+
+// Move moves a folder and its content to another path, renaming it. The
+// content of its files is not pinned again.
+func Move(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	f, body, err := pathRequest(cc, u)
+	if err != nil {
+		return err
+	}
+
+	previous, err := f.QueryParent().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	parent, name, err := files.Destination(cc, u, body.Path)
+	if err != nil {
+		return err
+	}
+
+	f, err = cc.Client.MoveFolder(ctx, f, parent, name)
+	if err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, previous); err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	return folderResponse(cc, f)
+}
+
+// Copy copies a folder and its content to another path. The copied files
+// share the pinned content of the original ones.
+func Copy(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	f, body, err := pathRequest(cc, u)
+	if err != nil {
+		return err
+	}
+
+	var (
+		names []string
+		sizes []int64
+	)
+	if err := walk(cc, u, f, func(file *ent.File) {
+		names = append(names, file.Name)
+		sizes = append(sizes, file.Size)
+	}); err != nil {
+		return err
+	}
+
+	if err := files.CheckLimits(cc, u, names, sizes); err != nil {
+		return err
+	}
+
+	parent, name, err := files.Destination(cc, u, body.Path)
+	if err != nil {
+		return err
+	}
+
+	copied, err := copyFolder(cc, u, f, parent, name)
+	if err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	files.PublishUsage(cc, u)
+
+	return folderResponse(cc, copied)
+}
+
+// DeleteFolder deletes an empty folder, or a folder and its content, whose
+// files are unpinned, when recursive.
+func DeleteFolder(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	ctx := cc.Request().Context()
+
+	var body types.DeleteFolderRequest
+	if err := cc.Bind(&body); err != nil {
+		return err
+	}
+
+	f, err := userFolder(cc, u)
+	if err != nil {
+		return err
+	}
+
+	parent, err := f.QueryParent().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	if !body.Recursive {
+		empty, err := cc.Client.FolderEmpty(ctx, f)
+		if err != nil {
+			return err
+		}
+
+		if !empty {
+			return apierror.Conflict(apierror.CodeFolderNotEmpty, "the folder is not empty")
+		}
+	}
+
+	if err := files.RemoveFolder(cc, u, f); err != nil {
+		return err
+	}
+
+	if err := cc.Client.TouchFolder(ctx, parent); err != nil {
+		return err
+	}
+
+	files.PublishUsage(cc, u)
+
+	return cc.NoContent(http.StatusOK)
+}
+
+// pathRequest returns the folder of the request and the path it is moved or
+// copied to, which must not be within the folder.
+func pathRequest(cc *types.Context, u *ent.User) (*ent.Folder, *types.PathRequest, error) {
+	var body types.PathRequest
+	if err := cc.Bind(&body); err != nil {
+		return nil, nil, err
+	}
+
+	if err := cc.Validate(&body); err != nil {
+		return nil, nil, cc.ValidationError(err)
+	}
+
+	f, err := userFolder(cc, u)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	source, err := cc.Client.FolderPath(cc.Request().Context(), f)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	names, err := files.SplitPath(body.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if destination := "/" + strings.Join(names, "/"); strings.HasPrefix(destination+"/", source+"/") {
+		return nil, nil, apierror.Invalid("path", "within", "must not be within the folder")
+	}
+
+	return f, &body, nil
+}
+
+func contents(cc *types.Context, u *ent.User, f *ent.Folder, limit, offset int) error {
+	ctx := cc.Request().Context()
+	if limit == 0 {
+		limit = defaultContentsLimit
+	}
+
+	folders, pinned, total, err := cc.Client.FolderContents(ctx, u, f, limit, offset)
+	if err != nil {
+		return err
+	}
+
+	p, err := cc.Client.FolderPath(ctx, f)
+	if err != nil {
+		return err
+	}
+
+	res := &types.FolderContentsResponse{
+		Path:    p,
+		Folders: folders,
+		Files:   pinned,
+		Total:   total,
+		Limit:   limit,
+		Offset:  offset,
+	}
+	if f != nil {
+		res.Folder = &types.FolderResponse{Folder: f, Path: p}
+	}
+
+	// Empty pages are answered with empty lists rather than null
+	if res.Folders == nil {
+		res.Folders = []*ent.Folder{}
+	}
+	if res.Files == nil {
+		res.Files = []*ent.File{}
+	}
+
+	return cc.JSON(http.StatusOK, res)
+}
+
+// walk calls fn with the pinned files of f and of its descendants.
+func walk(cc *types.Context, u *ent.User, f *ent.Folder, fn func(*ent.File)) error {
+	ctx := cc.Request().Context()
+	children, err := cc.Client.FolderChildren(ctx, u, f)
+	if err != nil {
+		return err
+	}
+
+	for _, child := range children {
+		if err := walk(cc, u, child, fn); err != nil {
+			return err
+		}
+	}
+
+	pinned, err := cc.Client.FolderFiles(ctx, u, f)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range pinned {
+		fn(file)
+	}
+
+	return nil
+}
+
+// copyFolder copies f and its content as name in parent.
+func copyFolder(cc *types.Context, u *ent.User, f *ent.Folder, parent *ent.Folder, name string) (*ent.Folder, error) {
+	ctx := cc.Request().Context()
+	copied, err := cc.Client.NewFolder(ctx, u, parent, name)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := cc.Client.FolderChildren(ctx, u, f)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range children {
+		if _, err := copyFolder(cc, u, child, copied, child.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	pinned, err := cc.Client.FolderFiles(ctx, u, f)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, file := range pinned {
+		c, err := cc.Client.CopyToFolder(ctx, u, file, copied, file.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		files.Pinned(cc, u, c, c.Name)
+	}
+
+	return copied, nil
+}
+
+func userFolder(cc *types.Context, u *ent.User) (*ent.Folder, error) {
+	id, err := uuid.Parse(cc.Param("id"))
+	if err != nil {
+		return nil, apierror.NotFound()
+	}
+
+	f, err := cc.Client.FolderOf(cc.Request().Context(), u, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apierror.NotFound()
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func folderResponse(cc *types.Context, f *ent.Folder) error {
+	p, err := cc.Client.FolderPath(cc.Request().Context(), f)
+	if err != nil {
+		return err
+	}
+
+	return cc.JSON(http.StatusOK, &types.FolderResponse{Folder: f, Path: p})
+}
@@ -0,0 +1,20 @@
+package folders
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/sthorer/api/api/middlewares"
+)
+
+func Apply(e *echo.Echo) {
+	group := e.Group("/folders")
+
+	group.Use(middlewares.TokenAuth())
+
+	group.GET("", List)
+	group.POST("", NewFolder)
+	group.GET("/:id", GetFolder)
+	group.GET("/:id/contents", ListContents)
+	group.POST("/:id/move", Move)
+	group.POST("/:id/copy", Copy)
+	group.DELETE("/:id", DeleteFolder)
+}
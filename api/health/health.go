@@ -0,0 +1,153 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/utils"
+)
+
+// checkTimeout bounds every dependency check.
+const checkTimeout = time.Second * 5
+
+// check returns the details of a dependency, or an error when it is not
+// usable.
+type check func(ctx context.Context, c *types.Context) (interface{}, error)
+
+var checks = map[string]check{
+	"database": checkDatabase,
+	"ipfs":     checkIPFS,
+	"workers":  checkWorkers,
+	"disk":     checkDisk,
+}
+
+// Liveness reports the process is running. It does not check any
+// dependency.
+func Liveness(c echo.Context) error {
+	return c.JSON(http.StatusOK, &types.HealthResponse{Status: types.StatusOK})
+}
+
+// Readiness checks every dependency concurrently and answers 503 when any
+// of them is down.
+func Readiness(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+
+	res := &types.ReadinessResponse{
+		Status: types.StatusReady,
+		Checks: make(map[string]*types.CheckResult, len(checks)),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, fn := range checks {
+		wg.Add(1)
+		go func(name string, fn check) {
+			defer wg.Done()
+			result := run(c, fn)
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Checks[name] = result
+			if result.Status != types.CheckUp {
+				res.Status = types.StatusNotReady
+			}
+		}(name, fn)
+	}
+	wg.Wait()
+
+	status := http.StatusOK
+	if res.Status != types.StatusReady {
+		status = http.StatusServiceUnavailable
+	}
+
+	return c.JSON(status, res)
+}
+
+func run(c *types.Context, fn check) *types.CheckResult {
+	ctx, cancel := context.WithTimeout(c.Request().Context(), checkTimeout)
+	defer cancel()
+
+	start := time.Now()
+	details, err := fn(ctx, c)
+	result := &types.CheckResult{
+		Status:    types.CheckUp,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+		Details:   details,
+	}
+
+	if err != nil {
+		result.Status = types.CheckDown
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+func checkDatabase(ctx context.Context, c *types.Context) (interface{}, error) {
+	return nil, c.Client.Ping(ctx)
+}
+
+func checkIPFS(ctx context.Context, c *types.Context) (interface{}, error) {
+	status, err := c.Shell.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func checkWorkers(ctx context.Context, c *types.Context) (interface{}, error) {
+	depth, err := c.Client.CountDueWebhookDeliveries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unindexed, err := c.Client.CountUnindexedFiles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	unthumbnailed, err := c.Thumbnailer.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WorkersDetails{
+		Webhooks:            c.Workers.Webhooks,
+		QueueDepth:          depth,
+		Indexing:            c.Workers.Indexing,
+		IndexQueueDepth:     unindexed,
+		Thumbnails:          c.Workers.Thumbnails,
+		ThumbnailQueueDepth: unthumbnailed,
+	}, nil
+}
+
+// checkDisk checks the space left in the directory uploads are staged in
+// while being received.
+func checkDisk(_ context.Context, c *types.Context) (interface{}, error) {
+	details := &types.DiskDetails{
+		Path:     os.TempDir(),
+		MinBytes: int64(c.Uploads.MinFreeSpace),
+	}
+
+	free, err := utils.FreeDiskSpace(details.Path)
+	if err != nil {
+		return nil, err
+	}
+	details.FreeBytes = free
+
+	if details.MinBytes > 0 && free < uint64(details.MinBytes) {
+		return details, fmt.Errorf("only %d bytes are free, %d are required", free, details.MinBytes)
+	}
+
+	return details, nil
+}
@@ -0,0 +1,10 @@
+package health
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+func Apply(e *echo.Echo) {
+	e.GET("/healthz", Liveness)
+	e.GET("/readyz", Readiness)
+}
@@ -0,0 +1,35 @@
+package middlewares
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/sthorer/api/config"
+)
+
+// JWTOrTokenAuth accepts either an API token (Basic auth) or a JWT. The JWT
+// can also be given in the access_token query parameter, for clients such as
+// EventSource which cannot set headers.
+func JWTOrTokenAuth(conf *config.Config) echo.MiddlewareFunc {
+	tokenAuth := TokenAuth()
+	jwtAuth := JWTAuth(conf)
+	queryJWTAuth := middleware.JWTWithConfig(jwtConfig(conf, "query:access_token"))
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		withToken := tokenAuth(next)
+		withJWT := jwtAuth(Auth(next))
+		withQueryJWT := queryJWTAuth(Auth(next))
+
+		return func(c echo.Context) error {
+			switch {
+			case strings.HasPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Basic "):
+				return withToken(c)
+			case c.QueryParam("access_token") != "":
+				return withQueryJWT(c)
+			default:
+				return withJWT(c)
+			}
+		}
+	}
+}
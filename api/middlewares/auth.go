@@ -1,11 +1,9 @@
 package middlewares
 
 import (
-	"context"
-	"net/http"
-
 	"github.com/dgrijalva/jwt-go"
 	"github.com/labstack/echo/v4"
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
 )
 
@@ -15,9 +13,9 @@ func Auth(next echo.HandlerFunc) echo.HandlerFunc {
 		user := c.Get(types.JWTKey).(*jwt.Token)
 		claims := user.Claims.(*types.JWTCustomClaims)
 
-		u, err := c.Client.User.Get(context.Background(), claims.UserID)
+		u, err := c.Client.User.Get(c.Request().Context(), claims.UserID)
 		if err != nil {
-			return c.NoContent(http.StatusUnauthorized)
+			return apierror.Unauthorized().WithInternal(err)
 		}
 
 		c.Set(types.UserKey, u)
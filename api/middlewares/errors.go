@@ -0,0 +1,32 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+)
+
+// ErrorHandler answers every error with the JSON error envelope. Internal
+// errors are answered with a generic message, the request logger records
+// the underlying error.
+func ErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	e := *apierror.From(err)
+	e.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(e.Status)
+	} else {
+		err = c.JSON(e.Status, &types.ErrorResponse{Error: &e})
+	}
+
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
@@ -1,23 +1,46 @@
 package middlewares
 
 import (
-	"context"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
 	"github.com/sthorer/api/ent"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/metrics"
 )
 
+// readMethods are the methods of requests which only read, allowed to Read
+// tokens. Write tokens are allowed the other ones.
+var readMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	echo.PROPFIND:      true,
+}
+
+// TokenAllows tells whether the permissions of t allow requests made with
+// method.
+func TokenAllows(t *ent.Token, method string) bool {
+	if readMethods[method] {
+		return t.Permissions != token.PermissionsWrite
+	}
+
+	return t.Permissions != token.PermissionsRead
+}
+
+// TokenAuth authenticates requests with the email of the user and the
+// secret of one of their tokens, whose permissions must allow the method of
+// the request.
 func TokenAuth() echo.MiddlewareFunc {
 	return middleware.BasicAuth(func(username, secret string, c echo.Context) (bool, error) {
 		cc := c.(*types.Context)
-		ctx := context.Background()
+		ctx := cc.Request().Context()
 		t, err := cc.Client.Token.
 			Query().
 			Where(token.Secret(secret), token.HasUserWith(user.Email(username))).
@@ -25,12 +48,18 @@ func TokenAuth() echo.MiddlewareFunc {
 			Only(ctx)
 		if err != nil {
 			if ent.IsNotFound(err) {
-				return false, echo.NewHTTPError(http.StatusUnauthorized, "invalid token")
+				metrics.AuthFailures.WithLabelValues(metrics.AuthToken).Inc()
+				return false, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidToken, "invalid token")
 			}
 
 			return false, err
 		}
 
+		if !TokenAllows(t, cc.Request().Method) {
+			return false, apierror.New(http.StatusForbidden, apierror.CodeForbidden, "token permissions do not allow this request").
+				WithDetails("permissions", t.Permissions)
+		}
+
 		if err = t.Update().SetLastUsed(time.Now()).Exec(ctx); err != nil {
 			return false, err
 		}
@@ -1,16 +1,36 @@
 package middlewares
 
 import (
+	"net/http"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
 	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/metrics"
 )
 
 func JWTAuth(conf *config.Config) echo.MiddlewareFunc {
-	return middleware.JWTWithConfig(middleware.JWTConfig{
-		ContextKey: types.JWTKey,
-		SigningKey: []byte(conf.Secret),
-		Claims:     &types.JWTCustomClaims{},
-	})
+	return middleware.JWTWithConfig(jwtConfig(conf, middleware.DefaultJWTConfig.TokenLookup))
+}
+
+func jwtConfig(conf *config.Config, lookup string) middleware.JWTConfig {
+	return middleware.JWTConfig{
+		ContextKey:              types.JWTKey,
+		SigningKey:              []byte(conf.JWT.Secret),
+		Claims:                  &types.JWTCustomClaims{},
+		TokenLookup:             lookup,
+		ErrorHandlerWithContext: jwtError,
+	}
+}
+
+// jwtError counts rejected JWTs and answers like the default error handler.
+func jwtError(err error, _ echo.Context) error {
+	if err == middleware.ErrJWTMissing {
+		return err
+	}
+
+	metrics.AuthFailures.WithLabelValues(metrics.AuthJWT).Inc()
+	return apierror.New(http.StatusUnauthorized, apierror.CodeInvalidToken, "invalid or expired jwt").WithInternal(err)
 }
@@ -0,0 +1,74 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+)
+
+// RequestLogger logs every request once it is handled. It must run after
+// the Context middleware.
+func RequestLogger() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			cc := c.(*types.Context)
+			start := time.Now()
+			err := next(c)
+
+			req := c.Request()
+			status := responseStatus(c, err)
+			logger := cc.Log()
+			kv := []interface{}{
+				"method", req.Method,
+				"uri", logger.RedactURL(req.URL),
+				"route", c.Path(),
+				"status", status,
+				"latency", time.Since(start).String(),
+				"remote_ip", c.RealIP(),
+				"user_agent", req.UserAgent(),
+				"bytes_in", req.ContentLength,
+				"bytes_out", c.Response().Size,
+			}
+			if err != nil {
+				kv = append(kv, "error", err)
+			}
+
+			switch {
+			case status >= http.StatusInternalServerError:
+				logger.Error("request", kv...)
+			case status >= http.StatusBadRequest:
+				logger.Warn("request", kv...)
+			default:
+				logger.Info("request", kv...)
+			}
+
+			return err
+		}
+	}
+}
+
+// Recover turns panics into 500 errors and logs them with their stack. It
+// must run after the Context middleware.
+func Recover() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					stack := make([]byte, 4<<10)
+					stack = stack[:runtime.Stack(stack, false)]
+
+					c.(*types.Context).Log().Error("panic recovered", "panic", fmt.Sprint(r), "stack", string(stack))
+					err = apierror.Internal(fmt.Errorf("panic: %v", r))
+				}
+			}()
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const tunnelledMethodKey = "TunnelledMethod"
+
+// routedMethods are the methods echo routes.
+var routedMethods = map[string]bool{
+	http.MethodConnect: true,
+	http.MethodDelete:  true,
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPatch:   true,
+	http.MethodPost:    true,
+	echo.PROPFIND:      true,
+	http.MethodPut:     true,
+	http.MethodTrace:   true,
+	echo.REPORT:        true,
+}
+
+// TunnelMethods lets the routes under prefix handle methods echo does not
+// route, such as the ones of WebDAV. Requests made with them are routed as
+// POST requests, until RestoreMethod restores their method.
+func TunnelMethods(prefix string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			if !routedMethods[req.Method] && (req.URL.Path == prefix || strings.HasPrefix(req.URL.Path, prefix+"/")) {
+				c.Set(tunnelledMethodKey, req.Method)
+				req.Method = http.MethodPost
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// RestoreMethod restores the method of requests tunnelled by TunnelMethods
+// once routed. It must come first so that other middlewares see the method.
+func RestoreMethod(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if method, ok := c.Get(tunnelledMethodKey).(string); ok {
+			c.Request().Method = method
+		}
+
+		return next(c)
+	}
+}
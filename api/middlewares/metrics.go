@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/metrics"
+)
+
+// Metrics counts the requests and records their latency by method, route
+// and status.
+func Metrics(e *echo.Echo) echo.MiddlewareFunc {
+	route := routeOf(e)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			labels := []string{c.Request().Method, route(c), strconv.Itoa(responseStatus(c, err))}
+			metrics.HTTPRequests.WithLabelValues(labels...).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"sync"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+)
+
+const unmatchedRoute = "unmatched"
+
+// routeOf returns a function naming the route of e a request matched.
+// Requests matching no route are grouped, so unknown paths cannot be used
+// to create an unbounded number of metric series or span names.
+func routeOf(e *echo.Echo) func(c echo.Context) string {
+	var (
+		once   sync.Once
+		routes map[string]bool
+	)
+
+	return func(c echo.Context) string {
+		// Routes are all registered once requests are served
+		once.Do(func() {
+			routes = make(map[string]bool)
+			for _, r := range e.Routes() {
+				routes[r.Path] = true
+			}
+		})
+
+		if route := c.Path(); routes[route] {
+			return route
+		}
+
+		return unmatchedRoute
+	}
+}
+
+// responseStatus returns the status of the response to c, given the error
+// returned by the next handler. Errors are only turned into responses by
+// the error handler, once every middleware has returned, unless a handler
+// already answered them.
+func responseStatus(c echo.Context, err error) int {
+	if err == nil || c.Response().Committed {
+		return c.Response().Status
+	}
+
+	return apierror.From(err).Status
+}
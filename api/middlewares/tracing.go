@@ -0,0 +1,56 @@
+package middlewares
+
+import (
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sthorer/api/tracing"
+)
+
+// Tracing starts a span for every request, continuing the trace of the
+// caller if any, and makes it the parent of the spans started by handlers
+// through the request context. It must run before the RequestID middleware:
+// requests without an ID get their trace ID as request ID, so logs and
+// traces can be matched.
+func Tracing(e *echo.Echo) echo.MiddlewareFunc {
+	route := routeOf(e)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			r := route(c)
+			ctx, span := tracing.Tracer().Start(ctx, req.Method+" "+r,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPMethodKey.String(req.Method),
+					semconv.HTTPRouteKey.String(r),
+					semconv.HTTPTargetKey.String(req.URL.Path),
+				),
+			)
+			defer span.End()
+
+			if sc := span.SpanContext(); sc.HasTraceID() && req.Header.Get(echo.HeaderXRequestID) == "" {
+				req.Header.Set(echo.HeaderXRequestID, sc.TraceID().String())
+			}
+			span.SetAttributes(attribute.String("http.request_id", req.Header.Get(echo.HeaderXRequestID)))
+
+			c.SetRequest(req.WithContext(ctx))
+			err := next(c)
+
+			status := responseStatus(c, err)
+			span.SetAttributes(semconv.HTTPStatusCodeKey.Int(status))
+			span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(status))
+			if err != nil {
+				span.RecordError(err)
+			}
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,4 @@
+package openapi
+
+// Operations exposes the documented routes to the tests of the package.
+var Operations = operations
@@ -0,0 +1,243 @@
+// Package openapi describes the routes of the API as an OpenAPI 3 document.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+)
+
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	In          string `json:"in,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+const (
+	bearerAuth = "bearerAuth"
+	basicAuth  = "basicAuth"
+	sigV4Auth  = "sigV4Auth"
+)
+
+// methods are the methods OpenAPI describes.
+var methods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodPut:     true,
+	http.MethodPost:    true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+	http.MethodHead:    true,
+	http.MethodPatch:   true,
+	http.MethodTrace:   true,
+}
+
+var pathParam = regexp.MustCompile(`:([^/]+)`)
+
+// notFoundHandler is the route name of echo's not found handler, named
+// like echo names routes.
+var notFoundHandler = runtime.FuncForPC(reflect.ValueOf(echo.NotFoundHandler).Pointer()).Name()
+
+// Generate describes every route registered on e. Routes are documented
+// with the operations declared in this package, or generically otherwise.
+func Generate(e *echo.Echo) *Document {
+	s := &schemas{components: make(map[string]*Schema)}
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    Info{Title: "Sthorer API", Version: "1.0.0"},
+		Paths:   make(map[string]map[string]*Operation),
+		Components: Components{
+			Schemas: s.components,
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer"},
+				basicAuth: {
+					Type:        "http",
+					Scheme:      "basic",
+					Description: "The email of the user and the secret of an API token. Read tokens are only allowed read requests, Write tokens the others",
+				},
+				sigV4Auth: {
+					Type:        "apiKey",
+					In:          "header",
+					Name:        echo.HeaderAuthorization,
+					Description: "AWS Signature Version 4 with an API token as access key, in the header or the query string",
+				},
+			},
+		},
+	}
+
+	errorSchema := s.of(reflect.TypeOf(types.ErrorResponse{}))
+
+	routes := e.Routes()
+	sort.Slice(routes, func(i, j int) bool {
+		return routes[i].Path < routes[j].Path || routes[i].Path == routes[j].Path && routes[i].Method < routes[j].Method
+	})
+
+	for _, r := range routes {
+		// Groups with middlewares register catch-all routes to echo's
+		// not found handler, which are not part of the API
+		if r.Name == notFoundHandler {
+			continue
+		}
+
+		// Methods such as PROPFIND cannot be described
+		if !methods[r.Method] {
+			continue
+		}
+
+		path := pathParam.ReplaceAllString(r.Path, "{$1}")
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*Operation)
+		}
+
+		desc := operations[r.Method+" "+r.Path]
+		if desc == nil {
+			desc = &operation{}
+		}
+
+		op := &Operation{
+			Summary:   desc.summary,
+			Tags:      []string{tag(r.Path)},
+			Responses: make(map[string]*Response),
+			Security:  security(r.Path),
+		}
+
+		for _, m := range pathParam.FindAllStringSubmatch(r.Path, -1) {
+			op.Parameters = append(op.Parameters, &Parameter{
+				Name:     m[1],
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+
+		if desc.request != nil {
+			t := reflect.TypeOf(desc.request)
+			if params := s.parameters(t); len(params) > 0 {
+				op.Parameters = append(op.Parameters, params...)
+			} else {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content:  map[string]*MediaType{echo.MIMEApplicationJSON: {Schema: s.of(t)}},
+				}
+			}
+		}
+
+		if desc.multipart {
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]*MediaType{echo.MIMEMultipartForm: {Schema: &Schema{
+					Type:                 "object",
+					AdditionalProperties: &Schema{Type: "string", Format: "binary"},
+				}}},
+			}
+		}
+
+		status := http.StatusOK
+		if desc.status != 0 {
+			status = desc.status
+		}
+
+		res := &Response{Description: http.StatusText(status)}
+		switch {
+		case desc.stream != "":
+			res.Content = map[string]*MediaType{desc.stream: {Schema: &Schema{Type: "string"}}}
+		case desc.response != nil:
+			res.Content = map[string]*MediaType{echo.MIMEApplicationJSON: {Schema: s.of(reflect.TypeOf(desc.response))}}
+		}
+		op.Responses[strconv.Itoa(status)] = res
+
+		op.Responses["default"] = &Response{
+			Description: "Error",
+			Content:     map[string]*MediaType{echo.MIMEApplicationJSON: {Schema: errorSchema}},
+		}
+		if strings.HasPrefix(r.Path, "/s3") {
+			// The S3 gateway answers S3 error documents
+			op.Responses["default"].Content = map[string]*MediaType{echo.MIMEApplicationXML: {Schema: &Schema{Type: "string"}}}
+		}
+
+		doc.Paths[path][strings.ToLower(r.Method)] = op
+	}
+
+	return doc
+}
+
+func tag(path string) string {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if parts[0] == "" {
+		return "default"
+	}
+
+	return parts[0]
+}
+
+// security mirrors the authentication middlewares applied in each package.
+func security(path string) []map[string][]string {
+	switch {
+	case strings.HasPrefix(path, "/user/"):
+		return []map[string][]string{{bearerAuth: {}}}
+	case strings.HasPrefix(path, "/files/"), strings.HasPrefix(path, "/folders"), strings.HasPrefix(path, "/dav"):
+		return []map[string][]string{{basicAuth: {}}}
+	case path == "/events":
+		return []map[string][]string{{bearerAuth: {}}, {basicAuth: {}}}
+	case strings.HasPrefix(path, "/s3"):
+		return []map[string][]string{{sigV4Auth: {}}}
+	default:
+		return nil
+	}
+}
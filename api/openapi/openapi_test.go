@@ -0,0 +1,59 @@
+package openapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/sthorer/api/api"
+	"github.com/sthorer/api/api/openapi"
+	"github.com/sthorer/api/config"
+)
+
+// generate describes the routes of the API with the default settings,
+// which enable every optional route.
+func generate() *openapi.Document {
+	return openapi.Generate(api.New(config.New(config.DefaultSettings())))
+}
+
+func TestOperationsDocumentEveryRoute(t *testing.T) {
+	// Routes are keyed like the operations, by method and echo path
+	routes := make(map[string]bool)
+	for path, ops := range generate().Paths {
+		path = strings.NewReplacer("{", ":", "}", "").Replace(path)
+		for method := range ops {
+			routes[strings.ToUpper(method)+" "+path] = true
+		}
+	}
+
+	for key := range routes {
+		if openapi.Operations[key] == nil {
+			t.Errorf("route %s has no operation", key)
+		}
+	}
+
+	for key := range openapi.Operations {
+		if !routes[key] {
+			t.Errorf("operation %s has no route", key)
+		}
+	}
+}
+
+func TestGenerateValidDocument(t *testing.T) {
+	raw, err := json.Marshal(generate())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := openapi3.NewLoader().LoadFromData(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := doc.Validate(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
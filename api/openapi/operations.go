@@ -0,0 +1,342 @@
+package openapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// operation documents a route. request is bound from the query string when
+// it has query tags, or from a JSON body otherwise.
+type operation struct {
+	summary   string
+	request   interface{}
+	response  interface{}
+	status    int
+	multipart bool
+	stream    string
+}
+
+// operations documents the routes, keyed by method and echo path.
+var operations = map[string]*operation{
+	"GET /": {
+		summary: "Check that the API is up",
+	},
+	"GET /metrics": {
+		summary: "Prometheus metrics",
+		stream:  "text/plain",
+	},
+	"GET /healthz": {
+		summary:  "Check that the process is alive",
+		response: types.HealthResponse{},
+	},
+	"GET /readyz": {
+		summary:  "Check the dependencies of the API, answers 503 when one is down",
+		response: types.ReadinessResponse{},
+	},
+	"GET /openapi.json": {
+		summary: "OpenAPI document of the API",
+		stream:  "application/json",
+	},
+	"GET /docs": {
+		summary: "API reference",
+		stream:  "text/html",
+	},
+
+	"POST /auth/login": {
+		summary:  "Log in and get a JWT",
+		request:  types.AuthRequest{},
+		response: types.AuthResponse{},
+	},
+	"POST /auth/register": {
+		summary:  "Create an account",
+		request:  types.AuthRequest{},
+		response: ent.User{},
+	},
+
+	"GET /user/me": {
+		summary:  "Current user",
+		response: ent.User{},
+	},
+	"GET /user/tokens": {
+		summary:  "List API tokens",
+		response: []*ent.Token{},
+	},
+	"POST /user/tokens/new": {
+		summary:  "Create an API token",
+		request:  types.NewTokenRequest{},
+		response: types.TokenSecretResponse{},
+	},
+	"GET /user/tokens/:id": {
+		summary:  "Get an API token",
+		response: ent.Token{},
+	},
+	"DELETE /user/tokens/:id": {
+		summary: "Revoke an API token",
+	},
+	"POST /user/tokens/:id/reset": {
+		summary:  "Replace the secret of an API token",
+		response: types.TokenSecretResponse{},
+	},
+	"GET /user/audit": {
+		summary:  "List audit events, or export them as JSON lines with format=jsonl",
+		request:  types.AuditLogsRequest{},
+		response: []*ent.AuditLog{},
+	},
+	"GET /user/webhooks": {
+		summary:  "List webhooks",
+		response: []*ent.Webhook{},
+	},
+	"POST /user/webhooks/new": {
+		summary:  "Create a webhook",
+		request:  types.NewWebhookRequest{},
+		response: types.WebhookSecretResponse{},
+	},
+	"GET /user/webhooks/:id": {
+		summary:  "Get a webhook",
+		response: ent.Webhook{},
+	},
+	"DELETE /user/webhooks/:id": {
+		summary: "Delete a webhook",
+	},
+	"GET /user/webhooks/:id/deliveries": {
+		summary:  "List the latest deliveries of a webhook",
+		response: []*ent.WebhookDelivery{},
+	},
+	"POST /user/webhooks/:id/test": {
+		summary:  "Send a ping event to a webhook",
+		response: ent.WebhookDelivery{},
+	},
+
+	"POST /files/upload": {
+		summary:   "Pin files, in the folder at the path form field if given, with the tags and metadata form fields. Their content type and media metadata are detected from their content. With the encryption form field, passphrase or kms, they are pinned encrypted with a key wrapped with the passphrase of the X-Encryption-Passphrase header or the master key of the API",
+		multipart: true,
+		response:  []*ent.File{},
+	},
+	"POST /files/presign": {
+		summary:  "Sign an upload URL, which files can be posted to without authentication until it expires, up to a total size, of the content types given, in the folder at path",
+		request:  types.PresignUploadRequest{},
+		response: types.PresignedUploadResponse{},
+	},
+	"POST /files/import/car": {
+		summary:   "Import the blocks of CAR files on the IPFS node and pin their roots, recording a file named after its CID for each root, in the folder at the path form field if given, with the tags and metadata form fields",
+		multipart: true,
+		response:  []*ent.File{},
+	},
+	"GET /files/search": {
+		summary:  "Search pinned files by tags, metadata, name, size, date, content type and media metadata, and by the words of their text with q",
+		request:  types.SearchFilesRequest{},
+		response: types.SearchFilesResponse{},
+	},
+	"PATCH /files/:id": {
+		summary:  "Replace the tags of a file and set its metadata, removing keys set to null",
+		request:  types.UpdateFileRequest{},
+		response: ent.File{},
+	},
+	"DELETE /files/:id": {
+		summary:  "Unpin a file",
+		response: ent.File{},
+	},
+	"POST /files/:id/move": {
+		summary:  "Move or rename a file, without pinning it again",
+		request:  types.PathRequest{},
+		response: types.FileResponse{},
+	},
+	"POST /files/:id/copy": {
+		summary:  "Copy a file, sharing its pinned content",
+		request:  types.PathRequest{},
+		response: types.FileResponse{},
+	},
+	"GET /files/:id/content": {
+		summary: "Download the content of a file, decrypted with the passphrase of the X-Encryption-Passphrase header or the master key of the API when encrypted, or a range of it",
+		stream:  "application/octet-stream",
+	},
+	"HEAD /files/:id/content": {
+		summary: "Check that the content of a file can be downloaded",
+	},
+	"GET /files/:id/car": {
+		summary: "Export the DAG of a file as a CAR file rooted at its CID",
+		stream:  "application/vnd.ipld.car",
+	},
+	"GET /files/:id/thumbnail": {
+		summary: "Download the thumbnail of an image fitting in size pixels, or the closest one",
+		request: types.ThumbnailRequest{},
+		stream:  "image/jpeg",
+	},
+	"HEAD /files/:id/thumbnail": {
+		summary: "Check that an image has a thumbnail",
+		request: types.ThumbnailRequest{},
+	},
+	"GET /files/:id/shares": {
+		summary:  "List the share links of a file, latest first",
+		response: []*types.ShareResponse{},
+	},
+	"POST /files/:id/shares": {
+		summary:  "Create a public link to a file, with an optional password, expiry and download limit",
+		request:  types.NewShareRequest{},
+		response: types.ShareResponse{},
+	},
+	"DELETE /files/:id/shares/:share": {
+		summary:  "Revoke a share link",
+		response: types.ShareResponse{},
+	},
+
+	"POST /uploads": {
+		summary:   "Pin files posted to a pre-signed upload URL, with the tags and metadata form fields",
+		multipart: true,
+		response:  []*ent.File{},
+	},
+
+	"GET /shares/:slug": {
+		summary: "Download the file of a share link, with its password as the basic authentication password when protected",
+		stream:  "application/octet-stream",
+	},
+	"HEAD /shares/:slug": {
+		summary: "Check that a share link works",
+	},
+
+	"GET /folders": {
+		summary:  "List the content of the folder at a path, the root by default",
+		request:  types.FolderContentsRequest{},
+		response: types.FolderContentsResponse{},
+	},
+	"POST /folders": {
+		summary:  "Create a folder and its missing parents",
+		request:  types.NewFolderRequest{},
+		response: types.FolderResponse{},
+	},
+	"GET /folders/:id": {
+		summary:  "Get a folder",
+		response: types.FolderResponse{},
+	},
+	"GET /folders/:id/contents": {
+		summary:  "List the content of a folder, folders first",
+		request:  types.FolderPageRequest{},
+		response: types.FolderContentsResponse{},
+	},
+	"POST /folders/:id/move": {
+		summary:  "Move or rename a folder and its content",
+		request:  types.PathRequest{},
+		response: types.FolderResponse{},
+	},
+	"POST /folders/:id/copy": {
+		summary:  "Copy a folder and its content, sharing the pinned content of its files",
+		request:  types.PathRequest{},
+		response: types.FolderResponse{},
+	},
+	"DELETE /folders/:id": {
+		summary: "Delete an empty folder, or unpin its files and delete its content with recursive=true",
+		request: types.DeleteFolderRequest{},
+	},
+
+	"GET /s3": {
+		summary: "S3 ListBuckets",
+		stream:  echo.MIMEApplicationXML,
+	},
+	"GET /s3/": {
+		summary: "S3 ListBuckets",
+		stream:  echo.MIMEApplicationXML,
+	},
+	"GET /s3/:bucket": {
+		summary: "S3 ListObjects, ListObjectsV2 with list-type=2 or GetBucketLocation with location",
+		stream:  echo.MIMEApplicationXML,
+	},
+	"HEAD /s3/:bucket": {
+		summary: "S3 HeadBucket",
+	},
+	"PUT /s3/:bucket": {
+		summary: "S3 CreateBucket",
+	},
+	"DELETE /s3/:bucket": {
+		summary: "S3 DeleteBucket, the bucket must be empty",
+		status:  http.StatusNoContent,
+	},
+	"POST /s3/:bucket": {
+		summary: "S3 DeleteObjects with delete",
+		stream:  echo.MIMEApplicationXML,
+	},
+	"GET /s3/:bucket/*": {
+		summary: "S3 GetObject, with support for single byte ranges, or ListParts with uploadId",
+		stream:  echo.MIMEOctetStream,
+	},
+	"HEAD /s3/:bucket/*": {
+		summary: "S3 HeadObject",
+	},
+	"PUT /s3/:bucket/*": {
+		summary: "S3 PutObject, CopyObject with x-amz-copy-source or UploadPart with partNumber and uploadId",
+	},
+	"DELETE /s3/:bucket/*": {
+		summary: "S3 DeleteObject or AbortMultipartUpload with uploadId",
+		status:  http.StatusNoContent,
+	},
+	"POST /s3/:bucket/*": {
+		summary: "S3 CreateMultipartUpload with uploads or CompleteMultipartUpload with uploadId",
+		stream:  echo.MIMEApplicationXML,
+	},
+
+	"OPTIONS /dav": {
+		summary: "WebDAV capabilities",
+	},
+	"OPTIONS /dav/": {
+		summary: "WebDAV capabilities",
+	},
+	"OPTIONS /dav/*": {
+		summary: "WebDAV capabilities",
+	},
+	"GET /dav": {
+		summary: "Download the root folder of the user, which is not allowed",
+	},
+	"GET /dav/": {
+		summary: "Download the root folder of the user, which is not allowed",
+	},
+	"GET /dav/*": {
+		summary: "Download a file of the tree of the user, with support for byte ranges",
+		stream:  echo.MIMEOctetStream,
+	},
+	"HEAD /dav": {
+		summary: "Describe the root folder of the user",
+	},
+	"HEAD /dav/": {
+		summary: "Describe the root folder of the user",
+	},
+	"HEAD /dav/*": {
+		summary: "Describe a file of the tree of the user",
+	},
+	"PUT /dav": {
+		summary: "Pin a file at the root folder of the user, which is not allowed",
+	},
+	"PUT /dav/": {
+		summary: "Pin a file at the root folder of the user, which is not allowed",
+	},
+	"PUT /dav/*": {
+		summary: "Pin a file at a path of the tree of the user, replacing the previous one",
+		status:  http.StatusCreated,
+	},
+	"DELETE /dav": {
+		summary: "Delete the root folder of the user, which is not allowed",
+	},
+	"DELETE /dav/": {
+		summary: "Delete the root folder of the user, which is not allowed",
+	},
+	"DELETE /dav/*": {
+		summary: "Unpin a file, or the files of a folder before deleting it",
+		status:  http.StatusNoContent,
+	},
+	"POST /dav": {
+		summary: "WebDAV methods echo does not route: MKCOL, COPY, MOVE, LOCK, UNLOCK and PROPPATCH",
+	},
+	"POST /dav/": {
+		summary: "WebDAV methods echo does not route: MKCOL, COPY, MOVE, LOCK, UNLOCK and PROPPATCH",
+	},
+	"POST /dav/*": {
+		summary: "WebDAV methods echo does not route: MKCOL, COPY, MOVE, LOCK, UNLOCK and PROPPATCH",
+	},
+
+	"GET /events": {
+		summary: "Stream pin, upload progress and quota events",
+		stream:  "text/event-stream",
+	},
+}
@@ -0,0 +1,43 @@
+package openapi
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+const docsPage = `<!DOCTYPE html>
+<html>
+  <head>
+    <title>Sthorer API</title>
+    <meta charset="utf-8"/>
+    <meta name="viewport" content="width=device-width, initial-scale=1">
+  </head>
+  <body>
+    <redoc spec-url="/openapi.json"></redoc>
+    <script src="https://cdn.jsdelivr.net/npm/redoc@2/bundles/redoc.standalone.js"></script>
+  </body>
+</html>
+`
+
+// Apply serves the OpenAPI document and its reference page. It must be
+// called once every other route is registered.
+func Apply(e *echo.Echo) {
+	var (
+		once sync.Once
+		doc  *Document
+	)
+
+	e.GET("/openapi.json", func(c echo.Context) error {
+		once.Do(func() {
+			doc = Generate(e)
+		})
+
+		return c.JSON(http.StatusOK, doc)
+	})
+
+	e.GET("/docs", func(c echo.Context) error {
+		return c.HTML(http.StatusOK, docsPage)
+	})
+}
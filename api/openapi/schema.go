@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent/migrate"
+)
+
+// Schema is an OpenAPI schema object.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+)
+
+// schemas builds component schemas from Go types, following their json tags
+// the way encoding/json does.
+type schemas struct {
+	components map[string]*Schema
+}
+
+func (s *schemas) of(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case uuidType:
+		return &Schema{Type: "string", Format: "uuid"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := s.of(t.Elem())
+		if schema.Ref == "" {
+			schema.Nullable = true
+		}
+		return schema
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string", Enum: enumValues(t)}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: true}
+	case reflect.Struct:
+		return s.ref(t)
+	default:
+		return &Schema{}
+	}
+}
+
+// ref registers the schema of struct t as a component and references it.
+func (s *schemas) ref(t reflect.Type) *Schema {
+	name := t.Name()
+	if _, ok := s.components[name]; !ok {
+		// Registered before walking fields to stop on recursive types
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		s.components[name] = schema
+		s.fields(t, schema)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+func (s *schemas) fields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts := parseTag(f.Tag.Get("json"))
+		if name == "-" {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		// Embedded structs without a name are inlined, like encoding/json does
+		if f.Anonymous && name == "" && ft.Kind() == reflect.Struct {
+			s.fields(ft, schema)
+			continue
+		}
+
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+
+		schema.Properties[name] = s.of(f.Type)
+		if !strings.Contains(opts, "omitempty") && f.Type.Kind() != reflect.Ptr || strings.Contains(f.Tag.Get("validate"), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// parameters describes the fields of t bound from the query string.
+func (s *schemas) parameters(t reflect.Type) []*Parameter {
+	var params []*Parameter
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Tag.Get("query")
+		if name == "" {
+			continue
+		}
+
+		params = append(params, &Parameter{
+			Name:     name,
+			In:       "query",
+			Required: strings.Contains(f.Tag.Get("validate"), "required"),
+			Schema:   s.of(f.Type),
+		})
+	}
+
+	return params
+}
+
+// enumValues returns the values of ent enum types, as declared in the
+// migration schema.
+func enumValues(t reflect.Type) []string {
+	if !strings.HasPrefix(t.PkgPath(), "github.com/sthorer/api/ent/") {
+		return nil
+	}
+
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	for _, table := range migrate.Tables {
+		if !strings.HasPrefix(strings.ReplaceAll(table.Name, "_", ""), pkg[:len(pkg)-1]) {
+			continue
+		}
+
+		for _, c := range table.Columns {
+			if len(c.Enums) > 0 && strings.EqualFold(strings.ReplaceAll(c.Name, "_", ""), t.Name()) {
+				return c.Enums
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseTag(tag string) (string, string) {
+	if i := strings.Index(tag, ","); i >= 0 {
+		return tag[:i], tag[i+1:]
+	}
+
+	return tag, ""
+}
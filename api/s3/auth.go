@@ -0,0 +1,423 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/middlewares"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/token"
+	"github.com/sthorer/api/metrics"
+)
+
+// Requests are authenticated with AWS Signature Version 4, in the
+// Authorization header or in the query string of pre-signed URLs. The
+// access key ID is the ID of an API token and the secret access key its
+// secret.
+
+const (
+	algorithm = "AWS4-HMAC-SHA256"
+	service   = "s3"
+	terminal  = "aws4_request"
+
+	amzDateFormat = "20060102T150405Z"
+	dateFormat    = "20060102"
+
+	unsignedPayload          = "UNSIGNED-PAYLOAD"
+	streamingPayload         = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	streamingUnsignedTrailer = "STREAMING-UNSIGNED-PAYLOAD-TRAILER"
+	emptySHA256              = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	// maxSkew bounds the difference between the request and server times
+	maxSkew = 15 * time.Minute
+
+	// maxExpires bounds the lifetime of pre-signed URLs
+	maxExpires = 7 * 24 * time.Hour
+)
+
+// signature holds the parsed authentication of a request.
+type signature struct {
+	accessKey     string
+	date          string
+	region        string
+	amzDate       string
+	time          time.Time
+	signedHeaders []string
+	signature     string
+	payload       string
+
+	// expires is the lifetime of pre-signed URLs, zero otherwise
+	expires time.Duration
+}
+
+// signs tells whether header is one of the signed headers of s.
+func (s *signature) signs(header string) bool {
+	for _, name := range s.signedHeaders {
+		if name == header {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *signature) scope() string {
+	return strings.Join([]string{s.date, s.region, service, terminal}, "/")
+}
+
+// Authenticate verifies the signature of requests made for region and
+// authenticates them with the signing API token.
+func Authenticate(region string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			cc := c.(*types.Context)
+			req := c.Request()
+
+			var (
+				sig *signature
+				err error
+			)
+			switch {
+			case req.URL.Query().Get("X-Amz-Algorithm") != "":
+				sig, err = parsePresigned(req.URL.Query())
+			case req.Header.Get(echo.HeaderAuthorization) != "":
+				sig, err = parseAuthorization(req)
+			default:
+				return errAccessDenied
+			}
+			if err != nil {
+				return err
+			}
+
+			// Signatures not covering the host could be replayed against
+			// other hosts
+			if !sig.signs("host") {
+				return newError(http.StatusBadRequest, errMalformedAuth.Code, "The authorization is malformed; the signed headers must include host")
+			}
+
+			if sig.region != region {
+				return newError(http.StatusBadRequest, errMalformedAuth.Code, fmt.Sprintf("The authorization header is malformed; the region '%s' is wrong; expecting '%s'", sig.region, region))
+			}
+
+			now := time.Now()
+			if sig.expires > 0 {
+				if now.Before(sig.time.Add(-maxSkew)) || now.After(sig.time.Add(sig.expires)) {
+					return errExpiredRequest
+				}
+			} else if now.Sub(sig.time) > maxSkew || sig.time.Sub(now) > maxSkew {
+				return errTimeTooSkewed
+			}
+
+			ctx := req.Context()
+			t, err := signingToken(cc, sig.accessKey)
+			if err != nil {
+				return err
+			}
+
+			key := signingKey(t.Secret, sig.date, sig.region)
+			expected := hex.EncodeToString(hmacSHA256(key, stringToSign(sig, canonicalRequest(req, sig))))
+			if !hmac.Equal([]byte(expected), []byte(sig.signature)) {
+				metrics.AuthFailures.WithLabelValues(metrics.AuthSigV4).Inc()
+				return errSignatureMismatch
+			}
+
+			if !middlewares.TokenAllows(t, req.Method) {
+				return errAccessDenied
+			}
+
+			switch sig.payload {
+			case unsignedPayload:
+			case streamingPayload:
+				req.Body = newChunkedReader(req.Body, &chunkSigner{key: key, sig: sig, previous: sig.signature})
+			case streamingUnsignedTrailer:
+				req.Body = newChunkedReader(req.Body, nil)
+			default:
+				expected, err := hex.DecodeString(sig.payload)
+				if err != nil || len(expected) != sha256.Size {
+					return errNotImplemented
+				}
+				req.Body = &verifiedBody{ReadCloser: req.Body, hash: sha256.New(), expected: expected}
+			}
+
+			if err = t.Update().SetLastUsed(now).Exec(ctx); err != nil {
+				return err
+			}
+
+			cc.Set(types.TokenKey, t)
+			cc.Set(types.UserKey, t.Edges.User)
+
+			return next(c)
+		}
+	}
+}
+
+func signingToken(cc *types.Context, accessKey string) (*ent.Token, error) {
+	id, err := uuid.Parse(accessKey)
+	if err != nil {
+		metrics.AuthFailures.WithLabelValues(metrics.AuthSigV4).Inc()
+		return nil, errInvalidAccessKeyID
+	}
+
+	t, err := cc.Client.Token.
+		Query().
+		Where(token.ID(id)).
+		WithUser().
+		Only(cc.Request().Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			metrics.AuthFailures.WithLabelValues(metrics.AuthSigV4).Inc()
+			return nil, errInvalidAccessKeyID
+		}
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// parseAuthorization parses headers such as:
+//
+//	Authorization: AWS4-HMAC-SHA256 Credential=AKID/20200101/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-date, Signature=...
+func parseAuthorization(req *http.Request) (*signature, error) {
+	auth := req.Header.Get(echo.HeaderAuthorization)
+	if !strings.HasPrefix(auth, algorithm+" ") {
+		return nil, errUnsupportedAlgorithm
+	}
+
+	sig := &signature{payload: req.Header.Get("X-Amz-Content-Sha256")}
+	for _, part := range strings.Split(strings.TrimPrefix(auth, algorithm+" "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+		if len(kv) != 2 {
+			return nil, errMalformedAuth
+		}
+
+		switch kv[0] {
+		case "Credential":
+			if err := sig.parseCredential(kv[1]); err != nil {
+				return nil, err
+			}
+		case "SignedHeaders":
+			sig.signedHeaders = strings.Split(kv[1], ";")
+		case "Signature":
+			sig.signature = kv[1]
+		}
+	}
+
+	if sig.accessKey == "" || len(sig.signedHeaders) == 0 || sig.signature == "" {
+		return nil, errMalformedAuth
+	}
+
+	if sig.payload == "" {
+		return nil, errMissingSecurityHeader
+	}
+
+	sig.amzDate = req.Header.Get("X-Amz-Date")
+	if sig.amzDate == "" {
+		// The Date header is only used without X-Amz-Date
+		date, err := http.ParseTime(req.Header.Get("Date"))
+		if err != nil {
+			return nil, errMissingSecurityHeader
+		}
+		sig.amzDate = date.UTC().Format(amzDateFormat)
+	}
+
+	return sig, sig.parseTime()
+}
+
+// parsePresigned parses the authentication of pre-signed URLs, given in
+// their query string.
+func parsePresigned(query url.Values) (*signature, error) {
+	if query.Get("X-Amz-Algorithm") != algorithm {
+		return nil, errUnsupportedAlgorithm
+	}
+
+	sig := &signature{
+		amzDate:       query.Get("X-Amz-Date"),
+		signedHeaders: strings.Split(query.Get("X-Amz-SignedHeaders"), ";"),
+		signature:     query.Get("X-Amz-Signature"),
+		payload:       unsignedPayload,
+	}
+
+	if err := sig.parseCredential(query.Get("X-Amz-Credential")); err != nil {
+		return nil, err
+	}
+
+	expires, err := strconv.Atoi(query.Get("X-Amz-Expires"))
+	if err != nil || expires <= 0 || time.Duration(expires)*time.Second > maxExpires {
+		return nil, errMalformedAuth
+	}
+	sig.expires = time.Duration(expires) * time.Second
+
+	if sig.signature == "" {
+		return nil, errMalformedAuth
+	}
+
+	if sig.amzDate == "" {
+		return nil, errMissingSecurityHeader
+	}
+
+	return sig, sig.parseTime()
+}
+
+func (s *signature) parseCredential(credential string) error {
+	parts := strings.Split(credential, "/")
+	if len(parts) != 5 || parts[3] != service || parts[4] != terminal {
+		return errMalformedAuth
+	}
+
+	s.accessKey, s.date, s.region = parts[0], parts[1], parts[2]
+	return nil
+}
+
+func (s *signature) parseTime() error {
+	t, err := time.Parse(amzDateFormat, s.amzDate)
+	if err != nil || t.Format(dateFormat) != s.date {
+		return errMalformedAuth
+	}
+
+	s.time = t
+	return nil
+}
+
+func canonicalRequest(req *http.Request, sig *signature) string {
+	headers := make([]string, 0, len(sig.signedHeaders))
+	for _, name := range sig.signedHeaders {
+		headers = append(headers, name+":"+headerValue(req, name)+"\n")
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		uriEncode(req.URL.Path, false),
+		canonicalQuery(req.URL.Query(), sig.expires > 0),
+		strings.Join(headers, ""),
+		strings.Join(sig.signedHeaders, ";"),
+		sig.payload,
+	}, "\n")
+}
+
+func headerValue(req *http.Request, name string) string {
+	switch name {
+	case "host":
+		return req.Host
+	case "content-length":
+		if req.Header.Get("Content-Length") == "" && req.ContentLength >= 0 {
+			return strconv.FormatInt(req.ContentLength, 10)
+		}
+	case "transfer-encoding":
+		return strings.Join(req.TransferEncoding, ",")
+	}
+
+	values := req.Header.Values(name)
+	for i, v := range values {
+		values[i] = strings.Join(strings.Fields(v), " ")
+	}
+
+	return strings.Join(values, ",")
+}
+
+// canonicalQuery sorts and encodes the query parameters. The signature of
+// pre-signed URLs is not part of what it signs.
+func canonicalQuery(query url.Values, presigned bool) string {
+	var params []string
+	for key, values := range query {
+		if presigned && key == "X-Amz-Signature" {
+			continue
+		}
+
+		for _, v := range values {
+			params = append(params, uriEncode(key, true)+"="+uriEncode(v, true))
+		}
+	}
+
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// uriEncode percent-encodes every byte of s but the unreserved characters
+// of RFC 3986, and slashes unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func stringToSign(sig *signature, canonicalRequest string) string {
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	return strings.Join([]string{algorithm, sig.amzDate, sig.scope(), hex.EncodeToString(sum[:])}, "\n")
+}
+
+func signingKey(secret, date, region string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secret), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, service)
+	return hmacSHA256(key, terminal)
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// verifiedBody checks the body matches the SHA-256 hash of its signature
+// once entirely read.
+type verifiedBody struct {
+	io.ReadCloser
+	hash     hash.Hash
+	expected []byte
+	err      error
+}
+
+func (b *verifiedBody) Read(p []byte) (int, error) {
+	if b.err != nil {
+		return 0, b.err
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.hash.Write(p[:n])
+	if err == io.EOF && !hmac.Equal(b.hash.Sum(nil), b.expected) {
+		b.err = errContentSHA256Mismatch
+		return n, b.err
+	}
+
+	return n, err
+}
+
+func (b *verifiedBody) Err() error {
+	return b.err
+}
+
+// payloadError returns the error found verifying the body of req, if any.
+// The body is read by the IPFS client, which does not tell why it failed.
+func payloadError(req *http.Request) error {
+	if body, ok := req.Body.(interface{ Err() error }); ok {
+		return body.Err()
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package s3
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+const (
+	// timeFormat is the format of times in S3 documents
+	timeFormat = "2006-01-02T15:04:05.000Z"
+
+	maxListKeys      = 1000
+	maxDeleteObjects = 1000
+
+	storageClass = "STANDARD"
+)
+
+var bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+func listBuckets(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	buckets, err := cc.Client.UserBuckets(cc.Request().Context(), u)
+	if err != nil {
+		return err
+	}
+
+	res := &types.S3ListBucketsResponse{
+		Xmlns:   types.S3Namespace,
+		Owner:   owner(u),
+		Buckets: make([]types.S3Bucket, 0, len(buckets)),
+	}
+	for _, b := range buckets {
+		res.Buckets = append(res.Buckets, types.S3Bucket{
+			Name:         b.Name,
+			CreationDate: b.CreatedAt.UTC().Format(timeFormat),
+		})
+	}
+
+	return cc.XML(http.StatusOK, res)
+}
+
+func createBucket(cc *types.Context, name string) error {
+	if !bucketName.MatchString(name) {
+		return errInvalidBucketName
+	}
+
+	// The location constraint of the body, if any, is ignored: buckets
+	// live where the API does
+	u := cc.Get(types.UserKey).(*ent.User)
+	if _, err := cc.Client.NewBucket(cc.Request().Context(), u, name); err != nil {
+		if ent.IsConstraintError(err) {
+			return errBucketAlreadyOwned
+		}
+		return err
+	}
+
+	cc.Response().Header().Set("Location", "/"+name)
+	return cc.NoContent(http.StatusOK)
+}
+
+func deleteBucket(cc *types.Context, b *ent.Bucket) error {
+	empty, err := cc.Client.BucketEmpty(cc.Request().Context(), b)
+	if err != nil {
+		return err
+	}
+
+	if !empty {
+		return errBucketNotEmpty
+	}
+
+	if err := cc.Client.DeleteBucket(cc.Request().Context(), b); err != nil {
+		return err
+	}
+
+	return cc.NoContent(http.StatusNoContent)
+}
+
+func bucketLocation(cc *types.Context) error {
+	return cc.XML(http.StatusOK, &types.S3LocationResponse{
+		Xmlns:    types.S3Namespace,
+		Location: cc.S3.Region,
+	})
+}
+
+// listObjects answers both versions of ListObjects. Keys sharing a prefix
+// up to the delimiter are grouped in a common prefix, which counts as one
+// key.
+func listObjects(cc *types.Context, b *ent.Bucket) error {
+	query := cc.QueryParams()
+	v2 := query.Get("list-type") == "2"
+	prefix, delimiter := query.Get("prefix"), query.Get("delimiter")
+
+	maxKeys := maxListKeys
+	if raw := query.Get("max-keys"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return newError(http.StatusBadRequest, "InvalidArgument", "max-keys must be a positive integer.")
+		}
+		if n < maxKeys {
+			maxKeys = n
+		}
+	}
+
+	encoding := query.Get("encoding-type")
+	if encoding != "" && encoding != "url" {
+		return newError(http.StatusBadRequest, "InvalidArgument", "Invalid Encoding Method specified in Request.")
+	}
+
+	// after is the key listed objects are greater than
+	var after string
+	if v2 {
+		after = query.Get("start-after")
+		if token := query.Get("continuation-token"); token != "" {
+			raw, err := base64.RawURLEncoding.DecodeString(token)
+			if err != nil {
+				return newError(http.StatusBadRequest, "InvalidArgument", "The continuation token provided is incorrect.")
+			}
+			after = string(raw)
+		}
+	} else {
+		after = query.Get("marker")
+	}
+	marker := after
+
+	res := &types.S3ListObjectsResponse{
+		Xmlns:     types.S3Namespace,
+		Name:      b.Name,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+
+	var (
+		count int
+		last  string
+		done  = maxKeys == 0
+	)
+	for !done {
+		batch, err := cc.Client.ListObjects(cc.Request().Context(), b, prefix, after, maxKeys-count+1)
+		if err != nil {
+			return err
+		}
+
+		done = len(batch) < maxKeys-count+1
+		grouped := false
+		for _, f := range batch {
+			if !strings.HasPrefix(f.Key, prefix) {
+				// Keys are sorted: no other one has the prefix
+				done = true
+				break
+			}
+
+			if count == maxKeys {
+				res.IsTruncated = true
+				done = true
+				break
+			}
+
+			if i := strings.Index(f.Key[len(prefix):], delimiter); delimiter != "" && i != -1 {
+				common := f.Key[:len(prefix)+i+len(delimiter)]
+
+				// Skip the keys the prefix groups, which may only be
+				// listed by a following request
+				after = common + string(utf8.MaxRune)
+				grouped = true
+				if common > marker {
+					res.CommonPrefixes = append(res.CommonPrefixes, types.S3CommonPrefix{Prefix: common})
+					count++
+					last = common
+				}
+				break
+			}
+
+			res.Contents = append(res.Contents, types.S3Object{
+				Key:          f.Key,
+				LastModified: f.PinnedAt.UTC().Format(timeFormat),
+				ETag:         etag(f),
+				Size:         f.Size,
+				StorageClass: storageClass,
+			})
+			count++
+			last = f.Key
+			after = f.Key
+		}
+
+		if grouped {
+			done = false
+		}
+	}
+
+	if v2 {
+		res.KeyCount = &count
+		res.StartAfter = query.Get("start-after")
+		res.ContinuationToken = query.Get("continuation-token")
+		if res.IsTruncated {
+			res.NextContinuationToken = base64.RawURLEncoding.EncodeToString([]byte(after))
+		}
+	} else {
+		m := query.Get("marker")
+		res.Marker = &m
+		if res.IsTruncated {
+			res.NextMarker = last
+		}
+	}
+
+	if encoding == "url" {
+		res.EncodingType = encoding
+		encodeKeys(res)
+	}
+
+	return cc.XML(http.StatusOK, res)
+}
+
+// encodeKeys URL-encodes the keys of res, as requested by clients which
+// cannot parse every character in XML.
+func encodeKeys(res *types.S3ListObjectsResponse) {
+	res.Prefix = url.QueryEscape(res.Prefix)
+	res.Delimiter = url.QueryEscape(res.Delimiter)
+	res.StartAfter = url.QueryEscape(res.StartAfter)
+	res.NextMarker = url.QueryEscape(res.NextMarker)
+	if res.Marker != nil {
+		m := url.QueryEscape(*res.Marker)
+		res.Marker = &m
+	}
+
+	for i := range res.Contents {
+		res.Contents[i].Key = url.QueryEscape(res.Contents[i].Key)
+	}
+
+	for i := range res.CommonPrefixes {
+		res.CommonPrefixes[i].Prefix = url.QueryEscape(res.CommonPrefixes[i].Prefix)
+	}
+}
+
+func deleteObjects(cc *types.Context, b *ent.Bucket) error {
+	var body types.S3DeleteObjectsRequest
+	if err := xml.NewDecoder(io.LimitReader(cc.Request().Body, 2<<20)).Decode(&body); err != nil {
+		return errMalformedXML
+	}
+
+	if len(body.Objects) == 0 || len(body.Objects) > maxDeleteObjects {
+		return errMalformedXML
+	}
+
+	res := &types.S3DeleteObjectsResponse{Xmlns: types.S3Namespace}
+	for _, o := range body.Objects {
+		if err := removeObject(cc, b, o.Key); err != nil {
+			e := fromError(err)
+			cc.Log().Error("failed to delete object", "bucket", b.Name, "key", o.Key, "error", err)
+			res.Errors = append(res.Errors, types.S3DeleteError{Key: o.Key, Code: e.Code, Message: e.Message})
+			continue
+		}
+
+		if !body.Quiet {
+			res.Deleted = append(res.Deleted, types.S3DeletedObject{Key: o.Key})
+		}
+	}
+
+	if payload := payloadError(cc.Request()); payload != nil {
+		return payload
+	}
+
+	return cc.XML(http.StatusOK, res)
+}
+
+func userBucket(cc *types.Context, name string) (*ent.Bucket, error) {
+	b, err := cc.Client.UserBucket(cc.Request().Context(), cc.Get(types.UserKey).(*ent.User), name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errNoSuchBucket
+		}
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func owner(u *ent.User) types.S3Owner {
+	return types.S3Owner{ID: strconv.Itoa(u.ID), DisplayName: u.Email}
+}
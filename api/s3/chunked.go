@@ -0,0 +1,208 @@
+package s3
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// maxChunkHeader bounds the length of the header line of a chunk.
+const maxChunkHeader = 4096
+
+// chunkSigner verifies the signature of each chunk of streaming uploads,
+// chained from the signature of the request.
+type chunkSigner struct {
+	key      []byte
+	sig      *signature
+	previous string
+}
+
+func (s *chunkSigner) verify(signature string, sum []byte) error {
+	toSign := strings.Join([]string{
+		algorithm + "-PAYLOAD",
+		s.sig.amzDate,
+		s.sig.scope(),
+		s.previous,
+		emptySHA256,
+		hex.EncodeToString(sum),
+	}, "\n")
+
+	expected := hex.EncodeToString(hmacSHA256(s.key, toSign))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errSignatureMismatch
+	}
+
+	s.previous = signature
+	return nil
+}
+
+// chunkedReader decodes bodies in the aws-chunked encoding, made of chunks
+// each preceded by their hexadecimal size and, when signed, signature:
+//
+//	400;chunk-signature=...\r\n<1024 bytes>\r\n0;chunk-signature=...\r\n\r\n
+//
+// Trailing headers following the last chunk are ignored.
+type chunkedReader struct {
+	r      *bufio.Reader
+	body   io.Closer
+	signer *chunkSigner
+
+	// signature and hash of the current chunk, of which remaining bytes
+	// are still to be read
+	signature string
+	hash      hash.Hash
+	remaining int64
+
+	started bool
+	done    bool
+	err     error
+}
+
+func newChunkedReader(body io.ReadCloser, signer *chunkSigner) *chunkedReader {
+	return &chunkedReader{
+		r:      bufio.NewReader(body),
+		body:   body,
+		signer: signer,
+		hash:   sha256.New(),
+	}
+}
+
+func (r *chunkedReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	for r.remaining == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		if err := r.next(); err != nil {
+			r.err = err
+			return 0, err
+		}
+	}
+
+	if int64(len(p)) > r.remaining {
+		p = p[:r.remaining]
+	}
+
+	n, err := r.r.Read(p)
+	r.remaining -= int64(n)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		err = errIncompleteBody
+	}
+	if err != nil {
+		r.err = err
+	}
+
+	return n, err
+}
+
+// next ends the current chunk and starts the next one.
+func (r *chunkedReader) next() error {
+	if r.started {
+		if err := r.expectCRLF(); err != nil {
+			return err
+		}
+
+		if err := r.verify(); err != nil {
+			return err
+		}
+	}
+	r.started = true
+
+	line, err := r.line()
+	if err != nil {
+		return err
+	}
+
+	header := strings.SplitN(line, ";", 2)
+	size, err := strconv.ParseInt(header[0], 16, 64)
+	if err != nil || size < 0 {
+		return errIncompleteBody
+	}
+
+	r.signature = ""
+	if len(header) == 2 {
+		r.signature = strings.TrimPrefix(header[1], "chunk-signature=")
+	}
+	r.hash.Reset()
+	r.remaining = size
+
+	if size > 0 {
+		return nil
+	}
+
+	// The last chunk is empty, and followed by optional trailing headers
+	// and an empty line
+	if err := r.verify(); err != nil {
+		return err
+	}
+
+	for {
+		line, err := r.line()
+		if err != nil {
+			return err
+		}
+
+		if line == "" {
+			r.done = true
+			return nil
+		}
+	}
+}
+
+func (r *chunkedReader) verify() error {
+	if r.signer == nil {
+		return nil
+	}
+
+	return r.signer.verify(r.signature, r.hash.Sum(nil))
+}
+
+func (r *chunkedReader) line() (string, error) {
+	var line []byte
+	for {
+		part, isPrefix, err := r.r.ReadLine()
+		if err != nil {
+			return "", errIncompleteBody
+		}
+
+		line = append(line, part...)
+		if len(line) > maxChunkHeader {
+			return "", errIncompleteBody
+		}
+
+		if !isPrefix {
+			return string(line), nil
+		}
+	}
+}
+
+func (r *chunkedReader) expectCRLF() error {
+	line, err := r.line()
+	if err != nil {
+		return err
+	}
+
+	if line != "" {
+		return errIncompleteBody
+	}
+
+	return nil
+}
+
+func (r *chunkedReader) Err() error {
+	return r.err
+}
+
+func (r *chunkedReader) Close() error {
+	return r.body.Close()
+}
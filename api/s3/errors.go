@@ -0,0 +1,136 @@
+package s3
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+)
+
+// Error is an error answered with an S3 error code.
+type Error struct {
+	Status   int
+	Code     string
+	Message  string
+	Internal error
+}
+
+func (e *Error) Error() string {
+	if e.Internal != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Internal)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Internal
+}
+
+func newError(status int, code, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+var (
+	errAccessDenied          = newError(http.StatusForbidden, "AccessDenied", "Access Denied.")
+	errBadDigest             = newError(http.StatusBadRequest, "BadDigest", "The Content-MD5 you specified did not match what we received.")
+	errBucketAlreadyOwned    = newError(http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.")
+	errBucketNotEmpty        = newError(http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty.")
+	errContentSHA256Mismatch = newError(http.StatusBadRequest, "XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed.")
+	errExpiredRequest        = newError(http.StatusForbidden, "AccessDenied", "Request has expired.")
+	errIncompleteBody        = newError(http.StatusBadRequest, "IncompleteBody", "You did not provide the number of bytes specified by the Content-Length HTTP header.")
+	errInvalidAccessKeyID    = newError(http.StatusForbidden, "InvalidAccessKeyId", "The access key ID you provided does not exist in our records.")
+	errInvalidBucketName     = newError(http.StatusBadRequest, "InvalidBucketName", "The specified bucket is not valid.")
+	errInvalidDigest         = newError(http.StatusBadRequest, "InvalidDigest", "The Content-MD5 you specified is not valid.")
+	errInvalidPart           = newError(http.StatusBadRequest, "InvalidPart", "One or more of the specified parts could not be found.")
+	errInvalidPartOrder      = newError(http.StatusBadRequest, "InvalidPartOrder", "The list of parts was not in ascending order.")
+	errInvalidRange          = newError(http.StatusRequestedRangeNotSatisfiable, "InvalidRange", "The requested range is not satisfiable.")
+	errKeyTooLong            = newError(http.StatusBadRequest, "KeyTooLongError", "Your key is too long.")
+	errMalformedAuth         = newError(http.StatusBadRequest, "AuthorizationHeaderMalformed", "The authorization header is malformed.")
+	errMalformedXML          = newError(http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.")
+	errMissingContentLength  = newError(http.StatusLengthRequired, "MissingContentLength", "You must provide the Content-Length HTTP header.")
+	errMissingSecurityHeader = newError(http.StatusBadRequest, "MissingSecurityHeader", "Your request is missing a required header.")
+	errNoSuchBucket          = newError(http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist.")
+	errNoSuchKey             = newError(http.StatusNotFound, "NoSuchKey", "The specified key does not exist.")
+	errNoSuchUpload          = newError(http.StatusNotFound, "NoSuchUpload", "The specified multipart upload does not exist.")
+	errNotImplemented        = newError(http.StatusNotImplemented, "NotImplemented", "A header or query you provided implies functionality that is not implemented.")
+	errSignatureMismatch     = newError(http.StatusForbidden, "SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.")
+	errTimeTooSkewed         = newError(http.StatusForbidden, "RequestTimeTooSkewed", "The difference between the request time and the server's time is too large.")
+	errUnsupportedAlgorithm  = newError(http.StatusBadRequest, "InvalidArgument", "Only the AWS4-HMAC-SHA256 signature algorithm is supported.")
+)
+
+// apiCodes are the S3 codes of the errors of the rest of the API.
+var apiCodes = map[apierror.Code]string{
+	apierror.CodeBadRequest:       "InvalidRequest",
+	apierror.CodeValidationFailed: "InvalidArgument",
+	apierror.CodeUnauthorized:     "AccessDenied",
+	apierror.CodeInvalidToken:     "AccessDenied",
+	apierror.CodeForbidden:        "AccessDenied",
+	apierror.CodeNotFound:         "NoSuchKey",
+	apierror.CodeMethodNotAllowed: "MethodNotAllowed",
+	apierror.CodeRequestTooLarge:  "EntityTooLarge",
+	apierror.CodeFileTooLarge:     "EntityTooLarge",
+	apierror.CodeQuotaExceeded:    "QuotaExceeded",
+	apierror.CodeUnavailable:      "ServiceUnavailable",
+	apierror.CodeInternal:         "InternalError",
+}
+
+// fromError returns the S3 error answered for err.
+func fromError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	ae := apierror.From(err)
+	code, ok := apiCodes[ae.Code]
+	if !ok {
+		code = "InternalError"
+		if ae.Status < http.StatusInternalServerError {
+			code = "InvalidRequest"
+		}
+	}
+
+	// Entities too large are bad requests in S3
+	status := ae.Status
+	if code == "EntityTooLarge" {
+		status = http.StatusBadRequest
+	}
+
+	message := ae.Message
+	if ae.Code == apierror.CodeInternal {
+		message = "We encountered an internal error. Please try again."
+	}
+
+	return &Error{Status: status, Code: code, Message: message, Internal: err}
+}
+
+// renderErrors answers the errors of the next handlers with S3 error
+// documents. The error is still returned so that it is logged.
+func renderErrors(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := next(c)
+		if err == nil || c.Response().Committed {
+			return err
+		}
+
+		e := fromError(err)
+		if c.Request().Method == http.MethodHead {
+			_ = c.NoContent(e.Status)
+			return err
+		}
+
+		_ = c.XML(e.Status, &types.S3ErrorResponse{
+			Code:      e.Code,
+			Message:   e.Message,
+			Resource:  c.Request().URL.Path,
+			RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+		})
+
+		return err
+	}
+}
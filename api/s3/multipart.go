@@ -0,0 +1,453 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// Parts of multipart uploads are kept on disk until the upload is
+// completed, when they are added to the IPFS node as a single file. Each
+// upload has a directory holding its description and its parts, along with
+// the MD5 digest of each part:
+//
+//	<id>/upload.json
+//	<id>/00001
+//	<id>/00001.md5
+const (
+	uploadFile = "upload.json"
+	digestExt  = ".md5"
+
+	maxPartNumber = 10000
+)
+
+type multipartUpload struct {
+	ID        string                 `json:"-"`
+	UserID    int                    `json:"user_id"`
+	BucketID  uuid.UUID              `json:"bucket_id"`
+	Key       string                 `json:"key"`
+	Metadata  map[string]interface{} `json:"metadata"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+type uploadedPart struct {
+	Number     int
+	Size       int64
+	Digest     string
+	ModifiedAt time.Time
+}
+
+func createMultipartUpload(cc *types.Context, b *ent.Bucket, key string) error {
+	if len(key) > maxKeyLength {
+		return errKeyTooLong
+	}
+
+	removeExpiredUploads(cc)
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+
+	upload := &multipartUpload{
+		ID:        id.String(),
+		UserID:    cc.Get(types.UserKey).(*ent.User).ID,
+		BucketID:  b.ID,
+		Key:       key,
+		Metadata:  objectMetadata(cc.Request(), key, 0),
+		CreatedAt: time.Now(),
+	}
+
+	dir := uploadDir(cc, upload.ID)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	content, err := json.Marshal(upload)
+	if err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, uploadFile), content, 0600); err != nil {
+		_ = os.RemoveAll(dir)
+		return err
+	}
+
+	return cc.XML(http.StatusOK, &types.S3CreateMultipartUploadResponse{
+		Xmlns:    types.S3Namespace,
+		Bucket:   b.Name,
+		Key:      key,
+		UploadID: upload.ID,
+	})
+}
+
+func uploadPart(cc *types.Context, b *ent.Bucket, key string) error {
+	if cc.Request().Header.Get(copySourceHeader) != "" {
+		return errNotImplemented
+	}
+
+	upload, err := loadUpload(cc, b, key)
+	if err != nil {
+		return err
+	}
+
+	number, err := strconv.Atoi(cc.QueryParam("partNumber"))
+	if err != nil || number < 1 || number > maxPartNumber {
+		return newError(http.StatusBadRequest, "InvalidArgument", fmt.Sprintf("Part number must be an integer between 1 and %d, inclusive.", maxPartNumber))
+	}
+
+	req := cc.Request()
+	size := contentLength(req)
+	if size < 0 {
+		return errMissingContentLength
+	}
+
+	digest, err := contentMD5(req)
+	if err != nil {
+		return err
+	}
+
+	// Parts are limited as a whole, as they make a single file
+	parts, err := listUploadedParts(cc, upload)
+	if err != nil {
+		return err
+	}
+
+	total := size
+	for _, p := range parts {
+		if p.Number != number {
+			total += p.Size
+		}
+	}
+
+	u := cc.Get(types.UserKey).(*ent.User)
+	if err := files.CheckLimits(cc, u, []string{key}, []int64{total}); err != nil {
+		return err
+	}
+
+	dir := uploadDir(cc, upload.ID)
+	tmp, err := ioutil.TempFile(dir, "part-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	sum := md5.New()
+	n, err := io.Copy(io.MultiWriter(tmp, sum), newSizedReader(req.Body, size))
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if perr := payloadError(req); perr != nil {
+		return perr
+	}
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case n != size:
+		return errIncompleteBody
+	case digest != nil && !bytes.Equal(digest, sum.Sum(nil)):
+		return errBadDigest
+	}
+
+	name := partName(number)
+	hexDigest := hex.EncodeToString(sum.Sum(nil))
+	if err := ioutil.WriteFile(filepath.Join(dir, name+digestExt), []byte(hexDigest), 0600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), filepath.Join(dir, name)); err != nil {
+		return err
+	}
+
+	cc.Response().Header().Set("ETag", `"`+hexDigest+`"`)
+	return cc.NoContent(http.StatusOK)
+}
+
+// completeMultipartUpload adds the parts listed by the request, in order,
+// as the content of the object. Its ETag is the MD5 digest of the digests of
+// the parts, followed by their number.
+func completeMultipartUpload(cc *types.Context, b *ent.Bucket, key string) error {
+	upload, err := loadUpload(cc, b, key)
+	if err != nil {
+		return err
+	}
+
+	var body types.S3CompleteMultipartUploadRequest
+	if err := xml.NewDecoder(io.LimitReader(cc.Request().Body, 2<<20)).Decode(&body); err != nil {
+		return errMalformedXML
+	}
+
+	if payload := payloadError(cc.Request()); payload != nil {
+		return payload
+	}
+
+	if len(body.Parts) == 0 {
+		return errMalformedXML
+	}
+
+	uploaded, err := listUploadedParts(cc, upload)
+	if err != nil {
+		return err
+	}
+
+	byNumber := make(map[int]*uploadedPart, len(uploaded))
+	for _, p := range uploaded {
+		byNumber[p.Number] = p
+	}
+
+	dir := uploadDir(cc, upload.ID)
+	var (
+		size    int64
+		digests []byte
+		readers []io.Reader
+	)
+	for i, completed := range body.Parts {
+		if i > 0 && completed.PartNumber <= body.Parts[i-1].PartNumber {
+			return errInvalidPartOrder
+		}
+
+		p, ok := byNumber[completed.PartNumber]
+		if !ok || strings.Trim(completed.ETag, `"`) != p.Digest {
+			return errInvalidPart
+		}
+
+		digest, err := hex.DecodeString(p.Digest)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.Open(filepath.Join(dir, partName(p.Number)))
+		if err != nil {
+			return err
+		}
+		defer content.Close()
+
+		size += p.Size
+		digests = append(digests, digest...)
+		readers = append(readers, content)
+	}
+
+	u := cc.Get(types.UserKey).(*ent.User)
+	if err := files.CheckLimits(cc, u, []string{key}, []int64{size}); err != nil {
+		return err
+	}
+
+	hash, sample, err := files.Add(cc, u, path.Base(key), size, io.MultiReader(readers...))
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(digests)
+	tag := fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), len(body.Parts))
+
+	metadata := upload.Metadata
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	metadata[metaSize] = size
+	declared, _ := metadata[metaContentType].(string)
+	files.Inspect(metadata, sample, key, declared)
+
+	f, err := storeObject(cc, u, b, key, hash, tag, size, metadata)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		cc.Log().Warn("failed to remove multipart upload", "upload_id", upload.ID, "error", err)
+	}
+
+	return cc.XML(http.StatusOK, &types.S3CompleteMultipartUploadResponse{
+		Xmlns:    types.S3Namespace,
+		Location: "/s3/" + b.Name + "/" + key,
+		Bucket:   b.Name,
+		Key:      key,
+		ETag:     etag(f),
+	})
+}
+
+func abortMultipartUpload(cc *types.Context, b *ent.Bucket, key string) error {
+	upload, err := loadUpload(cc, b, key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(uploadDir(cc, upload.ID)); err != nil {
+		return err
+	}
+
+	return cc.NoContent(http.StatusNoContent)
+}
+
+func listParts(cc *types.Context, b *ent.Bucket, key string) error {
+	upload, err := loadUpload(cc, b, key)
+	if err != nil {
+		return err
+	}
+
+	parts, err := listUploadedParts(cc, upload)
+	if err != nil {
+		return err
+	}
+
+	res := &types.S3ListPartsResponse{
+		Xmlns:    types.S3Namespace,
+		Bucket:   b.Name,
+		Key:      key,
+		UploadID: upload.ID,
+		Parts:    make([]types.S3Part, 0, len(parts)),
+	}
+	for _, p := range parts {
+		res.Parts = append(res.Parts, types.S3Part{
+			PartNumber:   p.Number,
+			LastModified: p.ModifiedAt.UTC().Format(timeFormat),
+			ETag:         `"` + p.Digest + `"`,
+			Size:         p.Size,
+		})
+	}
+
+	return cc.XML(http.StatusOK, res)
+}
+
+// loadUpload returns the multipart upload of the request, which must be
+// one of the user for key in b.
+func loadUpload(cc *types.Context, b *ent.Bucket, key string) (*multipartUpload, error) {
+	// The ID is part of paths: only accept UUIDs
+	id, err := uuid.Parse(cc.QueryParam("uploadId"))
+	if err != nil {
+		return nil, errNoSuchUpload
+	}
+
+	content, err := ioutil.ReadFile(filepath.Join(uploadDir(cc, id.String()), uploadFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errNoSuchUpload
+		}
+		return nil, err
+	}
+
+	var upload multipartUpload
+	if err := json.Unmarshal(content, &upload); err != nil {
+		return nil, err
+	}
+	upload.ID = id.String()
+
+	u := cc.Get(types.UserKey).(*ent.User)
+	if upload.UserID != u.ID || upload.BucketID != b.ID || upload.Key != key {
+		return nil, errNoSuchUpload
+	}
+
+	if time.Since(upload.CreatedAt) > cc.S3.MultipartExpiry {
+		return nil, errNoSuchUpload
+	}
+
+	return &upload, nil
+}
+
+// listUploadedParts returns the parts of upload, by number.
+func listUploadedParts(cc *types.Context, upload *multipartUpload) ([]*uploadedPart, error) {
+	dir := uploadDir(cc, upload.ID)
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var parts []*uploadedPart
+	for _, entry := range entries {
+		number, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		digest, err := ioutil.ReadFile(filepath.Join(dir, entry.Name()+digestExt))
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, &uploadedPart{
+			Number:     number,
+			Size:       entry.Size(),
+			Digest:     string(digest),
+			ModifiedAt: entry.ModTime(),
+		})
+	}
+
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].Number < parts[j].Number
+	})
+
+	return parts, nil
+}
+
+// removeExpiredUploads removes the multipart uploads which were neither
+// completed nor aborted in time.
+func removeExpiredUploads(cc *types.Context) {
+	root := multipartDir(cc)
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			cc.Log().Warn("failed to list multipart uploads", "error", err)
+		}
+		return
+	}
+
+	for _, entry := range entries {
+		// Only the directories of uploads are removed, the directory may
+		// be shared
+		if _, err := uuid.Parse(entry.Name()); err != nil || !entry.IsDir() {
+			continue
+		}
+
+		// The description of uploads is written once, when created. Until
+		// then, or when it is lost, the directory tells the upload age
+		created := entry.ModTime()
+		if info, err := os.Stat(filepath.Join(root, entry.Name(), uploadFile)); err == nil {
+			created = info.ModTime()
+		}
+
+		if time.Since(created) <= cc.S3.MultipartExpiry {
+			continue
+		}
+
+		if err := os.RemoveAll(filepath.Join(root, entry.Name())); err != nil {
+			cc.Log().Warn("failed to remove expired multipart upload", "upload_id", entry.Name(), "error", err)
+		}
+	}
+}
+
+func multipartDir(cc *types.Context) string {
+	if cc.S3.MultipartDir != "" {
+		return cc.S3.MultipartDir
+	}
+
+	return filepath.Join(os.TempDir(), "sthorer-multipart")
+}
+
+func uploadDir(cc *types.Context, id string) string {
+	return filepath.Join(multipartDir(cc), id)
+}
+
+func partName(number int) string {
+	return fmt.Sprintf("%05d", number)
+}
@@ -0,0 +1,424 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+)
+
+const (
+	maxKeyLength = 1024
+
+	metaHeaderPrefix = "X-Amz-Meta-"
+	copySourceHeader = "X-Amz-Copy-Source"
+
+	// Metadata keys of objects
+	metaName        = "name"
+	metaSize        = "size"
+	metaContentType = database.ContentTypeKey
+	metaMedia       = database.MediaKey
+	metaUser        = database.MetaKey
+)
+
+func getObject(cc *types.Context, b *ent.Bucket, key string) error {
+	f, err := object(cc, b, key)
+	if err != nil {
+		return err
+	}
+
+	res := cc.Response()
+	objectHeaders(res.Header(), f)
+	res.Header().Set("Accept-Ranges", "bytes")
+
+	offset, length := int64(0), f.Size
+	status := http.StatusOK
+	if spec := cc.Request().Header.Get("Range"); spec != "" {
+		start, end, ok, err := parseRange(spec, f.Size)
+		if err != nil {
+			res.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", f.Size))
+			return err
+		}
+
+		if ok {
+			offset, length = start, end-start+1
+			status = http.StatusPartialContent
+			res.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, f.Size))
+		}
+	}
+	res.Header().Set(echo.HeaderContentLength, strconv.FormatInt(length, 10))
+
+	if cc.Request().Method == http.MethodHead || length == 0 {
+		res.WriteHeader(status)
+		return nil
+	}
+
+	content, err := cc.Shell.CatContext(cc.Request().Context(), f.Hash, offset, length)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	res.WriteHeader(status)
+	if _, err := io.Copy(res, content); err != nil {
+		// The response is committed, the client sees a truncated body
+		cc.Log().Warn("failed to stream object", "file_id", f.ID, "error", err)
+	}
+
+	return nil
+}
+
+// parseRange parses a single byte range of an object of size bytes. ok is
+// false when the range is ignored, such as multiple ranges.
+func parseRange(spec string, size int64) (start, end int64, ok bool, err error) {
+	if !strings.HasPrefix(spec, "bytes=") || strings.Contains(spec, ",") {
+		return 0, 0, false, nil
+	}
+
+	bounds := strings.SplitN(strings.TrimPrefix(spec, "bytes="), "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, false, nil
+	}
+
+	switch {
+	case bounds[0] == "":
+		// Suffix range: the last bytes
+		n, perr := strconv.ParseInt(bounds[1], 10, 64)
+		if perr != nil {
+			return 0, 0, false, nil
+		}
+		if n <= 0 || size == 0 {
+			return 0, 0, false, errInvalidRange
+		}
+		if n > size {
+			n = size
+		}
+		return size - n, size - 1, true, nil
+	default:
+		start, perr := strconv.ParseInt(bounds[0], 10, 64)
+		if perr != nil {
+			return 0, 0, false, nil
+		}
+
+		end := size - 1
+		if bounds[1] != "" {
+			if end, perr = strconv.ParseInt(bounds[1], 10, 64); perr != nil || end < start {
+				return 0, 0, false, nil
+			}
+			if end > size-1 {
+				end = size - 1
+			}
+		}
+
+		if start >= size {
+			return 0, 0, false, errInvalidRange
+		}
+		return start, end, true, nil
+	}
+}
+
+func putObject(cc *types.Context, b *ent.Bucket, key string) error {
+	if len(key) > maxKeyLength {
+		return errKeyTooLong
+	}
+
+	if cc.Request().Header.Get(copySourceHeader) != "" {
+		return copyObject(cc, b, key)
+	}
+
+	req := cc.Request()
+	size := contentLength(req)
+	if size < 0 {
+		return errMissingContentLength
+	}
+
+	digest, err := contentMD5(req)
+	if err != nil {
+		return err
+	}
+
+	u := cc.Get(types.UserKey).(*ent.User)
+	if err := files.CheckLimits(cc, u, []string{key}, []int64{size}); err != nil {
+		return err
+	}
+
+	body := newSizedReader(req.Body, size)
+	sum := md5.New()
+	hash, sample, err := files.Add(cc, u, path.Base(key), size, io.TeeReader(body, sum))
+	if err != nil {
+		if perr := payloadError(req); perr != nil {
+			return perr
+		}
+		if body.n > size {
+			return errIncompleteBody
+		}
+		return err
+	}
+
+	switch {
+	case body.n != size:
+		files.Discard(cc, hash)
+		return errIncompleteBody
+	case digest != nil && !bytes.Equal(digest, sum.Sum(nil)):
+		files.Discard(cc, hash)
+		return errBadDigest
+	}
+
+	metadata := objectMetadata(req, key, size)
+	files.Inspect(metadata, sample, key, req.Header.Get(echo.HeaderContentType))
+
+	f, err := storeObject(cc, u, b, key, hash, hex.EncodeToString(sum.Sum(nil)), size, metadata)
+	if err != nil {
+		return err
+	}
+
+	cc.Response().Header().Set("ETag", etag(f))
+	return cc.NoContent(http.StatusOK)
+}
+
+// copyObject copies an object of the user, sharing its pinned content.
+func copyObject(cc *types.Context, b *ent.Bucket, key string) error {
+	source, err := url.PathUnescape(strings.TrimPrefix(cc.Request().Header.Get(copySourceHeader), "/"))
+	if err != nil {
+		return errNoSuchKey
+	}
+
+	// Versions are not supported, only the current one can be copied
+	source = strings.SplitN(source, "?", 2)[0]
+	parts := strings.SplitN(source, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return errNoSuchKey
+	}
+
+	sourceBucket, err := userBucket(cc, parts[0])
+	if err != nil {
+		return err
+	}
+
+	src, err := object(cc, sourceBucket, parts[1])
+	if err != nil {
+		return err
+	}
+
+	u := cc.Get(types.UserKey).(*ent.User)
+	if err := files.CheckLimits(cc, u, []string{key}, []int64{src.Size}); err != nil {
+		return err
+	}
+
+	// The media metadata belongs to the content, unlike the metadata the
+	// directive replaces
+	metadata := objectMetadata(cc.Request(), key, src.Size)
+	if v, ok := src.Metadata[metaMedia]; ok {
+		metadata[metaMedia] = v
+	}
+	if cc.Request().Header.Get("X-Amz-Metadata-Directive") != "REPLACE" {
+		for _, name := range []string{metaContentType, metaUser} {
+			if v, ok := src.Metadata[name]; ok {
+				metadata[name] = v
+			} else {
+				delete(metadata, name)
+			}
+		}
+	}
+
+	ctx := cc.Request().Context()
+	previous, err := cc.Client.Object(ctx, b, key)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	f, err := cc.Client.CopyFile(ctx, u, src, b, key, metadata)
+	if err != nil {
+		return err
+	}
+
+	if err := replaced(cc, u, f, previous); err != nil {
+		return err
+	}
+
+	return cc.XML(http.StatusOK, &types.S3CopyObjectResponse{
+		Xmlns:        types.S3Namespace,
+		LastModified: f.PinnedAt.UTC().Format(timeFormat),
+		ETag:         etag(f),
+	})
+}
+
+func deleteObject(cc *types.Context, b *ent.Bucket, key string) error {
+	if err := removeObject(cc, b, key); err != nil {
+		return err
+	}
+
+	files.PublishUsage(cc, cc.Get(types.UserKey).(*ent.User))
+	return cc.NoContent(http.StatusNoContent)
+}
+
+// removeObject unpins the object of b at key. Deleting a missing object
+// succeeds.
+func removeObject(cc *types.Context, b *ent.Bucket, key string) error {
+	f, err := cc.Client.Object(cc.Request().Context(), b, key)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	_, err = files.Remove(cc, cc.Get(types.UserKey).(*ent.User), f)
+	return err
+}
+
+// storeObject records content pinned for the object of b at key, which
+// replaces the previous one.
+func storeObject(cc *types.Context, u *ent.User, b *ent.Bucket, key, hash, etag string, size int64, metadata map[string]interface{}) (*ent.File, error) {
+	ctx := cc.Request().Context()
+	previous, err := cc.Client.Object(ctx, b, key)
+	if err != nil && !ent.IsNotFound(err) {
+		files.Discard(cc, hash)
+		return nil, err
+	}
+
+	f, err := cc.Client.NewObject(ctx, u, b, key, hash, etag, size, metadata)
+	if err != nil {
+		files.Discard(cc, hash)
+		return nil, err
+	}
+
+	return f, replaced(cc, u, f, previous)
+}
+
+// replaced records the new object f and unpins the previous one, if any.
+func replaced(cc *types.Context, u *ent.User, f, previous *ent.File) error {
+	files.Pinned(cc, u, f, f.Key)
+
+	if previous != nil {
+		if _, err := files.Remove(cc, u, previous); err != nil {
+			return err
+		}
+	}
+
+	files.PublishUsage(cc, u)
+	return nil
+}
+
+func object(cc *types.Context, b *ent.Bucket, key string) (*ent.File, error) {
+	f, err := cc.Client.Object(cc.Request().Context(), b, key)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errNoSuchKey
+		}
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// objectMetadata returns the metadata of an object uploaded by req.
+func objectMetadata(req *http.Request, key string, size int64) map[string]interface{} {
+	metadata := map[string]interface{}{
+		metaName: path.Base(key),
+		metaSize: size,
+	}
+
+	if ct := req.Header.Get(echo.HeaderContentType); ct != "" {
+		metadata[metaContentType] = ct
+	}
+
+	meta := make(map[string]interface{})
+	for name, values := range req.Header {
+		if strings.HasPrefix(name, metaHeaderPrefix) {
+			meta[strings.ToLower(strings.TrimPrefix(name, metaHeaderPrefix))] = strings.Join(values, ",")
+		}
+	}
+	if len(meta) > 0 {
+		metadata[metaUser] = meta
+	}
+
+	return metadata
+}
+
+func objectHeaders(h http.Header, f *ent.File) {
+	h.Set("ETag", etag(f))
+	h.Set(echo.HeaderLastModified, f.PinnedAt.UTC().Format(http.TimeFormat))
+	h.Set("X-Ipfs-Path", "/ipfs/"+f.Hash)
+
+	h.Set(echo.HeaderContentType, files.ContentType(f))
+
+	if meta, ok := f.Metadata[metaUser].(map[string]interface{}); ok {
+		for name, v := range meta {
+			h.Set(metaHeaderPrefix+name, fmt.Sprint(v))
+		}
+	}
+}
+
+func etag(f *ent.File) string {
+	if f.Etag == "" {
+		return `"` + f.Hash + `"`
+	}
+
+	return `"` + f.Etag + `"`
+}
+
+// contentLength returns the length of the decoded body of req, -1 when
+// unknown.
+func contentLength(req *http.Request) int64 {
+	if decoded := req.Header.Get("X-Amz-Decoded-Content-Length"); decoded != "" {
+		n, err := strconv.ParseInt(decoded, 10, 64)
+		if err != nil {
+			return -1
+		}
+		return n
+	}
+
+	return req.ContentLength
+}
+
+// contentMD5 returns the digest of the Content-MD5 header of req, if any.
+func contentMD5(req *http.Request) ([]byte, error) {
+	header := req.Header.Get("Content-MD5")
+	if header == "" {
+		return nil, nil
+	}
+
+	digest, err := base64.StdEncoding.DecodeString(header)
+	if err != nil || len(digest) != md5.Size {
+		return nil, errInvalidDigest
+	}
+
+	return digest, nil
+}
+
+// sizedReader reads a body of the size declared by its request, and fails
+// as soon as it reads more, so that the limits checked against the size
+// hold.
+type sizedReader struct {
+	r    io.Reader
+	size int64
+	n    int64
+}
+
+func newSizedReader(r io.Reader, size int64) *sizedReader {
+	return &sizedReader{r: io.LimitReader(r, size+1), size: size}
+}
+
+func (r *sizedReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.n += int64(n)
+	if r.n > r.size {
+		return n, errIncompleteBody
+	}
+	return n, err
+}
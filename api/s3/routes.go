@@ -0,0 +1,130 @@
+package s3
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/config"
+)
+
+// Prefix is the path the gateway is served under, with path-style bucket
+// addressing: /s3/<bucket>/<key>.
+const Prefix = "/s3"
+
+// subresources are the query parameters selecting features of buckets and
+// objects which the gateway does not implement.
+var subresources = []string{
+	"accelerate", "acl", "analytics", "cors", "encryption", "intelligent-tiering",
+	"inventory", "legal-hold", "lifecycle", "logging", "metrics", "notification",
+	"object-lock", "ownershipControls", "policy", "policyStatus", "publicAccessBlock",
+	"replication", "requestPayment", "restore", "retention", "select", "tagging",
+	"torrent", "versionId", "versioning", "versions", "website",
+}
+
+var methods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPut,
+	http.MethodPost,
+	http.MethodDelete,
+}
+
+func Apply(e *echo.Echo, conf *config.Config) {
+	if !conf.S3.Enabled {
+		return
+	}
+
+	group := e.Group(Prefix)
+
+	group.Use(renderErrors)
+	group.Use(Authenticate(conf.S3.Region))
+
+	group.GET("", listBuckets)
+	group.GET("/", listBuckets)
+	group.Match(methods, "/:bucket", bucket)
+	group.Match(methods, "/:bucket/*", bucket)
+}
+
+// bucket dispatches the requests made on a bucket or one of its objects,
+// according to their method and subresource.
+func bucket(c echo.Context) error {
+	cc := c.(*types.Context)
+	req := cc.Request()
+	query := req.URL.Query()
+
+	for _, name := range subresources {
+		if _, ok := query[name]; ok {
+			return errNotImplemented
+		}
+	}
+
+	name := c.Param("bucket")
+	key := strings.TrimPrefix(req.URL.Path, Prefix+"/"+name)
+	key = strings.TrimPrefix(key, "/")
+
+	if req.Method == http.MethodPut && key == "" {
+		return createBucket(cc, name)
+	}
+
+	b, err := userBucket(cc, name)
+	if err != nil {
+		return err
+	}
+
+	if key == "" {
+		_, location := query["location"]
+		_, uploads := query["uploads"]
+		_, remove := query["delete"]
+
+		switch {
+		case req.Method == http.MethodGet && location:
+			return bucketLocation(cc)
+		case uploads:
+			// Listing multipart uploads is not supported
+			return errNotImplemented
+		case req.Method == http.MethodGet:
+			return listObjects(cc, b)
+		case req.Method == http.MethodHead:
+			return cc.NoContent(http.StatusOK)
+		case req.Method == http.MethodDelete:
+			return deleteBucket(cc, b)
+		case req.Method == http.MethodPost && remove:
+			return deleteObjects(cc, b)
+		}
+
+		return errNotImplemented
+	}
+
+	_, uploads := query["uploads"]
+	_, uploadID := query["uploadId"]
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead:
+		if uploadID && req.Method == http.MethodGet {
+			return listParts(cc, b, key)
+		}
+		return getObject(cc, b, key)
+	case http.MethodPut:
+		if uploadID {
+			return uploadPart(cc, b, key)
+		}
+		return putObject(cc, b, key)
+	case http.MethodPost:
+		switch {
+		case uploads:
+			return createMultipartUpload(cc, b, key)
+		case uploadID:
+			return completeMultipartUpload(cc, b, key)
+		}
+	case http.MethodDelete:
+		if uploadID {
+			return abortMultipartUpload(cc, b, key)
+		}
+		return deleteObject(cc, b, key)
+	}
+
+	return errNotImplemented
+}
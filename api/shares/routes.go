@@ -0,0 +1,12 @@
+package shares
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// Apply registers the public endpoints of share links, which require no
+// account.
+func Apply(e *echo.Echo) {
+	e.GET("/shares/:slug", Download)
+	e.HEAD("/shares/:slug", Download)
+}
@@ -0,0 +1,104 @@
+package shares
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+)
+
+// Download streams the file of a share link from the IPFS node. Links
+// with a password require it as the password of HTTP basic
+// authentication, with any user name. Only the downloads of the content
+// count against the download limit, not HEAD requests or revalidations.
+func Download(c echo.Context) error {
+	cc := c.(*types.Context)
+	ctx := cc.Request().Context()
+
+	s, err := cc.Client.ShareBySlug(ctx, cc.Param("slug"))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apierror.NotFound()
+		}
+		return err
+	}
+
+	f := s.Edges.File
+	switch {
+	case s.RevokedAt != nil:
+		return apierror.New(http.StatusGone, apierror.CodeShareRevoked, "share link has been revoked")
+	case f == nil || f.UnpinnedAt != nil:
+		return apierror.New(http.StatusGone, apierror.CodeShareRevoked, "shared file has been unpinned")
+	case s.ExpiresAt != nil && !time.Now().Before(*s.ExpiresAt):
+		return apierror.New(http.StatusGone, apierror.CodeShareExpired, "share link has expired")
+	case s.MaxDownloads != nil && s.Downloads >= *s.MaxDownloads:
+		return apierror.New(http.StatusGone, apierror.CodeDownloadLimit, "share link has no downloads left")
+	}
+
+	if s.Password != "" {
+		_, password, ok := cc.Request().BasicAuth()
+		if !ok || !database.SharePasswordMatches(s, password) {
+			cc.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="share", charset="UTF-8"`)
+			return apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, "share link requires a password")
+		}
+	}
+
+	res := cc.Response()
+	etag := strconv.Quote(f.Hash)
+	res.Header().Set("ETag", etag)
+	res.Header().Set("Cache-Control", "private, no-cache")
+	if cc.Request().Header.Get("If-None-Match") == etag {
+		return cc.NoContent(http.StatusNotModified)
+	}
+
+	head := cc.Request().Method == http.MethodHead
+	if !head {
+		counted, err := cc.Client.CountShareDownload(ctx, s)
+		if err != nil {
+			return err
+		}
+		if !counted {
+			// Concurrent downloads used the last ones, or the link was
+			// revoked meanwhile
+			return apierror.New(http.StatusGone, apierror.CodeDownloadLimit, "share link has no downloads left")
+		}
+	}
+
+	// The content is served from the origin of the API, it must not be
+	// sniffed or run as a page of the API
+	res.Header().Set(echo.HeaderContentType, files.ContentType(f))
+	res.Header().Set(echo.HeaderXContentTypeOptions, "nosniff")
+	res.Header().Set(echo.HeaderContentSecurityPolicy, "sandbox")
+	if name := files.DownloadName(f); name != "" {
+		res.Header().Set(echo.HeaderContentDisposition, mime.FormatMediaType("inline", map[string]string{"filename": name}))
+	}
+	res.Header().Set(echo.HeaderContentLength, strconv.FormatInt(f.Size, 10))
+
+	if head || f.Size == 0 {
+		res.WriteHeader(http.StatusOK)
+		return nil
+	}
+
+	content, err := cc.Shell.CatContext(ctx, f.Hash, 0, f.Size)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	res.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(res, content); err != nil {
+		// The response is committed, the client sees a truncated body
+		cc.Log().Warn("failed to stream shared file", "share_id", s.ID, "file_id", f.ID, "error", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,11 @@
+package stream
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/sthorer/api/api/middlewares"
+	"github.com/sthorer/api/config"
+)
+
+func Apply(e *echo.Echo, conf *config.Config) {
+	e.GET("/events", Events, middlewares.JWTOrTokenAuth(conf))
+}
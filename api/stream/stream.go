@@ -0,0 +1,90 @@
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/events"
+)
+
+const (
+	heartbeatInterval = time.Second * 15
+
+	mimeTextEventStream = "text/event-stream"
+)
+
+// Events streams the events of the authenticated user as Server-Sent Events.
+// The current quota is sent first so clients start from a known state.
+func Events(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	u := c.Get(types.UserKey).(*ent.User)
+
+	sub := c.Events.Subscribe(u.ID)
+	defer sub.Close()
+
+	usage, err := c.Client.UserUsage(c.Request().Context(), u)
+	if err != nil {
+		return err
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, mimeTextEventStream)
+	res.Header().Set("Cache-Control", "no-cache")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	if err := write(res, &events.Event{Type: events.QuotaUpdated, Time: time.Now(), Data: usage}); err != nil {
+		return nil
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	done := c.Request().Context().Done()
+	for {
+		select {
+		case <-done:
+			return nil
+		case <-sub.Done():
+			// The server is shutting down
+			return nil
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(res, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+		case e := <-sub.Events():
+			if err := write(res, e); err != nil {
+				return nil
+			}
+		}
+	}
+}
+
+func write(res *echo.Response, e *events.Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return err
+	}
+
+	if e.ID != 0 {
+		if _, err := fmt.Fprintf(res, "id: %d\n", e.ID); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(res, "event: %s\ndata: %s\n\n", e.Type, data); err != nil {
+		return err
+	}
+
+	res.Flush()
+
+	return nil
+}
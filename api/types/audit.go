@@ -0,0 +1,11 @@
+package types
+
+type AuditLogsRequest struct {
+	Action  string `query:"action" validate:"omitempty,oneof=Login Register TokenCreate TokenReset TokenRevoke Upload Unpin"`
+	TokenID string `query:"token_id" validate:"omitempty,uuid"`
+	Since   int64  `query:"since" validate:"omitempty,min=0"`
+	Until   int64  `query:"until" validate:"omitempty,min=0"`
+	Limit   int    `query:"limit" validate:"omitempty,min=1,max=1000"`
+	Offset  int    `query:"offset" validate:"omitempty,min=0"`
+	Format  string `query:"format" validate:"omitempty,oneof=json jsonl"`
+}
@@ -1,11 +1,14 @@
 package types
 
 import (
-	"net/http"
-
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/logging"
 )
 
 const (
@@ -19,6 +22,49 @@ type Context struct {
 	*config.Config
 }
 
+// Log returns a logger adding the request ID, trace ID and authenticated
+// user and token, if any, to every entry.
+func (c *Context) Log() *logging.Logger {
+	kv := []interface{}{"request_id", c.Response().Header().Get(echo.HeaderXRequestID)}
+
+	if sc := trace.SpanContextFromContext(c.Request().Context()); sc.HasTraceID() {
+		kv = append(kv, "trace_id", sc.TraceID().String())
+	}
+
+	if u, ok := c.Get(UserKey).(*ent.User); ok {
+		kv = append(kv, "user_id", u.ID)
+	}
+
+	if t, ok := c.Get(TokenKey).(*ent.Token); ok {
+		kv = append(kv, "token_id", t.ID)
+	}
+
+	return c.Config.Log().With(kv...)
+}
+
+// ValidationError returns the error answered for err, as returned by
+// Validate.
 func (c *Context) ValidationError(err error) error {
-	return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	return apierror.Validation(err)
+}
+
+// Audit records entry in the audit log, filling in the request ID, client
+// IP and user agent of the current request. When entry has no token, the
+// API token used to authenticate the request (if any) is recorded.
+// Entries are recorded once the action is done, failures are logged
+// rather than failing the request.
+func (c *Context) Audit(entry *database.AuditEntry) {
+	entry.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+	entry.IP = c.RealIP()
+	entry.UserAgent = c.Request().UserAgent()
+
+	if entry.TokenID == nil {
+		if t, ok := c.Get(TokenKey).(*ent.Token); ok {
+			entry.TokenID = &t.ID
+		}
+	}
+
+	if _, err := c.Client.Audit(c.Request().Context(), entry); err != nil {
+		c.Log().Error("failed to record audit entry", "action", entry.Action, "error", err)
+	}
 }
@@ -0,0 +1,8 @@
+package types
+
+import "github.com/sthorer/api/api/apierror"
+
+// ErrorResponse is the body of every error response.
+type ErrorResponse struct {
+	Error *apierror.Error `json:"error"`
+}
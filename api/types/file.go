@@ -0,0 +1,83 @@
+package types
+
+import (
+	"time"
+
+	"github.com/sthorer/api/ent"
+)
+
+// UpdateFileRequest replaces the tags of a file, and sets its metadata
+// keys, or removes them when null.
+type UpdateFileRequest struct {
+	Tags     *[]string          `json:"tags"`
+	Metadata map[string]*string `json:"metadata"`
+}
+
+type SearchFilesRequest struct {
+	Query        string   `query:"q" validate:"omitempty,max=1024"`
+	Tags         []string `query:"tag" validate:"omitempty,max=64"`
+	Metadata     []string `query:"meta" validate:"omitempty,max=64,dive,max=320"`
+	NamePrefix   string   `query:"name_prefix" validate:"omitempty,max=255"`
+	NameContains string   `query:"name_contains" validate:"omitempty,max=255"`
+	MinSize      *int64   `query:"min_size" validate:"omitempty,min=0"`
+	MaxSize      *int64   `query:"max_size" validate:"omitempty,min=0"`
+	Since        int64    `query:"since" validate:"omitempty,min=0"`
+	Until        int64    `query:"until" validate:"omitempty,min=0"`
+	ContentType  string   `query:"content_type" validate:"omitempty,max=255"`
+	MinWidth     *int     `query:"min_width" validate:"omitempty,min=0"`
+	MaxWidth     *int     `query:"max_width" validate:"omitempty,min=0"`
+	MinHeight    *int     `query:"min_height" validate:"omitempty,min=0"`
+	MaxHeight    *int     `query:"max_height" validate:"omitempty,min=0"`
+	MinDuration  *float64 `query:"min_duration" validate:"omitempty,min=0"`
+	MaxDuration  *float64 `query:"max_duration" validate:"omitempty,min=0"`
+	MinPages     *int     `query:"min_pages" validate:"omitempty,min=0"`
+	MaxPages     *int     `query:"max_pages" validate:"omitempty,min=0"`
+	TakenSince   int64    `query:"taken_since" validate:"omitempty,min=0"`
+	TakenUntil   int64    `query:"taken_until" validate:"omitempty,min=0"`
+	Limit        int      `query:"limit" validate:"omitempty,min=1,max=1000"`
+	Offset       int      `query:"offset" validate:"omitempty,min=0"`
+}
+
+type SearchFilesResponse struct {
+	Files  []*FileMatch `json:"files"`
+	Total  int          `json:"total"`
+	Limit  int          `json:"limit"`
+	Offset int          `json:"offset"`
+}
+
+// FileMatch is a file found by a search. Full-text searches rank files and
+// give a snippet of their text as HTML, with the words found in mark
+// elements.
+type FileMatch struct {
+	*ent.File
+	Rank    float64 `json:"rank,omitempty"`
+	Snippet string  `json:"snippet,omitempty"`
+}
+
+type FileResponse struct {
+	*ent.File
+	Path string `json:"path"`
+}
+
+// ThumbnailRequest selects the thumbnail of an image fitting in a square
+// of size pixels, or the smallest one when zero.
+type ThumbnailRequest struct {
+	Size int `query:"size" validate:"omitempty,min=1,max=4096"`
+}
+
+// PresignUploadRequest restricts the uploads of a pre-signed upload URL.
+// It expires in ExpiresIn seconds, 15 minutes when zero.
+type PresignUploadRequest struct {
+	MaxSize      int64    `json:"max_size" validate:"required,min=1"`
+	ContentTypes []string `json:"content_types" validate:"omitempty,max=32,dive,required,max=255"`
+	Path         string   `json:"path" validate:"omitempty,max=4096"`
+	ExpiresIn    int      `json:"expires_in" validate:"omitempty,min=1"`
+}
+
+// PresignedUploadResponse is a pre-signed upload URL, which a multipart
+// form of files can be posted to without authentication, like to
+// /files/upload.
+type PresignedUploadResponse struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
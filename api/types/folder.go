@@ -0,0 +1,46 @@
+package types
+
+import "github.com/sthorer/api/ent"
+
+type NewFolderRequest struct {
+	Path string `json:"path" validate:"required,max=4096"`
+}
+
+// PathRequest moves or copies a folder or file to Path, whose missing
+// folders are created.
+type PathRequest struct {
+	Path string `json:"path" validate:"required,max=4096"`
+}
+
+type FolderContentsRequest struct {
+	Path   string `query:"path" validate:"omitempty,max=4096"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=1000"`
+	Offset int    `query:"offset" validate:"omitempty,min=0"`
+}
+
+type FolderPageRequest struct {
+	Limit  int `query:"limit" validate:"omitempty,min=1,max=1000"`
+	Offset int `query:"offset" validate:"omitempty,min=0"`
+}
+
+type DeleteFolderRequest struct {
+	Recursive bool `query:"recursive"`
+}
+
+type FolderResponse struct {
+	*ent.Folder
+	Path string `json:"path"`
+}
+
+// FolderContentsResponse is a page of the content of a folder: its folders
+// come first, followed by its files.
+type FolderContentsResponse struct {
+	// Folder is nil for the root
+	Folder  *FolderResponse `json:"folder"`
+	Path    string          `json:"path"`
+	Folders []*ent.Folder   `json:"folders"`
+	Files   []*ent.File     `json:"files"`
+	Total   int             `json:"total"`
+	Limit   int             `json:"limit"`
+	Offset  int             `json:"offset"`
+}
@@ -0,0 +1,46 @@
+package types
+
+const (
+	StatusOK       = "ok"
+	StatusReady    = "ready"
+	StatusNotReady = "not_ready"
+
+	CheckUp   = "up"
+	CheckDown = "down"
+)
+
+type HealthResponse struct {
+	Status string `json:"status"`
+}
+
+type ReadinessResponse struct {
+	Status string                  `json:"status"`
+	Checks map[string]*CheckResult `json:"checks"`
+}
+
+// CheckResult is the status of a dependency of the API.
+type CheckResult struct {
+	Status    string      `json:"status"`
+	LatencyMS float64     `json:"latency_ms"`
+	Error     string      `json:"error,omitempty"`
+	Details   interface{} `json:"details,omitempty"`
+}
+
+type DiskDetails struct {
+	Path      string `json:"path"`
+	FreeBytes uint64 `json:"free_bytes"`
+	MinBytes  int64  `json:"min_bytes"`
+}
+
+type WorkersDetails struct {
+	Webhooks   int `json:"webhooks"`
+	QueueDepth int `json:"queue_depth"`
+
+	// Indexing workers and files left to index
+	Indexing        int `json:"indexing"`
+	IndexQueueDepth int `json:"index_queue_depth"`
+
+	// Thumbnail workers and images left to generate thumbnails for
+	Thumbnails          int `json:"thumbnails"`
+	ThumbnailQueueDepth int `json:"thumbnail_queue_depth"`
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"time"
+
+	"github.com/sthorer/api/ent"
+)
+
+type NewShareRequest struct {
+	Password     string     `json:"password" validate:"omitempty,min=4,max=72"`
+	ExpiresAt    *time.Time `json:"expires_at"`
+	MaxDownloads *int       `json:"max_downloads" validate:"omitempty,min=1"`
+}
+
+// ShareResponse is a share link, with its public URL. Protected links
+// require their password, as the password of HTTP basic authentication.
+type ShareResponse struct {
+	*ent.Share
+	URL       string `json:"url"`
+	Protected bool   `json:"protected"`
+}
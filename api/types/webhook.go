@@ -0,0 +1,14 @@
+package types
+
+import "github.com/sthorer/api/ent"
+
+type NewWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,url,max=2048"`
+	Events []string `json:"events" validate:"required,min=1,dive,oneof=pin.pinned pin.failed pin.unpinned"`
+	Secret string   `json:"secret" validate:"omitempty,min=16,max=80"`
+}
+
+type WebhookSecretResponse struct {
+	*ent.Webhook
+	Secret string `json:"secret"`
+}
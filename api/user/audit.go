@@ -0,0 +1,87 @@
+package user
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/auditlog"
+)
+
+const (
+	defaultAuditLimit = 100
+
+	mimeApplicationNDJSON = "application/x-ndjson"
+)
+
+func ListAuditLogs(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	u := c.Get(types.UserKey).(*ent.User)
+
+	var body types.AuditLogsRequest
+	if err := c.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(&body); err != nil {
+		return c.ValidationError(err)
+	}
+
+	filter := &database.AuditFilter{
+		Action: auditlog.Action(body.Action),
+		Limit:  body.Limit,
+		Offset: body.Offset,
+	}
+
+	if body.TokenID != "" {
+		id, err := uuid.Parse(body.TokenID)
+		if err != nil {
+			return apierror.Invalid("token_id", "uuid", "must be a UUID").WithInternal(err)
+		}
+		filter.TokenID = &id
+	}
+
+	if body.Since > 0 {
+		filter.Since = time.Unix(body.Since, 0)
+	}
+
+	if body.Until > 0 {
+		filter.Until = time.Unix(body.Until, 0)
+	}
+
+	// JSON lines exports return every matching event unless a limit is given.
+	export := body.Format == "jsonl"
+	if filter.Limit == 0 && !export {
+		filter.Limit = defaultAuditLimit
+	}
+
+	logs, err := c.Client.ListAuditLogs(c.Request().Context(), u, filter)
+	if err != nil {
+		return err
+	}
+
+	if !export {
+		return c.JSON(http.StatusOK, logs)
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, mimeApplicationNDJSON)
+	res.Header().Set(echo.HeaderContentDisposition, `attachment; filename="audit.jsonl"`)
+	res.WriteHeader(http.StatusOK)
+
+	enc := json.NewEncoder(res)
+	for _, l := range logs {
+		if err := enc.Encode(l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -19,4 +19,11 @@ func Apply(e *echo.Echo, conf *config.Config) {
 	group.GET("/tokens/:id", GetToken)
 	group.DELETE("/tokens/:id", RevokeToken)
 	group.POST("/tokens/:id/reset", ResetToken)
+	group.GET("/audit", ListAuditLogs)
+	group.GET("/webhooks", ListWebhooks)
+	group.POST("/webhooks/new", NewWebhook)
+	group.GET("/webhooks/:id", GetWebhook)
+	group.DELETE("/webhooks/:id", DeleteWebhook)
+	group.GET("/webhooks/:id/deliveries", ListWebhookDeliveries)
+	group.POST("/webhooks/:id/test", TestWebhook)
 }
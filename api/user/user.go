@@ -1,16 +1,17 @@
 package user
 
 import (
-	"context"
-	"fmt"
 	"net/http"
 
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent/auditlog"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
 
 	"github.com/google/uuid"
 
 	"github.com/labstack/echo/v4"
+	"github.com/sthorer/api/api/apierror"
 	"github.com/sthorer/api/api/types"
 	"github.com/sthorer/api/ent"
 )
@@ -27,7 +28,7 @@ func ListTokens(ctx echo.Context) error {
 	tokens, err := c.Client.Token.
 		Query().
 		Where(token.HasUserWith(user.ID(u.ID))).
-		All(context.Background())
+		All(c.Request().Context())
 	if err != nil {
 		return err
 	}
@@ -45,16 +46,20 @@ func NewToken(ctx echo.Context) error {
 	}
 
 	if err := c.Validate(&body); err != nil {
-		return err
+		return c.ValidationError(err)
 	}
 
-	fmt.Println("USER:", user)
-
-	token, err := c.Client.NewToken(context.Background(), user, body.Name)
+	token, err := c.Client.NewToken(c.Request().Context(), user, body.Name)
 	if err != nil {
 		return err
 	}
 
+	c.Audit(&database.AuditEntry{
+		Action:   auditlog.ActionTokenCreate,
+		User:     user,
+		Metadata: map[string]interface{}{"token_id": token.ID, "name": token.Name},
+	})
+
 	return c.JSON(http.StatusOK, &types.TokenSecretResponse{
 		Token:  token,
 		Secret: token.Secret,
@@ -63,12 +68,12 @@ func NewToken(ctx echo.Context) error {
 
 func ResetToken(c echo.Context) error {
 	cc := c.(*types.Context)
-	id, err := uuid.FromBytes([]byte(cc.Param("id")))
+	id, err := uuid.Parse(cc.Param("id"))
 	if err != nil {
-		return cc.NoContent(http.StatusNotFound)
+		return apierror.NotFound()
 	}
 
-	ctx := context.Background()
+	ctx := cc.Request().Context()
 	u := cc.Get(types.UserKey).(*ent.User)
 	t, err := cc.Client.Token.
 		Query().
@@ -76,7 +81,7 @@ func ResetToken(c echo.Context) error {
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return cc.NoContent(http.StatusNotFound)
+			return apierror.NotFound()
 		}
 		return err
 	}
@@ -86,6 +91,12 @@ func ResetToken(c echo.Context) error {
 		return err
 	}
 
+	cc.Audit(&database.AuditEntry{
+		Action:   auditlog.ActionTokenReset,
+		User:     u,
+		Metadata: map[string]interface{}{"token_id": t.ID},
+	})
+
 	return c.JSON(http.StatusOK, &types.TokenSecretResponse{
 		Token:  t,
 		Secret: t.Secret,
@@ -94,19 +105,19 @@ func ResetToken(c echo.Context) error {
 
 func GetToken(ctx echo.Context) error {
 	c := ctx.(*types.Context)
-	id, err := uuid.FromBytes([]byte(c.Param("id")))
+	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.NoContent(http.StatusNotFound)
+		return apierror.NotFound()
 	}
 
 	u := c.Get(types.UserKey).(*ent.User)
 	t, err := c.Client.Token.
 		Query().
 		Where(token.ID(id), token.HasUserWith(user.ID(u.ID))).
-		Only(context.Background())
+		Only(c.Request().Context())
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return c.NoContent(http.StatusNotFound)
+			return apierror.NotFound()
 		}
 		return err
 	}
@@ -116,17 +127,36 @@ func GetToken(ctx echo.Context) error {
 
 func RevokeToken(ctx echo.Context) error {
 	c := ctx.(*types.Context)
-	id, err := uuid.FromBytes([]byte(c.Param("id")))
+	id, err := uuid.Parse(c.Param("id"))
 	if err != nil {
-		return c.NoContent(http.StatusNotFound)
+		return apierror.NotFound()
 	}
 
-	if err := c.Client.RevokeToken(context.Background(), id); err != nil {
+	u := c.Get(types.UserKey).(*ent.User)
+	exists, err := c.Client.Token.
+		Query().
+		Where(token.ID(id), token.HasUserWith(user.ID(u.ID))).
+		Exist(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return apierror.NotFound()
+	}
+
+	if err := c.Client.RevokeToken(c.Request().Context(), id); err != nil {
 		if ent.IsNotFound(err) {
-			return echo.NewHTTPError(http.StatusNotFound)
+			return apierror.NotFound()
 		}
 		return err
 	}
 
+	c.Audit(&database.AuditEntry{
+		Action:   auditlog.ActionTokenRevoke,
+		User:     u,
+		Metadata: map[string]interface{}{"token_id": id},
+	})
+
 	return c.NoContent(http.StatusOK)
 }
@@ -0,0 +1,149 @@
+package user
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+	"github.com/sthorer/api/utils"
+	"github.com/sthorer/api/webhooks"
+)
+
+func ListWebhooks(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	u := c.Get(types.UserKey).(*ent.User)
+
+	hooks, err := c.Client.Webhook.
+		Query().
+		Where(webhook.HasUserWith(user.ID(u.ID))).
+		All(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, hooks)
+}
+
+func NewWebhook(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	u := c.Get(types.UserKey).(*ent.User)
+
+	var body types.NewWebhookRequest
+	if err := c.Bind(&body); err != nil {
+		return err
+	}
+
+	if err := c.Validate(&body); err != nil {
+		return c.ValidationError(err)
+	}
+
+	if err := webhooks.CheckURL(body.URL); err != nil {
+		return apierror.Invalid("url", "url", "must be an http or https URL of a public host").WithInternal(err)
+	}
+
+	secret := body.Secret
+	if secret == "" {
+		s, err := utils.GenerateSecret(40)
+		if err != nil {
+			return err
+		}
+		secret = s
+	}
+
+	w, err := c.Client.NewWebhook(c.Request().Context(), u, body.URL, body.Events, secret)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &types.WebhookSecretResponse{
+		Webhook: w,
+		Secret:  w.Secret,
+	})
+}
+
+func GetWebhook(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	w, err := userWebhook(c)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, w)
+}
+
+func DeleteWebhook(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	w, err := userWebhook(c)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Client.DeleteWebhook(c.Request().Context(), w); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func ListWebhookDeliveries(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	w, err := userWebhook(c)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := w.QueryDeliveries().
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt)).
+		Limit(100).
+		All(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// TestWebhook synchronously sends a ping event to the webhook and returns
+// the resulting delivery. Failed pings are retried like any other delivery.
+func TestWebhook(ctx echo.Context) error {
+	c := ctx.(*types.Context)
+	w, err := userWebhook(c)
+	if err != nil {
+		return err
+	}
+
+	d, err := c.Webhooks.Ping(c.Request().Context(), w)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, d)
+}
+
+func userWebhook(c *types.Context) (*ent.Webhook, error) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, apierror.NotFound()
+	}
+
+	u := c.Get(types.UserKey).(*ent.User)
+	w, err := c.Client.Webhook.
+		Query().
+		Where(webhook.ID(id), webhook.HasUserWith(user.ID(u.ID))).
+		Only(c.Request().Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, apierror.NotFound()
+		}
+		return nil, err
+	}
+
+	return w, nil
+}
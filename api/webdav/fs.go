@@ -0,0 +1,658 @@
+package webdav
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/webdav"
+
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/encryption"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/media"
+)
+
+// fileSystem is the tree of folders and pinned files of a user, seen by the
+// WebDAV handler while serving a request. A folder and a file may have the
+// same name, the folder then hides the file.
+type fileSystem struct {
+	cc *types.Context
+	u  *ent.User
+
+	// Data keys of the encrypted files the request may read
+	keys map[uuid.UUID]*encryption.DataKey
+
+	// bodyErr is the failure to read the body of the request, if any
+	bodyErr error
+}
+
+// body records the failure to read the body of a request in fs, which the
+// handler does not report to the files written.
+type body struct {
+	io.ReadCloser
+	fs *fileSystem
+}
+
+func (b *body) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && err != io.EOF && b.fs.bodyErr == nil {
+		b.fs.bodyErr = err
+	}
+	return n, err
+}
+
+// node is a resolved name: the root, a folder or a file in parent.
+type node struct {
+	parent *ent.Folder
+	name   string
+	folder *ent.Folder
+	file   *ent.File
+}
+
+func (n *node) root() bool {
+	return n.folder == nil && n.file == nil
+}
+
+func (fs *fileSystem) Mkdir(ctx context.Context, name string, perm os.FileMode) error {
+	parent, base, err := fs.parent(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fs.child(ctx, parent, base); err == nil {
+		return os.ErrExist
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if _, err := fs.cc.Client.NewFolder(ctx, fs.u, parent, base); err != nil {
+		return err
+	}
+
+	return fs.cc.Client.TouchFolder(ctx, parent)
+}
+
+func (fs *fileSystem) OpenFile(ctx context.Context, name string, flag int, perm os.FileMode) (webdav.File, error) {
+	if flag&(os.O_WRONLY|os.O_RDWR) != 0 {
+		if flag&os.O_CREATE == 0 {
+			// Files are immutable, they can only be replaced
+			return nil, os.ErrPermission
+		}
+
+		parent, base, err := fs.parent(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+
+		n, err := fs.child(ctx, parent, base)
+		if err == nil && n.folder != nil {
+			return nil, os.ErrExist
+		} else if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+
+		return newWriteFile(fs, parent, base), nil
+	}
+
+	n, err := fs.resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if n.file != nil {
+		return &readFile{fs: fs, f: n.file}, nil
+	}
+
+	return &dirFile{fs: fs, n: n}, nil
+}
+
+// RemoveAll unpins the file at name, or the files of the folder at name and
+// its descendants before deleting them.
+func (fs *fileSystem) RemoveAll(ctx context.Context, name string) error {
+	n, err := fs.resolve(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case n.root():
+		return os.ErrPermission
+	case n.folder != nil:
+		err = files.RemoveFolder(fs.cc, fs.u, n.folder)
+	default:
+		err = fs.removeFiles(ctx, n.parent, n.name)
+	}
+	if err != nil {
+		return err
+	}
+
+	files.PublishUsage(fs.cc, fs.u)
+	return fs.cc.Client.TouchFolder(ctx, n.parent)
+}
+
+// removeFiles unpins the files named name in parent, of which only the
+// latest is visible.
+func (fs *fileSystem) removeFiles(ctx context.Context, parent *ent.Folder, name string) error {
+	for {
+		f, err := fs.cc.Client.FolderFile(ctx, fs.u, parent, name)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+
+		if _, err := files.Remove(fs.cc, fs.u, f); err != nil {
+			return err
+		}
+	}
+}
+
+// Rename moves the file or folder at oldName to newName, which does not
+// exist. The content of files is not pinned again.
+func (fs *fileSystem) Rename(ctx context.Context, oldName, newName string) error {
+	n, err := fs.resolve(ctx, oldName)
+	if err != nil {
+		return err
+	}
+
+	if n.root() {
+		return os.ErrPermission
+	}
+
+	parent, base, err := fs.parent(ctx, newName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fs.child(ctx, parent, base); err == nil {
+		return os.ErrExist
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	if n.folder != nil {
+		if parent != nil {
+			within, err := fs.cc.Client.FolderWithin(ctx, parent, n.folder)
+			if err != nil {
+				return err
+			}
+			if within {
+				return os.ErrPermission
+			}
+		}
+
+		if _, err := fs.cc.Client.MoveFolder(ctx, n.folder, parent, base); err != nil {
+			return err
+		}
+	} else if _, err := fs.cc.Client.MoveFile(ctx, n.file, parent, base); err != nil {
+		return err
+	}
+
+	if err := fs.cc.Client.TouchFolder(ctx, n.parent); err != nil {
+		return err
+	}
+
+	return fs.cc.Client.TouchFolder(ctx, parent)
+}
+
+func (fs *fileSystem) Stat(ctx context.Context, name string) (os.FileInfo, error) {
+	n, err := fs.resolve(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.info(), nil
+}
+
+// resolve returns the node at name.
+func (fs *fileSystem) resolve(ctx context.Context, name string) (*node, error) {
+	if strings.Trim(name, "/") == "" {
+		return &node{name: "/"}, nil
+	}
+
+	parent, base, err := fs.parent(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return fs.child(ctx, parent, base)
+}
+
+// parent returns the folder holding name, which must exist, and the last
+// element of name.
+func (fs *fileSystem) parent(ctx context.Context, name string) (*ent.Folder, string, error) {
+	elements := strings.Split(strings.Trim(path.Clean("/"+name), "/"), "/")
+	base := elements[len(elements)-1]
+	if base == "" {
+		return nil, "", os.ErrInvalid
+	}
+
+	if len(base) > files.MaxNameLength {
+		return nil, "", os.ErrInvalid
+	}
+
+	var parent *ent.Folder
+	for _, element := range elements[:len(elements)-1] {
+		f, err := fs.cc.Client.UserFolder(ctx, fs.u, parent, element)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, "", os.ErrNotExist
+			}
+			return nil, "", err
+		}
+
+		parent = f
+	}
+
+	return parent, base, nil
+}
+
+// child returns the folder or file named name in parent.
+func (fs *fileSystem) child(ctx context.Context, parent *ent.Folder, name string) (*node, error) {
+	n := &node{parent: parent, name: name}
+
+	f, err := fs.cc.Client.UserFolder(ctx, fs.u, parent, name)
+	if err == nil {
+		n.folder = f
+		return n, nil
+	} else if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	file, err := fs.cc.Client.FolderFile(ctx, fs.u, parent, name)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, os.ErrNotExist
+		}
+		return nil, err
+	}
+
+	n.file = file
+	return n, nil
+}
+
+func (n *node) info() *fileInfo {
+	switch {
+	case n.folder != nil:
+		return folderInfo(n.folder)
+	case n.file != nil:
+		return pinnedInfo(n.file)
+	default:
+		return &fileInfo{name: "/", dir: true, modTime: time.Now()}
+	}
+}
+
+// fileInfo describes a folder or pinned file. The ETag of pinned files is
+// their hash, and their content type the one given when uploaded.
+type fileInfo struct {
+	name        string
+	size        int64
+	modTime     time.Time
+	dir         bool
+	etag        string
+	contentType string
+}
+
+func folderInfo(f *ent.Folder) *fileInfo {
+	return &fileInfo{name: f.Name, dir: true, modTime: f.UpdatedAt}
+}
+
+func pinnedInfo(f *ent.File) *fileInfo {
+	info := &fileInfo{name: f.Name, size: f.Size, modTime: f.PinnedAt, etag: `"` + f.Hash + `"`}
+
+	info.contentType = files.ContentType(f)
+
+	return info
+}
+
+func (i *fileInfo) Name() string       { return i.name }
+func (i *fileInfo) Size() int64        { return i.size }
+func (i *fileInfo) ModTime() time.Time { return i.modTime }
+func (i *fileInfo) IsDir() bool        { return i.dir }
+func (i *fileInfo) Sys() interface{}   { return nil }
+
+func (i *fileInfo) Mode() os.FileMode {
+	if i.dir {
+		return os.ModeDir | 0755
+	}
+
+	return 0644
+}
+
+func (i *fileInfo) ETag(ctx context.Context) (string, error) {
+	if i.etag == "" {
+		return "", webdav.ErrNotImplemented
+	}
+
+	return i.etag, nil
+}
+
+func (i *fileInfo) ContentType(ctx context.Context) (string, error) {
+	if i.contentType == "" {
+		return "", webdav.ErrNotImplemented
+	}
+
+	return i.contentType, nil
+}
+
+var (
+	errIsDir     = errors.New("is a directory")
+	errNotDir    = errors.New("not a directory")
+	errReadOnly  = errors.New("file opened for reading")
+	errTruncated = errors.New("content shorter than its declared length")
+)
+
+// readFile streams the content of a pinned file from the IPFS node, from
+// the offset it was seeked to. Encrypted files are only decrypted with the
+// data keys resolved for the request.
+type readFile struct {
+	fs      *fileSystem
+	f       *ent.File
+	offset  int64
+	content *files.Content
+}
+
+func (f *readFile) Read(p []byte) (int, error) {
+	if f.content == nil {
+		key, ok := f.fs.keys[f.f.ID]
+		if !ok {
+			encrypted, err := f.fs.cc.Client.Encrypted(f.fs.cc.Request().Context(), f.f)
+			if err != nil {
+				return 0, err
+			}
+			if encrypted {
+				return 0, os.ErrPermission
+			}
+		}
+
+		f.content = files.NewContent(f.fs.cc, f.f, key)
+		if _, err := f.content.Seek(f.offset, io.SeekStart); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.content.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *readFile) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += f.offset
+	case io.SeekEnd:
+		offset += f.f.Size
+	}
+
+	if offset < 0 {
+		return 0, os.ErrInvalid
+	}
+
+	if f.content != nil {
+		if _, err := f.content.Seek(offset, io.SeekStart); err != nil {
+			return 0, err
+		}
+	}
+	f.offset = offset
+
+	return offset, nil
+}
+
+func (f *readFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, errNotDir
+}
+
+func (f *readFile) Stat() (os.FileInfo, error) {
+	return pinnedInfo(f.f), nil
+}
+
+func (f *readFile) Write(p []byte) (int, error) {
+	return 0, errReadOnly
+}
+
+func (f *readFile) Close() error {
+	if f.content != nil {
+		return f.content.Close()
+	}
+
+	return nil
+}
+
+// dirFile lists the folders and files of a folder, or of the root.
+type dirFile struct {
+	fs *fileSystem
+	n  *node
+
+	entries []os.FileInfo
+	listed  bool
+}
+
+func (f *dirFile) Readdir(count int) ([]os.FileInfo, error) {
+	if !f.listed {
+		if err := f.list(); err != nil {
+			return nil, err
+		}
+		f.listed = true
+	}
+
+	if count <= 0 {
+		entries := f.entries
+		f.entries = nil
+		return entries, nil
+	}
+
+	if len(f.entries) == 0 {
+		return nil, io.EOF
+	}
+
+	if count > len(f.entries) {
+		count = len(f.entries)
+	}
+
+	entries := f.entries[:count]
+	f.entries = f.entries[count:]
+	return entries, nil
+}
+
+func (f *dirFile) list() error {
+	ctx := f.fs.cc.Request().Context()
+	children, err := f.fs.cc.Client.FolderChildren(ctx, f.fs.u, f.n.folder)
+	if err != nil {
+		return err
+	}
+
+	pinned, err := f.fs.cc.Client.FolderFiles(ctx, f.fs.u, f.n.folder)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(children)+len(pinned))
+	for _, child := range children {
+		if !seen[child.Name] {
+			f.entries = append(f.entries, folderInfo(child))
+			seen[child.Name] = true
+		}
+	}
+
+	// Files are listed latest first for each name
+	for _, file := range pinned {
+		if !seen[file.Name] {
+			f.entries = append(f.entries, pinnedInfo(file))
+			seen[file.Name] = true
+		}
+	}
+
+	return nil
+}
+
+func (f *dirFile) Stat() (os.FileInfo, error) {
+	return f.n.info(), nil
+}
+
+func (f *dirFile) Read(p []byte) (int, error) {
+	return 0, errIsDir
+}
+
+func (f *dirFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, nil
+}
+
+func (f *dirFile) Write(p []byte) (int, error) {
+	return 0, errIsDir
+}
+
+func (f *dirFile) Close() error {
+	return nil
+}
+
+// writeFile adds what is written to the IPFS node as it is received, and
+// records the pinned file once closed, replacing the previous one of the
+// same name.
+type writeFile struct {
+	fs     *fileSystem
+	parent *ent.Folder
+	name   string
+
+	w       *io.PipeWriter
+	done    chan error
+	hash    string
+	sample  *media.Sample
+	written int64
+
+	// err is the first failure to write the content
+	err error
+
+	// info is filled once the file is recorded
+	info *fileInfo
+}
+
+func newWriteFile(fs *fileSystem, parent *ent.Folder, name string) *writeFile {
+	r, w := io.Pipe()
+	f := &writeFile{
+		fs:     fs,
+		parent: parent,
+		name:   name,
+		w:      w,
+		done:   make(chan error, 1),
+		info:   &fileInfo{name: name, modTime: time.Now()},
+	}
+
+	go func() {
+		hash, sample, err := files.Add(fs.cc, fs.u, name, fs.cc.Request().ContentLength, r)
+		f.hash, f.sample = hash, sample
+		r.CloseWithError(err)
+		f.done <- err
+	}()
+
+	return f
+}
+
+func (f *writeFile) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.written += int64(n)
+	if err != nil && f.err == nil {
+		f.err = err
+	}
+	return n, err
+}
+
+// Close records the pinned file, unless its content was not received in
+// full: the handler closes files even when the body could not be read, the
+// previous file is then kept.
+func (f *writeFile) Close() error {
+	cc := f.fs.cc
+	ctx := cc.Request().Context()
+
+	err := f.err
+	if err == nil {
+		err = f.fs.bodyErr
+	}
+	if length := cc.Request().ContentLength; err == nil && length >= 0 && f.written != length {
+		err = errTruncated
+	}
+
+	if err != nil {
+		f.w.CloseWithError(err)
+		if addErr := <-f.done; addErr == nil {
+			files.Discard(cc, f.hash)
+		}
+		return err
+	}
+
+	f.w.Close()
+	if err := <-f.done; err != nil {
+		return err
+	}
+
+	// The size of chunked uploads is only known now
+	if err := files.CheckLimits(cc, f.fs.u, []string{f.name}, []int64{f.written}); err != nil {
+		files.Discard(cc, f.hash)
+		return err
+	}
+
+	previous, err := cc.Client.FolderFile(ctx, f.fs.u, f.parent, f.name)
+	if err != nil && !ent.IsNotFound(err) {
+		files.Discard(cc, f.hash)
+		return err
+	}
+
+	metadata := map[string]interface{}{
+		"name": f.name,
+		"size": f.written,
+	}
+	files.Inspect(metadata, f.sample, f.name, cc.Request().Header.Get("Content-Type"))
+
+	// Replacing a file keeps the tags and metadata set by its user
+	var tags []string
+	if previous != nil {
+		tags = previous.Tags
+		metadata = files.WithMeta(metadata, files.Meta(previous))
+	}
+
+	pinned, err := cc.Client.NewFile(ctx, f.fs.u, f.parent, f.name, f.hash, f.written, tags, metadata)
+	if err != nil {
+		files.Discard(cc, f.hash)
+		return err
+	}
+
+	files.Pinned(cc, f.fs.u, pinned, f.name)
+
+	if previous != nil {
+		if _, err := files.Remove(cc, f.fs.u, previous); err != nil {
+			return err
+		}
+	}
+
+	if err := cc.Client.TouchFolder(ctx, f.parent); err != nil {
+		return err
+	}
+
+	files.PublishUsage(cc, f.fs.u)
+
+	*f.info = *pinnedInfo(pinned)
+	return nil
+}
+
+func (f *writeFile) Stat() (os.FileInfo, error) {
+	return f.info, nil
+}
+
+func (f *writeFile) Read(p []byte) (int, error) {
+	return 0, os.ErrPermission
+}
+
+func (f *writeFile) Seek(offset int64, whence int) (int64, error) {
+	return 0, os.ErrPermission
+}
+
+func (f *writeFile) Readdir(count int) ([]os.FileInfo, error) {
+	return nil, errNotDir
+}
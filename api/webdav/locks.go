@@ -0,0 +1,135 @@
+package webdav
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/net/webdav"
+
+	"github.com/sthorer/api/ent"
+)
+
+// sweepInterval is how often the lock systems of the users who did not come
+// back once their locks expired are looked for.
+const sweepInterval = time.Minute
+
+// locks holds the WebDAV locks of each user, in memory: they are lost on
+// restart, which clients recover from. The lock system of a user is dropped
+// once no request uses it and it holds no lock.
+var locks = &lockSystems{systems: make(map[int]*lockSystem)}
+
+type lockSystems struct {
+	mu        sync.Mutex
+	systems   map[int]*lockSystem
+	lastSweep time.Time
+}
+
+// acquire returns the lock system of u for a request, which must call
+// release once served.
+func (l *lockSystems) acquire(u *ent.User) (webdav.LockSystem, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+		l.lastSweep = now
+	}
+
+	ls, ok := l.systems[u.ID]
+	if !ok {
+		ls = &lockSystem{LockSystem: webdav.NewMemLS(), expiries: make(map[string]time.Time)}
+		l.systems[u.ID] = ls
+	}
+	ls.requests++
+
+	return ls, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		ls.requests--
+		if ls.requests == 0 && ls.empty(time.Now()) {
+			delete(l.systems, u.ID)
+		}
+	}
+}
+
+func (l *lockSystems) sweep(now time.Time) {
+	for id, ls := range l.systems {
+		if ls.requests == 0 && ls.empty(now) {
+			delete(l.systems, id)
+		}
+	}
+}
+
+// lockSystem keeps track of when the locks of a webdav.LockSystem expire,
+// which it does not tell.
+type lockSystem struct {
+	webdav.LockSystem
+
+	// requests is the number of requests using the lock system, guarded
+	// by the mutex of lockSystems
+	requests int
+
+	mu sync.Mutex
+	// expiries are the expiry times of the locks by token, zero for the
+	// ones which never expire
+	expiries map[string]time.Time
+}
+
+func (ls *lockSystem) Create(now time.Time, details webdav.LockDetails) (string, error) {
+	token, err := ls.LockSystem.Create(now, details)
+	if err == nil {
+		ls.expire(token, now, details.Duration)
+	}
+
+	return token, err
+}
+
+func (ls *lockSystem) Refresh(now time.Time, token string, duration time.Duration) (webdav.LockDetails, error) {
+	details, err := ls.LockSystem.Refresh(now, token, duration)
+	if err == nil {
+		ls.expire(token, now, duration)
+	}
+
+	return details, err
+}
+
+func (ls *lockSystem) Unlock(now time.Time, token string) error {
+	err := ls.LockSystem.Unlock(now, token)
+	if err == nil || err == webdav.ErrNoSuchLock {
+		ls.mu.Lock()
+		delete(ls.expiries, token)
+		ls.mu.Unlock()
+	}
+
+	return err
+}
+
+func (ls *lockSystem) expire(token string, now time.Time, duration time.Duration) {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	// Negative durations are infinite timeouts
+	var expiry time.Time
+	if duration >= 0 {
+		expiry = now.Add(duration)
+	}
+	ls.expiries[token] = expiry
+}
+
+// empty tells whether all the locks were unlocked or expired at now,
+// forgetting the expired ones.
+func (ls *lockSystem) empty(now time.Time) bool {
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+
+	for token, expiry := range ls.expiries {
+		if expiry.IsZero() || now.Before(expiry) {
+			continue
+		}
+		delete(ls.expiries, token)
+	}
+
+	return len(ls.expiries) == 0
+}
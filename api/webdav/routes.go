@@ -0,0 +1,118 @@
+package webdav
+
+import (
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/net/webdav"
+
+	"github.com/sthorer/api/api/apierror"
+	"github.com/sthorer/api/api/files"
+	"github.com/sthorer/api/api/middlewares"
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/encryption"
+	"github.com/sthorer/api/ent"
+)
+
+// Prefix is the path the tree of the authenticated user is served under.
+const Prefix = "/dav"
+
+// methods are the methods routed to the WebDAV handler. The route of POST
+// also receives the requests made with the methods tunnelled by
+// middlewares.TunnelMethods: MKCOL, COPY, MOVE, LOCK, UNLOCK and PROPPATCH.
+var methods = []string{
+	http.MethodOptions,
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodDelete,
+	echo.PROPFIND,
+}
+
+// Apply serves WebDAV under Prefix. The methods echo does not route must be
+// tunnelled with middlewares.TunnelMethods.
+func Apply(e *echo.Echo, conf *config.Config) {
+	if !conf.WebDAV.Enabled {
+		return
+	}
+
+	group := e.Group(Prefix)
+
+	group.Use(middlewares.TokenAuth())
+
+	group.Match(methods, "", Serve)
+	group.Match(methods, "/", Serve)
+	group.Match(methods, "/*", Serve)
+}
+
+func Serve(c echo.Context) error {
+	cc := c.(*types.Context)
+	u := cc.Get(types.UserKey).(*ent.User)
+	req := cc.Request()
+
+	// Limits are checked before receiving the content when its size is
+	// known, to answer with the reason
+	if req.Method == http.MethodPut && req.ContentLength >= 0 {
+		if err := files.CheckLimits(cc, u, []string{req.URL.Path}, []int64{req.ContentLength}); err != nil {
+			return err
+		}
+	}
+
+	fs := &fileSystem{cc: cc, u: u, keys: make(map[uuid.UUID]*encryption.DataKey)}
+
+	switch req.Method {
+	case http.MethodPut:
+		req.Body = &body{ReadCloser: req.Body, fs: fs}
+	case http.MethodGet, http.MethodHead:
+		n, err := fs.resolve(req.Context(), strings.TrimPrefix(req.URL.Path, Prefix))
+		if err == nil && n.file != nil {
+			// Encrypted files are decrypted with the passphrase of the
+			// request, errors are answered before the handler serves them
+			key, err := files.DataKey(cc, n.file)
+			if err != nil {
+				return err
+			}
+			if key != nil {
+				fs.keys[n.file.ID] = key
+			}
+
+			// The handler would serve files with the type of their
+			// extension
+			cc.Response().Header().Set(echo.HeaderContentType, files.ContentType(n.file))
+		}
+	case "COPY":
+		// Copies would be uploaded decrypted
+		n, err := fs.resolve(req.Context(), strings.TrimPrefix(req.URL.Path, Prefix))
+		if err == nil && n.file != nil {
+			encrypted, err := cc.Client.Encrypted(req.Context(), n.file)
+			if err != nil {
+				return err
+			}
+			if encrypted {
+				return apierror.Conflict(apierror.CodeFileEncrypted, "encrypted files cannot be copied over WebDAV")
+			}
+		}
+	}
+
+	ls, release := locks.acquire(u)
+	defer release()
+
+	h := &webdav.Handler{
+		Prefix:     Prefix,
+		FileSystem: fs,
+		LockSystem: ls,
+		Logger: func(r *http.Request, err error) {
+			if err != nil && !os.IsNotExist(err) {
+				cc.Log().Warn("webdav request failed", "method", r.Method, "path", r.URL.Path, "error", err)
+			}
+		},
+	}
+	h.ServeHTTP(cc.Response(), req)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+func (c *Client) AuditLogs(ctx context.Context, filter *types.AuditLogsRequest) ([]*ent.AuditLog, error) {
+	var logs []*ent.AuditLog
+	if err := c.call(ctx, &request{
+		method: http.MethodGet,
+		path:   "/user/audit",
+		query:  auditQuery(filter, "json"),
+	}, &logs); err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// ExportAuditLogs writes the matching audit events to w as JSON lines.
+func (c *Client) ExportAuditLogs(ctx context.Context, filter *types.AuditLogsRequest, w io.Writer) error {
+	res, err := c.do(ctx, &request{
+		method: http.MethodGet,
+		path:   "/user/audit",
+		query:  auditQuery(filter, "jsonl"),
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	_, err = io.Copy(w, res.Body)
+	return err
+}
+
+func auditQuery(filter *types.AuditLogsRequest, format string) url.Values {
+	q := url.Values{"format": {format}}
+	if filter == nil {
+		return q
+	}
+
+	if filter.Action != "" {
+		q.Set("action", filter.Action)
+	}
+
+	if filter.TokenID != "" {
+		q.Set("token_id", filter.TokenID)
+	}
+
+	if filter.Since > 0 {
+		q.Set("since", strconv.FormatInt(filter.Since, 10))
+	}
+
+	if filter.Until > 0 {
+		q.Set("until", strconv.FormatInt(filter.Until, 10))
+	}
+
+	if filter.Limit > 0 {
+		q.Set("limit", strconv.Itoa(filter.Limit))
+	}
+
+	if filter.Offset > 0 {
+		q.Set("offset", strconv.Itoa(filter.Offset))
+	}
+
+	return q
+}
@@ -0,0 +1,39 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// Login authenticates with an email and password. On success, the returned
+// JWT is used as the credentials of the client.
+func (c *Client) Login(ctx context.Context, email, password string) (*types.AuthResponse, error) {
+	var res types.AuthResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/auth/login",
+		body:   jsonBody(&types.AuthRequest{Email: email, Password: password}),
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	c.SetCredentials(JWT(res.Token))
+
+	return &res, nil
+}
+
+func (c *Client) Register(ctx context.Context, email, password string) (*ent.User, error) {
+	var u ent.User
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/auth/register",
+		body:   jsonBody(&types.AuthRequest{Email: email, Password: password}),
+	}, &u); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
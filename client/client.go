@@ -0,0 +1,312 @@
+// Package client is a Go client for the Sthorer API.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = time.Millisecond * 500
+)
+
+// Client is a Sthorer API client. It is safe for concurrent use, except for
+// changing its credentials while requests are in flight.
+type Client struct {
+	baseURL      *url.URL
+	httpClient   *http.Client
+	credentials  Credentials
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithCredentials sets the credentials used to authenticate requests.
+func WithCredentials(creds Credentials) Option {
+	return func(c *Client) {
+		c.credentials = creds
+	}
+}
+
+// WithRetries sets how many times failed idempotent requests are retried,
+// waiting backoff, then twice as long, and so on between attempts.
+func WithRetries(max int, backoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = max
+		c.retryBackoff = backoff
+	}
+}
+
+func New(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		baseURL:      u,
+		httpClient:   http.DefaultClient,
+		maxRetries:   defaultMaxRetries,
+		retryBackoff: defaultRetryBackoff,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// SetCredentials replaces the credentials used to authenticate requests.
+func (c *Client) SetCredentials(creds Credentials) {
+	c.credentials = creds
+}
+
+// Error is returned when the API responds with an unsuccessful status.
+type Error struct {
+	StatusCode int
+
+	// Code identifies the kind of error, empty when the response is not
+	// an API error, such as those of a proxy
+	Code    string
+	Message string
+
+	// Fields lists the invalid request fields of validation errors
+	Fields []*FieldError
+
+	// Details holds values specific to the code, such as a limit
+	Details map[string]interface{}
+
+	// RequestID identifies the request in the logs of the API
+	RequestID string
+}
+
+// FieldError is an invalid request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	message := e.Message
+	if message == "" {
+		message = http.StatusText(e.StatusCode)
+	}
+
+	if e.Code == "" {
+		return fmt.Sprintf("sthorer: %d %s", e.StatusCode, message)
+	}
+
+	return fmt.Sprintf("sthorer: %d %s: %s", e.StatusCode, e.Code, message)
+}
+
+// request describes an API call. body is called for each attempt and
+// returns the request body and its content type. header is sent after the
+// credentials, which it may replace.
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	header http.Header
+	body   func() (io.Reader, string, error)
+}
+
+func jsonBody(v interface{}) func() (io.Reader, string, error) {
+	return func() (io.Reader, string, error) {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return bytes.NewReader(raw), "application/json", nil
+	}
+}
+
+// do sends r, retrying idempotent requests on network errors and transient
+// statuses, and returns the successful response. The caller must close its
+// body.
+func (c *Client) do(ctx context.Context, r *request) (*http.Response, error) {
+	var lastErr error
+
+	// The API does not deduplicate requests, those sent again could be
+	// applied twice
+	maxRetries := c.maxRetries
+	if !idempotent[r.method] {
+		maxRetries = 0
+	}
+
+	backoff := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		res, err := c.send(ctx, r)
+		switch {
+		case err != nil:
+			if _, ok := err.(*buildError); ok || ctx.Err() != nil {
+				return nil, err
+			}
+			lastErr = err
+		case res.StatusCode >= 200 && res.StatusCode <= 299:
+			return res, nil
+		default:
+			lastErr = readError(res)
+			_ = res.Body.Close()
+
+			if !retryable(res.StatusCode) {
+				return nil, lastErr
+			}
+		}
+
+		if attempt >= maxRetries {
+			return nil, lastErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
+
+func (c *Client) send(ctx context.Context, r *request) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path += r.path
+	u.RawQuery = r.query.Encode()
+
+	var (
+		body        io.Reader
+		contentType string
+	)
+
+	if r.body != nil {
+		var err error
+		if body, contentType, err = r.body(); err != nil {
+			return nil, &buildError{err}
+		}
+	}
+
+	req, err := http.NewRequest(r.method, u.String(), body)
+	if err != nil {
+		return nil, &buildError{err}
+	}
+
+	req = req.WithContext(ctx)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	if c.credentials != nil {
+		c.credentials.Authenticate(req)
+	}
+
+	for name, values := range r.header {
+		req.Header[name] = values
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// buildError wraps errors preparing a request, which are never retried.
+type buildError struct {
+	err error
+}
+
+func (e *buildError) Error() string {
+	return e.err.Error()
+}
+
+// idempotent are the methods of requests which can be sent again.
+var idempotent = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+	http.MethodOptions: true,
+}
+
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+func readError(res *http.Response) error {
+	raw, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1<<16))
+	apiErr := &Error{StatusCode: res.StatusCode}
+
+	var body struct {
+		Error *struct {
+			Code      string                 `json:"code"`
+			Message   string                 `json:"message"`
+			Fields    []*FieldError          `json:"fields"`
+			Details   map[string]interface{} `json:"details"`
+			RequestID string                 `json:"request_id"`
+		} `json:"error"`
+	}
+
+	if json.Unmarshal(raw, &body) == nil && body.Error != nil {
+		apiErr.Code = body.Error.Code
+		apiErr.Message = body.Error.Message
+		apiErr.Fields = body.Error.Fields
+		apiErr.Details = body.Error.Details
+		apiErr.RequestID = body.Error.RequestID
+	} else {
+		apiErr.Message = strings.TrimSpace(string(raw))
+	}
+
+	return apiErr
+}
+
+// call sends r and decodes the JSON response into out, if not nil.
+func (c *Client) call(ctx context.Context, r *request, out interface{}) error {
+	res, err := c.do(ctx, r)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if out == nil {
+		_, err = io.Copy(ioutil.Discard, res.Body)
+		return err
+	}
+
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// stream sends r and returns the body of the response, which the caller
+// must close.
+func (c *Client) stream(ctx context.Context, r *request) (io.ReadCloser, error) {
+	res, err := c.do(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// Ping checks that the API is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	return c.call(ctx, &request{method: http.MethodGet, path: "/"}, nil)
+}
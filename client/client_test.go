@@ -0,0 +1,143 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// dropConnection is a status making the test server close the connection
+// without answering.
+const dropConnection = 0
+
+func TestRetries(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statuses   []int
+		maxRetries int
+		attempts   int
+		status     int
+	}{
+		{"GET succeeds after transient statuses", http.MethodGet, []int{503, 502, 504, 200}, 3, 4, 200},
+		{"GET retries rate limited requests", http.MethodGet, []int{429, 200}, 3, 2, 200},
+		{"GET retries dropped connections", http.MethodGet, []int{dropConnection, 200}, 3, 2, 200},
+		{"GET gives up after the retries", http.MethodGet, []int{503, 503, 503, 503}, 2, 3, 503},
+		{"GET is not retried without retries", http.MethodGet, []int{503, 200}, 0, 1, 503},
+		{"GET is not retried on server errors", http.MethodGet, []int{500, 200}, 3, 1, 500},
+		{"GET is not retried on client errors", http.MethodGet, []int{404, 200}, 3, 1, 404},
+		{"HEAD is retried", http.MethodHead, []int{503, 200}, 3, 2, 200},
+		{"PUT is retried", http.MethodPut, []int{503, 200}, 3, 2, 200},
+		{"DELETE is retried", http.MethodDelete, []int{503, 200}, 3, 2, 200},
+		{"POST is not retried", http.MethodPost, []int{503, 200}, 3, 1, 503},
+		{"POST is not retried on dropped connections", http.MethodPost, []int{dropConnection, 200}, 3, 1, dropConnection},
+		{"PATCH is not retried", http.MethodPatch, []int{429, 200}, 3, 1, 429},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu     sync.Mutex
+				bodies []string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+
+				mu.Lock()
+				bodies = append(bodies, string(body))
+				attempt := len(bodies)
+				mu.Unlock()
+
+				status := tt.statuses[len(tt.statuses)-1]
+				if attempt <= len(tt.statuses) {
+					status = tt.statuses[attempt-1]
+				}
+
+				if status == dropConnection {
+					conn, _, _ := w.(http.Hijacker).Hijack()
+					_ = conn.Close()
+					return
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				if status != 200 {
+					_, _ = w.Write([]byte(`{"error":{"code":"unavailable","message":"try again","request_id":"req"}}`))
+				}
+			}))
+			defer srv.Close()
+
+			c, err := New(srv.URL, WithRetries(tt.maxRetries, time.Millisecond))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			r := &request{method: tt.method, path: "/"}
+			if tt.method != http.MethodGet && tt.method != http.MethodHead {
+				r.body = jsonBody(map[string]string{"k": "v"})
+			}
+
+			err = c.call(context.Background(), r, nil)
+
+			var apiErr *Error
+			switch {
+			case tt.status == 200 && err != nil:
+				t.Errorf("call() error = %v, want none", err)
+			case tt.status == dropConnection && err == nil:
+				t.Errorf("call() error = nil, want a network error")
+			case tt.status != 200 && tt.status != dropConnection:
+				if !errors.As(err, &apiErr) {
+					t.Fatalf("call() error = %v, want an *Error", err)
+				}
+				if apiErr.StatusCode != tt.status || apiErr.Code != "unavailable" || apiErr.RequestID != "req" {
+					t.Errorf("call() error = %+v, want status %d from the envelope", apiErr, tt.status)
+				}
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if len(bodies) != tt.attempts {
+				t.Errorf("got %d attempts, want %d", len(bodies), tt.attempts)
+			}
+
+			// Retries send the body again
+			for i, body := range bodies {
+				if r.body != nil && body != `{"k":"v"}` {
+					t.Errorf("attempt %d sent body %q", i+1, body)
+				}
+			}
+		})
+	}
+}
+
+func TestRetriesStopWithContext(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	c, err := New(srv.URL, WithRetries(5, time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/"}, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("call() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if n := atomic.LoadInt32(&attempts); n != 1 {
+		t.Errorf("got %d attempts, want 1", n)
+	}
+}
@@ -0,0 +1,27 @@
+package client
+
+import "net/http"
+
+// Credentials authenticate requests to the API.
+type Credentials interface {
+	Authenticate(req *http.Request)
+}
+
+// JWT authenticates with a token returned by Login. It grants access to the
+// account endpoints under /user.
+type JWT string
+
+func (t JWT) Authenticate(req *http.Request) {
+	req.Header.Set("Authorization", "Bearer "+string(t))
+}
+
+// APIToken authenticates with an API token secret, as created by NewToken.
+// It grants access to the file endpoints under /files.
+type APIToken struct {
+	Email  string
+	Secret string
+}
+
+func (t *APIToken) Authenticate(req *http.Request) {
+	req.SetBasicAuth(t.Email, t.Secret)
+}
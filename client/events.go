@@ -0,0 +1,64 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Event is an event received from the event stream. Data holds the JSON
+// payload, whose shape depends on Type.
+type Event struct {
+	ID   uint64
+	Type string
+	Data json.RawMessage
+}
+
+// Events subscribes to the event stream of the authenticated user and calls
+// fn for every event until ctx is done, the stream ends or fn returns an
+// error, which is then returned.
+func (c *Client) Events(ctx context.Context, fn func(*Event) error) error {
+	res, err := c.do(ctx, &request{method: http.MethodGet, path: "/events"})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	var (
+		e    Event
+		data []string
+	)
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if e.Type != "" || len(data) > 0 {
+				e.Data = json.RawMessage(strings.Join(data, "\n"))
+				if err := fn(&e); err != nil {
+					return err
+				}
+			}
+			e, data = Event{}, nil
+		case strings.HasPrefix(line, ":"):
+			// Comments are heartbeats
+		case strings.HasPrefix(line, "id:"):
+			e.ID, _ = strconv.ParseUint(strings.TrimSpace(line[3:]), 10, 64)
+		case strings.HasPrefix(line, "event:"):
+			e.Type = strings.TrimSpace(line[6:])
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(line[5:], " "))
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return scanner.Err()
+}
@@ -0,0 +1,303 @@
+package client
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+// PassphraseHeader is the header holding the passphrase of files encrypted
+// with one.
+const PassphraseHeader = "X-Encryption-Passphrase"
+
+// UploadFile is a file to upload.
+type UploadFile struct {
+	Name   string
+	Reader io.Reader
+
+	// Size of the content, only used to report progress. Zero if unknown.
+	Size int64
+}
+
+// ProgressFunc is called while files are uploaded with the name of the file
+// being sent, the number of bytes of it sent so far and its size.
+type ProgressFunc func(name string, sent, total int64)
+
+// Upload streams files to the API, which pins them. Uploads are not
+// retried. progress may be nil.
+func (c *Client) Upload(ctx context.Context, files []*UploadFile, progress ProgressFunc) ([]*ent.File, error) {
+	var pinned []*ent.File
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/files/upload",
+		body:   multipartBody(files, progress),
+	}, &pinned); err != nil {
+		return nil, err
+	}
+
+	return pinned, nil
+}
+
+// UploadPath uploads the file at path.
+func (c *Client) UploadPath(ctx context.Context, path string, progress ProgressFunc) ([]*ent.File, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Upload(ctx, []*UploadFile{{
+		Name:   filepath.Base(path),
+		Reader: f,
+		Size:   info.Size(),
+	}}, progress)
+}
+
+// Unpin unpins a file and returns it.
+func (c *Client) Unpin(ctx context.Context, id uuid.UUID) (*ent.File, error) {
+	var f ent.File
+	if err := c.call(ctx, &request{method: http.MethodDelete, path: "/files/" + id.String()}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// UpdateFile replaces the tags of a file and sets or, for nil values,
+// deletes its metadata keys.
+func (c *Client) UpdateFile(ctx context.Context, id uuid.UUID, req *types.UpdateFileRequest) (*ent.File, error) {
+	var f ent.File
+	if err := c.call(ctx, &request{
+		method: http.MethodPatch,
+		path:   "/files/" + id.String(),
+		body:   jsonBody(req),
+	}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// MoveFile moves a file to path, whose missing folders are created.
+func (c *Client) MoveFile(ctx context.Context, id uuid.UUID, path string) (*types.FileResponse, error) {
+	return c.filePath(ctx, id, "/move", path)
+}
+
+// CopyFile pins a copy of a file at path, whose missing folders are created.
+func (c *Client) CopyFile(ctx context.Context, id uuid.UUID, path string) (*types.FileResponse, error) {
+	return c.filePath(ctx, id, "/copy", path)
+}
+
+func (c *Client) filePath(ctx context.Context, id uuid.UUID, action, path string) (*types.FileResponse, error) {
+	var f types.FileResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/files/" + id.String() + action,
+		body:   jsonBody(&types.PathRequest{Path: path}),
+	}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// Download returns the content of a file. passphrase is only needed for
+// files encrypted with one. The caller must close the content.
+func (c *Client) Download(ctx context.Context, id uuid.UUID, passphrase string) (io.ReadCloser, error) {
+	header := http.Header{}
+	if passphrase != "" {
+		header.Set(PassphraseHeader, passphrase)
+	}
+
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/files/" + id.String() + "/content", header: header})
+}
+
+// Thumbnail returns a JPEG thumbnail of an image file, size pixels wide at
+// most. The caller must close it.
+func (c *Client) Thumbnail(ctx context.Context, id uuid.UUID, size int) (io.ReadCloser, error) {
+	q := url.Values{}
+	if size > 0 {
+		q.Set("size", strconv.Itoa(size))
+	}
+
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/files/" + id.String() + "/thumbnail", query: q})
+}
+
+// ExportCAR returns the DAG of a file as a CAR archive. The caller must
+// close it.
+func (c *Client) ExportCAR(ctx context.Context, id uuid.UUID) (io.ReadCloser, error) {
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/files/" + id.String() + "/car"})
+}
+
+// ImportCAR uploads CAR archives and pins their roots. Imports are not
+// retried. progress may be nil.
+func (c *Client) ImportCAR(ctx context.Context, files []*UploadFile, progress ProgressFunc) ([]*ent.File, error) {
+	var pinned []*ent.File
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/files/import/car",
+		body:   multipartBody(files, progress),
+	}, &pinned); err != nil {
+		return nil, err
+	}
+
+	return pinned, nil
+}
+
+// Presign returns a URL anyone can upload a file to, without credentials,
+// until it expires.
+func (c *Client) Presign(ctx context.Context, req *types.PresignUploadRequest) (*types.PresignedUploadResponse, error) {
+	var res types.PresignedUploadResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/files/presign",
+		body:   jsonBody(req),
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SearchFiles lists the pinned files of the user matching filter, latest
+// first, or the most relevant first when it has a query.
+func (c *Client) SearchFiles(ctx context.Context, filter *types.SearchFilesRequest) (*types.SearchFilesResponse, error) {
+	var res types.SearchFilesResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodGet,
+		path:   "/files/search",
+		query:  searchQuery(filter),
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func searchQuery(filter *types.SearchFilesRequest) url.Values {
+	q := url.Values{}
+	if filter == nil {
+		return q
+	}
+
+	setString := func(key, v string) {
+		if v != "" {
+			q.Set(key, v)
+		}
+	}
+	setInt := func(key string, v int64) {
+		if v > 0 {
+			q.Set(key, strconv.FormatInt(v, 10))
+		}
+	}
+	// Bounds are set when not nil, zero is a bound too
+	setBound := func(key string, v interface{}) {
+		switch v := v.(type) {
+		case *int:
+			if v != nil {
+				q.Set(key, strconv.Itoa(*v))
+			}
+		case *int64:
+			if v != nil {
+				q.Set(key, strconv.FormatInt(*v, 10))
+			}
+		case *float64:
+			if v != nil {
+				q.Set(key, strconv.FormatFloat(*v, 'f', -1, 64))
+			}
+		}
+	}
+
+	setString("q", filter.Query)
+	for _, tag := range filter.Tags {
+		q.Add("tag", tag)
+	}
+	for _, pair := range filter.Metadata {
+		q.Add("meta", pair)
+	}
+	setString("name_prefix", filter.NamePrefix)
+	setString("name_contains", filter.NameContains)
+	setBound("min_size", filter.MinSize)
+	setBound("max_size", filter.MaxSize)
+	setInt("since", filter.Since)
+	setInt("until", filter.Until)
+	setString("content_type", filter.ContentType)
+	setBound("min_width", filter.MinWidth)
+	setBound("max_width", filter.MaxWidth)
+	setBound("min_height", filter.MinHeight)
+	setBound("max_height", filter.MaxHeight)
+	setBound("min_duration", filter.MinDuration)
+	setBound("max_duration", filter.MaxDuration)
+	setBound("min_pages", filter.MinPages)
+	setBound("max_pages", filter.MaxPages)
+	setInt("taken_since", filter.TakenSince)
+	setInt("taken_until", filter.TakenUntil)
+	setInt("limit", int64(filter.Limit))
+	setInt("offset", int64(filter.Offset))
+
+	return q
+}
+
+func multipartBody(files []*UploadFile, progress ProgressFunc) func() (io.Reader, string, error) {
+	return func() (io.Reader, string, error) {
+		pr, pw := io.Pipe()
+		mw := multipart.NewWriter(pw)
+
+		go func() {
+			for _, f := range files {
+				part, err := mw.CreateFormFile("file", f.Name)
+				if err != nil {
+					_ = pw.CloseWithError(err)
+					return
+				}
+
+				var r io.Reader = f.Reader
+				if progress != nil {
+					r = &progressReader{Reader: f.Reader, name: f.Name, total: f.Size, progress: progress}
+				}
+
+				if _, err := io.Copy(part, r); err != nil {
+					_ = pw.CloseWithError(err)
+					return
+				}
+			}
+
+			_ = pw.CloseWithError(mw.Close())
+		}()
+
+		return pr, mw.FormDataContentType(), nil
+	}
+}
+
+type progressReader struct {
+	io.Reader
+	name     string
+	sent     int64
+	total    int64
+	progress ProgressFunc
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		r.progress(r.name, r.sent, r.total)
+	}
+
+	return n, err
+}
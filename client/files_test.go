@@ -0,0 +1,93 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/sthorer/api/api/types"
+)
+
+func TestSearchQueryRoundTrip(t *testing.T) {
+	size, width, pages := int64(1024), 640, 0
+	duration := 2.5
+
+	tests := []struct {
+		name   string
+		filter *types.SearchFilesRequest
+	}{
+		{"empty", &types.SearchFilesRequest{}},
+		{"every field", &types.SearchFilesRequest{
+			Query:        "report",
+			Tags:         []string{"a", "b"},
+			Metadata:     []string{"k=v", "x=y=z"},
+			NamePrefix:   "2020-",
+			NameContains: "draft",
+			MinSize:      &size,
+			MaxSize:      &size,
+			Since:        1600000000,
+			Until:        1700000000,
+			ContentType:  "image/",
+			MinWidth:     &width,
+			MaxWidth:     &width,
+			MinHeight:    &width,
+			MaxHeight:    &width,
+			MinDuration:  &duration,
+			MaxDuration:  &duration,
+			MinPages:     &pages,
+			MaxPages:     &pages,
+			TakenSince:   1500000000,
+			TakenUntil:   1500000001,
+			Limit:        10,
+			Offset:       20,
+		}},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/files/search?"+searchQuery(tt.filter).Encode(), nil)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			var got types.SearchFilesRequest
+			if err := c.Bind(&got); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(&got, tt.filter) {
+				t.Errorf("got %+v, want %+v", got, *tt.filter)
+			}
+		})
+	}
+}
+
+func TestSearchQueryEncodesEveryField(t *testing.T) {
+	fields := reflect.TypeOf(types.SearchFilesRequest{})
+	q := searchQuery(&types.SearchFilesRequest{})
+	if len(q) != 0 {
+		t.Fatalf("empty filter encoded as %v", q)
+	}
+
+	// Fields added to the request fail here until they are encoded
+	want := map[string]bool{}
+	for i := 0; i < fields.NumField(); i++ {
+		want[fields.Field(i).Tag.Get("query")] = true
+	}
+
+	size, width, duration := int64(1), 1, 1.0
+	q = searchQuery(&types.SearchFilesRequest{
+		Query: "q", Tags: []string{"t"}, Metadata: []string{"k=v"}, NamePrefix: "p", NameContains: "c",
+		MinSize: &size, MaxSize: &size, Since: 1, Until: 1, ContentType: "c",
+		MinWidth: &width, MaxWidth: &width, MinHeight: &width, MaxHeight: &width,
+		MinDuration: &duration, MaxDuration: &duration, MinPages: &width, MaxPages: &width,
+		TakenSince: 1, TakenUntil: 1, Limit: 1, Offset: 1,
+	})
+	for key := range want {
+		if _, ok := q[key]; !ok {
+			t.Errorf("%s is not encoded", key)
+		}
+	}
+}
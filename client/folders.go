@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+)
+
+// FolderAt lists a page of the content of the folder at path, the root
+// when empty.
+func (c *Client) FolderAt(ctx context.Context, path string, limit, offset int) (*types.FolderContentsResponse, error) {
+	q := pageQuery(limit, offset)
+	if path != "" {
+		q.Set("path", path)
+	}
+
+	var res types.FolderContentsResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/folders", query: q}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// NewFolder creates the folder at path, along with its missing parents.
+func (c *Client) NewFolder(ctx context.Context, path string) (*types.FolderResponse, error) {
+	var f types.FolderResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/folders",
+		body:   jsonBody(&types.NewFolderRequest{Path: path}),
+	}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// GetFolder returns a folder along with its path.
+func (c *Client) GetFolder(ctx context.Context, id uuid.UUID) (*types.FolderResponse, error) {
+	var f types.FolderResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/folders/" + id.String()}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// FolderContents lists a page of the content of a folder.
+func (c *Client) FolderContents(ctx context.Context, id uuid.UUID, limit, offset int) (*types.FolderContentsResponse, error) {
+	var res types.FolderContentsResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodGet,
+		path:   "/folders/" + id.String() + "/contents",
+		query:  pageQuery(limit, offset),
+	}, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// MoveFolder moves a folder to path, whose missing folders are created.
+func (c *Client) MoveFolder(ctx context.Context, id uuid.UUID, path string) (*types.FolderResponse, error) {
+	return c.folderPath(ctx, id, "/move", path)
+}
+
+// CopyFolder copies a folder and its content to path, whose missing
+// folders are created.
+func (c *Client) CopyFolder(ctx context.Context, id uuid.UUID, path string) (*types.FolderResponse, error) {
+	return c.folderPath(ctx, id, "/copy", path)
+}
+
+func (c *Client) folderPath(ctx context.Context, id uuid.UUID, action, path string) (*types.FolderResponse, error) {
+	var f types.FolderResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/folders/" + id.String() + action,
+		body:   jsonBody(&types.PathRequest{Path: path}),
+	}, &f); err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// DeleteFolder deletes an empty folder or, when recursive, unpins its
+// files and deletes its folders too.
+func (c *Client) DeleteFolder(ctx context.Context, id uuid.UUID, recursive bool) error {
+	q := url.Values{}
+	if recursive {
+		q.Set("recursive", "true")
+	}
+
+	return c.call(ctx, &request{method: http.MethodDelete, path: "/folders/" + id.String(), query: q}, nil)
+}
+
+func pageQuery(limit, offset int) url.Values {
+	q := url.Values{}
+	if limit > 0 {
+		q.Set("limit", strconv.Itoa(limit))
+	}
+
+	if offset > 0 {
+		q.Set("offset", strconv.Itoa(offset))
+	}
+
+	return q
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+)
+
+func (c *Client) ListShares(ctx context.Context, fileID uuid.UUID) ([]*types.ShareResponse, error) {
+	var shares []*types.ShareResponse
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/files/" + fileID.String() + "/shares"}, &shares); err != nil {
+		return nil, err
+	}
+
+	return shares, nil
+}
+
+// NewShare creates a public link to a file, optionally protected by a
+// password, expiring or limited to a number of downloads.
+func (c *Client) NewShare(ctx context.Context, fileID uuid.UUID, req *types.NewShareRequest) (*types.ShareResponse, error) {
+	var s types.ShareResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/files/" + fileID.String() + "/shares",
+		body:   jsonBody(req),
+	}, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (c *Client) RevokeShare(ctx context.Context, fileID, shareID uuid.UUID) (*types.ShareResponse, error) {
+	var s types.ShareResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodDelete,
+		path:   "/files/" + fileID.String() + "/shares/" + shareID.String(),
+	}, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+// DownloadShare returns the content of the file shared with slug. password
+// is only needed for protected shares. The caller must close the content.
+func (c *Client) DownloadShare(ctx context.Context, slug, password string) (io.ReadCloser, error) {
+	header := http.Header{}
+	if password != "" {
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth("share", password)
+		header.Set("Authorization", req.Header.Get("Authorization"))
+	}
+
+	return c.stream(ctx, &request{method: http.MethodGet, path: "/shares/" + slug, header: header})
+}
@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+func (c *Client) Me(ctx context.Context) (*ent.User, error) {
+	var u ent.User
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/me"}, &u); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func (c *Client) ListTokens(ctx context.Context) ([]*ent.Token, error) {
+	var tokens []*ent.Token
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/tokens"}, &tokens); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+// NewToken creates an API token. Its secret is only ever returned here and
+// by ResetToken.
+func (c *Client) NewToken(ctx context.Context, name string) (*types.TokenSecretResponse, error) {
+	var t types.TokenSecretResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/user/tokens/new",
+		body:   jsonBody(&types.NewTokenRequest{Name: name}),
+	}, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (c *Client) GetToken(ctx context.Context, id uuid.UUID) (*ent.Token, error) {
+	var t ent.Token
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/tokens/" + id.String()}, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+// ResetToken replaces the secret of a token and returns the new one.
+func (c *Client) ResetToken(ctx context.Context, id uuid.UUID) (*types.TokenSecretResponse, error) {
+	var t types.TokenSecretResponse
+	if err := c.call(ctx, &request{method: http.MethodPost, path: "/user/tokens/" + id.String() + "/reset"}, &t); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (c *Client) RevokeToken(ctx context.Context, id uuid.UUID) error {
+	return c.call(ctx, &request{method: http.MethodDelete, path: "/user/tokens/" + id.String()}, nil)
+}
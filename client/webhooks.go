@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/ent"
+)
+
+func (c *Client) ListWebhooks(ctx context.Context) ([]*ent.Webhook, error) {
+	var hooks []*ent.Webhook
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/webhooks"}, &hooks); err != nil {
+		return nil, err
+	}
+
+	return hooks, nil
+}
+
+// NewWebhook subscribes a URL to events. When no secret is given, one is
+// generated and returned.
+func (c *Client) NewWebhook(ctx context.Context, req *types.NewWebhookRequest) (*types.WebhookSecretResponse, error) {
+	var w types.WebhookSecretResponse
+	if err := c.call(ctx, &request{
+		method: http.MethodPost,
+		path:   "/user/webhooks/new",
+		body:   jsonBody(req),
+	}, &w); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+func (c *Client) GetWebhook(ctx context.Context, id uuid.UUID) (*ent.Webhook, error) {
+	var w ent.Webhook
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/webhooks/" + id.String()}, &w); err != nil {
+		return nil, err
+	}
+
+	return &w, nil
+}
+
+func (c *Client) DeleteWebhook(ctx context.Context, id uuid.UUID) error {
+	return c.call(ctx, &request{method: http.MethodDelete, path: "/user/webhooks/" + id.String()}, nil)
+}
+
+func (c *Client) WebhookDeliveries(ctx context.Context, id uuid.UUID) ([]*ent.WebhookDelivery, error) {
+	var deliveries []*ent.WebhookDelivery
+	if err := c.call(ctx, &request{method: http.MethodGet, path: "/user/webhooks/" + id.String() + "/deliveries"}, &deliveries); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// TestWebhook sends a ping event to the webhook and returns the delivery.
+func (c *Client) TestWebhook(ctx context.Context, id uuid.UUID) (*ent.WebhookDelivery, error) {
+	var d ent.WebhookDelivery
+	if err := c.call(ctx, &request{method: http.MethodPost, path: "/user/webhooks/" + id.String() + "/test"}, &d); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
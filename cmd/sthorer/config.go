@@ -0,0 +1,82 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/sthorer/api/client"
+)
+
+const defaultGateway = "https://ipfs.io"
+
+// cliConfig is persisted in the configuration file between invocations.
+type cliConfig struct {
+	URL         string `json:"url"`
+	Gateway     string `json:"gateway,omitempty"`
+	Email       string `json:"email,omitempty"`
+	JWT         string `json:"jwt,omitempty"`
+	TokenID     string `json:"token_id,omitempty"`
+	TokenSecret string `json:"token_secret,omitempty"`
+}
+
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		dir = "."
+	}
+
+	return filepath.Join(dir, "sthorer", "config.json")
+}
+
+func loadConfig(path string) (*cliConfig, error) {
+	conf := &cliConfig{URL: "http://127.0.0.1:1234", Gateway: defaultGateway}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return conf, nil
+		}
+		return nil, err
+	}
+
+	if err := json.Unmarshal(raw, conf); err != nil {
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// save writes the configuration, which holds secrets, readable only by the
+// current user.
+func (c *cliConfig) save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	raw, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// jwtClient returns a client authenticated with the session from login.
+func (c *cliConfig) jwtClient() (*client.Client, error) {
+	if c.JWT == "" {
+		return nil, errNotLoggedIn
+	}
+
+	return client.New(c.URL, client.WithCredentials(client.JWT(c.JWT)))
+}
+
+// tokenClient returns a client authenticated with the API token of the CLI.
+func (c *cliConfig) tokenClient() (*client.Client, error) {
+	if c.TokenSecret == "" {
+		return nil, errNotLoggedIn
+	}
+
+	return client.New(c.URL, client.WithCredentials(&client.APIToken{Email: c.Email, Secret: c.TokenSecret}))
+}
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+	"github.com/sthorer/api/client"
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/events"
+)
+
+// searchPageSize is the largest page the search endpoint returns.
+const searchPageSize = 1000
+
+var errStop = errors.New("stop")
+
+func upload(ctx context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: sthorer upload <path...>")
+	}
+
+	c, err := e.conf.tokenClient()
+	if err != nil {
+		return err
+	}
+
+	var paths []string
+	for _, root := range args {
+		if err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.Mode().IsRegular() {
+				paths = append(paths, path)
+			}
+
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var pinned []*ent.File
+	for _, path := range paths {
+		files, err := c.UploadPath(ctx, path, func(name string, sent, total int64) {
+			if !e.json {
+				fmt.Fprintf(os.Stderr, "\r%s: %s / %s", path, formatBytes(sent), formatBytes(total))
+			}
+		})
+		if !e.json {
+			fmt.Fprintln(os.Stderr)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+
+		pinned = append(pinned, files...)
+	}
+
+	return e.printFiles(pinned)
+}
+
+func (e *env) printFiles(files []*ent.File) error {
+	return e.output(files, func(w *tabwriter.Writer) {
+		row(w, "ID", "HASH", "NAME", "SIZE")
+		for _, f := range files {
+			row(w, f.ID, f.Hash, f.Metadata["name"], formatBytes(f.Size))
+		}
+	})
+}
+
+// pin is a pinned file as listed by ls.
+type pin struct {
+	ID       string    `json:"id"`
+	Hash     string    `json:"hash"`
+	Name     string    `json:"name"`
+	Size     int64     `json:"size"`
+	PinnedAt time.Time `json:"pinned_at"`
+}
+
+// list prints the pinned files, latest first.
+func list(ctx context.Context, e *env, args []string) error {
+	c, err := e.conf.tokenClient()
+	if err != nil {
+		return err
+	}
+
+	var pins []*pin
+	for offset := 0; ; offset += searchPageSize {
+		res, err := c.SearchFiles(ctx, &types.SearchFilesRequest{Limit: searchPageSize, Offset: offset})
+		if err != nil {
+			return err
+		}
+
+		for _, f := range res.Files {
+			name, _ := f.Metadata["name"].(string)
+			pins = append(pins, &pin{ID: f.ID.String(), Hash: f.Hash, Name: name, Size: f.Size, PinnedAt: f.PinnedAt})
+		}
+
+		if len(res.Files) < searchPageSize || offset+len(res.Files) >= res.Total {
+			break
+		}
+	}
+
+	return e.output(pins, func(w *tabwriter.Writer) {
+		row(w, "ID", "HASH", "NAME", "SIZE", "PINNED")
+		for _, p := range pins {
+			row(w, p.ID, p.Hash, p.Name, formatBytes(p.Size), formatTime(p.PinnedAt))
+		}
+	})
+}
+
+func unpin(ctx context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: sthorer unpin <id...>")
+	}
+
+	c, err := e.conf.tokenClient()
+	if err != nil {
+		return err
+	}
+
+	var files []*ent.File
+	for _, arg := range args {
+		id, err := uuid.Parse(arg)
+		if err != nil {
+			return fmt.Errorf("%s: %v", arg, err)
+		}
+
+		f, err := c.Unpin(ctx, id)
+		if err != nil {
+			return fmt.Errorf("%s: %v", arg, err)
+		}
+
+		files = append(files, f)
+	}
+
+	return e.printFiles(files)
+}
+
+// get downloads content through an IPFS HTTP gateway.
+func get(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("get", flag.ExitOnError)
+	gateway := flags.String("gateway", e.conf.Gateway, "URL of the IPFS HTTP gateway")
+	_ = flags.Parse(args)
+
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		return errors.New("usage: sthorer get [-gateway URL] <cid> [output]")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(*gateway, "/")+"/ipfs/"+flags.Arg(0), nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("gateway responded %s", res.Status)
+	}
+
+	var out io.Writer = os.Stdout
+	if flags.NArg() == 2 {
+		f, err := os.Create(flags.Arg(1))
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		out = f
+	}
+
+	_, err = io.Copy(out, res.Body)
+	return err
+}
+
+// showUsage prints the usage sent at the start of the event stream.
+func showUsage(ctx context.Context, e *env, args []string) error {
+	c, err := e.conf.tokenClient()
+	if err != nil {
+		return err
+	}
+
+	var usage database.Usage
+	err = c.Events(ctx, func(ev *client.Event) error {
+		if ev.Type != events.QuotaUpdated {
+			return nil
+		}
+
+		if err := json.Unmarshal(ev.Data, &usage); err != nil {
+			return err
+		}
+
+		return errStop
+	})
+	if err != errStop {
+		if err == nil {
+			err = errors.New("event stream ended before reporting usage")
+		}
+		return err
+	}
+
+	return e.output(&usage, func(w *tabwriter.Writer) {
+		row(w, "FILES", "SIZE")
+		row(w, usage.Files, formatBytes(usage.Bytes))
+	})
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/sthorer/api/client"
+)
+
+// login authenticates with an email and password, saves the session and
+// creates the API token used by file commands.
+func login(ctx context.Context, e *env, args []string) error {
+	flags := flag.NewFlagSet("login", flag.ExitOnError)
+	email := flags.String("email", "", "account email, prompted if empty")
+	password := flags.String("password", "", "account password, prompted if empty")
+	_ = flags.Parse(args)
+
+	stdin := bufio.NewReader(os.Stdin)
+	if *email == "" {
+		fmt.Fprint(os.Stderr, "Email: ")
+		line, err := stdin.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		*email = strings.TrimSpace(line)
+	}
+
+	if *password == "" {
+		fmt.Fprint(os.Stderr, "Password: ")
+		raw, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return err
+		}
+		*password = string(raw)
+	}
+
+	c, err := client.New(e.conf.URL)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.Login(ctx, *email, *password)
+	if err != nil {
+		return err
+	}
+
+	if e.conf.Email != res.Email {
+		e.conf.TokenID, e.conf.TokenSecret = "", ""
+	}
+
+	e.conf.Email = res.Email
+	e.conf.JWT = res.Token
+
+	if e.conf.TokenSecret == "" {
+		t, err := c.NewToken(ctx, cliTokenName())
+		if err != nil {
+			return err
+		}
+
+		e.conf.TokenID = t.ID.String()
+		e.conf.TokenSecret = t.Secret
+	}
+
+	if err := e.conf.save(e.configPath); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Logged in as %s\n", res.Email)
+
+	return nil
+}
+
+func cliTokenName() string {
+	name := "sthorer-cli"
+	if host, err := os.Hostname(); err == nil {
+		name += "@" + host
+	}
+
+	if len(name) > 64 {
+		name = name[:64]
+	}
+
+	return name
+}
@@ -0,0 +1,134 @@
+// Command sthorer manages the pins and API tokens of a Sthorer account.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+
+	"github.com/sthorer/api/client"
+)
+
+var errNotLoggedIn = errors.New("not logged in, run: sthorer login")
+
+// Codes of API errors handled by the CLI
+const (
+	codeUnauthorized = "unauthorized"
+	codeInvalidToken = "invalid_token"
+)
+
+const usage = `Usage: sthorer [flags] <command> [arguments]
+
+Commands:
+  login                          log in and create an API token for the CLI
+  tokens list                    list API tokens
+  tokens create <name>           create an API token
+  tokens reset <id>              replace the secret of an API token
+  tokens revoke <id>             revoke an API token
+  upload <path...>               pin files, walking directories recursively
+  ls                             list pinned files
+  unpin <id...>                  unpin files
+  get <cid> [output]             download content through the IPFS gateway
+  usage                          show the storage currently pinned
+
+Flags:
+`
+
+// command runs a subcommand with its arguments.
+type command func(ctx context.Context, env *env, args []string) error
+
+var commands = map[string]command{
+	"login":  login,
+	"tokens": tokens,
+	"upload": upload,
+	"ls":     list,
+	"unpin":  unpin,
+	"get":    get,
+	"usage":  showUsage,
+}
+
+// env is shared by all commands.
+type env struct {
+	conf       *cliConfig
+	configPath string
+	json       bool
+}
+
+func main() {
+	flags := flag.NewFlagSet("sthorer", flag.ExitOnError)
+	configPath := flags.String("config", defaultConfigPath(), "path of the configuration file")
+	url := flags.String("url", "", "URL of the Sthorer API, saved on login")
+	jsonOutput := flags.Bool("json", false, "print JSON instead of tables")
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(os.Args[1:])
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[flags.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "sthorer: unknown command %q\n\n", flags.Arg(0))
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	conf, err := loadConfig(*configPath)
+	if err != nil {
+		fatal(err)
+	}
+
+	if *url != "" {
+		conf.URL = *url
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	if err := cmd(ctx, &env{conf: conf, configPath: *configPath, json: *jsonOutput}, flags.Args()[1:]); err != nil {
+		fatal(err)
+	}
+}
+
+func fatal(err error) {
+	var apiErr *client.Error
+	if !errors.As(err, &apiErr) {
+		fmt.Fprintln(os.Stderr, "sthorer:", err)
+		os.Exit(1)
+	}
+
+	message := apiErr.Message
+	if message == "" {
+		message = http.StatusText(apiErr.StatusCode)
+	}
+	fmt.Fprintln(os.Stderr, "sthorer:", message)
+
+	for _, field := range apiErr.Fields {
+		fmt.Fprintf(os.Stderr, "  %s: %s\n", field.Field, field.Message)
+	}
+
+	switch apiErr.Code {
+	case codeUnauthorized, codeInvalidToken:
+		fmt.Fprintln(os.Stderr, "run: sthorer login")
+	}
+
+	if apiErr.RequestID != "" {
+		fmt.Fprintln(os.Stderr, "request id:", apiErr.RequestID)
+	}
+	os.Exit(1)
+}
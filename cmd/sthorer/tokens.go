@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/api/types"
+)
+
+func tokens(ctx context.Context, e *env, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: sthorer tokens list|create|reset|revoke")
+	}
+
+	c, err := e.conf.jwtClient()
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "list":
+		tokens, err := c.ListTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		return e.output(tokens, func(w *tabwriter.Writer) {
+			row(w, "ID", "NAME", "PERMISSIONS", "CREATED", "LAST USED")
+			for _, t := range tokens {
+				row(w, t.ID, t.Name, t.Permissions, formatTime(t.CreatedAt), formatTime(t.LastUsed))
+			}
+		})
+	case "create":
+		if len(args) != 2 {
+			return errors.New("usage: sthorer tokens create <name>")
+		}
+
+		t, err := c.NewToken(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		return e.printSecret(t)
+	case "reset":
+		id, err := tokenID(args, "reset")
+		if err != nil {
+			return err
+		}
+
+		t, err := c.ResetToken(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if t.ID.String() == e.conf.TokenID {
+			e.conf.TokenSecret = t.Secret
+			if err := e.conf.save(e.configPath); err != nil {
+				return err
+			}
+		}
+
+		return e.printSecret(t)
+	case "revoke":
+		id, err := tokenID(args, "revoke")
+		if err != nil {
+			return err
+		}
+
+		if err := c.RevokeToken(ctx, id); err != nil {
+			return err
+		}
+
+		if id.String() == e.conf.TokenID {
+			e.conf.TokenID, e.conf.TokenSecret = "", ""
+			if err := e.conf.save(e.configPath); err != nil {
+				return err
+			}
+			fmt.Fprintln(os.Stderr, "Revoked the token of the CLI, run sthorer login to create a new one")
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("unknown tokens command %q", args[0])
+	}
+}
+
+func (e *env) printSecret(t *types.TokenSecretResponse) error {
+	return e.output(t, func(w *tabwriter.Writer) {
+		row(w, "ID", "NAME", "SECRET")
+		row(w, t.ID, t.Name, t.Secret)
+	})
+}
+
+func tokenID(args []string, cmd string) (uuid.UUID, error) {
+	if len(args) != 2 {
+		return uuid.UUID{}, fmt.Errorf("usage: sthorer tokens %s <id>", cmd)
+	}
+
+	return uuid.Parse(args[1])
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"errors"
+	"os"
+
+	"github.com/sthorer/api/config"
+)
+
+const configUsage = "usage: sthorer config print"
+
+// configCommand runs the config command, which prints the settings
+// resolved from the configuration file, the environment and the flags.
+// Secrets are redacted.
+func configCommand(settings *config.Settings, args []string) error {
+	if len(args) != 1 || args[0] != "print" {
+		return errors.New(configUsage)
+	}
+
+	out, err := settings.Redacted().YAML()
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(out)
+	return err
+}
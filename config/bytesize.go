@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/gommon/bytes"
+)
+
+// ByteSize is a size in bytes which can be written either as a plain
+// number or with a unit, such as 50MB.
+type ByteSize int64
+
+const (
+	B  ByteSize = 1
+	KB          = B << 10
+	MB          = KB << 10
+	GB          = MB << 10
+	TB          = GB << 10
+)
+
+// ParseByteSize parses sizes like 512, 64KB or 1.5GB. Units are powers of
+// 1024.
+func ParseByteSize(s string) (ByteSize, error) {
+	n, err := bytes.Parse(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	return ByteSize(n), nil
+}
+
+// String formats b with the largest unit it is a whole multiple of.
+func (b ByteSize) String() string {
+	units := []struct {
+		size ByteSize
+		name string
+	}{{TB, "TB"}, {GB, "GB"}, {MB, "MB"}, {KB, "KB"}}
+
+	for _, unit := range units {
+		if b != 0 && b%unit.size == 0 {
+			return strconv.FormatInt(int64(b/unit.size), 10) + unit.name
+		}
+	}
+
+	return strconv.FormatInt(int64(b), 10)
+}
+
+func (b ByteSize) MarshalYAML() (interface{}, error) {
+	return b.String(), nil
+}
+
+func (b *ByteSize) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var raw string
+	if err := unmarshal(&raw); err != nil {
+		return err
+	}
+
+	size, err := ParseByteSize(raw)
+	if err != nil {
+		return err
+	}
+
+	*b = size
+	return nil
+}
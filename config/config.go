@@ -1,9 +1,12 @@
 package config
 
 import (
-	"log"
-	"os"
-	"strconv"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/dgrijalva/jwt-go"
 
@@ -14,11 +17,26 @@ import (
 	"github.com/sthorer/api/ipfs"
 
 	"github.com/sthorer/api/database"
+
+	"github.com/sthorer/api/encryption"
+
+	"github.com/sthorer/api/events"
+
+	"github.com/sthorer/api/logging"
+
+	"github.com/sthorer/api/metrics"
+
+	"github.com/sthorer/api/search"
+
+	"github.com/sthorer/api/thumbnails"
+
+	"github.com/sthorer/api/tracing"
+
+	"github.com/sthorer/api/webhooks"
 )
 
 type Config struct {
-	// The IPFS node URL
-	IPFSNodeURL string
+	*Settings
 
 	// IPFS shell connection
 	Shell *ipfs.IPFS
@@ -26,76 +44,178 @@ type Config struct {
 	// Database client
 	Client *database.Database
 
-	// Listening host
-	Host string
+	// Webhook deliveries dispatcher
+	Webhooks *webhooks.Dispatcher
+
+	// Indexer of the text of files
+	Indexer *search.Indexer
 
-	// Listening port
-	Port uint16
+	// Generator of the thumbnails of images
+	Thumbnailer *thumbnails.Generator
 
-	// A secret used to encrypt and decrypt JWT tokens
-	Secret string
+	// Key manager wrapping the data keys of files encrypted by the API,
+	// nil when no master key is configured
+	KeyManager encryption.KeyManager
+
+	// In-process bus of per-user events
+	Events *events.Bus
 
 	// Validator instance
 	Validator *validator.Validate
-}
 
-const (
-	defaultHost = "127.0.0.1"
-	defaultPort = 1234
-)
+	// Logger used outside of requests
+	logger *logging.Logger
 
-func Initialize() (conf *Config, err error) {
-	host := os.Getenv("STHORER_HOST")
-	if host == "" {
-		host = defaultHost
-	}
+	// Started services, in start order
+	components []*component
+}
+
+// component is a started service, stopped when the configuration is
+// closed.
+type component struct {
+	name string
+	stop func(ctx context.Context) error
+}
 
-	port := uint16(defaultPort)
-	if rawPort := os.Getenv("STHORER_PORT"); rawPort != "" {
-		p, err := strconv.ParseUint(rawPort, 10, 16)
+// Initialize starts the services described by settings, which must have
+// been validated: tracing, the database, the IPFS node connection and the
+// background workers, in that order. Startup is aborted when ctx is done.
+func Initialize(ctx context.Context, settings *Settings) (*Config, error) {
+	logger := logging.Default()
+	if settings.JWT.Secret == "" {
+		logger.Warn("no JWT secret is configured, a temporary random secret will be generated")
+		newSecret, err := utils.GenerateSecret(40)
 		if err != nil {
 			return nil, err
 		}
 
-		port = uint16(p)
+		settings.JWT.Secret = newSecret
 	}
 
-	secret := os.Getenv("STHORER_SECRET")
-	if secret == "" {
-		log.Println("STHORER_SECRET is not set. A temporary random secret will be generated")
-		newSecret, err := utils.GenerateSecret(40)
+	conf := New(settings)
+	if err := conf.start(ctx); err != nil {
+		_ = conf.Close(context.Background())
+		return nil, err
+	}
+
+	return conf, nil
+}
+
+// New returns the configuration of settings, without starting its
+// services.
+func New(settings *Settings) *Config {
+	return &Config{
+		Settings:  settings,
+		Validator: newValidator(),
+		Events:    events.NewBus(),
+		logger:    logging.Default(),
+	}
+}
+
+func (c *Config) start(ctx context.Context) (err error) {
+	if c.Encryption.MasterKey != "" {
+		key, err := base64.StdEncoding.DecodeString(c.Encryption.MasterKey)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("invalid encryption master key: %w", err)
 		}
 
-		secret = newSecret
+		if c.KeyManager, err = encryption.NewLocalKeyManager(c.Encryption.MasterKeyID, key); err != nil {
+			return err
+		}
 	}
 
-	conf = &Config{
-		Host:      host,
-		Port:      port,
-		Secret:    secret,
-		Validator: validator.New(),
+	shutdownTracing, err := tracing.Initialize(ctx, &c.Tracing)
+	if err != nil {
+		return err
 	}
+	c.started("tracing", shutdownTracing)
 
-	if conf.Shell, err = ipfs.Initialize(); err != nil {
-		return nil, err
+	if c.Client, err = database.Initialize(&c.Database); err != nil {
+		return err
+	}
+	c.started("database", func(context.Context) error {
+		return c.Client.Close()
+	})
+
+	err = metrics.RegisterUsage(func() (map[string]*metrics.PlanUsage, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		return c.Client.PlanUsage(ctx)
+	})
+	if err != nil {
+		return err
 	}
 
-	if conf.Client, err = database.Initialize(); err != nil {
-		return nil, err
+	if c.Shell, err = ipfs.Initialize(ctx, &c.IPFS); err != nil {
+		return err
 	}
+	c.started("IPFS node connection", func(context.Context) error {
+		return c.Shell.Close()
+	})
 
-	return conf, nil
+	c.Webhooks = webhooks.New(c.Client, c.Workers.Webhooks, c.logger)
+	c.Webhooks.Start()
+	c.started("webhook workers", c.Webhooks.Stop)
+
+	c.Indexer = search.New(c.Client, c.Shell, int64(c.Search.MaxSize), c.Workers.Indexing, c.logger)
+	c.Indexer.Start()
+	c.started("indexing workers", c.Indexer.Stop)
+
+	c.Thumbnailer = thumbnails.New(c.Client, c.Shell, c.Thumbnails.Sizes, int64(c.Thumbnails.MaxSize), int64(c.Thumbnails.MaxPixels), c.Workers.Thumbnails, c.logger)
+	c.Thumbnailer.Start()
+	c.started("thumbnail workers", c.Thumbnailer.Stop)
+
+	return nil
 }
 
-func (c *Config) Close() error {
-	return c.Client.Close()
+func (c *Config) started(name string, stop func(ctx context.Context) error) {
+	c.components = append(c.components, &component{name: name, stop: stop})
+}
+
+// Close stops the started services in the reverse order of their start.
+// Services still stopping when ctx is done are aborted. The first error
+// is returned once every service is stopped.
+func (c *Config) Close(ctx context.Context) error {
+	var first error
+	for i := len(c.components) - 1; i >= 0; i-- {
+		comp := c.components[i]
+		if err := comp.stop(ctx); err != nil {
+			c.logger.Error("failed to stop component", "component", comp.name, "error", err)
+			if first == nil {
+				first = err
+			}
+		}
+	}
+
+	c.components = nil
+	return first
+}
+
+// Log returns the logger of the services.
+func (c *Config) Log() *logging.Logger {
+	return c.logger
 }
 
 func (c *Config) GenerateJWT(claims jwt.Claims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	// Generate encoded token and send it as response.
-	return token.SignedString([]byte(c.Secret))
+	return token.SignedString([]byte(c.JWT.Secret))
+}
+
+// newValidator returns a validator of requests, naming fields after their
+// JSON or query parameter name as clients know them.
+func newValidator() *validator.Validate {
+	v := validator.New()
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"json", "query", "form"} {
+			if name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]; name != "" && name != "-" {
+				return name
+			}
+		}
+
+		return field.Name
+	})
+
+	return v
 }
@@ -1,9 +1,37 @@
 package config
 
-const (
-	// 50 MB
-	FreeUploadLimit = 5e+7
-
-	// 1 GB
-	PremiumUploadLimit = 1e+9
+import (
+	"github.com/sthorer/api/ent/user"
 )
+
+// Plan defines the limits applied to the users subscribed to it.
+type Plan struct {
+	// Maximum size of a single uploaded file
+	UploadLimit ByteSize `yaml:"upload_limit" validate:"gt=0"`
+
+	// Maximum size of all the pinned files of a user, 0 means unlimited
+	StorageLimit ByteSize `yaml:"storage_limit" validate:"gte=0"`
+}
+
+func defaultPlans() map[string]*Plan {
+	return map[string]*Plan{
+		user.PlanFree.String(): {
+			// 50 MB
+			UploadLimit: 50 * MB,
+			// 1 GB
+			StorageLimit: 1 * GB,
+		},
+		user.PlanPremium.String(): {
+			// 1 GB
+			UploadLimit: 1 * GB,
+			// 100 GB
+			StorageLimit: 100 * GB,
+		},
+	}
+}
+
+// Plan returns the limits of plan. Every plan is guaranteed to be defined
+// once the settings are validated.
+func (s *Settings) Plan(plan user.Plan) *Plan {
+	return s.Plans[plan.String()]
+}
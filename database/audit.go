@@ -0,0 +1,90 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/user"
+)
+
+// AuditEntry describes an action to record in the audit log.
+type AuditEntry struct {
+	Action    auditlog.Action
+	User      *ent.User
+	TokenID   *uuid.UUID
+	RequestID string
+	IP        string
+	UserAgent string
+	Metadata  map[string]interface{}
+}
+
+// AuditFilter restricts the audit logs returned by ListAuditLogs.
+// Zero values are ignored.
+type AuditFilter struct {
+	Action  auditlog.Action
+	TokenID *uuid.UUID
+	Since   time.Time
+	Until   time.Time
+	Limit   int
+	Offset  int
+}
+
+func (db *Database) Audit(ctx context.Context, entry *AuditEntry) (*ent.AuditLog, error) {
+	create := db.AuditLog.
+		Create().
+		SetAction(entry.Action).
+		SetRequestID(entry.RequestID).
+		SetIP(entry.IP).
+		SetUserAgent(entry.UserAgent)
+
+	if entry.User != nil {
+		create.SetUser(entry.User)
+	}
+
+	if entry.TokenID != nil {
+		create.SetTokenID(*entry.TokenID)
+	}
+
+	if entry.Metadata != nil {
+		create.SetMetadata(entry.Metadata)
+	}
+
+	return create.Save(ctx)
+}
+
+func (db *Database) ListAuditLogs(ctx context.Context, u *ent.User, filter *AuditFilter) ([]*ent.AuditLog, error) {
+	query := db.AuditLog.
+		Query().
+		Where(auditlog.HasUserWith(user.ID(u.ID))).
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID))
+
+	if filter.Action != "" {
+		query.Where(auditlog.ActionEQ(filter.Action))
+	}
+
+	if filter.TokenID != nil {
+		query.Where(auditlog.TokenID(*filter.TokenID))
+	}
+
+	if !filter.Since.IsZero() {
+		query.Where(auditlog.CreatedAtGTE(filter.Since))
+	}
+
+	if !filter.Until.IsZero() {
+		query.Where(auditlog.CreatedAtLT(filter.Until))
+	}
+
+	if filter.Limit > 0 {
+		query.Limit(filter.Limit)
+	}
+
+	if filter.Offset > 0 {
+		query.Offset(filter.Offset)
+	}
+
+	return query.All(ctx)
+}
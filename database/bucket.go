@@ -0,0 +1,180 @@
+package database
+
+import (
+	"context"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+func (db *Database) NewBucket(ctx context.Context, u *ent.User, name string) (*ent.Bucket, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Bucket.
+		Create().
+		SetID(id).
+		SetName(name).
+		SetUser(u).
+		Save(ctx)
+}
+
+func (db *Database) UserBuckets(ctx context.Context, u *ent.User) ([]*ent.Bucket, error) {
+	return db.Bucket.
+		Query().
+		Where(bucket.HasUserWith(user.ID(u.ID))).
+		Order(ent.Asc(bucket.FieldName)).
+		All(ctx)
+}
+
+func (db *Database) UserBucket(ctx context.Context, u *ent.User, name string) (*ent.Bucket, error) {
+	return db.Bucket.
+		Query().
+		Where(bucket.Name(name), bucket.HasUserWith(user.ID(u.ID))).
+		Only(ctx)
+}
+
+// BucketEmpty tells whether b has no pinned object.
+func (db *Database) BucketEmpty(ctx context.Context, b *ent.Bucket) (bool, error) {
+	exists, err := db.File.
+		Query().
+		Where(file.HasBucketWith(bucket.ID(b.ID)), file.UnpinnedAtIsNil()).
+		Exist(ctx)
+	return !exists, err
+}
+
+// DeleteBucket deletes b, which must be empty. Its unpinned objects are
+// kept, detached from it.
+func (db *Database) DeleteBucket(ctx context.Context, b *ent.Bucket) error {
+	return db.Bucket.
+		DeleteOne(b).
+		Exec(ctx)
+}
+
+// NewObject records an object pinned through the S3 gateway.
+func (db *Database) NewObject(ctx context.Context, u *ent.User, b *ent.Bucket, key, hash, etag string, size int64, metadata map[string]interface{}) (*ent.File, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	create := db.File.
+		Create().
+		SetID(id).
+		SetHash(hash).
+		SetSize(size).
+		SetUser(u).
+		SetBucket(b).
+		SetKey(key).
+		SetEtag(etag).
+		SetMetadata(metadata)
+	if ct := contentType(metadata); ct != "" {
+		create.SetContentType(ct)
+	}
+
+	f, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, db.indexFile(ctx, f)
+}
+
+// Object returns the pinned object of b at key.
+func (db *Database) Object(ctx context.Context, b *ent.Bucket, key string) (*ent.File, error) {
+	return db.File.
+		Query().
+		Where(file.HasBucketWith(bucket.ID(b.ID)), file.Key(key), file.UnpinnedAtIsNil()).
+		Order(ent.Desc(file.FieldPinnedAt)).
+		First(ctx)
+}
+
+// ListObjects returns at most limit pinned objects of b whose key is
+// greater than after and at least from, in the binary order of their keys.
+func (db *Database) ListObjects(ctx context.Context, b *ent.Bucket, from, after string, limit int) ([]*ent.File, error) {
+	return db.File.
+		Query().
+		Where(
+			file.HasBucketWith(bucket.ID(b.ID)),
+			file.UnpinnedAtIsNil(),
+			db.keyCompare(sql.GTE, from),
+			db.keyCompare(sql.GT, after),
+		).
+		Order(db.keyOrder).
+		Limit(limit).
+		All(ctx)
+}
+
+// keyCollation makes the comparison of keys bytewise, as S3 lists keys in
+// the binary order of their UTF-8 encoding. SQLite compares bytes by
+// default.
+func (db *Database) keyCollation() string {
+	if db.dialect == dialect.Postgres {
+		return ` COLLATE "C"`
+	}
+
+	return ""
+}
+
+func (db *Database) keyCompare(op func(string, interface{}) *sql.Predicate, v string) predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		s.Where(op(s.C(file.FieldKey)+db.keyCollation(), v))
+	})
+}
+
+func (db *Database) keyOrder(s *sql.Selector) {
+	s.OrderBy(s.C(file.FieldKey) + db.keyCollation())
+}
+
+// CopyFile records a copy of f, sharing its pinned content.
+func (db *Database) CopyFile(ctx context.Context, u *ent.User, f *ent.File, b *ent.Bucket, key string, metadata map[string]interface{}) (*ent.File, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	create := db.File.
+		Create().
+		SetID(id).
+		SetHash(f.Hash).
+		SetSize(f.Size).
+		SetUser(u).
+		SetBucket(b).
+		SetKey(key).
+		SetEtag(f.Etag).
+		SetMetadata(metadata)
+	if ct := contentType(metadata); ct != "" {
+		create.SetContentType(ct)
+	}
+	if len(f.Tags) > 0 {
+		create.SetTags(f.Tags)
+	}
+
+	copied, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.indexFile(ctx, copied); err != nil {
+		return nil, err
+	}
+
+	if err := db.copyEncryptionKey(ctx, f, copied); err != nil {
+		return nil, err
+	}
+
+	if err := db.copyThumbnails(ctx, f, copied); err != nil {
+		return nil, err
+	}
+
+	return copied, db.copyText(ctx, f, copied)
+}
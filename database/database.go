@@ -2,40 +2,89 @@ package database
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"os"
+
+	entsql "github.com/facebookincubator/ent/dialect/sql"
 
 	"github.com/sthorer/api/ent"
+	_ "github.com/sthorer/api/ent/runtime"
+	"github.com/sthorer/api/logging"
 )
 
 type Database struct {
 	*ent.Client
+
+	db      *sql.DB
+	dialect string
+
+	// textSearch is the Postgres text search configuration of the index
+	// of the text of files
+	textSearch string
 }
 
-const (
-	defaultDatabaseDriver = "sqlite3"
-	defaultDatabaseURL    = "db.sqlite?_fk=1"
-)
+// Settings configures the database connection.
+type Settings struct {
+	// Driver is either sqlite3 or postgres
+	Driver string `yaml:"driver" validate:"required,oneof=sqlite3 postgres"`
 
-func Initialize() (*Database, error) {
-	databaseDriver := os.Getenv("STHORER_DB_DRIVER")
-	if databaseDriver == "" {
-		databaseDriver = defaultDatabaseDriver
-	}
+	// URL is the driver specific data source name
+	URL string `yaml:"url" validate:"required"`
+
+	// AutoMigrate applies pending migrations on startup
+	AutoMigrate bool `yaml:"auto_migrate"`
+
+	// TextSearch is the text search configuration Postgres indexes the text
+	// of files with, such as english or simple
+	TextSearch string `yaml:"text_search" validate:"required"`
+}
 
-	databaseURL := os.Getenv("STHORER_DB_URL")
-	if databaseURL == "" {
-		databaseURL = defaultDatabaseURL
+// Open connects to the database without checking its schema.
+func Open(settings *Settings) (*Database, error) {
+	drv, err := entsql.Open(settings.Driver, settings.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening connection to %s: %v", settings.Driver, err)
 	}
 
-	client, err := ent.Open(databaseDriver, databaseURL)
+	return &Database{
+		Client:     ent.NewClient(ent.Driver(&instrumentedDriver{drv})),
+		db:         drv.DB(),
+		dialect:    drv.Dialect(),
+		textSearch: settings.TextSearch,
+	}, nil
+}
+
+// Ping checks the database is reachable.
+func (db *Database) Ping(ctx context.Context) error {
+	return db.db.PingContext(ctx)
+}
+
+// Initialize connects to the database and makes sure its schema is up to
+// date. Pending migrations are only applied when AutoMigrate is set,
+// otherwise they must be applied with the migrate command.
+func Initialize(settings *Settings) (*Database, error) {
+	db, err := Open(settings)
 	if err != nil {
-		return nil, fmt.Errorf("failed opening connection to %s: %v", databaseDriver, err)
+		return nil, err
+	}
+
+	ctx := context.Background()
+	if settings.AutoMigrate {
+		applied, err := db.MigrateUp(ctx, 0)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("failed migrating database: %v", err)
+		}
+
+		for _, m := range applied {
+			logging.Default().Info("applied migration", "version", m.Version, "name", m.Name)
+		}
 	}
 
-	if err := client.Schema.Create(context.Background()); err != nil {
-		return nil, fmt.Errorf("failed creating schema resources: %v", err)
+	if err := db.CheckSchema(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
 	}
 
-	return &Database{Client: client}, err
+	return db, nil
 }
@@ -0,0 +1,57 @@
+// Package databasetest provides databases to the tests of the packages
+// using one.
+package databasetest
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+)
+
+// New returns a migrated SQLite database in a temporary directory, removed
+// once the test completes.
+func New(t *testing.T) *database.Database {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "sthorer-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := database.Initialize(&database.Settings{
+		Driver:      "sqlite3",
+		URL:         filepath.Join(dir, "db.sqlite") + "?_fk=1",
+		AutoMigrate: true,
+		TextSearch:  "english",
+	})
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+		_ = os.RemoveAll(dir)
+	})
+
+	return db
+}
+
+// User registers a user with email.
+func User(t *testing.T, db *database.Database, email string) *ent.User {
+	t.Helper()
+
+	u, err := db.UserRegister(context.Background(), email, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u
+}
@@ -0,0 +1,244 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/thumbnail"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/media"
+)
+
+// Keys of the metadata of files, which also holds the name and size of
+// their content.
+const (
+	// MetaKey is the key of the metadata set by users
+	MetaKey = "meta"
+
+	// ContentTypeKey is the key of the content type of the content
+	ContentTypeKey = "content_type"
+
+	// MediaKey is the key of the metadata of the media of the content, as
+	// returned by media.Probe
+	MediaKey = "media"
+
+	// EncryptionModeKey is the key of the mode of encryption of encrypted
+	// contents, passphrase or kms
+	EncryptionModeKey = "encryption"
+)
+
+// NewFile records a file of u named name in parent, nil for the root of
+// its tree.
+func (db *Database) NewFile(ctx context.Context, u *ent.User, parent *ent.Folder, name, hash string, size int64, tags []string, metadata map[string]interface{}) (*ent.File, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	create := db.File.
+		Create().
+		SetID(id).
+		SetHash(hash).
+		SetSize(size).
+		SetName(name).
+		SetUser(u).
+		SetMetadata(metadata)
+	if ct := contentType(metadata); ct != "" {
+		create.SetContentType(ct)
+	}
+	if len(tags) > 0 {
+		create.SetTags(tags)
+	}
+	if parent != nil {
+		create.SetFolder(parent)
+	}
+
+	f, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, db.indexFile(ctx, f)
+}
+
+// UpdateFile replaces the tags and metadata of f.
+func (db *Database) UpdateFile(ctx context.Context, f *ent.File, tags []string, metadata map[string]interface{}) (*ent.File, error) {
+	update := f.Update().SetMetadata(metadata)
+	if len(tags) > 0 {
+		update.SetTags(tags)
+	} else {
+		update.ClearTags()
+	}
+
+	f, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.indexFile(ctx, f); err != nil {
+		return nil, err
+	}
+
+	return f, db.refreshText(ctx, f)
+}
+
+// indexFile replaces the indexed tags, metadata and media metadata of f with
+// its own.
+func (db *Database) indexFile(ctx context.Context, f *ent.File) error {
+	if _, err := db.FileTag.
+		Delete().
+		Where(filetag.HasFileWith(file.ID(f.ID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	if _, err := db.FileProperty.
+		Delete().
+		Where(fileproperty.HasFileWith(file.ID(f.ID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	for _, tag := range f.Tags {
+		if _, err := db.FileTag.
+			Create().
+			SetName(tag).
+			SetFile(f).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	meta, _ := f.Metadata[MetaKey].(map[string]interface{})
+	for key, value := range meta {
+		s, ok := value.(string)
+		if !ok || key == "" || len(key) > 64 || len(s) > 255 {
+			continue
+		}
+
+		if _, err := db.FileProperty.
+			Create().
+			SetKey(key).
+			SetValue(s).
+			SetFile(f).
+			Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return db.indexMedia(ctx, f)
+}
+
+// indexMedia replaces the indexed media metadata of f with its own.
+func (db *Database) indexMedia(ctx context.Context, f *ent.File) error {
+	if _, err := db.FileMedia.
+		Delete().
+		Where(filemedia.HasFileWith(file.ID(f.ID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	value, ok := f.Metadata[MediaKey]
+	if !ok {
+		return nil
+	}
+
+	// The metadata is decoded as a map once loaded
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	var info media.Info
+	if err := json.Unmarshal(b, &info); err != nil {
+		// Invalid metadata is not indexed, like the metadata of users
+		return nil
+	}
+
+	create := db.FileMedia.
+		Create().
+		SetFile(f)
+	// Times are compared as text by SQLite, they are all stored in UTC
+	if info.TakenAt != nil {
+		create.SetTakenAt(info.TakenAt.UTC())
+	}
+	if info.Width > 0 && info.Height > 0 {
+		create.SetWidth(info.Width).SetHeight(info.Height)
+	}
+	if info.Duration > 0 {
+		create.SetDuration(info.Duration)
+	}
+	if info.Pages > 0 {
+		create.SetPages(info.Pages)
+	}
+
+	_, err = create.Save(ctx)
+	return err
+}
+
+// contentType returns the content type recorded in metadata, if any.
+func contentType(metadata map[string]interface{}) string {
+	ct, _ := metadata[ContentTypeKey].(string)
+	if len(ct) > 255 {
+		return ""
+	}
+
+	return ct
+}
+
+func (db *Database) UnpinFile(ctx context.Context, f *ent.File) (*ent.File, error) {
+	return f.Update().
+		SetUnpinnedAt(time.Now()).
+		Save(ctx)
+}
+
+// HashReferenced tells whether another pinned file than f has its content,
+// or a thumbnail of another pinned file, in which case the content must
+// stay pinned on the node.
+func (db *Database) HashReferenced(ctx context.Context, f *ent.File) (bool, error) {
+	exists, err := db.File.
+		Query().
+		Where(file.Hash(f.Hash), file.IDNEQ(f.ID), file.UnpinnedAtIsNil()).
+		Exist(ctx)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	return db.Thumbnail.
+		Query().
+		Where(thumbnail.Hash(f.Hash), thumbnail.HasFileWith(file.IDNEQ(f.ID), file.UnpinnedAtIsNil())).
+		Exist(ctx)
+}
+
+// HashPinned tells whether a pinned file has the content hash, or a
+// thumbnail of a pinned file.
+func (db *Database) HashPinned(ctx context.Context, hash string) (bool, error) {
+	exists, err := db.File.
+		Query().
+		Where(file.Hash(hash), file.UnpinnedAtIsNil()).
+		Exist(ctx)
+	if err != nil || exists {
+		return exists, err
+	}
+
+	return db.Thumbnail.
+		Query().
+		Where(thumbnail.Hash(hash), thumbnail.HasFileWith(file.UnpinnedAtIsNil())).
+		Exist(ctx)
+}
+
+// UploadedFileExists tells whether u has pinned a file of content hash
+// through the upload endpoint, outside of any bucket.
+func (db *Database) UploadedFileExists(ctx context.Context, u *ent.User, hash string) (bool, error) {
+	return db.File.
+		Query().
+		Where(file.Hash(hash), file.HasUserWith(user.ID(u.ID)), file.Not(file.HasBucket()), file.UnpinnedAtIsNil()).
+		Exist(ctx)
+}
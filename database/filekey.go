@@ -0,0 +1,66 @@
+package database
+
+import (
+	"context"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+)
+
+// NewFileKey is the wrapped data key of an encrypted file, recorded with
+// SetEncryptionKey.
+type NewFileKey struct {
+	Provider    filekey.Provider
+	KeyID       string
+	WrappedKey  []byte
+	SegmentSize int
+}
+
+// SetEncryptionKey records the data key f is encrypted with.
+func (db *Database) SetEncryptionKey(ctx context.Context, f *ent.File, k *NewFileKey) (*ent.FileKey, error) {
+	return db.FileKey.
+		Create().
+		SetProvider(k.Provider).
+		SetKeyID(k.KeyID).
+		SetWrappedKey(k.WrappedKey).
+		SetSegmentSize(k.SegmentSize).
+		SetFile(f).
+		Save(ctx)
+}
+
+// EncryptionKey returns the data key f is encrypted with. Files stored in
+// plaintext have none.
+func (db *Database) EncryptionKey(ctx context.Context, f *ent.File) (*ent.FileKey, error) {
+	return db.FileKey.
+		Query().
+		Where(filekey.HasFileWith(file.ID(f.ID))).
+		Only(ctx)
+}
+
+// Encrypted tells whether f is encrypted.
+func (db *Database) Encrypted(ctx context.Context, f *ent.File) (bool, error) {
+	return db.FileKey.
+		Query().
+		Where(filekey.HasFileWith(file.ID(f.ID))).
+		Exist(ctx)
+}
+
+// copyEncryptionKey gives copied, a copy of f sharing its content, the data
+// key of f.
+func (db *Database) copyEncryptionKey(ctx context.Context, f, copied *ent.File) error {
+	k, err := db.EncryptionKey(ctx, f)
+	if ent.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	_, err = db.SetEncryptionKey(ctx, copied, &NewFileKey{
+		Provider:    k.Provider,
+		KeyID:       k.KeyID,
+		WrappedKey:  k.WrappedKey,
+		SegmentSize: k.SegmentSize,
+	})
+	return err
+}
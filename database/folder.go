@@ -0,0 +1,341 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// Folders make a tree per user. A nil parent folder is the root of the
+// tree.
+
+func (db *Database) NewFolder(ctx context.Context, u *ent.User, parent *ent.Folder, name string) (*ent.Folder, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	create := db.Folder.
+		Create().
+		SetID(id).
+		SetName(name).
+		SetUser(u)
+	if parent != nil {
+		create.SetParent(parent)
+	}
+
+	return create.Save(ctx)
+}
+
+// UserFolder returns the folder of u named name in parent.
+func (db *Database) UserFolder(ctx context.Context, u *ent.User, parent *ent.Folder, name string) (*ent.Folder, error) {
+	return db.Folder.
+		Query().
+		Where(folder.HasUserWith(user.ID(u.ID)), inFolder(parent), folder.Name(name)).
+		First(ctx)
+}
+
+// FolderOf returns the folder of u of id.
+func (db *Database) FolderOf(ctx context.Context, u *ent.User, id uuid.UUID) (*ent.Folder, error) {
+	return db.Folder.
+		Query().
+		Where(folder.ID(id), folder.HasUserWith(user.ID(u.ID))).
+		Only(ctx)
+}
+
+// FolderAt returns the folder of u at the path made of names from the
+// root, nil for the root itself.
+func (db *Database) FolderAt(ctx context.Context, u *ent.User, names []string) (*ent.Folder, error) {
+	var f *ent.Folder
+	for _, name := range names {
+		child, err := db.UserFolder(ctx, u, f, name)
+		if err != nil {
+			return nil, err
+		}
+
+		f = child
+	}
+
+	return f, nil
+}
+
+// MakeFolders returns the folder of u at the path made of names from the
+// root, creating the missing ones.
+func (db *Database) MakeFolders(ctx context.Context, u *ent.User, names []string) (*ent.Folder, error) {
+	var f *ent.Folder
+	for _, name := range names {
+		child, err := db.UserFolder(ctx, u, f, name)
+		if ent.IsNotFound(err) {
+			child, err = db.NewFolder(ctx, u, f, name)
+			if err == nil {
+				err = db.TouchFolder(ctx, f)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		f = child
+	}
+
+	return f, nil
+}
+
+// FolderPath returns the path of f from the root of its tree, such as
+// /photos/2020.
+func (db *Database) FolderPath(ctx context.Context, f *ent.Folder) (string, error) {
+	var names []string
+	for f != nil {
+		names = append([]string{f.Name}, names...)
+
+		parent, err := f.QueryParent().Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return "", err
+		}
+
+		f = parent
+	}
+
+	return "/" + strings.Join(names, "/"), nil
+}
+
+// FilePath returns the path of f from the root of its tree.
+func (db *Database) FilePath(ctx context.Context, f *ent.File) (string, error) {
+	parent, err := f.QueryFolder().Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", err
+	}
+
+	p, err := db.FolderPath(ctx, parent)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(p, "/") + "/" + f.Name, nil
+}
+
+// TreeFile returns the pinned file of u of id, outside of any bucket.
+func (db *Database) TreeFile(ctx context.Context, u *ent.User, id uuid.UUID) (*ent.File, error) {
+	return db.File.
+		Query().
+		Where(file.ID(id), file.HasUserWith(user.ID(u.ID)), file.Not(file.HasBucket()), file.UnpinnedAtIsNil()).
+		Only(ctx)
+}
+
+// FolderContents returns a page of the content of parent for u: its folders
+// by name, followed by its pinned files by name and latest first, along
+// with the total number of folders and files.
+func (db *Database) FolderContents(ctx context.Context, u *ent.User, parent *ent.Folder, limit, offset int) ([]*ent.Folder, []*ent.File, int, error) {
+	folders := db.Folder.
+		Query().
+		Where(folder.HasUserWith(user.ID(u.ID)), inFolder(parent))
+	pinned := db.File.
+		Query().
+		Where(treeFiles(u, parent))
+
+	foldersCount, err := folders.Clone().Count(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	filesCount, err := pinned.Clone().Count(ctx)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	var children []*ent.Folder
+	if offset < foldersCount {
+		children, err = folders.
+			Order(ent.Asc(folder.FieldName)).
+			Limit(limit).
+			Offset(offset).
+			All(ctx)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	var files []*ent.File
+	if remaining := limit - len(children); remaining > 0 {
+		skip := offset - foldersCount
+		if skip < 0 {
+			skip = 0
+		}
+
+		files, err = pinned.
+			Order(ent.Asc(file.FieldName), ent.Desc(file.FieldPinnedAt)).
+			Limit(remaining).
+			Offset(skip).
+			All(ctx)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+	}
+
+	return children, files, foldersCount + filesCount, nil
+}
+
+// FolderChildren returns the folders of u in parent, by name.
+func (db *Database) FolderChildren(ctx context.Context, u *ent.User, parent *ent.Folder) ([]*ent.Folder, error) {
+	return db.Folder.
+		Query().
+		Where(folder.HasUserWith(user.ID(u.ID)), inFolder(parent)).
+		Order(ent.Asc(folder.FieldName)).
+		All(ctx)
+}
+
+// FolderFile returns the latest pinned file of u named name in parent.
+func (db *Database) FolderFile(ctx context.Context, u *ent.User, parent *ent.Folder, name string) (*ent.File, error) {
+	return db.File.
+		Query().
+		Where(treeFiles(u, parent), file.Name(name)).
+		Order(ent.Desc(file.FieldPinnedAt)).
+		First(ctx)
+}
+
+// FolderFiles returns the pinned files of u in parent, by name and latest
+// first.
+func (db *Database) FolderFiles(ctx context.Context, u *ent.User, parent *ent.Folder) ([]*ent.File, error) {
+	return db.File.
+		Query().
+		Where(treeFiles(u, parent)).
+		Order(ent.Asc(file.FieldName), ent.Desc(file.FieldPinnedAt)).
+		All(ctx)
+}
+
+// CopyToFolder records a copy of f named name in parent, sharing its pinned
+// content.
+func (db *Database) CopyToFolder(ctx context.Context, u *ent.User, f *ent.File, parent *ent.Folder, name string) (*ent.File, error) {
+	metadata := make(map[string]interface{}, len(f.Metadata))
+	for k, v := range f.Metadata {
+		metadata[k] = v
+	}
+
+	copied, err := db.NewFile(ctx, u, parent, name, f.Hash, f.Size, f.Tags, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.copyEncryptionKey(ctx, f, copied); err != nil {
+		return nil, err
+	}
+
+	if err := db.copyThumbnails(ctx, f, copied); err != nil {
+		return nil, err
+	}
+
+	return copied, db.copyText(ctx, f, copied)
+}
+
+// FolderEmpty tells whether f has neither folders nor pinned files.
+func (db *Database) FolderEmpty(ctx context.Context, f *ent.Folder) (bool, error) {
+	exists, err := f.QueryChildren().Exist(ctx)
+	if err != nil || exists {
+		return false, err
+	}
+
+	exists, err = f.QueryFiles().Where(file.UnpinnedAtIsNil()).Exist(ctx)
+	return !exists, err
+}
+
+// MoveFolder renames f to name and moves it to parent.
+func (db *Database) MoveFolder(ctx context.Context, f *ent.Folder, parent *ent.Folder, name string) (*ent.Folder, error) {
+	update := f.Update().SetName(name)
+	if parent != nil {
+		update.SetParent(parent)
+	} else {
+		update.ClearParent()
+	}
+
+	return update.Save(ctx)
+}
+
+// MoveFile renames f to name and moves it to parent. Its content is not
+// pinned again.
+func (db *Database) MoveFile(ctx context.Context, f *ent.File, parent *ent.Folder, name string) (*ent.File, error) {
+	update := f.Update().SetName(name)
+	if parent != nil {
+		update.SetFolder(parent)
+	} else {
+		update.ClearFolder()
+	}
+
+	f, err := update.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return f, db.refreshText(ctx, f)
+}
+
+// DeleteFolder deletes f, which must be empty. Its unpinned files are kept,
+// detached from it.
+func (db *Database) DeleteFolder(ctx context.Context, f *ent.Folder) error {
+	return db.Folder.
+		DeleteOne(f).
+		Exec(ctx)
+}
+
+// FolderWithin tells whether f is ancestor or one of its descendants.
+func (db *Database) FolderWithin(ctx context.Context, f *ent.Folder, ancestor *ent.Folder) (bool, error) {
+	for f != nil {
+		if f.ID == ancestor.ID {
+			return true, nil
+		}
+
+		parent, err := f.QueryParent().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+
+		f = parent
+	}
+
+	return false, nil
+}
+
+// TouchFolder records a change of the content of f.
+func (db *Database) TouchFolder(ctx context.Context, f *ent.Folder) error {
+	if f == nil {
+		return nil
+	}
+
+	return f.Update().
+		SetUpdatedAt(time.Now()).
+		Exec(ctx)
+}
+
+func inFolder(parent *ent.Folder) predicate.Folder {
+	if parent == nil {
+		return folder.Not(folder.HasParent())
+	}
+
+	return folder.HasParentWith(folder.ID(parent.ID))
+}
+
+// treeFiles selects the pinned files of u in parent. Files uploaded through
+// the S3 gateway belong to their bucket instead.
+func treeFiles(u *ent.User, parent *ent.Folder) predicate.File {
+	in := file.Not(file.HasFolder())
+	if parent != nil {
+		in = file.HasFolderWith(folder.ID(parent.ID))
+	}
+
+	return file.And(
+		file.HasUserWith(user.ID(u.ID)),
+		file.Not(file.HasBucket()),
+		file.UnpinnedAtIsNil(),
+		in,
+	)
+}
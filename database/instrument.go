@@ -0,0 +1,91 @@
+package database
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sthorer/api/metrics"
+	"github.com/sthorer/api/tracing"
+)
+
+// instrumentedDriver traces every query ent runs and records its latency.
+type instrumentedDriver struct {
+	dialect.Driver
+}
+
+func (d *instrumentedDriver) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, done := instrument(ctx, d.Dialect(), query)
+	defer func() { done(err) }()
+	return d.Driver.Exec(ctx, query, args, v)
+}
+
+func (d *instrumentedDriver) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, done := instrument(ctx, d.Dialect(), query)
+	defer func() { done(err) }()
+	return d.Driver.Query(ctx, query, args, v)
+}
+
+func (d *instrumentedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &instrumentedTx{Tx: tx, dialect: d.Dialect()}, nil
+}
+
+type instrumentedTx struct {
+	dialect.Tx
+	dialect string
+}
+
+func (tx *instrumentedTx) Exec(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, done := instrument(ctx, tx.dialect, query)
+	defer func() { done(err) }()
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+func (tx *instrumentedTx) Query(ctx context.Context, query string, args, v interface{}) (err error) {
+	ctx, done := instrument(ctx, tx.dialect, query)
+	defer func() { done(err) }()
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// instrument starts the span of query. The returned function ends it and
+// records the latency of query, labeled by its statement keyword to keep
+// the number of series bounded. Arguments are never recorded.
+func instrument(ctx context.Context, name, query string) (context.Context, func(err error)) {
+	operation := "other"
+	if fields := strings.Fields(query); len(fields) > 0 {
+		switch keyword := strings.ToLower(fields[0]); keyword {
+		case "select", "insert", "update", "delete":
+			operation = keyword
+		}
+	}
+
+	system := semconv.DBSystemSqlite
+	if name == dialect.Postgres {
+		system = semconv.DBSystemPostgreSQL
+	}
+
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, "db."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			system,
+			semconv.DBStatementKey.String(query),
+			attribute.String("db.operation", operation),
+		),
+	)
+
+	return ctx, func(err error) {
+		metrics.DBQueryDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+		tracing.End(span, err)
+	}
+}
@@ -0,0 +1,205 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect"
+
+	"github.com/sthorer/api/database/migrations"
+)
+
+var createMigrationsTable = map[string]string{
+	dialect.SQLite:   "CREATE TABLE IF NOT EXISTS schema_migrations(version integer PRIMARY KEY NOT NULL, name varchar(255) NOT NULL, applied_at datetime NOT NULL)",
+	dialect.Postgres: "CREATE TABLE IF NOT EXISTS schema_migrations(version bigint PRIMARY KEY NOT NULL, name varchar NOT NULL, applied_at timestamp with time zone NOT NULL)",
+}
+
+// MigrationStatus tells whether a migration is applied.
+type MigrationStatus struct {
+	*migrations.Migration
+	AppliedAt *time.Time
+}
+
+// MigrationStatus lists every known migration with when it was applied.
+func (db *Database) MigrationStatus(ctx context.Context) ([]*MigrationStatus, error) {
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status []*MigrationStatus
+	for _, m := range migrations.All() {
+		s := &MigrationStatus{Migration: m}
+		if at, ok := applied[m.Version]; ok {
+			s.AppliedAt = &at
+		}
+
+		status = append(status, s)
+	}
+
+	return status, nil
+}
+
+// CheckSchema returns an error unless every known migration, and only
+// those, are applied.
+func (db *Database) CheckSchema(ctx context.Context) error {
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	known := make(map[int]bool)
+	for _, m := range migrations.All() {
+		known[m.Version] = true
+	}
+
+	for version := range applied {
+		if !known[version] {
+			return fmt.Errorf("database schema is at unknown version %d (latest known is %d), refusing to start", version, migrations.Latest())
+		}
+	}
+
+	for _, m := range migrations.All() {
+		if _, ok := applied[m.Version]; !ok {
+			return fmt.Errorf("database schema is missing migration %d (%s), run: sthorer migrate up", m.Version, m.Name)
+		}
+	}
+
+	return nil
+}
+
+// MigrateUp applies at most steps pending migrations, or all of them when
+// steps is not positive, and returns those applied.
+func (db *Database) MigrateUp(ctx context.Context, steps int) ([]*migrations.Migration, error) {
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var done []*migrations.Migration
+	for _, m := range migrations.All() {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+
+		if steps > 0 && len(done) == steps {
+			break
+		}
+
+		if err := db.apply(ctx, m.Up, m.Data, "INSERT INTO schema_migrations(version, name, applied_at) VALUES ("+db.placeholders(3)+")", m.Version, m.Name, time.Now()); err != nil {
+			return done, fmt.Errorf("migration %d (%s): %v", m.Version, m.Name, err)
+		}
+
+		done = append(done, m)
+	}
+
+	return done, nil
+}
+
+// MigrateDown reverts the last steps applied migrations and returns those
+// reverted.
+func (db *Database) MigrateDown(ctx context.Context, steps int) ([]*migrations.Migration, error) {
+	applied, err := db.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	all := migrations.All()
+	var done []*migrations.Migration
+	for i := len(all) - 1; i >= 0 && len(done) < steps; i-- {
+		m := all[i]
+		if _, ok := applied[m.Version]; !ok {
+			continue
+		}
+
+		if err := db.apply(ctx, m.Down, nil, "DELETE FROM schema_migrations WHERE version = "+db.placeholders(1), m.Version); err != nil {
+			return done, fmt.Errorf("migration %d (%s): %v", m.Version, m.Name, err)
+		}
+
+		done = append(done, m)
+	}
+
+	return done, nil
+}
+
+// apply runs the statements of a migration for the dialect of db, then
+// data if not nil, and records it with query, in a single transaction.
+func (db *Database) apply(ctx context.Context, statements map[string]string, data func(context.Context, *sql.Tx, string) error, query string, args ...interface{}) error {
+	stmts, ok := statements[db.dialect]
+	if !ok {
+		return fmt.Errorf("unsupported dialect %s", db.dialect)
+	}
+
+	tx, err := db.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, stmts); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if data != nil {
+		if err := data(ctx, tx, db.dialect); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (db *Database) appliedMigrations(ctx context.Context) (map[int]time.Time, error) {
+	create, ok := createMigrationsTable[db.dialect]
+	if !ok {
+		return nil, fmt.Errorf("unsupported dialect %s", db.dialect)
+	}
+
+	if _, err := db.db.ExecContext(ctx, create); err != nil {
+		return nil, err
+	}
+
+	rows, err := db.db.QueryContext(ctx, "SELECT version, applied_at FROM schema_migrations")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var (
+			version   int
+			appliedAt time.Time
+		)
+
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+// placeholders returns n comma separated bind parameters for the dialect.
+func (db *Database) placeholders(n int) string {
+	var s string
+	for i := 1; i <= n; i++ {
+		if i > 1 {
+			s += ", "
+		}
+
+		s += migrations.Placeholder(db.dialect, i)
+	}
+
+	return s
+}
@@ -0,0 +1,51 @@
+package migrations
+
+import "github.com/facebookincubator/ent/dialect"
+
+// The initial schema is created if missing, so that databases previously
+// set up by the automatic ent migration can be adopted. Those have a
+// required unpinned_at column, which SQLite relaxes when 0002 rebuilds the
+// files table and Postgres here.
+func init() {
+	register(&Migration{
+		Version: 1,
+		Name:    "initial",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE IF NOT EXISTS users(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, email varchar(255) UNIQUE NOT NULL, password varchar(255) NOT NULL, active bool NOT NULL DEFAULT true, updated_at datetime NOT NULL, created_at datetime NOT NULL, plan varchar(255) NOT NULL);
+CREATE TABLE IF NOT EXISTS tokens(id uuid NOT NULL, name varchar(255) NOT NULL, secret varchar(255) NOT NULL, permissions varchar(255) NOT NULL, created_at datetime NOT NULL, last_used datetime NULL, user_tokens integer NULL, PRIMARY KEY(id), FOREIGN KEY(user_tokens) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS files(id uuid NOT NULL, hash varchar(255) UNIQUE NOT NULL, size integer NOT NULL, pinned_at datetime NOT NULL, unpinned_at datetime NULL, metadata json NULL, user_files integer NULL, PRIMARY KEY(id), FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS audit_logs(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, action varchar(255) NOT NULL, token_id uuid NULL, request_id varchar(255) NULL, ip varchar(255) NULL, user_agent varchar(255) NULL, metadata json NULL, created_at datetime NOT NULL, user_audit_logs integer NULL, FOREIGN KEY(user_audit_logs) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS webhooks(id uuid NOT NULL, url varchar(255) NOT NULL, events json NOT NULL, secret varchar(255) NOT NULL, active bool NOT NULL DEFAULT true, created_at datetime NOT NULL, user_webhooks integer NULL, PRIMARY KEY(id), FOREIGN KEY(user_webhooks) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS webhook_deliveries(id uuid NOT NULL, event varchar(255) NOT NULL, payload json NOT NULL, status varchar(255) NOT NULL, attempts integer NOT NULL, next_attempt_at datetime NOT NULL, response_status integer NULL, last_error varchar(255) NULL, created_at datetime NOT NULL, delivered_at datetime NULL, webhook_deliveries uuid NULL, PRIMARY KEY(id), FOREIGN KEY(webhook_deliveries) REFERENCES webhooks(id) ON DELETE SET NULL);
+`,
+			dialect.Postgres: `
+CREATE TABLE IF NOT EXISTS users(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, email varchar UNIQUE NOT NULL, password varchar NOT NULL, active boolean NOT NULL DEFAULT true, updated_at timestamp with time zone NOT NULL, created_at timestamp with time zone NOT NULL, plan varchar NOT NULL, PRIMARY KEY(id));
+CREATE TABLE IF NOT EXISTS tokens(id uuid NOT NULL, name varchar NOT NULL, secret varchar NOT NULL, permissions varchar NOT NULL, created_at timestamp with time zone NOT NULL, last_used timestamp with time zone NULL, user_tokens bigint NULL, PRIMARY KEY(id), CONSTRAINT tokens_users_tokens FOREIGN KEY(user_tokens) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS files(id uuid NOT NULL, hash varchar UNIQUE NOT NULL, size bigint NOT NULL, pinned_at timestamp with time zone NOT NULL, unpinned_at timestamp with time zone NULL, metadata jsonb NULL, user_files bigint NULL, PRIMARY KEY(id), CONSTRAINT files_users_files FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS audit_logs(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, action varchar NOT NULL, token_id uuid NULL, request_id varchar NULL, ip varchar NULL, user_agent varchar NULL, metadata jsonb NULL, created_at timestamp with time zone NOT NULL, user_audit_logs bigint NULL, PRIMARY KEY(id), CONSTRAINT audit_logs_users_audit_logs FOREIGN KEY(user_audit_logs) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS webhooks(id uuid NOT NULL, url varchar NOT NULL, events jsonb NOT NULL, secret varchar NOT NULL, active boolean NOT NULL DEFAULT true, created_at timestamp with time zone NOT NULL, user_webhooks bigint NULL, PRIMARY KEY(id), CONSTRAINT webhooks_users_webhooks FOREIGN KEY(user_webhooks) REFERENCES users(id) ON DELETE SET NULL);
+CREATE TABLE IF NOT EXISTS webhook_deliveries(id uuid NOT NULL, event varchar NOT NULL, payload jsonb NOT NULL, status varchar NOT NULL, attempts bigint NOT NULL, next_attempt_at timestamp with time zone NOT NULL, response_status bigint NULL, last_error varchar NULL, created_at timestamp with time zone NOT NULL, delivered_at timestamp with time zone NULL, webhook_deliveries uuid NULL, PRIMARY KEY(id), CONSTRAINT webhook_deliveries_webhooks_deliveries FOREIGN KEY(webhook_deliveries) REFERENCES webhooks(id) ON DELETE SET NULL);
+ALTER TABLE files ALTER COLUMN unpinned_at DROP NOT NULL;
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+DROP TABLE audit_logs;
+DROP TABLE files;
+DROP TABLE tokens;
+DROP TABLE users;
+`,
+			dialect.Postgres: `
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+DROP TABLE audit_logs;
+DROP TABLE files;
+DROP TABLE tokens;
+DROP TABLE users;
+`,
+		},
+	})
+}
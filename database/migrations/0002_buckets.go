@@ -0,0 +1,50 @@
+package migrations
+
+import "github.com/facebookincubator/ent/dialect"
+
+// Files uploaded through the S3 gateway belong to a bucket and have a key.
+// Several files may now share a hash: the unique constraint is replaced by
+// an index, which SQLite can only do by rebuilding the table.
+func init() {
+	register(&Migration{
+		Version: 2,
+		Name:    "buckets",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE buckets(id uuid NOT NULL, name varchar(63) NOT NULL, created_at datetime NOT NULL, user_buckets integer NULL, PRIMARY KEY(id), FOREIGN KEY(user_buckets) REFERENCES users(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX bucket_name_user_buckets ON buckets(name, user_buckets);
+CREATE TABLE files_new(id uuid NOT NULL, hash varchar(255) NOT NULL, size integer NOT NULL, pinned_at datetime NOT NULL, unpinned_at datetime NULL, metadata json NULL, key varchar(1024) NULL, etag varchar(255) NULL, bucket_files uuid NULL, user_files integer NULL, PRIMARY KEY(id), FOREIGN KEY(bucket_files) REFERENCES buckets(id) ON DELETE SET NULL, FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+INSERT INTO files_new(id, hash, size, pinned_at, unpinned_at, metadata, user_files) SELECT id, hash, size, pinned_at, unpinned_at, metadata, user_files FROM files;
+DROP TABLE files;
+ALTER TABLE files_new RENAME TO files;
+CREATE INDEX file_hash ON files(hash);
+CREATE INDEX file_bucket_files ON files(bucket_files);
+`,
+			dialect.Postgres: `
+CREATE TABLE buckets(id uuid NOT NULL, name varchar(63) NOT NULL, created_at timestamp with time zone NOT NULL, user_buckets bigint NULL, PRIMARY KEY(id), CONSTRAINT buckets_users_buckets FOREIGN KEY(user_buckets) REFERENCES users(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX bucket_name_user_buckets ON buckets(name, user_buckets);
+ALTER TABLE files DROP CONSTRAINT files_hash_key;
+ALTER TABLE files ADD COLUMN key varchar(1024) NULL, ADD COLUMN etag varchar NULL, ADD COLUMN bucket_files uuid NULL;
+ALTER TABLE files ADD CONSTRAINT files_buckets_files FOREIGN KEY(bucket_files) REFERENCES buckets(id) ON DELETE SET NULL;
+CREATE INDEX file_hash ON files(hash);
+CREATE INDEX file_bucket_files ON files(bucket_files);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE files_old(id uuid NOT NULL, hash varchar(255) UNIQUE NOT NULL, size integer NOT NULL, pinned_at datetime NOT NULL, unpinned_at datetime NULL, metadata json NULL, user_files integer NULL, PRIMARY KEY(id), FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+INSERT INTO files_old(id, hash, size, pinned_at, unpinned_at, metadata, user_files) SELECT id, hash, size, pinned_at, unpinned_at, metadata, user_files FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+DROP TABLE buckets;
+`,
+			dialect.Postgres: `
+DROP INDEX file_bucket_files;
+DROP INDEX file_hash;
+ALTER TABLE files DROP COLUMN bucket_files, DROP COLUMN etag, DROP COLUMN key;
+ALTER TABLE files ADD CONSTRAINT files_hash_key UNIQUE (hash);
+DROP TABLE buckets;
+`,
+		},
+	})
+}
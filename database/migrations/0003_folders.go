@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Files are named and placed in a tree of folders per user. Files uploaded
+// before are named after their metadata, at the root.
+func init() {
+	register(&Migration{
+		Version: 3,
+		Name:    "folders",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE folders(id uuid NOT NULL, name varchar(255) NOT NULL, updated_at datetime NOT NULL, created_at datetime NOT NULL, folder_children uuid NULL, user_folders integer NULL, PRIMARY KEY(id), FOREIGN KEY(folder_children) REFERENCES folders(id) ON DELETE SET NULL, FOREIGN KEY(user_folders) REFERENCES users(id) ON DELETE SET NULL);
+CREATE INDEX folder_name_folder_children ON folders(name, folder_children);
+ALTER TABLE files ADD COLUMN name varchar(255) NULL;
+ALTER TABLE files ADD COLUMN folder_files uuid NULL REFERENCES folders(id) ON DELETE SET NULL;
+CREATE INDEX file_name_folder_files ON files(name, folder_files);
+`,
+			dialect.Postgres: `
+CREATE TABLE folders(id uuid NOT NULL, name varchar(255) NOT NULL, updated_at timestamp with time zone NOT NULL, created_at timestamp with time zone NOT NULL, folder_children uuid NULL, user_folders bigint NULL, PRIMARY KEY(id), CONSTRAINT folders_folders_children FOREIGN KEY(folder_children) REFERENCES folders(id) ON DELETE SET NULL, CONSTRAINT folders_users_folders FOREIGN KEY(user_folders) REFERENCES users(id) ON DELETE SET NULL);
+CREATE INDEX folder_name_folder_children ON folders(name, folder_children);
+ALTER TABLE files ADD COLUMN name varchar(255) NULL, ADD COLUMN folder_files uuid NULL;
+ALTER TABLE files ADD CONSTRAINT files_folders_files FOREIGN KEY(folder_files) REFERENCES folders(id) ON DELETE SET NULL;
+CREATE INDEX file_name_folder_files ON files(name, folder_files);
+`,
+		},
+		Data: nameFiles,
+		Down: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE files_old(id uuid NOT NULL, hash varchar(255) NOT NULL, size integer NOT NULL, pinned_at datetime NOT NULL, unpinned_at datetime NULL, metadata json NULL, key varchar(1024) NULL, etag varchar(255) NULL, bucket_files uuid NULL, user_files integer NULL, PRIMARY KEY(id), FOREIGN KEY(bucket_files) REFERENCES buckets(id) ON DELETE SET NULL, FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+INSERT INTO files_old(id, hash, size, pinned_at, unpinned_at, metadata, key, etag, bucket_files, user_files) SELECT id, hash, size, pinned_at, unpinned_at, metadata, key, etag, bucket_files, user_files FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE INDEX file_hash ON files(hash);
+CREATE INDEX file_bucket_files ON files(bucket_files);
+DROP TABLE folders;
+`,
+			dialect.Postgres: `
+DROP INDEX file_name_folder_files;
+ALTER TABLE files DROP COLUMN folder_files, DROP COLUMN name;
+DROP TABLE folders;
+`,
+		},
+	})
+}
+
+// nameFiles names the files outside of buckets after their metadata, which
+// SQLite cannot read without its JSON extension.
+func nameFiles(ctx context.Context, tx *sql.Tx, d string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, metadata FROM files WHERE bucket_files IS NULL AND metadata IS NOT NULL")
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]string)
+	for rows.Next() {
+		var (
+			id       string
+			metadata []byte
+		)
+		if err := rows.Scan(&id, &metadata); err != nil {
+			rows.Close()
+			return err
+		}
+
+		var m struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(metadata, &m) == nil && m.Name != "" {
+			names[id] = m.Name
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	update := "UPDATE files SET name = " + Placeholder(d, 1) + " WHERE id = " + Placeholder(d, 2)
+	for id, name := range names {
+		if _, err := tx.ExecContext(ctx, update, truncate(name, 255), id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
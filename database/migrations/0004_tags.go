@@ -0,0 +1,130 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"unicode/utf8"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Files have tags and a content type, and the metadata users give them is
+// indexed for searches. The content type and metadata of objects uploaded
+// before are taken from their metadata.
+func init() {
+	register(&Migration{
+		Version: 4,
+		Name:    "tags",
+		Up: map[string]string{
+			dialect.SQLite: `
+ALTER TABLE files ADD COLUMN tags json NULL;
+ALTER TABLE files ADD COLUMN content_type varchar(255) NULL;
+CREATE TABLE file_tags(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, name varchar(64) NOT NULL, file_indexed_tags uuid NULL, FOREIGN KEY(file_indexed_tags) REFERENCES files(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX filetag_name_file_indexed_tags ON file_tags(name, file_indexed_tags);
+CREATE TABLE file_properties(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, key varchar(64) NOT NULL, value varchar(255) NOT NULL, file_indexed_properties uuid NULL, FOREIGN KEY(file_indexed_properties) REFERENCES files(id) ON DELETE SET NULL);
+CREATE INDEX fileproperty_key_value ON file_properties(key, value);
+CREATE INDEX fileproperty_file_indexed_properties ON file_properties(file_indexed_properties);
+`,
+			dialect.Postgres: `
+ALTER TABLE files ADD COLUMN tags jsonb NULL, ADD COLUMN content_type varchar(255) NULL;
+CREATE TABLE file_tags(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, name varchar(64) NOT NULL, file_indexed_tags uuid NULL, PRIMARY KEY(id), CONSTRAINT file_tags_files_indexed_tags FOREIGN KEY(file_indexed_tags) REFERENCES files(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX filetag_name_file_indexed_tags ON file_tags(name, file_indexed_tags);
+CREATE TABLE file_properties(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, key varchar(64) NOT NULL, value varchar(255) NOT NULL, file_indexed_properties uuid NULL, PRIMARY KEY(id), CONSTRAINT file_properties_files_indexed_properties FOREIGN KEY(file_indexed_properties) REFERENCES files(id) ON DELETE SET NULL);
+CREATE INDEX fileproperty_key_value ON file_properties(key, value);
+CREATE INDEX fileproperty_file_indexed_properties ON file_properties(file_indexed_properties);
+`,
+		},
+		Data: indexMetadata,
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE file_properties;
+DROP TABLE file_tags;
+CREATE TABLE files_old(id uuid NOT NULL, hash varchar(255) NOT NULL, size integer NOT NULL, pinned_at datetime NOT NULL, unpinned_at datetime NULL, metadata json NULL, key varchar(1024) NULL, etag varchar(255) NULL, bucket_files uuid NULL, user_files integer NULL, name varchar(255) NULL, folder_files uuid NULL REFERENCES folders(id) ON DELETE SET NULL, PRIMARY KEY(id), FOREIGN KEY(bucket_files) REFERENCES buckets(id) ON DELETE SET NULL, FOREIGN KEY(user_files) REFERENCES users(id) ON DELETE SET NULL);
+INSERT INTO files_old(id, hash, size, pinned_at, unpinned_at, metadata, key, etag, bucket_files, user_files, name, folder_files) SELECT id, hash, size, pinned_at, unpinned_at, metadata, key, etag, bucket_files, user_files, name, folder_files FROM files;
+DROP TABLE files;
+ALTER TABLE files_old RENAME TO files;
+CREATE INDEX file_hash ON files(hash);
+CREATE INDEX file_bucket_files ON files(bucket_files);
+CREATE INDEX file_name_folder_files ON files(name, folder_files);
+`,
+			dialect.Postgres: `
+DROP TABLE file_properties;
+DROP TABLE file_tags;
+ALTER TABLE files DROP COLUMN content_type, DROP COLUMN tags;
+`,
+		},
+	})
+}
+
+// indexMetadata fills in the content type of files and indexes the
+// metadata of users, which SQLite cannot read without its JSON extension.
+func indexMetadata(ctx context.Context, tx *sql.Tx, d string) error {
+	rows, err := tx.QueryContext(ctx, "SELECT id, metadata FROM files WHERE metadata IS NOT NULL")
+	if err != nil {
+		return err
+	}
+
+	type metadata struct {
+		ContentType string                 `json:"content_type"`
+		Meta        map[string]interface{} `json:"meta"`
+	}
+
+	files := make(map[string]*metadata)
+	for rows.Next() {
+		var (
+			id  string
+			raw []byte
+		)
+		if err := rows.Scan(&id, &raw); err != nil {
+			rows.Close()
+			return err
+		}
+
+		var m metadata
+		if json.Unmarshal(raw, &m) == nil {
+			files[id] = &m
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	update := "UPDATE files SET content_type = " + Placeholder(d, 1) + " WHERE id = " + Placeholder(d, 2)
+	insert := "INSERT INTO file_properties(key, value, file_indexed_properties) VALUES (" + Placeholder(d, 1) + ", " + Placeholder(d, 2) + ", " + Placeholder(d, 3) + ")"
+	for id, m := range files {
+		if m.ContentType != "" {
+			if _, err := tx.ExecContext(ctx, update, truncate(m.ContentType, 255), id); err != nil {
+				return err
+			}
+		}
+
+		for key, value := range m.Meta {
+			s, ok := value.(string)
+			if !ok || key == "" || len(key) > 64 {
+				continue
+			}
+
+			if _, err := tx.ExecContext(ctx, insert, key, truncate(s, 255), id); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// truncate shortens s to at most n bytes, without splitting characters.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// The text of files, extracted from their content along with their name
+// and metadata, is indexed for full-text searches. The index is not part of
+// the ent schema as each dialect has its own: an FTS4 table kept up to date
+// by triggers for SQLite, and a weighted tsvector for Postgres. Files
+// without text are indexed by the indexing workers, which catch up with the
+// files pinned before.
+func init() {
+	register(&Migration{
+		Version: 5,
+		Name:    "texts",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE file_texts(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, file_id uuid NOT NULL UNIQUE, name text NOT NULL, meta text NOT NULL, body text NOT NULL, FOREIGN KEY(file_id) REFERENCES files(id) ON DELETE CASCADE);
+CREATE VIRTUAL TABLE file_texts_fts USING fts4(content="file_texts", name, meta, body, tokenize=unicode61 "remove_diacritics=1");
+CREATE TRIGGER file_texts_bu BEFORE UPDATE ON file_texts BEGIN
+  DELETE FROM file_texts_fts WHERE docid = old.id;
+END;
+CREATE TRIGGER file_texts_bd BEFORE DELETE ON file_texts BEGIN
+  DELETE FROM file_texts_fts WHERE docid = old.id;
+END;
+CREATE TRIGGER file_texts_au AFTER UPDATE ON file_texts BEGIN
+  INSERT INTO file_texts_fts(docid, name, meta, body) VALUES (new.id, new.name, new.meta, new.body);
+END;
+CREATE TRIGGER file_texts_ai AFTER INSERT ON file_texts BEGIN
+  INSERT INTO file_texts_fts(docid, name, meta, body) VALUES (new.id, new.name, new.meta, new.body);
+END;
+`,
+			dialect.Postgres: `
+CREATE TABLE file_texts(file_id uuid NOT NULL, name text NOT NULL, meta text NOT NULL, body text NOT NULL, document tsvector NOT NULL, PRIMARY KEY(file_id), CONSTRAINT file_texts_files FOREIGN KEY(file_id) REFERENCES files(id) ON DELETE CASCADE);
+CREATE INDEX file_texts_document ON file_texts USING gin(document);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TRIGGER file_texts_ai;
+DROP TRIGGER file_texts_au;
+DROP TRIGGER file_texts_bd;
+DROP TRIGGER file_texts_bu;
+DROP TABLE file_texts_fts;
+DROP TABLE file_texts;
+`,
+			dialect.Postgres: `
+DROP TABLE file_texts;
+`,
+		},
+	})
+}
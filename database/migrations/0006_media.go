@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// The media metadata of files, such as the dimensions of images and the
+// duration of audio and video, is indexed for searches. It is detected
+// when files are uploaded, files uploaded before have none.
+func init() {
+	register(&Migration{
+		Version: 6,
+		Name:    "media",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE file_media(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, width integer NULL, height integer NULL, duration real NULL, pages integer NULL, taken_at datetime NULL, file_indexed_media uuid UNIQUE NULL, FOREIGN KEY(file_indexed_media) REFERENCES files(id) ON DELETE SET NULL);
+`,
+			dialect.Postgres: `
+CREATE TABLE file_media(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, width bigint NULL, height bigint NULL, duration double precision NULL, pages bigint NULL, taken_at timestamp with time zone NULL, file_indexed_media uuid UNIQUE NULL, PRIMARY KEY(id), CONSTRAINT file_media_files_indexed_media FOREIGN KEY(file_indexed_media) REFERENCES files(id) ON DELETE SET NULL);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE file_media;
+`,
+			dialect.Postgres: `
+DROP TABLE file_media;
+`,
+		},
+	})
+}
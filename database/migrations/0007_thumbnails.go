@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Images have thumbnails, generated in the background for the images
+// already pinned too.
+func init() {
+	register(&Migration{
+		Version: 7,
+		Name:    "thumbnails",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE thumbnails(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, size integer NOT NULL, width integer NOT NULL, height integer NOT NULL, hash varchar(255) NULL, content_type varchar(255) NULL, bytes integer NOT NULL, file_thumbnails uuid NULL, FOREIGN KEY(file_thumbnails) REFERENCES files(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX thumbnail_size_file_thumbnails ON thumbnails(size, file_thumbnails);
+CREATE INDEX thumbnail_hash ON thumbnails(hash);
+`,
+			dialect.Postgres: `
+CREATE TABLE thumbnails(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, size bigint NOT NULL, width bigint NOT NULL, height bigint NOT NULL, hash varchar(255) NULL, content_type varchar(255) NULL, bytes bigint NOT NULL, file_thumbnails uuid NULL, PRIMARY KEY(id), CONSTRAINT thumbnails_files_thumbnails FOREIGN KEY(file_thumbnails) REFERENCES files(id) ON DELETE SET NULL);
+CREATE UNIQUE INDEX thumbnail_size_file_thumbnails ON thumbnails(size, file_thumbnails);
+CREATE INDEX thumbnail_hash ON thumbnails(hash);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE thumbnails;
+`,
+			dialect.Postgres: `
+DROP TABLE thumbnails;
+`,
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Files are shared with public links.
+func init() {
+	register(&Migration{
+		Version: 8,
+		Name:    "shares",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE shares(id uuid NOT NULL, slug varchar(64) UNIQUE NOT NULL, password varchar(255) NULL, expires_at datetime NULL, max_downloads integer NULL, downloads integer NOT NULL, last_downloaded_at datetime NULL, revoked_at datetime NULL, created_at datetime NOT NULL, file_shares uuid NULL, user_shares integer NULL, PRIMARY KEY(id), FOREIGN KEY(file_shares) REFERENCES files(id) ON DELETE SET NULL, FOREIGN KEY(user_shares) REFERENCES users(id) ON DELETE SET NULL);
+CREATE INDEX share_file_shares ON shares(file_shares);
+`,
+			dialect.Postgres: `
+CREATE TABLE shares(id uuid NOT NULL, slug varchar(64) UNIQUE NOT NULL, password varchar NULL, expires_at timestamp with time zone NULL, max_downloads bigint NULL, downloads bigint NOT NULL, last_downloaded_at timestamp with time zone NULL, revoked_at timestamp with time zone NULL, created_at timestamp with time zone NOT NULL, file_shares uuid NULL, user_shares bigint NULL, PRIMARY KEY(id), CONSTRAINT shares_files_shares FOREIGN KEY(file_shares) REFERENCES files(id) ON DELETE SET NULL, CONSTRAINT shares_users_shares FOREIGN KEY(user_shares) REFERENCES users(id) ON DELETE SET NULL);
+CREATE INDEX share_file_shares ON shares(file_shares);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE shares;
+`,
+			dialect.Postgres: `
+DROP TABLE shares;
+`,
+		},
+	})
+}
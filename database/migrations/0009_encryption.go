@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Files may be encrypted before they are pinned, with a data key of their
+// own stored wrapped next to them.
+func init() {
+	register(&Migration{
+		Version: 9,
+		Name:    "encryption",
+		Up: map[string]string{
+			dialect.SQLite: `
+CREATE TABLE file_keys(id integer PRIMARY KEY AUTOINCREMENT NOT NULL, provider varchar(255) NOT NULL, key_id varchar(255) NOT NULL, wrapped_key blob NOT NULL, segment_size integer NOT NULL, created_at datetime NOT NULL, file_encryption_key uuid UNIQUE NULL, FOREIGN KEY(file_encryption_key) REFERENCES files(id) ON DELETE SET NULL);
+`,
+			dialect.Postgres: `
+CREATE TABLE file_keys(id bigint GENERATED BY DEFAULT AS IDENTITY NOT NULL, provider varchar NOT NULL, key_id varchar NOT NULL, wrapped_key bytea NOT NULL, segment_size bigint NOT NULL, created_at timestamp with time zone NOT NULL, file_encryption_key uuid UNIQUE NULL, PRIMARY KEY(id), CONSTRAINT file_keys_files_encryption_key FOREIGN KEY(file_encryption_key) REFERENCES files(id) ON DELETE SET NULL);
+`,
+		},
+		Down: map[string]string{
+			dialect.SQLite: `
+DROP TABLE file_keys;
+`,
+			dialect.Postgres: `
+DROP TABLE file_keys;
+`,
+		},
+	})
+}
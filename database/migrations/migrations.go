@@ -0,0 +1,80 @@
+// Package migrations holds the versioned changes of the database schema.
+//
+// Every change to the ent schema must come with a new migration, in a file
+// named after its version, with statements for every supported dialect.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/facebookincubator/ent/dialect"
+)
+
+// Dialects lists the database dialects migrations are written for.
+var Dialects = []string{dialect.SQLite, dialect.Postgres}
+
+type Migration struct {
+	Version int
+	Name    string
+
+	// Up and Down hold the statements applying and reverting the migration,
+	// keyed by dialect.
+	Up   map[string]string
+	Down map[string]string
+
+	// Data, when set, updates the rows after the Up statements, for changes
+	// which are not portable in SQL. It runs in the same transaction.
+	Data func(ctx context.Context, tx *sql.Tx, dialect string) error
+}
+
+// Placeholder returns the i-th bind parameter, from 1, for dialect d.
+func Placeholder(d string, i int) string {
+	if d == dialect.Postgres {
+		return fmt.Sprintf("$%d", i)
+	}
+
+	return "?"
+}
+
+var registered = make(map[int]*Migration)
+
+func register(m *Migration) {
+	if _, ok := registered[m.Version]; ok {
+		panic(fmt.Sprintf("migrations: version %d registered twice", m.Version))
+	}
+
+	for _, d := range Dialects {
+		if m.Up[d] == "" || m.Down[d] == "" {
+			panic(fmt.Sprintf("migrations: version %d has no %s statements", m.Version, d))
+		}
+	}
+
+	registered[m.Version] = m
+}
+
+// All returns the migrations ordered by version.
+func All() []*Migration {
+	all := make([]*Migration, 0, len(registered))
+	for _, m := range registered {
+		all = append(all, m)
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Version < all[j].Version
+	})
+
+	return all
+}
+
+// Latest returns the version of the last migration.
+func Latest() int {
+	all := All()
+	if len(all) == 0 {
+		return 0
+	}
+
+	return all[len(all)-1].Version
+}
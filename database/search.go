@@ -0,0 +1,324 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/database/migrations"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+)
+
+// FileFilter restricts the files returned by SearchFiles. Zero values are
+// ignored, and every given condition must be met.
+type FileFilter struct {
+	// Tags the files must all have
+	Tags []string
+
+	// Metadata the files must all have, set by users
+	Metadata map[string]string
+
+	// NamePrefix and NameContains match names regardless of their case
+	NamePrefix   string
+	NameContains string
+
+	MinSize *int64
+	MaxSize *int64
+
+	// Since and Until bound the time files were pinned at
+	Since time.Time
+	Until time.Time
+
+	// ContentType is either a media type, or a type followed by /* to
+	// match each of its subtypes
+	ContentType string
+
+	// Bounds of the media metadata of the files, in pixels, seconds and
+	// pages: files without it are not matched
+	MinWidth    *int
+	MaxWidth    *int
+	MinHeight   *int
+	MaxHeight   *int
+	MinDuration *float64
+	MaxDuration *float64
+	MinPages    *int
+	MaxPages    *int
+
+	// TakenSince and TakenUntil bound the time photos and videos were
+	// taken at
+	TakenSince time.Time
+	TakenUntil time.Time
+
+	Limit  int
+	Offset int
+}
+
+// SearchFiles returns a page of the pinned files of u matching filter,
+// latest first, along with the number of files matching it.
+//
+// The query is written by hand as names are matched with escaped LIKE
+// patterns, which the query builder does not support.
+func (db *Database) SearchFiles(ctx context.Context, u *ent.User, filter *FileFilter) ([]*ent.File, int, error) {
+	q := filterQuery(db.dialect, u, filter)
+
+	query, args := q.build("SELECT COUNT(*) FROM " + file.Table)
+	rows, err := db.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := scanCount(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, args = q.build("SELECT " + column(file.FieldID) + " FROM " + file.Table)
+	query += " ORDER BY " + column(file.FieldPinnedAt) + " DESC, " + column(file.FieldID) + " LIMIT " + strconv.Itoa(filter.Limit) + " OFFSET " + strconv.Itoa(filter.Offset)
+
+	rows, err = db.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ids, err := scanIDs(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	files, err := db.filesByID(ctx, ids)
+	return files, total, err
+}
+
+// filterQuery returns the conditions selecting the pinned files of u
+// matching filter.
+func filterQuery(dialect string, u *ent.User, filter *FileFilter) *searchQuery {
+	q := &searchQuery{dialect: dialect}
+	q.where(column(file.UserColumn)+" = ?", u.ID)
+	q.where(column(file.FieldUnpinnedAt) + " IS NULL")
+
+	for _, tag := range filter.Tags {
+		q.where("EXISTS (SELECT 1 FROM "+filetag.Table+" WHERE "+filetag.Table+"."+filetag.FileColumn+" = "+column(file.FieldID)+" AND "+filetag.Table+"."+filetag.FieldName+" = ?)", tag)
+	}
+
+	for key, value := range filter.Metadata {
+		q.where("EXISTS (SELECT 1 FROM "+fileproperty.Table+" WHERE "+fileproperty.Table+"."+fileproperty.FileColumn+" = "+column(file.FieldID)+" AND "+fileproperty.Table+"."+fileproperty.FieldKey+" = ? AND "+fileproperty.Table+"."+fileproperty.FieldValue+" = ?)", key, value)
+	}
+
+	if filter.NamePrefix != "" {
+		q.where("lower("+column(file.FieldName)+") LIKE ? ESCAPE '\\'", escapeLike(strings.ToLower(filter.NamePrefix))+"%")
+	}
+
+	if filter.NameContains != "" {
+		q.where("lower("+column(file.FieldName)+") LIKE ? ESCAPE '\\'", "%"+escapeLike(strings.ToLower(filter.NameContains))+"%")
+	}
+
+	if filter.MinSize != nil {
+		q.where(column(file.FieldSize)+" >= ?", *filter.MinSize)
+	}
+
+	if filter.MaxSize != nil {
+		q.where(column(file.FieldSize)+" <= ?", *filter.MaxSize)
+	}
+
+	if !filter.Since.IsZero() {
+		q.where(column(file.FieldPinnedAt)+" >= ?", filter.Since)
+	}
+
+	if !filter.Until.IsZero() {
+		q.where(column(file.FieldPinnedAt)+" < ?", filter.Until)
+	}
+
+	if filter.ContentType != "" {
+		if strings.HasSuffix(filter.ContentType, "/*") {
+			q.where(column(file.FieldContentType)+" LIKE ? ESCAPE '\\'", escapeLike(strings.TrimSuffix(filter.ContentType, "*"))+"%")
+		} else {
+			q.where(column(file.FieldContentType)+" = ?", filter.ContentType)
+		}
+	}
+
+	if cond, args := mediaConditions(filter); cond != "" {
+		q.where("EXISTS (SELECT 1 FROM "+filemedia.Table+" WHERE "+filemedia.Table+"."+filemedia.FileColumn+" = "+column(file.FieldID)+cond+")", args...)
+	}
+
+	return q
+}
+
+// mediaConditions returns the conditions of filter on the media metadata
+// of files, each preceded by AND.
+func mediaConditions(filter *FileFilter) (string, []interface{}) {
+	var (
+		b    strings.Builder
+		args []interface{}
+	)
+	bound := func(field, op string, value interface{}) {
+		b.WriteString(" AND " + filemedia.Table + "." + field + " " + op + " ?")
+		args = append(args, value)
+	}
+
+	for _, c := range []struct {
+		field string
+		op    string
+		value *int
+	}{
+		{filemedia.FieldWidth, ">=", filter.MinWidth},
+		{filemedia.FieldWidth, "<=", filter.MaxWidth},
+		{filemedia.FieldHeight, ">=", filter.MinHeight},
+		{filemedia.FieldHeight, "<=", filter.MaxHeight},
+		{filemedia.FieldPages, ">=", filter.MinPages},
+		{filemedia.FieldPages, "<=", filter.MaxPages},
+	} {
+		if c.value != nil {
+			bound(c.field, c.op, *c.value)
+		}
+	}
+
+	if filter.MinDuration != nil {
+		bound(filemedia.FieldDuration, ">=", *filter.MinDuration)
+	}
+	if filter.MaxDuration != nil {
+		bound(filemedia.FieldDuration, "<=", *filter.MaxDuration)
+	}
+
+	if !filter.TakenSince.IsZero() {
+		bound(filemedia.FieldTakenAt, ">=", filter.TakenSince.UTC())
+	}
+	if !filter.TakenUntil.IsZero() {
+		bound(filemedia.FieldTakenAt, "<", filter.TakenUntil.UTC())
+	}
+
+	return b.String(), args
+}
+
+// query runs a query written by hand, instrumented like the ones of ent.
+func (db *Database) query(ctx context.Context, query string, args ...interface{}) (rows *sql.Rows, err error) {
+	ctx, done := instrument(ctx, db.dialect, query)
+	defer func() { done(err) }()
+	return db.db.QueryContext(ctx, query, args...)
+}
+
+// filesByID returns the files of ids, in the same order.
+func (db *Database) filesByID(ctx context.Context, ids []uuid.UUID) ([]*ent.File, error) {
+	if len(ids) == 0 {
+		return []*ent.File{}, nil
+	}
+
+	found, err := db.File.
+		Query().
+		Where(file.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.File, len(found))
+	for _, f := range found {
+		byID[f.ID] = f
+	}
+
+	files := make([]*ent.File, 0, len(ids))
+	for _, id := range ids {
+		if f, ok := byID[id]; ok {
+			files = append(files, f)
+		}
+	}
+
+	return files, nil
+}
+
+// searchQuery accumulates the conditions of a search, written with ? for
+// their arguments.
+type searchQuery struct {
+	dialect    string
+	conditions []string
+	args       []interface{}
+}
+
+func (q *searchQuery) where(condition string, args ...interface{}) {
+	q.conditions = append(q.conditions, condition)
+	q.args = append(q.args, args...)
+}
+
+// build returns the query made of head and the conditions, with the
+// placeholders of the dialect. args are the arguments of head, if any.
+func (q *searchQuery) build(head string, args ...interface{}) (string, []interface{}) {
+	var b strings.Builder
+	b.WriteString(head)
+
+	for i, condition := range q.conditions {
+		if i == 0 {
+			b.WriteString(" WHERE ")
+		} else {
+			b.WriteString(" AND ")
+		}
+		b.WriteString(condition)
+	}
+
+	return rebind(q.dialect, b.String()), append(args, q.args...)
+}
+
+// rebind replaces the ? of query with the placeholders of the dialect.
+func rebind(dialect, query string) string {
+	var b strings.Builder
+	n := 0
+	for {
+		i := strings.IndexByte(query, '?')
+		if i == -1 {
+			break
+		}
+
+		n++
+		b.WriteString(query[:i])
+		b.WriteString(migrations.Placeholder(dialect, n))
+		query = query[i+1:]
+	}
+	b.WriteString(query)
+
+	return b.String()
+}
+
+// column returns the qualified name of a column of files, as searches join
+// other tables.
+func column(name string) string {
+	return file.Table + "." + name
+}
+
+func scanCount(rows *sql.Rows) (int, error) {
+	defer rows.Close()
+
+	var n int
+	for rows.Next() {
+		if err := rows.Scan(&n); err != nil {
+			return 0, err
+		}
+	}
+
+	return n, rows.Err()
+}
+
+func scanIDs(rows *sql.Rows) ([]uuid.UUID, error) {
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// escapeLike escapes the wildcards of LIKE patterns in s.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
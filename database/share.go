@@ -0,0 +1,115 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/sthorer/api/database/migrations"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/utils"
+)
+
+// slugLength is the length of the slugs of share links, which are as hard
+// to guess as secrets.
+const slugLength = 32
+
+// ShareOptions restrict the access to a share link.
+type ShareOptions struct {
+	// Password required to download the file, none when empty
+	Password string
+
+	// ExpiresAt is the time the link stops working, never when nil
+	ExpiresAt *time.Time
+
+	// MaxDownloads is the number of times the file can be downloaded,
+	// unlimited when nil
+	MaxDownloads *int
+}
+
+// NewShare creates a share link of f, pinned by u.
+func (db *Database) NewShare(ctx context.Context, u *ent.User, f *ent.File, opts *ShareOptions) (*ent.Share, error) {
+	slug, err := utils.GenerateSecret(slugLength)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	create := db.Share.
+		Create().
+		SetID(id).
+		SetSlug(slug).
+		SetUser(u).
+		SetFile(f).
+		SetNillableMaxDownloads(opts.MaxDownloads)
+
+	if opts.ExpiresAt != nil {
+		create.SetExpiresAt(opts.ExpiresAt.UTC())
+	}
+
+	if opts.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(opts.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, err
+		}
+		create.SetPassword(string(hash))
+	}
+
+	return create.Save(ctx)
+}
+
+// ShareBySlug returns the share link of slug, along with its file.
+func (db *Database) ShareBySlug(ctx context.Context, slug string) (*ent.Share, error) {
+	return db.Share.
+		Query().
+		Where(share.Slug(slug)).
+		WithFile().
+		Only(ctx)
+}
+
+// RevokeShare revokes s, which stops working.
+func (db *Database) RevokeShare(ctx context.Context, s *ent.Share) (*ent.Share, error) {
+	if s.RevokedAt != nil {
+		return s, nil
+	}
+
+	return s.Update().
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+}
+
+// CountShareDownload records a download of the file of s. It returns false
+// when s has been revoked or has no downloads left, concurrent downloads
+// counting against the limit.
+func (db *Database) CountShareDownload(ctx context.Context, s *ent.Share) (bool, error) {
+	query := "UPDATE " + share.Table + " SET " +
+		share.FieldDownloads + " = " + share.FieldDownloads + " + 1, " +
+		share.FieldLastDownloadedAt + " = " + migrations.Placeholder(db.dialect, 1) +
+		" WHERE " + share.FieldID + " = " + migrations.Placeholder(db.dialect, 2) +
+		" AND " + share.FieldRevokedAt + " IS NULL" +
+		" AND (" + share.FieldMaxDownloads + " IS NULL OR " + share.FieldDownloads + " < " + share.FieldMaxDownloads + ")"
+
+	res, err := db.exec(ctx, query, time.Now().UTC(), s.ID)
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n == 1, nil
+}
+
+// SharePasswordMatches tells whether password is the password of s.
+func SharePasswordMatches(s *ent.Share, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(s.Password), []byte(password)) == nil
+}
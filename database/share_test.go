@@ -0,0 +1,141 @@
+package database_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/database/databasetest"
+)
+
+func TestCountShareDownload(t *testing.T) {
+	ctx := context.Background()
+	db := databasetest.New(t)
+	u := databasetest.User(t, db, "a@b.co")
+
+	f, err := db.NewFile(ctx, u, nil, "a.txt", "QmHash", 5, nil, map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limit := func(n int) *int { return &n }
+
+	tests := []struct {
+		name         string
+		maxDownloads *int
+		revoked      bool
+		counted      []bool
+	}{
+		{"unlimited", nil, false, []bool{true, true, true}},
+		{"single download", limit(1), false, []bool{true, false}},
+		{"limited", limit(2), false, []bool{true, true, false, false}},
+		{"revoked", nil, true, []bool{false}},
+		{"revoked with downloads left", limit(2), true, []bool{false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := db.NewShare(ctx, u, f, &database.ShareOptions{MaxDownloads: tt.maxDownloads})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.revoked {
+				if s, err = db.RevokeShare(ctx, s); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var counted []bool
+			downloads := 0
+			for range tt.counted {
+				ok, err := db.CountShareDownload(ctx, s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				counted = append(counted, ok)
+				if ok {
+					downloads++
+				}
+			}
+
+			if !reflect.DeepEqual(counted, tt.counted) {
+				t.Errorf("CountShareDownload() = %v, want %v", counted, tt.counted)
+			}
+
+			s, err = db.Share.Get(ctx, s.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if s.Downloads != downloads {
+				t.Errorf("got %d downloads recorded, want %d", s.Downloads, downloads)
+			}
+
+			if (downloads > 0) != (s.LastDownloadedAt != nil) {
+				t.Errorf("last download time = %v with %d downloads", s.LastDownloadedAt, downloads)
+			}
+		})
+	}
+}
+
+func TestCountShareDownloadConcurrently(t *testing.T) {
+	const (
+		maxDownloads = 5
+		downloads    = 20
+	)
+
+	ctx := context.Background()
+	db := databasetest.New(t)
+	u := databasetest.User(t, db, "a@b.co")
+
+	f, err := db.NewFile(ctx, u, nil, "a.txt", "QmHash", 5, nil, map[string]interface{}{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	max := maxDownloads
+	s, err := db.NewShare(ctx, u, f, &database.ShareOptions{MaxDownloads: &max})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		counted int
+	)
+	for i := 0; i < downloads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ok, err := db.CountShareDownload(ctx, s)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			if ok {
+				mu.Lock()
+				counted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if counted != maxDownloads {
+		t.Errorf("%d concurrent downloads counted, want %d", counted, maxDownloads)
+	}
+
+	if s, err = db.Share.Get(ctx, s.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Downloads != maxDownloads {
+		t.Errorf("got %d downloads recorded, want %d", s.Downloads, maxDownloads)
+	}
+}
@@ -0,0 +1,488 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/facebookincubator/ent/dialect"
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/database/migrations"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+)
+
+// The text of files is indexed in the file_texts table, in three parts
+// weighted from the most to the least relevant: their name, their tags and
+// metadata, and the body extracted from their content. Files are indexed
+// once they have a row, even without body.
+
+// IndexText indexes the name and metadata of f along with body, the text
+// extracted from its content, replacing its previous text.
+func (db *Database) IndexText(ctx context.Context, f *ent.File, body string) error {
+	var query string
+	args := []interface{}{f.ID, textName(f), textMeta(f), unhighlighted.Replace(body)}
+	if db.dialect == dialect.Postgres {
+		query = "INSERT INTO file_texts(file_id, name, meta, body, document) VALUES ($1, $2, $3, $4, " + textDocument("$5", "$2", "$3", "$4") + ") " +
+			"ON CONFLICT (file_id) DO UPDATE SET name = excluded.name, meta = excluded.meta, body = excluded.body, document = excluded.document"
+		args = append(args, db.textSearch)
+	} else {
+		query = "INSERT INTO file_texts(file_id, name, meta, body) VALUES (?, ?, ?, ?) " +
+			"ON CONFLICT (file_id) DO UPDATE SET name = excluded.name, meta = excluded.meta, body = excluded.body"
+	}
+
+	_, err := db.exec(ctx, query, args...)
+	return err
+}
+
+// refreshText updates the indexed name and metadata of f, if indexed,
+// keeping its body.
+func (db *Database) refreshText(ctx context.Context, f *ent.File) error {
+	var query string
+	args := []interface{}{textName(f), textMeta(f), f.ID}
+	if db.dialect == dialect.Postgres {
+		query = "UPDATE file_texts SET name = $1, meta = $2, document = " + textDocument("$4", "$1", "$2", "body") + " WHERE file_id = $3"
+		args = append(args, db.textSearch)
+	} else {
+		query = "UPDATE file_texts SET name = ?, meta = ? WHERE file_id = ?"
+	}
+
+	_, err := db.exec(ctx, query, args...)
+	return err
+}
+
+// copyText indexes copied with the body of f, if indexed. Otherwise
+// copied is left to the indexing workers.
+func (db *Database) copyText(ctx context.Context, f *ent.File, copied *ent.File) error {
+	rows, err := db.query(ctx, "SELECT body FROM file_texts WHERE file_id = "+migrations.Placeholder(db.dialect, 1), f.ID)
+	if err != nil {
+		return err
+	}
+
+	var (
+		body    string
+		indexed bool
+	)
+	for rows.Next() {
+		if err := rows.Scan(&body); err != nil {
+			rows.Close()
+			return err
+		}
+		indexed = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	if !indexed {
+		return nil
+	}
+
+	return db.IndexText(ctx, copied, body)
+}
+
+// UnindexedFiles returns at most limit pinned files whose text is not
+// indexed yet, oldest first.
+func (db *Database) UnindexedFiles(ctx context.Context, limit int) ([]*ent.File, error) {
+	rows, err := db.query(ctx, "SELECT "+file.FieldID+" FROM "+file.Table+
+		" WHERE "+file.FieldUnpinnedAt+" IS NULL AND NOT EXISTS (SELECT 1 FROM file_texts WHERE file_texts.file_id = "+file.Table+"."+file.FieldID+")"+
+		" ORDER BY "+file.FieldPinnedAt+" LIMIT "+migrations.Placeholder(db.dialect, 1), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := scanIDs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.filesByID(ctx, ids)
+}
+
+// CountUnindexedFiles returns the number of pinned files whose text is not
+// indexed yet.
+func (db *Database) CountUnindexedFiles(ctx context.Context) (int, error) {
+	rows, err := db.query(ctx, "SELECT COUNT(*) FROM "+file.Table+
+		" WHERE "+file.FieldUnpinnedAt+" IS NULL AND NOT EXISTS (SELECT 1 FROM file_texts WHERE file_texts.file_id = "+file.Table+"."+file.FieldID+")")
+	if err != nil {
+		return 0, err
+	}
+
+	return scanCount(rows)
+}
+
+// exec runs a statement written by hand, instrumented like the ones of ent.
+func (db *Database) exec(ctx context.Context, query string, args ...interface{}) (res sql.Result, err error) {
+	ctx, done := instrument(ctx, db.dialect, query)
+	defer func() { done(err) }()
+	return db.db.ExecContext(ctx, query, args...)
+}
+
+// textDocument returns the expression of the tsvector indexing the name,
+// meta and body expressions with the text search configuration config.
+func textDocument(config, name, meta, body string) string {
+	return "setweight(to_tsvector(" + config + "::regconfig, " + name + "), 'A') || " +
+		"setweight(to_tsvector(" + config + "::regconfig, " + meta + "), 'B') || " +
+		"setweight(to_tsvector(" + config + "::regconfig, " + body + "), 'C')"
+}
+
+var unhighlighted = strings.NewReplacer(HighlightStart, "", HighlightEnd, "")
+
+// textName returns the indexed name of f, its key for objects without name.
+func textName(f *ent.File) string {
+	if f.Name != "" {
+		return unhighlighted.Replace(f.Name)
+	}
+
+	return unhighlighted.Replace(f.Key)
+}
+
+// textMeta returns the indexed tags and metadata set by users of f, as
+// words.
+func textMeta(f *ent.File) string {
+	parts := append([]string{}, f.Tags...)
+
+	meta, _ := f.Metadata[MetaKey].(map[string]interface{})
+	keys := make([]string, 0, len(meta))
+	for key := range meta {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if s, ok := meta[key].(string); ok {
+			parts = append(parts, key, s)
+		}
+	}
+
+	return unhighlighted.Replace(strings.Join(parts, " "))
+}
+
+// Matched words are marked in snippets with characters of the private use
+// area of Unicode, removed from the indexed text.
+const (
+	HighlightStart = "\ue000"
+	HighlightEnd   = "\ue001"
+)
+
+const (
+	maxTerms     = 32
+	snippetWords = 24
+)
+
+// TextMatch is a file matching a full-text search.
+type TextMatch struct {
+	File *ent.File
+
+	// Rank grows with the relevance of the file to the search
+	Rank float64
+
+	// Snippet is an excerpt of the text of the file, with the matched words
+	// between HighlightStart and HighlightEnd
+	Snippet string
+}
+
+// TextTerms returns the words searched by q, lowercased and without
+// duplicates. Other characters only separate words.
+func TextTerms(q string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, term := range strings.FieldsFunc(strings.ToLower(q), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if seen[term] {
+			continue
+		}
+
+		seen[term] = true
+		terms = append(terms, term)
+		if len(terms) == maxTerms {
+			break
+		}
+	}
+
+	return terms
+}
+
+// SearchText returns a page of the pinned files of u matching filter whose
+// text has every term, the most relevant first, along with the number of
+// files matching.
+func (db *Database) SearchText(ctx context.Context, u *ent.User, terms []string, filter *FileFilter) ([]*TextMatch, int, error) {
+	if len(terms) == 0 {
+		return []*TextMatch{}, 0, nil
+	}
+
+	var (
+		matches []*TextMatch
+		total   int
+		err     error
+	)
+	if db.dialect == dialect.Postgres {
+		matches, total, err = db.searchTextPostgres(ctx, u, terms, filter)
+	} else {
+		matches, total, err = db.searchTextSQLite(ctx, u, terms, filter)
+	}
+	if err != nil || len(matches) == 0 {
+		return []*TextMatch{}, total, err
+	}
+
+	ids := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		ids[i] = m.File.ID
+	}
+
+	files, err := db.filesByID(ctx, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	byID := make(map[uuid.UUID]*ent.File, len(files))
+	for _, f := range files {
+		byID[f.ID] = f
+	}
+
+	found := matches[:0]
+	for _, m := range matches {
+		if f, ok := byID[m.File.ID]; ok {
+			m.File = f
+			found = append(found, m)
+		}
+	}
+
+	return found, total, nil
+}
+
+// searchTextPostgres ranks the matching files with the weights of the
+// parts of their text, and highlights the page of files.
+func (db *Database) searchTextPostgres(ctx context.Context, u *ent.User, terms []string, filter *FileFilter) ([]*TextMatch, int, error) {
+	text := strings.Join(terms, " ")
+	from := " FROM " + file.Table + " JOIN file_texts ON file_texts.file_id = " + column(file.FieldID)
+
+	q := filterQuery(db.dialect, u, filter)
+	q.where("file_texts.document @@ plainto_tsquery(?::regconfig, ?)", db.textSearch, text)
+
+	query, args := q.build("SELECT COUNT(*)" + from)
+	rows, err := db.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := scanCount(rows)
+	if err != nil || total == 0 {
+		return nil, total, err
+	}
+
+	query, args = q.build("SELECT "+column(file.FieldID)+", ts_rank_cd(file_texts.document, plainto_tsquery(?::regconfig, ?)) AS rank"+from, db.textSearch, text)
+	query += " ORDER BY rank DESC, " + column(file.FieldPinnedAt) + " DESC, " + column(file.FieldID) + " LIMIT " + strconv.Itoa(filter.Limit) + " OFFSET " + strconv.Itoa(filter.Offset)
+
+	rows, err = db.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var matches []*TextMatch
+	for rows.Next() {
+		m := &TextMatch{File: &ent.File{}}
+		if err := rows.Scan(&m.File.ID, &m.Rank); err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+		matches = append(matches, m)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil || len(matches) == 0 {
+		return nil, total, err
+	}
+
+	options := "StartSel=" + HighlightStart + ", StopSel=" + HighlightEnd + ", MaxWords=" + strconv.Itoa(snippetWords) + ", MinWords=" + strconv.Itoa(snippetWords/2) + `, MaxFragments=2, FragmentDelimiter=" … "`
+	args = []interface{}{db.textSearch, options, db.textSearch, text}
+	query = "SELECT file_id, ts_headline($1::regconfig, concat_ws(' ', name, meta, body), plainto_tsquery($3::regconfig, $4), $2) FROM file_texts WHERE file_id IN (" + db.idPlaceholders(len(args), len(matches)) + ")"
+	for _, m := range matches {
+		args = append(args, m.File.ID)
+	}
+
+	return matches, total, db.scanSnippets(ctx, matches, query, args...)
+}
+
+// searchTextSQLite ranks the matching files with the BM25 function, which
+// SQLite does not provide for FTS4, and highlights the page of files.
+func (db *Database) searchTextSQLite(ctx context.Context, u *ent.User, terms []string, filter *FileFilter) ([]*TextMatch, int, error) {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + term + `"`
+	}
+	match := strings.Join(quoted, " ")
+
+	q := filterQuery(db.dialect, u, filter)
+	q.where("file_texts_fts MATCH ?", match)
+
+	query, args := q.build("SELECT " + column(file.FieldID) + ", " + column(file.FieldPinnedAt) + ", matchinfo(file_texts_fts, 'pcnalx') FROM " + file.Table +
+		" JOIN file_texts ON file_texts.file_id = " + column(file.FieldID) +
+		" JOIN file_texts_fts ON file_texts_fts.docid = file_texts.id")
+	rows, err := db.query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	type ranked struct {
+		match    *TextMatch
+		pinnedAt time.Time
+	}
+
+	var all []*ranked
+	for rows.Next() {
+		var (
+			r    = &ranked{match: &TextMatch{File: &ent.File{}}}
+			info []byte
+		)
+		if err := rows.Scan(&r.match.File.ID, &r.pinnedAt, &info); err != nil {
+			rows.Close()
+			return nil, 0, err
+		}
+
+		r.match.Rank = bm25(info, textWeights)
+		all = append(all, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if all[i].match.Rank != all[j].match.Rank {
+			return all[i].match.Rank > all[j].match.Rank
+		}
+		if !all[i].pinnedAt.Equal(all[j].pinnedAt) {
+			return all[i].pinnedAt.After(all[j].pinnedAt)
+		}
+		return all[i].match.File.ID.String() < all[j].match.File.ID.String()
+	})
+
+	total := len(all)
+	if filter.Offset >= total {
+		return nil, total, nil
+	}
+
+	all = all[filter.Offset:]
+	if len(all) > filter.Limit {
+		all = all[:filter.Limit]
+	}
+
+	matches := make([]*TextMatch, len(all))
+	for i, r := range all {
+		matches[i] = r.match
+	}
+
+	args = []interface{}{HighlightStart, HighlightEnd, "…", match}
+	query = "SELECT file_texts.file_id, snippet(file_texts_fts, ?, ?, ?, -1, " + strconv.Itoa(snippetWords) + ") FROM file_texts_fts" +
+		" JOIN file_texts ON file_texts.id = file_texts_fts.docid" +
+		" WHERE file_texts_fts MATCH ? AND file_texts.file_id IN (" + db.idPlaceholders(len(args), len(matches)) + ")"
+	for _, m := range matches {
+		args = append(args, m.File.ID)
+	}
+
+	return matches, total, db.scanSnippets(ctx, matches, query, args...)
+}
+
+// scanSnippets sets the snippets of matches, selected by query with the ID
+// of their file.
+func (db *Database) scanSnippets(ctx context.Context, matches []*TextMatch, query string, args ...interface{}) error {
+	rows, err := db.query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	snippets := make(map[uuid.UUID]string, len(matches))
+	for rows.Next() {
+		var (
+			id      uuid.UUID
+			snippet string
+		)
+		if err := rows.Scan(&id, &snippet); err != nil {
+			return err
+		}
+		snippets[id] = snippet
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, m := range matches {
+		m.Snippet = snippets[m.File.ID]
+	}
+
+	return nil
+}
+
+// idPlaceholders returns n comma separated placeholders, following the
+// first placeholders already in the query.
+func (db *Database) idPlaceholders(first, n int) string {
+	placeholders := make([]string, n)
+	for i := range placeholders {
+		placeholders[i] = migrations.Placeholder(db.dialect, first+i+1)
+	}
+
+	return strings.Join(placeholders, ", ")
+}
+
+// textWeights weight the name, meta and body of the text of files.
+var textWeights = []float64{4, 2, 1}
+
+// bm25 returns the BM25 rank of a row from its matchinfo with the pcnalx
+// format: 32 bits integers, in the byte order of the host, little endian on
+// the platforms supported.
+func bm25(info []byte, weights []float64) float64 {
+	const (
+		k1 = 1.2
+		b  = 0.75
+	)
+
+	value := func(i int) float64 {
+		return float64(binary.LittleEndian.Uint32(info[i*4:]))
+	}
+
+	if len(info) < 12 {
+		return 0
+	}
+
+	phrases, columns := int(value(0)), int(value(1))
+	if columns != len(weights) || len(info) < 4*(3+2*columns+3*phrases*columns) {
+		return 0
+	}
+
+	rows := value(2)
+	averages, lengths, hits := 3, 3+columns, 3+2*columns
+
+	var rank float64
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns; c++ {
+			i := hits + 3*(p*columns+c)
+			frequency, matching := value(i), value(i+2)
+			if frequency == 0 {
+				continue
+			}
+
+			// Positive even for the words most rows have
+			idf := math.Log(1 + (rows-matching+0.5)/(matching+0.5))
+
+			average := value(averages + c)
+			if average == 0 {
+				average = 1
+			}
+
+			norm := 1 - b + b*value(lengths+c)/average
+			rank += weights[c] * idf * frequency * (k1 + 1) / (frequency + k1*norm)
+		}
+	}
+
+	return rank
+}
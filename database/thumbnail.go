@@ -0,0 +1,173 @@
+package database
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sthorer/api/database/migrations"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/thumbnail"
+)
+
+// NewThumbnail is a thumbnail of a file, recorded with SetThumbnails.
+type NewThumbnail struct {
+	Size        int
+	Width       int
+	Height      int
+	Hash        string
+	ContentType string
+	Bytes       int64
+}
+
+// UnthumbnailedFiles returns up to limit pinned files of contentTypes, of
+// at most maxSize bytes, which have no thumbnail yet, oldest first.
+func (db *Database) UnthumbnailedFiles(ctx context.Context, contentTypes []string, maxSize int64, limit int) ([]*ent.File, error) {
+	q := unthumbnailedQuery(db.dialect, contentTypes, maxSize)
+	query, args := q.build("SELECT " + column(file.FieldID) + " FROM " + file.Table)
+	query += " ORDER BY " + column(file.FieldPinnedAt) + " LIMIT " + migrations.Placeholder(db.dialect, len(args)+1)
+
+	rows, err := db.query(ctx, query, append(args, limit)...)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := scanIDs(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.filesByID(ctx, ids)
+}
+
+// CountUnthumbnailedFiles returns the number of files UnthumbnailedFiles
+// would return without limit.
+func (db *Database) CountUnthumbnailedFiles(ctx context.Context, contentTypes []string, maxSize int64) (int, error) {
+	q := unthumbnailedQuery(db.dialect, contentTypes, maxSize)
+	query, args := q.build("SELECT COUNT(*) FROM " + file.Table)
+
+	rows, err := db.query(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return scanCount(rows)
+}
+
+func unthumbnailedQuery(dialect string, contentTypes []string, maxSize int64) *searchQuery {
+	q := &searchQuery{dialect: dialect}
+	q.where(column(file.FieldUnpinnedAt) + " IS NULL")
+	q.where(column(file.FieldSize)+" <= ?", maxSize)
+	q.where("NOT EXISTS (SELECT 1 FROM " + thumbnail.Table + " WHERE " + thumbnail.Table + "." + thumbnail.FileColumn + " = " + column(file.FieldID) + ")")
+	// The content of encrypted files is not decrypted in the background
+	q.where("NOT EXISTS (SELECT 1 FROM " + filekey.Table + " WHERE " + filekey.Table + "." + filekey.FileColumn + " = " + column(file.FieldID) + ")")
+
+	args := make([]interface{}, len(contentTypes))
+	for i, contentType := range contentTypes {
+		args[i] = contentType
+	}
+	q.where(column(file.FieldContentType)+" IN ("+strings.TrimSuffix(strings.Repeat("?, ", len(contentTypes)), ", ")+")", args...)
+
+	return q
+}
+
+// SetThumbnails replaces the thumbnails of f.
+func (db *Database) SetThumbnails(ctx context.Context, f *ent.File, thumbnails []*NewThumbnail) error {
+	if _, err := db.Thumbnail.
+		Delete().
+		Where(thumbnail.HasFileWith(file.ID(f.ID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	for _, t := range thumbnails {
+		create := db.Thumbnail.
+			Create().
+			SetSize(t.Size).
+			SetWidth(t.Width).
+			SetHeight(t.Height).
+			SetBytes(t.Bytes).
+			SetFile(f)
+		if t.Hash != "" {
+			create.SetHash(t.Hash).SetContentType(t.ContentType)
+		}
+
+		if _, err := create.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyThumbnails gives copied, a copy of f, the thumbnails of f.
+func (db *Database) copyThumbnails(ctx context.Context, f, copied *ent.File) error {
+	thumbnails, err := f.QueryThumbnails().All(ctx)
+	if err != nil || len(thumbnails) == 0 {
+		return err
+	}
+
+	copies := make([]*NewThumbnail, len(thumbnails))
+	for i, t := range thumbnails {
+		copies[i] = &NewThumbnail{
+			Size:        t.Size,
+			Width:       t.Width,
+			Height:      t.Height,
+			Hash:        t.Hash,
+			ContentType: t.ContentType,
+			Bytes:       t.Bytes,
+		}
+	}
+
+	return db.SetThumbnails(ctx, copied, copies)
+}
+
+// FileThumbnail returns the smallest thumbnail of f at least size pixels
+// big, or its biggest one. Images which could not be decoded only have a
+// thumbnail of size 0 without content.
+func (db *Database) FileThumbnail(ctx context.Context, f *ent.File, size int) (*ent.Thumbnail, error) {
+	t, err := f.QueryThumbnails().
+		Where(thumbnail.SizeGTE(size)).
+		Order(ent.Asc(thumbnail.FieldSize)).
+		First(ctx)
+	if !ent.IsNotFound(err) {
+		return t, err
+	}
+
+	return f.QueryThumbnails().
+		Order(ent.Desc(thumbnail.FieldSize)).
+		First(ctx)
+}
+
+// UnreferencedThumbnails returns the hashes of the thumbnails of f, an
+// unpinned file, which are not the content or the thumbnail of a pinned
+// file. Their content can be unpinned from the IPFS node.
+func (db *Database) UnreferencedThumbnails(ctx context.Context, f *ent.File) ([]string, error) {
+	thumbnails, err := f.QueryThumbnails().
+		Where(thumbnail.HashNotNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var hashes []string
+	seen := make(map[string]bool)
+	for _, t := range thumbnails {
+		if seen[t.Hash] {
+			continue
+		}
+		seen[t.Hash] = true
+
+		referenced, err := db.HashPinned(ctx, t.Hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if !referenced {
+			hashes = append(hashes, t.Hash)
+		}
+	}
+
+	return hashes, nil
+}
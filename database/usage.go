@@ -0,0 +1,88 @@
+package database
+
+import (
+	"context"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/metrics"
+)
+
+// Usage is the storage currently pinned by a user.
+type Usage struct {
+	Files int   `json:"files"`
+	Bytes int64 `json:"bytes"`
+}
+
+func (db *Database) UserUsage(ctx context.Context, u *ent.User) (*Usage, error) {
+	var rows []usageRow
+	err := db.File.
+		Query().
+		Where(file.HasUserWith(user.ID(u.ID)), file.UnpinnedAtIsNil()).
+		GroupBy(file.UserColumn).
+		Aggregate(usageAggregates()...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	usage := &Usage{}
+	if len(rows) > 0 {
+		usage.Files, usage.Bytes = rows[0].Count, rows[0].Sum
+	}
+
+	return usage, nil
+}
+
+// PlanUsage returns the storage currently pinned by the users of each plan.
+func (db *Database) PlanUsage(ctx context.Context) (map[string]*metrics.PlanUsage, error) {
+	var rows []usageRow
+	err := db.File.
+		Query().
+		Where(file.UnpinnedAtIsNil(), joinUser()).
+		GroupBy(user.FieldPlan).
+		Aggregate(usageAggregates()...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	// Plans without pins are reported too
+	usage := make(map[string]*metrics.PlanUsage)
+	for _, plan := range []user.Plan{user.PlanFree, user.PlanPremium} {
+		usage[plan.String()] = &metrics.PlanUsage{}
+	}
+	for _, row := range rows {
+		usage[row.Plan] = &metrics.PlanUsage{Pins: row.Count, Bytes: row.Sum}
+	}
+
+	return usage, nil
+}
+
+// usageRow is a row of the usage aggregates of files, grouped by user or
+// by plan.
+type usageRow struct {
+	User  int    `json:"user_files"`
+	Plan  string `json:"plan"`
+	Count int    `json:"count"`
+	Sum   int64  `json:"sum"`
+}
+
+func usageAggregates() []ent.Aggregate {
+	return []ent.Aggregate{
+		ent.As(ent.Count(), "count"),
+		ent.As(ent.Sum(file.FieldSize), "sum"),
+	}
+}
+
+// joinUser joins the owner of files, so that their fields can be grouped.
+func joinUser() predicate.File {
+	return predicate.File(func(s *sql.Selector) {
+		t := sql.Table(user.Table)
+		s.Join(t).On(s.C(file.UserColumn), t.C(user.FieldID))
+	})
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	"golang.org/x/crypto/bcrypt"
@@ -10,16 +11,26 @@ import (
 	"github.com/sthorer/api/ent/user"
 )
 
+// ErrInvalidCredentials is returned by UserLogin when no user has the email
+// or the password does not match.
+var ErrInvalidCredentials = errors.New("invalid email or password")
+
 func (db *Database) UserLogin(ctx context.Context, email, password string) (*ent.User, error) {
 	u, err := db.User.
 		Query().
 		Where(user.Email(strings.ToLower(email))).
 		Only(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidCredentials
+		}
 		return nil, err
 	}
 
 	if err = bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password)); err != nil {
+		if err == bcrypt.ErrMismatchedHashAndPassword {
+			return nil, ErrInvalidCredentials
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,161 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+func (db *Database) NewWebhook(ctx context.Context, u *ent.User, url string, events []string, secret string) (*ent.Webhook, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	return db.Webhook.
+		Create().
+		SetID(id).
+		SetURL(url).
+		SetEvents(events).
+		SetSecret(secret).
+		SetUser(u).
+		Save(ctx)
+}
+
+func (db *Database) DeleteWebhook(ctx context.Context, w *ent.Webhook) error {
+	if _, err := db.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.HasWebhookWith(webhook.ID(w.ID))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	return db.Webhook.
+		DeleteOne(w).
+		Exec(ctx)
+}
+
+// NewWebhookDelivery queues a delivery of event to w, sent by the
+// dispatcher once due.
+func (db *Database) NewWebhookDelivery(ctx context.Context, w *ent.Webhook, event string, payload map[string]interface{}, due time.Time) (*ent.WebhookDelivery, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := db.WebhookDelivery.
+		Create().
+		SetID(id).
+		SetEvent(event).
+		SetPayload(payload).
+		SetNextAttemptAt(due).
+		SetWebhook(w).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Edges.Webhook = w
+
+	return d, nil
+}
+
+// NewWebhookDeliveries queues a delivery of event for every active webhook
+// of u subscribed to it.
+func (db *Database) NewWebhookDeliveries(ctx context.Context, u *ent.User, event string, payload map[string]interface{}) ([]*ent.WebhookDelivery, error) {
+	webhooks, err := db.Webhook.
+		Query().
+		Where(webhook.HasUserWith(user.ID(u.ID)), webhook.Active(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*ent.WebhookDelivery
+	for _, w := range webhooks {
+		if !subscribed(w, event) {
+			continue
+		}
+
+		d, err := db.NewWebhookDelivery(ctx, w, event, payload, time.Now())
+		if err != nil {
+			return nil, err
+		}
+
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
+
+// PendingWebhookDeliveries returns the deliveries that are due, oldest first.
+func (db *Database) PendingWebhookDeliveries(ctx context.Context, limit int) ([]*ent.WebhookDelivery, error) {
+	return db.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		WithWebhook().
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// CountDueWebhookDeliveries returns the number of pending deliveries due to
+// be sent, the depth of the dispatcher queue.
+func (db *Database) CountDueWebhookDeliveries(ctx context.Context) (int, error) {
+	return db.WebhookDelivery.
+		Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPending),
+			webhookdelivery.NextAttemptAtLTE(time.Now()),
+		).
+		Count(ctx)
+}
+
+func (db *Database) WebhookDeliverySucceeded(ctx context.Context, d *ent.WebhookDelivery, status int) (*ent.WebhookDelivery, error) {
+	return d.Update().
+		SetStatus(webhookdelivery.StatusSucceeded).
+		AddAttempts(1).
+		SetResponseStatus(status).
+		ClearLastError().
+		SetDeliveredAt(time.Now()).
+		Save(ctx)
+}
+
+// WebhookDeliveryFailed records a failed attempt. The delivery is retried at
+// next, or marked as failed for good when next is zero.
+func (db *Database) WebhookDeliveryFailed(ctx context.Context, d *ent.WebhookDelivery, status int, reason string, next time.Time) (*ent.WebhookDelivery, error) {
+	update := d.Update().
+		AddAttempts(1).
+		SetLastError(reason)
+
+	if status != 0 {
+		update.SetResponseStatus(status)
+	}
+
+	if next.IsZero() {
+		update.SetStatus(webhookdelivery.StatusFailed)
+	} else {
+		update.SetNextAttemptAt(next)
+	}
+
+	return update.Save(ctx)
+}
+
+func subscribed(w *ent.Webhook, event string) bool {
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,163 @@
+package encryption
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ErrInvalidKey is returned when a data key cannot be unwrapped, such as
+// with the wrong passphrase.
+var ErrInvalidKey = errors.New("encryption: invalid key")
+
+// KeyManager wraps data keys with master keys it holds, so that data keys
+// can be stored next to the contents they encrypt. Implementations may
+// hold master keys in memory, or delegate to a key management service.
+type KeyManager interface {
+	// WrapKey returns key encrypted with a master key, along with the ID
+	// of this master key.
+	WrapKey(ctx context.Context, key []byte) (wrapped []byte, keyID string, err error)
+
+	// UnwrapKey decrypts key, wrapped with the master key of keyID. It
+	// returns ErrInvalidKey when the key cannot be decrypted.
+	UnwrapKey(ctx context.Context, wrapped []byte, keyID string) ([]byte, error)
+}
+
+// LocalKeyManager wraps data keys with a single master key it holds in
+// memory, such as one of the configuration.
+type LocalKeyManager struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// NewLocalKeyManager returns a key manager wrapping data keys with key, a
+// 32 bytes long master key identified by id.
+func NewLocalKeyManager(id string, key []byte) (*LocalKeyManager, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("encryption: master key must be %d bytes long, got %d", KeySize, len(key))
+	}
+
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalKeyManager{id: id, aead: aead}, nil
+}
+
+// WrapKey implements KeyManager.
+func (m *LocalKeyManager) WrapKey(_ context.Context, key []byte) ([]byte, string, error) {
+	wrapped, err := seal(m.aead, key, []byte(m.id))
+	return wrapped, m.id, err
+}
+
+// UnwrapKey implements KeyManager.
+func (m *LocalKeyManager) UnwrapKey(_ context.Context, wrapped []byte, keyID string) ([]byte, error) {
+	if keyID != m.id {
+		return nil, fmt.Errorf("encryption: unknown master key %q", keyID)
+	}
+
+	return open(m.aead, wrapped, []byte(keyID))
+}
+
+// The parameters of scrypt deriving master keys from passphrases, the
+// ones recommended for interactive logins.
+const (
+	scryptN  = 1 << 15
+	scryptR  = 8
+	scryptP  = 1
+	saltSize = 16
+	scryptID = "scrypt"
+)
+
+// Passphrase wraps data keys with a master key derived from a passphrase.
+// Every data key is wrapped with a key derived with a random salt, stored
+// in the wrapped key: the passphrase is the only secret.
+type Passphrase string
+
+// WrapKey implements KeyManager.
+func (p Passphrase) WrapKey(_ context.Context, key []byte) ([]byte, string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, "", err
+	}
+
+	aead, err := p.aead(salt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	wrapped, err := seal(aead, key, salt)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return append(salt, wrapped...), scryptID, nil
+}
+
+// UnwrapKey implements KeyManager.
+func (p Passphrase) UnwrapKey(_ context.Context, wrapped []byte, keyID string) ([]byte, error) {
+	if keyID != scryptID {
+		return nil, fmt.Errorf("encryption: unknown passphrase key derivation %q", keyID)
+	}
+
+	if len(wrapped) < saltSize {
+		return nil, ErrInvalidKey
+	}
+
+	salt := wrapped[:saltSize]
+	aead, err := p.aead(salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return open(aead, wrapped[saltSize:], salt)
+}
+
+func (p Passphrase) aead(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(p), salt, scryptN, scryptR, scryptP, KeySize)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAEAD(key)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts key with aead, authenticating data, and returns it after a
+// random nonce.
+func seal(aead cipher.AEAD, key, data []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, key, data), nil
+}
+
+// open decrypts a key sealed with seal.
+func open(aead cipher.AEAD, sealed, data []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrInvalidKey
+	}
+
+	key, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], data)
+	if err != nil || len(key) != KeySize {
+		return nil, ErrInvalidKey
+	}
+
+	return key, nil
+}
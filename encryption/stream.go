@@ -0,0 +1,250 @@
+// Package encryption encrypts the content of files before it is pinned,
+// so that the IPFS network only ever sees ciphertext.
+//
+// Each content is encrypted with its own random data key, with AES-256-GCM
+// in segments, following the STREAM construction: every segment is sealed
+// with a nonce made of its index and of a flag marking the last one, so
+// that segments cannot be reordered, dropped or truncated unnoticed, and
+// the content can be decrypted from any segment. Data keys are stored
+// wrapped by a master key, held by a KeyManager.
+package encryption
+
+import (
+	"bufio"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	// KeySize is the size of data keys, for AES-256.
+	KeySize = 32
+
+	// SegmentSize is the size of the segments of plaintext contents are
+	// encrypted in.
+	SegmentSize = 64 << 10
+
+	// overhead is the size of the authentication tag of each segment.
+	overhead = 16
+
+	// maxSegments bounds the index of segments, which is 32 bits long in
+	// nonces.
+	maxSegments = 1 << 32
+)
+
+var (
+	// ErrAuthentication is returned when a ciphertext was modified, or is
+	// decrypted with the wrong key.
+	ErrAuthentication = errors.New("encryption: message authentication failed")
+
+	errTooLong = errors.New("encryption: content too long")
+)
+
+// DataKey is the key a content is encrypted with, along with the size of
+// the segments it is encrypted in.
+type DataKey struct {
+	Key         []byte
+	SegmentSize int
+}
+
+// NewDataKey returns a random data key.
+func NewDataKey() (*DataKey, error) {
+	key := make([]byte, KeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return &DataKey{Key: key, SegmentSize: SegmentSize}, nil
+}
+
+// CiphertextSize returns the size of the ciphertext of a content of size
+// bytes. Empty contents have an empty last segment.
+func (k *DataKey) CiphertextSize(size int64) int64 {
+	segments := (size + int64(k.SegmentSize) - 1) / int64(k.SegmentSize)
+	if segments == 0 {
+		segments = 1
+	}
+
+	return size + segments*overhead
+}
+
+// Locate returns the segment holding the byte at offset of the plaintext,
+// the offset of this segment in the ciphertext, and the number of bytes of
+// the segment before offset.
+func (k *DataKey) Locate(offset int64) (segment, cipherOffset, skip int64) {
+	segment = offset / int64(k.SegmentSize)
+	return segment, segment * int64(k.SegmentSize+overhead), offset % int64(k.SegmentSize)
+}
+
+// Encrypt returns a reader of the ciphertext of the plaintext read from r.
+func (k *DataKey) Encrypt(r io.Reader) (io.Reader, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+
+	return &encrypter{aead: aead, r: r, size: k.SegmentSize, plain: make([]byte, 0, k.SegmentSize+1)}, nil
+}
+
+// Decrypt returns a reader of the plaintext of the ciphertext read from r,
+// which starts at the beginning of segment.
+func (k *DataKey) Decrypt(r io.Reader, segment int64) (io.Reader, error) {
+	aead, err := k.aead()
+	if err != nil {
+		return nil, err
+	}
+
+	return &decrypter{
+		aead:    aead,
+		r:       bufio.NewReaderSize(r, k.SegmentSize+overhead),
+		size:    k.SegmentSize + overhead,
+		segment: segment,
+		sealed:  make([]byte, k.SegmentSize+overhead),
+	}, nil
+}
+
+func (k *DataKey) aead() (cipher.AEAD, error) {
+	return newAEAD(k.Key)
+}
+
+// nonce returns the nonce of the segment of index, the last one or not.
+func nonce(index int64, last bool) []byte {
+	n := make([]byte, 12)
+	binary.BigEndian.PutUint32(n[7:11], uint32(index))
+	if last {
+		n[11] = 1
+	}
+	return n
+}
+
+// encrypter seals the plaintext it reads segment by segment. A segment is
+// only sealed once the byte following it is read, to know whether it is
+// the last one.
+type encrypter struct {
+	aead    cipher.AEAD
+	r       io.Reader
+	size    int
+	segment int64
+	plain   []byte
+	buf     []byte
+	sealed  []byte
+	done    bool
+	err     error
+}
+
+func (e *encrypter) Read(p []byte) (int, error) {
+	for len(e.sealed) == 0 {
+		if e.done {
+			return 0, io.EOF
+		}
+		if e.err != nil {
+			return 0, e.err
+		}
+		e.seal()
+	}
+
+	n := copy(p, e.sealed)
+	e.sealed = e.sealed[n:]
+	return n, nil
+}
+
+func (e *encrypter) seal() {
+	n, err := io.ReadFull(e.r, e.plain[len(e.plain):e.size+1])
+	e.plain = e.plain[:len(e.plain)+n]
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		e.err = err
+		return
+	}
+
+	if e.segment >= maxSegments {
+		e.err = errTooLong
+		return
+	}
+
+	last := len(e.plain) <= e.size
+	segment := e.plain
+	if !last {
+		segment = e.plain[:e.size]
+	}
+
+	e.sealed = e.aead.Seal(e.buf[:0], nonce(e.segment, last), segment, nil)
+	e.buf = e.sealed
+	e.segment++
+
+	if last {
+		e.done = true
+		return
+	}
+
+	// The byte read ahead starts the next segment
+	e.plain = append(e.plain[:0], e.plain[e.size])
+}
+
+// decrypter opens the segments of ciphertext it reads.
+type decrypter struct {
+	aead    cipher.AEAD
+	r       *bufio.Reader
+	size    int
+	segment int64
+	sealed  []byte
+	plain   []byte
+	done    bool
+	err     error
+}
+
+func (d *decrypter) Read(p []byte) (int, error) {
+	for len(d.plain) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		if d.done {
+			return 0, io.EOF
+		}
+		d.open()
+	}
+
+	n := copy(p, d.plain)
+	d.plain = d.plain[n:]
+	return n, nil
+}
+
+func (d *decrypter) open() {
+	n, err := io.ReadFull(d.r, d.sealed)
+	switch {
+	case err == io.EOF, err == io.ErrUnexpectedEOF:
+		// A short segment must be the last one
+	case err != nil:
+		d.err = err
+		return
+	}
+
+	// A full segment is the last one when nothing follows
+	last := n < d.size
+	if !last {
+		if _, err := d.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			d.err = err
+			return
+		}
+	}
+
+	if n < overhead {
+		d.err = io.ErrUnexpectedEOF
+		return
+	}
+
+	// Segments are opened in place, once the previous one was read
+	plain, err := d.aead.Open(d.sealed[:0], nonce(d.segment, last), d.sealed[:n], nil)
+	if err != nil {
+		// The ciphertext was truncated after a segment, or tampered with
+		d.err = ErrAuthentication
+		return
+	}
+
+	d.plain = plain
+	d.segment++
+	d.done = last
+}
@@ -0,0 +1,187 @@
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+)
+
+// segmentSize keeps the contents of the tests small.
+const segmentSize = 16
+
+func testKey(t *testing.T) *DataKey {
+	t.Helper()
+
+	k, err := NewDataKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.SegmentSize = segmentSize
+
+	return k
+}
+
+func randomContent(t *testing.T, size int) []byte {
+	t.Helper()
+
+	content := make([]byte, size)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+
+	return content
+}
+
+func encrypt(t *testing.T, k *DataKey, plaintext []byte) []byte {
+	t.Helper()
+
+	r, err := k.Encrypt(bytes.NewReader(plaintext))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ciphertext, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ciphertext
+}
+
+func decrypt(k *DataKey, ciphertext []byte, segment int64) ([]byte, error) {
+	r, err := k.Decrypt(bytes.NewReader(ciphertext), segment)
+	if err != nil {
+		return nil, err
+	}
+
+	return ioutil.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{"empty", 0},
+		{"one byte", 1},
+		{"partial segment", segmentSize - 1},
+		{"one segment", segmentSize},
+		{"one byte over a segment", segmentSize + 1},
+		{"whole segments", 3 * segmentSize},
+		{"partial last segment", 3*segmentSize + 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := testKey(t)
+			plaintext := randomContent(t, tt.size)
+			ciphertext := encrypt(t, k, plaintext)
+
+			if size := k.CiphertextSize(int64(tt.size)); int64(len(ciphertext)) != size {
+				t.Errorf("got %d bytes of ciphertext, CiphertextSize() = %d", len(ciphertext), size)
+			}
+
+			got, err := decrypt(k, ciphertext, 0)
+			if err != nil {
+				t.Fatalf("decrypt() error = %v", err)
+			}
+
+			if !bytes.Equal(got, plaintext) {
+				t.Errorf("decrypt() = %x, want %x", got, plaintext)
+			}
+		})
+	}
+}
+
+func TestDecryptFromOffset(t *testing.T) {
+	const size = 3*segmentSize + 5
+
+	k := testKey(t)
+	plaintext := randomContent(t, size)
+	ciphertext := encrypt(t, k, plaintext)
+
+	tests := []struct {
+		name   string
+		offset int64
+	}{
+		{"start", 0},
+		{"inside the first segment", 1},
+		{"end of the first segment", segmentSize - 1},
+		{"start of a segment", segmentSize},
+		{"inside a segment", 2*segmentSize + 3},
+		{"start of the last segment", 3 * segmentSize},
+		{"last byte", size - 1},
+		{"end", size},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			segment, cipherOffset, skip := k.Locate(tt.offset)
+
+			got, err := decrypt(k, ciphertext[cipherOffset:], segment)
+			if err != nil {
+				t.Fatalf("decrypt() error = %v", err)
+			}
+
+			if int64(len(got)) < skip {
+				t.Fatalf("decrypt() returned %d bytes, fewer than the %d to skip", len(got), skip)
+			}
+
+			if want := plaintext[tt.offset:]; !bytes.Equal(got[skip:], want) {
+				t.Errorf("decrypt() from %d = %x, want %x", tt.offset, got[skip:], want)
+			}
+		})
+	}
+}
+
+func TestDecryptDetectsTampering(t *testing.T) {
+	const sealedSize = segmentSize + overhead
+
+	k := testKey(t)
+	ciphertext := encrypt(t, k, randomContent(t, 3*segmentSize+5))
+
+	// segments returns the sealed segments of ciphertext at indexes
+	segments := func(indexes ...int) []byte {
+		var b []byte
+		for _, i := range indexes {
+			end := (i + 1) * sealedSize
+			if end > len(ciphertext) {
+				end = len(ciphertext)
+			}
+			b = append(b, ciphertext[i*sealedSize:end]...)
+		}
+		return b
+	}
+
+	flipped := append([]byte(nil), ciphertext...)
+	flipped[sealedSize+3] ^= 1
+
+	tests := []struct {
+		name       string
+		key        *DataKey
+		ciphertext []byte
+		segment    int64
+		err        error
+	}{
+		{"truncated after a segment", k, segments(0, 1, 2), 0, ErrAuthentication},
+		{"truncated inside a segment", k, ciphertext[:len(ciphertext)-3], 0, ErrAuthentication},
+		{"truncated to a partial tag", k, ciphertext[:2*sealedSize+overhead/2], 0, io.ErrUnexpectedEOF},
+		{"empty", k, nil, 0, io.ErrUnexpectedEOF},
+		{"dropped segment", k, segments(0, 2, 3), 0, ErrAuthentication},
+		{"reordered segments", k, segments(1, 0, 2, 3), 0, ErrAuthentication},
+		{"appended segment", k, segments(0, 1, 2, 3, 3), 0, ErrAuthentication},
+		{"modified byte", k, flipped, 0, ErrAuthentication},
+		{"wrong segment index", k, segments(1, 2, 3), 0, ErrAuthentication},
+		{"wrong key", testKey(t), ciphertext, 0, ErrAuthentication},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decrypt(tt.key, tt.ciphertext, tt.segment); err != tt.err {
+				t.Errorf("decrypt() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/user"
+)
+
+// AuditLog is the model entity for the AuditLog schema.
+type AuditLog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action auditlog.Action `json:"action,omitempty"`
+	// TokenID holds the value of the "token_id" field.
+	TokenID uuid.UUID `json:"token_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AuditLogQuery when eager-loading is set.
+	Edges           AuditLogEdges `json:"edges"`
+	user_audit_logs *int
+}
+
+// AuditLogEdges holds the relations/edges for other nodes in the graph.
+type AuditLogEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AuditLogEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditLog) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // action
+		&uuid.UUID{},      // token_id
+		&sql.NullString{}, // request_id
+		&sql.NullString{}, // ip
+		&sql.NullString{}, // user_agent
+		&[]byte{},         // metadata
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*AuditLog) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // user_audit_logs
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditLog fields.
+func (al *AuditLog) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(auditlog.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	al.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field action", values[0])
+	} else if value.Valid {
+		al.Action = auditlog.Action(value.String)
+	}
+	if value, ok := values[1].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field token_id", values[1])
+	} else if value != nil {
+		al.TokenID = *value
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field request_id", values[2])
+	} else if value.Valid {
+		al.RequestID = value.String
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field ip", values[3])
+	} else if value.Valid {
+		al.IP = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field user_agent", values[4])
+	} else if value.Valid {
+		al.UserAgent = value.String
+	}
+
+	if value, ok := values[5].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field metadata", values[5])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &al.Metadata); err != nil {
+			return fmt.Errorf("unmarshal field metadata: %v", err)
+		}
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[6])
+	} else if value.Valid {
+		al.CreatedAt = value.Time
+	}
+	values = values[7:]
+	if len(values) == len(auditlog.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_audit_logs", value)
+		} else if value.Valid {
+			al.user_audit_logs = new(int)
+			*al.user_audit_logs = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the AuditLog.
+func (al *AuditLog) QueryUser() *UserQuery {
+	return (&AuditLogClient{config: al.config}).QueryUser(al)
+}
+
+// Update returns a builder for updating this AuditLog.
+// Note that, you need to call AuditLog.Unwrap() before calling this method, if this AuditLog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (al *AuditLog) Update() *AuditLogUpdateOne {
+	return (&AuditLogClient{config: al.config}).UpdateOne(al)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (al *AuditLog) Unwrap() *AuditLog {
+	tx, ok := al.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AuditLog is not a transactional entity")
+	}
+	al.config.driver = tx.drv
+	return al
+}
+
+// String implements the fmt.Stringer.
+func (al *AuditLog) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditLog(")
+	builder.WriteString(fmt.Sprintf("id=%v", al.ID))
+	builder.WriteString(", action=")
+	builder.WriteString(fmt.Sprintf("%v", al.Action))
+	builder.WriteString(", token_id=")
+	builder.WriteString(fmt.Sprintf("%v", al.TokenID))
+	builder.WriteString(", request_id=")
+	builder.WriteString(al.RequestID)
+	builder.WriteString(", ip=")
+	builder.WriteString(al.IP)
+	builder.WriteString(", user_agent=")
+	builder.WriteString(al.UserAgent)
+	builder.WriteString(", metadata=")
+	builder.WriteString(fmt.Sprintf("%v", al.Metadata))
+	builder.WriteString(", created_at=")
+	builder.WriteString(al.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditLogs is a parsable slice of AuditLog.
+type AuditLogs []*AuditLog
+
+func (al AuditLogs) config(cfg config) {
+	for _i := range al {
+		al[_i].config = cfg
+	}
+}
@@ -0,0 +1,93 @@
+// github.com/sthorer/api
+
+package auditlog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+)
+
+const (
+	// Label holds the string label denoting the auditlog type in the database.
+	Label = "audit_log"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID        = "id"         // FieldAction holds the string denoting the action vertex property in the database.
+	FieldAction    = "action"     // FieldTokenID holds the string denoting the token_id vertex property in the database.
+	FieldTokenID   = "token_id"   // FieldRequestID holds the string denoting the request_id vertex property in the database.
+	FieldRequestID = "request_id" // FieldIP holds the string denoting the ip vertex property in the database.
+	FieldIP        = "ip"         // FieldUserAgent holds the string denoting the user_agent vertex property in the database.
+	FieldUserAgent = "user_agent" // FieldMetadata holds the string denoting the metadata vertex property in the database.
+	FieldMetadata  = "metadata"   // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+
+	// Table holds the table name of the auditlog in the database.
+	Table = "audit_logs"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "audit_logs"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_audit_logs"
+)
+
+// Columns holds all SQL columns for auditlog fields.
+var Columns = []string{
+	FieldID,
+	FieldAction,
+	FieldTokenID,
+	FieldRequestID,
+	FieldIP,
+	FieldUserAgent,
+	FieldMetadata,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the AuditLog type.
+var ForeignKeys = []string{
+	"user_audit_logs",
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/sthorer/api/ent/runtime"
+var (
+	Hooks [1]ent.Hook
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Action defines the type for the action enum field.
+type Action string
+
+// Action values.
+const (
+	ActionLogin       Action = "Login"
+	ActionRegister    Action = "Register"
+	ActionTokenCreate Action = "TokenCreate"
+	ActionTokenReset  Action = "TokenReset"
+	ActionTokenRevoke Action = "TokenRevoke"
+	ActionUpload      Action = "Upload"
+	ActionUnpin       Action = "Unpin"
+)
+
+func (s Action) String() string {
+	return string(s)
+}
+
+// ActionValidator is a validator for the "a" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionLogin, ActionRegister, ActionTokenCreate, ActionTokenReset, ActionTokenRevoke, ActionUpload, ActionUnpin:
+		return nil
+	default:
+		return fmt.Errorf("auditlog: invalid enum value for action field: %q", a)
+	}
+}
@@ -0,0 +1,262 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/user"
+)
+
+// AuditLogCreate is the builder for creating a AuditLog entity.
+type AuditLogCreate struct {
+	config
+	mutation *AuditLogMutation
+	hooks    []Hook
+}
+
+// SetAction sets the action field.
+func (alc *AuditLogCreate) SetAction(a auditlog.Action) *AuditLogCreate {
+	alc.mutation.SetAction(a)
+	return alc
+}
+
+// SetTokenID sets the token_id field.
+func (alc *AuditLogCreate) SetTokenID(u uuid.UUID) *AuditLogCreate {
+	alc.mutation.SetTokenID(u)
+	return alc
+}
+
+// SetRequestID sets the request_id field.
+func (alc *AuditLogCreate) SetRequestID(s string) *AuditLogCreate {
+	alc.mutation.SetRequestID(s)
+	return alc
+}
+
+// SetNillableRequestID sets the request_id field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableRequestID(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetRequestID(*s)
+	}
+	return alc
+}
+
+// SetIP sets the ip field.
+func (alc *AuditLogCreate) SetIP(s string) *AuditLogCreate {
+	alc.mutation.SetIP(s)
+	return alc
+}
+
+// SetNillableIP sets the ip field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableIP(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetIP(*s)
+	}
+	return alc
+}
+
+// SetUserAgent sets the user_agent field.
+func (alc *AuditLogCreate) SetUserAgent(s string) *AuditLogCreate {
+	alc.mutation.SetUserAgent(s)
+	return alc
+}
+
+// SetNillableUserAgent sets the user_agent field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserAgent(s *string) *AuditLogCreate {
+	if s != nil {
+		alc.SetUserAgent(*s)
+	}
+	return alc
+}
+
+// SetMetadata sets the metadata field.
+func (alc *AuditLogCreate) SetMetadata(m map[string]interface{}) *AuditLogCreate {
+	alc.mutation.SetMetadata(m)
+	return alc
+}
+
+// SetCreatedAt sets the created_at field.
+func (alc *AuditLogCreate) SetCreatedAt(t time.Time) *AuditLogCreate {
+	alc.mutation.SetCreatedAt(t)
+	return alc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableCreatedAt(t *time.Time) *AuditLogCreate {
+	if t != nil {
+		alc.SetCreatedAt(*t)
+	}
+	return alc
+}
+
+// SetUserID sets the user edge to User by id.
+func (alc *AuditLogCreate) SetUserID(id int) *AuditLogCreate {
+	alc.mutation.SetUserID(id)
+	return alc
+}
+
+// SetNillableUserID sets the user edge to User by id if the given value is not nil.
+func (alc *AuditLogCreate) SetNillableUserID(id *int) *AuditLogCreate {
+	if id != nil {
+		alc = alc.SetUserID(*id)
+	}
+	return alc
+}
+
+// SetUser sets the user edge to User.
+func (alc *AuditLogCreate) SetUser(u *User) *AuditLogCreate {
+	return alc.SetUserID(u.ID)
+}
+
+// Save creates the AuditLog in the database.
+func (alc *AuditLogCreate) Save(ctx context.Context) (*AuditLog, error) {
+	if _, ok := alc.mutation.Action(); !ok {
+		return nil, errors.New("ent: missing required field \"action\"")
+	}
+	if v, ok := alc.mutation.Action(); ok {
+		if err := auditlog.ActionValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"action\": %v", err)
+		}
+	}
+	if _, ok := alc.mutation.CreatedAt(); !ok {
+		v := auditlog.DefaultCreatedAt()
+		alc.mutation.SetCreatedAt(v)
+	}
+	var (
+		err  error
+		node *AuditLog
+	)
+	if len(alc.hooks) == 0 {
+		node, err = alc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			alc.mutation = mutation
+			node, err = alc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(alc.hooks) - 1; i >= 0; i-- {
+			mut = alc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (alc *AuditLogCreate) SaveX(ctx context.Context) *AuditLog {
+	v, err := alc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (alc *AuditLogCreate) sqlSave(ctx context.Context) (*AuditLog, error) {
+	var (
+		al    = &AuditLog{config: alc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: auditlog.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		}
+	)
+	if value, ok := alc.mutation.Action(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: auditlog.FieldAction,
+		})
+		al.Action = value
+	}
+	if value, ok := alc.mutation.TokenID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Value:  value,
+			Column: auditlog.FieldTokenID,
+		})
+		al.TokenID = value
+	}
+	if value, ok := alc.mutation.RequestID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldRequestID,
+		})
+		al.RequestID = value
+	}
+	if value, ok := alc.mutation.IP(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldIP,
+		})
+		al.IP = value
+	}
+	if value, ok := alc.mutation.UserAgent(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: auditlog.FieldUserAgent,
+		})
+		al.UserAgent = value
+	}
+	if value, ok := alc.mutation.Metadata(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: auditlog.FieldMetadata,
+		})
+		al.Metadata = value
+	}
+	if value, ok := alc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: auditlog.FieldCreatedAt,
+		})
+		al.CreatedAt = value
+	}
+	if nodes := alc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, alc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	al.ID = int(id)
+	return al, nil
+}
@@ -0,0 +1,377 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// AuditLogUpdate is the builder for updating AuditLog entities.
+type AuditLogUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *AuditLogMutation
+	predicates []predicate.AuditLog
+}
+
+// Where adds a new predicate for the builder.
+func (alu *AuditLogUpdate) Where(ps ...predicate.AuditLog) *AuditLogUpdate {
+	alu.predicates = append(alu.predicates, ps...)
+	return alu
+}
+
+// SetUserID sets the user edge to User by id.
+func (alu *AuditLogUpdate) SetUserID(id int) *AuditLogUpdate {
+	alu.mutation.SetUserID(id)
+	return alu
+}
+
+// SetNillableUserID sets the user edge to User by id if the given value is not nil.
+func (alu *AuditLogUpdate) SetNillableUserID(id *int) *AuditLogUpdate {
+	if id != nil {
+		alu = alu.SetUserID(*id)
+	}
+	return alu
+}
+
+// SetUser sets the user edge to User.
+func (alu *AuditLogUpdate) SetUser(u *User) *AuditLogUpdate {
+	return alu.SetUserID(u.ID)
+}
+
+// ClearUser clears the user edge to User.
+func (alu *AuditLogUpdate) ClearUser() *AuditLogUpdate {
+	alu.mutation.ClearUser()
+	return alu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (alu *AuditLogUpdate) Save(ctx context.Context) (int, error) {
+
+	var (
+		err      error
+		affected int
+	)
+	if len(alu.hooks) == 0 {
+		affected, err = alu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			alu.mutation = mutation
+			affected, err = alu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(alu.hooks) - 1; i >= 0; i-- {
+			mut = alu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, alu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (alu *AuditLogUpdate) SaveX(ctx context.Context) int {
+	affected, err := alu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (alu *AuditLogUpdate) Exec(ctx context.Context) error {
+	_, err := alu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (alu *AuditLogUpdate) ExecX(ctx context.Context) {
+	if err := alu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (alu *AuditLogUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	if ps := alu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if alu.mutation.TokenIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: auditlog.FieldTokenID,
+		})
+	}
+	if alu.mutation.RequestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if alu.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if alu.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldUserAgent,
+		})
+	}
+	if alu.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditlog.FieldMetadata,
+		})
+	}
+	if alu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := alu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, alu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// AuditLogUpdateOne is the builder for updating a single AuditLog entity.
+type AuditLogUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *AuditLogMutation
+}
+
+// SetUserID sets the user edge to User by id.
+func (aluo *AuditLogUpdateOne) SetUserID(id int) *AuditLogUpdateOne {
+	aluo.mutation.SetUserID(id)
+	return aluo
+}
+
+// SetNillableUserID sets the user edge to User by id if the given value is not nil.
+func (aluo *AuditLogUpdateOne) SetNillableUserID(id *int) *AuditLogUpdateOne {
+	if id != nil {
+		aluo = aluo.SetUserID(*id)
+	}
+	return aluo
+}
+
+// SetUser sets the user edge to User.
+func (aluo *AuditLogUpdateOne) SetUser(u *User) *AuditLogUpdateOne {
+	return aluo.SetUserID(u.ID)
+}
+
+// ClearUser clears the user edge to User.
+func (aluo *AuditLogUpdateOne) ClearUser() *AuditLogUpdateOne {
+	aluo.mutation.ClearUser()
+	return aluo
+}
+
+// Save executes the query and returns the updated entity.
+func (aluo *AuditLogUpdateOne) Save(ctx context.Context) (*AuditLog, error) {
+
+	var (
+		err  error
+		node *AuditLog
+	)
+	if len(aluo.hooks) == 0 {
+		node, err = aluo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*AuditLogMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			aluo.mutation = mutation
+			node, err = aluo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(aluo.hooks) - 1; i >= 0; i-- {
+			mut = aluo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, aluo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) SaveX(ctx context.Context) *AuditLog {
+	al, err := aluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return al
+}
+
+// Exec executes the query on the entity.
+func (aluo *AuditLogUpdateOne) Exec(ctx context.Context) error {
+	_, err := aluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aluo *AuditLogUpdateOne) ExecX(ctx context.Context) {
+	if err := aluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aluo *AuditLogUpdateOne) sqlSave(ctx context.Context) (al *AuditLog, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   auditlog.Table,
+			Columns: auditlog.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditlog.FieldID,
+			},
+		},
+	}
+	id, ok := aluo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing AuditLog.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if aluo.mutation.TokenIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeUUID,
+			Column: auditlog.FieldTokenID,
+		})
+	}
+	if aluo.mutation.RequestIDCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldRequestID,
+		})
+	}
+	if aluo.mutation.IPCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldIP,
+		})
+	}
+	if aluo.mutation.UserAgentCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: auditlog.FieldUserAgent,
+		})
+	}
+	if aluo.mutation.MetadataCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: auditlog.FieldMetadata,
+		})
+	}
+	if aluo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := aluo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   auditlog.UserTable,
+			Columns: []string{auditlog.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	al = &AuditLog{config: aluo.config}
+	_spec.Assign = al.assignValues
+	_spec.ScanValues = al.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, aluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditlog.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return al, nil
+}
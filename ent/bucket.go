@@ -0,0 +1,163 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/user"
+)
+
+// Bucket is the model entity for the Bucket schema.
+type Bucket struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BucketQuery when eager-loading is set.
+	Edges        BucketEdges `json:"edges"`
+	user_buckets *int
+}
+
+// BucketEdges holds the relations/edges for other nodes in the graph.
+type BucketEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// Files holds the value of the files edge.
+	Files []*File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BucketEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e BucketEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[1] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Bucket) scanValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},      // id
+		&sql.NullString{}, // name
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Bucket) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // user_buckets
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Bucket fields.
+func (b *Bucket) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(bucket.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	if value, ok := values[0].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field id", values[0])
+	} else if value != nil {
+		b.ID = *value
+	}
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		b.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[1])
+	} else if value.Valid {
+		b.CreatedAt = value.Time
+	}
+	values = values[2:]
+	if len(values) == len(bucket.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_buckets", value)
+		} else if value.Valid {
+			b.user_buckets = new(int)
+			*b.user_buckets = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the Bucket.
+func (b *Bucket) QueryUser() *UserQuery {
+	return (&BucketClient{config: b.config}).QueryUser(b)
+}
+
+// QueryFiles queries the files edge of the Bucket.
+func (b *Bucket) QueryFiles() *FileQuery {
+	return (&BucketClient{config: b.config}).QueryFiles(b)
+}
+
+// Update returns a builder for updating this Bucket.
+// Note that, you need to call Bucket.Unwrap() before calling this method, if this Bucket
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (b *Bucket) Update() *BucketUpdateOne {
+	return (&BucketClient{config: b.config}).UpdateOne(b)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (b *Bucket) Unwrap() *Bucket {
+	tx, ok := b.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Bucket is not a transactional entity")
+	}
+	b.config.driver = tx.drv
+	return b
+}
+
+// String implements the fmt.Stringer.
+func (b *Bucket) String() string {
+	var builder strings.Builder
+	builder.WriteString("Bucket(")
+	builder.WriteString(fmt.Sprintf("id=%v", b.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(b.Name)
+	builder.WriteString(", created_at=")
+	builder.WriteString(b.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Buckets is a parsable slice of Bucket.
+type Buckets []*Bucket
+
+func (b Buckets) config(cfg config) {
+	for _i := range b {
+		b[_i].config = cfg
+	}
+}
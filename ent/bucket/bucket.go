@@ -0,0 +1,57 @@
+// github.com/sthorer/api
+
+package bucket
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the bucket type in the database.
+	Label = "bucket"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID        = "id"   // FieldName holds the string denoting the name vertex property in the database.
+	FieldName      = "name" // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
+
+	// Table holds the table name of the bucket in the database.
+	Table = "buckets"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "buckets"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_buckets"
+	// FilesTable is the table the holds the files relation/edge.
+	FilesTable = "files"
+	// FilesInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FilesInverseTable = "files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "bucket_files"
+)
+
+// Columns holds all SQL columns for bucket fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Bucket type.
+var ForeignKeys = []string{
+	"user_buckets",
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
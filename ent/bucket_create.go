@@ -0,0 +1,206 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/user"
+)
+
+// BucketCreate is the builder for creating a Bucket entity.
+type BucketCreate struct {
+	config
+	mutation *BucketMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (bc *BucketCreate) SetName(s string) *BucketCreate {
+	bc.mutation.SetName(s)
+	return bc
+}
+
+// SetCreatedAt sets the created_at field.
+func (bc *BucketCreate) SetCreatedAt(t time.Time) *BucketCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (bc *BucketCreate) SetNillableCreatedAt(t *time.Time) *BucketCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the id field.
+func (bc *BucketCreate) SetID(u uuid.UUID) *BucketCreate {
+	bc.mutation.SetID(u)
+	return bc
+}
+
+// SetUserID sets the user edge to User by id.
+func (bc *BucketCreate) SetUserID(id int) *BucketCreate {
+	bc.mutation.SetUserID(id)
+	return bc
+}
+
+// SetUser sets the user edge to User.
+func (bc *BucketCreate) SetUser(u *User) *BucketCreate {
+	return bc.SetUserID(u.ID)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (bc *BucketCreate) AddFileIDs(ids ...uuid.UUID) *BucketCreate {
+	bc.mutation.AddFileIDs(ids...)
+	return bc
+}
+
+// AddFiles adds the files edges to File.
+func (bc *BucketCreate) AddFiles(f ...*File) *BucketCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bc.AddFileIDs(ids...)
+}
+
+// Save creates the Bucket in the database.
+func (bc *BucketCreate) Save(ctx context.Context) (*Bucket, error) {
+	if _, ok := bc.mutation.Name(); !ok {
+		return nil, errors.New("ent: missing required field \"name\"")
+	}
+	if v, ok := bc.mutation.Name(); ok {
+		if err := bucket.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := bucket.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := bc.mutation.UserID(); !ok {
+		return nil, errors.New("ent: missing required edge \"user\"")
+	}
+	var (
+		err  error
+		node *Bucket
+	)
+	if len(bc.hooks) == 0 {
+		node, err = bc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BucketMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bc.mutation = mutation
+			node, err = bc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(bc.hooks) - 1; i >= 0; i-- {
+			mut = bc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BucketCreate) SaveX(ctx context.Context) *Bucket {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bc *BucketCreate) sqlSave(ctx context.Context) (*Bucket, error) {
+	var (
+		b     = &Bucket{config: bc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: bucket.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: bucket.FieldID,
+			},
+		}
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		b.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: bucket.FieldName,
+		})
+		b.Name = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: bucket.FieldCreatedAt,
+		})
+		b.CreatedAt = value
+	}
+	if nodes := bc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucket.UserTable,
+			Columns: []string{bucket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := bc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bucket.FilesTable,
+			Columns: []string{bucket.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return b, nil
+}
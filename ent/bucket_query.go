@@ -0,0 +1,793 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// BucketQuery is the builder for querying Bucket entities.
+type BucketQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.Bucket
+	// eager-loading edges.
+	withUser  *UserQuery
+	withFiles *FileQuery
+	withFKs   bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (bq *BucketQuery) Where(ps ...predicate.Bucket) *BucketQuery {
+	bq.predicates = append(bq.predicates, ps...)
+	return bq
+}
+
+// Limit adds a limit step to the query.
+func (bq *BucketQuery) Limit(limit int) *BucketQuery {
+	bq.limit = &limit
+	return bq
+}
+
+// Offset adds an offset step to the query.
+func (bq *BucketQuery) Offset(offset int) *BucketQuery {
+	bq.offset = &offset
+	return bq
+}
+
+// Order adds an order step to the query.
+func (bq *BucketQuery) Order(o ...Order) *BucketQuery {
+	bq.order = append(bq.order, o...)
+	return bq
+}
+
+// QueryUser chains the current query on the user edge.
+func (bq *BucketQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bucket.Table, bucket.FieldID, bq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bucket.UserTable, bucket.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFiles chains the current query on the files edge.
+func (bq *BucketQuery) QueryFiles() *FileQuery {
+	query := &FileQuery{config: bq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bucket.Table, bucket.FieldID, bq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bucket.FilesTable, bucket.FilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(bq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Bucket entity in the query. Returns *NotFoundError when no bucket was found.
+func (bq *BucketQuery) First(ctx context.Context) (*Bucket, error) {
+	bs, err := bq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(bs) == 0 {
+		return nil, &NotFoundError{bucket.Label}
+	}
+	return bs[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (bq *BucketQuery) FirstX(ctx context.Context) *Bucket {
+	b, err := bq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return b
+}
+
+// FirstID returns the first Bucket id in the query. Returns *NotFoundError when no id was found.
+func (bq *BucketQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{bucket.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (bq *BucketQuery) FirstXID(ctx context.Context) uuid.UUID {
+	id, err := bq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Bucket entity in the query, returns an error if not exactly one entity was returned.
+func (bq *BucketQuery) Only(ctx context.Context) (*Bucket, error) {
+	bs, err := bq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(bs) {
+	case 1:
+		return bs[0], nil
+	case 0:
+		return nil, &NotFoundError{bucket.Label}
+	default:
+		return nil, &NotSingularError{bucket.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (bq *BucketQuery) OnlyX(ctx context.Context) *Bucket {
+	b, err := bq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// OnlyID returns the only Bucket id in the query, returns an error if not exactly one id was returned.
+func (bq *BucketQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = bq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{bucket.Label}
+	default:
+		err = &NotSingularError{bucket.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (bq *BucketQuery) OnlyXID(ctx context.Context) uuid.UUID {
+	id, err := bq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Buckets.
+func (bq *BucketQuery) All(ctx context.Context) ([]*Bucket, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return bq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (bq *BucketQuery) AllX(ctx context.Context) []*Bucket {
+	bs, err := bq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return bs
+}
+
+// IDs executes the query and returns a list of Bucket ids.
+func (bq *BucketQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := bq.Select(bucket.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (bq *BucketQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := bq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (bq *BucketQuery) Count(ctx context.Context) (int, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return bq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (bq *BucketQuery) CountX(ctx context.Context) int {
+	count, err := bq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (bq *BucketQuery) Exist(ctx context.Context) (bool, error) {
+	if err := bq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return bq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (bq *BucketQuery) ExistX(ctx context.Context) bool {
+	exist, err := bq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (bq *BucketQuery) Clone() *BucketQuery {
+	return &BucketQuery{
+		config:     bq.config,
+		limit:      bq.limit,
+		offset:     bq.offset,
+		order:      append([]Order{}, bq.order...),
+		unique:     append([]string{}, bq.unique...),
+		predicates: append([]predicate.Bucket{}, bq.predicates...),
+		// clone intermediate query.
+		sql:  bq.sql.Clone(),
+		path: bq.path,
+	}
+}
+
+//  WithUser tells the query-builder to eager-loads the nodes that are connected to
+// the "user" edge. The optional arguments used to configure the query builder of the edge.
+func (bq *BucketQuery) WithUser(opts ...func(*UserQuery)) *BucketQuery {
+	query := &UserQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withUser = query
+	return bq
+}
+
+//  WithFiles tells the query-builder to eager-loads the nodes that are connected to
+// the "files" edge. The optional arguments used to configure the query builder of the edge.
+func (bq *BucketQuery) WithFiles(opts ...func(*FileQuery)) *BucketQuery {
+	query := &FileQuery{config: bq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	bq.withFiles = query
+	return bq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Bucket.Query().
+//		GroupBy(bucket.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (bq *BucketQuery) GroupBy(field string, fields ...string) *BucketGroupBy {
+	group := &BucketGroupBy{config: bq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Bucket.Query().
+//		Select(bucket.FieldName).
+//		Scan(ctx, &v)
+//
+func (bq *BucketQuery) Select(field string, fields ...string) *BucketSelect {
+	selector := &BucketSelect{config: bq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := bq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return bq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (bq *BucketQuery) prepareQuery(ctx context.Context) error {
+	if bq.path != nil {
+		prev, err := bq.path(ctx)
+		if err != nil {
+			return err
+		}
+		bq.sql = prev
+	}
+	return nil
+}
+
+func (bq *BucketQuery) sqlAll(ctx context.Context) ([]*Bucket, error) {
+	var (
+		nodes       = []*Bucket{}
+		withFKs     = bq.withFKs
+		_spec       = bq.querySpec()
+		loadedTypes = [2]bool{
+			bq.withUser != nil,
+			bq.withFiles != nil,
+		}
+	)
+	if bq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, bucket.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Bucket{config: bq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, bq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := bq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Bucket)
+		for i := range nodes {
+			if fk := nodes[i].user_buckets; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_buckets" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := bq.withFiles; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Bucket)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.File(func(s *sql.Selector) {
+			s.Where(sql.InValues(bucket.FilesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.bucket_files
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "bucket_files" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bucket_files" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Files = append(node.Edges.Files, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (bq *BucketQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := bq.querySpec()
+	return sqlgraph.CountNodes(ctx, bq.driver, _spec)
+}
+
+func (bq *BucketQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := bq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (bq *BucketQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bucket.Table,
+			Columns: bucket.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: bucket.FieldID,
+			},
+		},
+		From:   bq.sql,
+		Unique: true,
+	}
+	if ps := bq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := bq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := bq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := bq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (bq *BucketQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(bq.driver.Dialect())
+	t1 := builder.Table(bucket.Table)
+	selector := builder.Select(t1.Columns(bucket.Columns...)...).From(t1)
+	if bq.sql != nil {
+		selector = bq.sql
+		selector.Select(selector.Columns(bucket.Columns...)...)
+	}
+	for _, p := range bq.predicates {
+		p(selector)
+	}
+	for _, p := range bq.order {
+		p(selector)
+	}
+	if offset := bq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := bq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// BucketGroupBy is the builder for group-by Bucket entities.
+type BucketGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (bgb *BucketGroupBy) Aggregate(fns ...Aggregate) *BucketGroupBy {
+	bgb.fns = append(bgb.fns, fns...)
+	return bgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (bgb *BucketGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := bgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	bgb.sql = query
+	return bgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bgb *BucketGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := bgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BucketGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BucketGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bgb *BucketGroupBy) StringsX(ctx context.Context) []string {
+	v, err := bgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BucketGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BucketGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bgb *BucketGroupBy) IntsX(ctx context.Context) []int {
+	v, err := bgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BucketGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BucketGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bgb *BucketGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := bgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (bgb *BucketGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(bgb.fields) > 1 {
+		return nil, errors.New("ent: BucketGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := bgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bgb *BucketGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := bgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bgb *BucketGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bgb.sqlQuery().Query()
+	if err := bgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bgb *BucketGroupBy) sqlQuery() *sql.Selector {
+	selector := bgb.sql
+	columns := make([]string, 0, len(bgb.fields)+len(bgb.fns))
+	columns = append(columns, bgb.fields...)
+	for _, fn := range bgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(bgb.fields...)
+}
+
+// BucketSelect is the builder for select fields of Bucket entities.
+type BucketSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (bs *BucketSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := bs.path(ctx)
+	if err != nil {
+		return err
+	}
+	bs.sql = query
+	return bs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (bs *BucketSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := bs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (bs *BucketSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BucketSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (bs *BucketSelect) StringsX(ctx context.Context) []string {
+	v, err := bs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (bs *BucketSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BucketSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (bs *BucketSelect) IntsX(ctx context.Context) []int {
+	v, err := bs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (bs *BucketSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BucketSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (bs *BucketSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := bs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (bs *BucketSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(bs.fields) > 1 {
+		return nil, errors.New("ent: BucketSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := bs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (bs *BucketSelect) BoolsX(ctx context.Context) []bool {
+	v, err := bs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (bs *BucketSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := bs.sqlQuery().Query()
+	if err := bs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (bs *BucketSelect) sqlQuery() sql.Querier {
+	selector := bs.sql
+	selector.Select(selector.Columns(bs.fields...)...)
+	return selector
+}
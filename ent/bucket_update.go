@@ -0,0 +1,448 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// BucketUpdate is the builder for updating Bucket entities.
+type BucketUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *BucketMutation
+	predicates []predicate.Bucket
+}
+
+// Where adds a new predicate for the builder.
+func (bu *BucketUpdate) Where(ps ...predicate.Bucket) *BucketUpdate {
+	bu.predicates = append(bu.predicates, ps...)
+	return bu
+}
+
+// SetUserID sets the user edge to User by id.
+func (bu *BucketUpdate) SetUserID(id int) *BucketUpdate {
+	bu.mutation.SetUserID(id)
+	return bu
+}
+
+// SetUser sets the user edge to User.
+func (bu *BucketUpdate) SetUser(u *User) *BucketUpdate {
+	return bu.SetUserID(u.ID)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (bu *BucketUpdate) AddFileIDs(ids ...uuid.UUID) *BucketUpdate {
+	bu.mutation.AddFileIDs(ids...)
+	return bu
+}
+
+// AddFiles adds the files edges to File.
+func (bu *BucketUpdate) AddFiles(f ...*File) *BucketUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bu.AddFileIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (bu *BucketUpdate) ClearUser() *BucketUpdate {
+	bu.mutation.ClearUser()
+	return bu
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (bu *BucketUpdate) RemoveFileIDs(ids ...uuid.UUID) *BucketUpdate {
+	bu.mutation.RemoveFileIDs(ids...)
+	return bu
+}
+
+// RemoveFiles removes files edges to File.
+func (bu *BucketUpdate) RemoveFiles(f ...*File) *BucketUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return bu.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (bu *BucketUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := bu.mutation.UserID(); bu.mutation.UserCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(bu.hooks) == 0 {
+		affected, err = bu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BucketMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			bu.mutation = mutation
+			affected, err = bu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(bu.hooks) - 1; i >= 0; i-- {
+			mut = bu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, bu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BucketUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BucketUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BucketUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (bu *BucketUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bucket.Table,
+			Columns: bucket.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: bucket.FieldID,
+			},
+		},
+	}
+	if ps := bu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if bu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucket.UserTable,
+			Columns: []string{bucket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucket.UserTable,
+			Columns: []string{bucket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := bu.mutation.RemovedFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bucket.FilesTable,
+			Columns: []string{bucket.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := bu.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bucket.FilesTable,
+			Columns: []string{bucket.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bucket.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// BucketUpdateOne is the builder for updating a single Bucket entity.
+type BucketUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *BucketMutation
+}
+
+// SetUserID sets the user edge to User by id.
+func (buo *BucketUpdateOne) SetUserID(id int) *BucketUpdateOne {
+	buo.mutation.SetUserID(id)
+	return buo
+}
+
+// SetUser sets the user edge to User.
+func (buo *BucketUpdateOne) SetUser(u *User) *BucketUpdateOne {
+	return buo.SetUserID(u.ID)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (buo *BucketUpdateOne) AddFileIDs(ids ...uuid.UUID) *BucketUpdateOne {
+	buo.mutation.AddFileIDs(ids...)
+	return buo
+}
+
+// AddFiles adds the files edges to File.
+func (buo *BucketUpdateOne) AddFiles(f ...*File) *BucketUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return buo.AddFileIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (buo *BucketUpdateOne) ClearUser() *BucketUpdateOne {
+	buo.mutation.ClearUser()
+	return buo
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (buo *BucketUpdateOne) RemoveFileIDs(ids ...uuid.UUID) *BucketUpdateOne {
+	buo.mutation.RemoveFileIDs(ids...)
+	return buo
+}
+
+// RemoveFiles removes files edges to File.
+func (buo *BucketUpdateOne) RemoveFiles(f ...*File) *BucketUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return buo.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (buo *BucketUpdateOne) Save(ctx context.Context) (*Bucket, error) {
+
+	if _, ok := buo.mutation.UserID(); buo.mutation.UserCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err  error
+		node *Bucket
+	)
+	if len(buo.hooks) == 0 {
+		node, err = buo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*BucketMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			buo.mutation = mutation
+			node, err = buo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(buo.hooks) - 1; i >= 0; i-- {
+			mut = buo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, buo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BucketUpdateOne) SaveX(ctx context.Context) *Bucket {
+	b, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// Exec executes the query on the entity.
+func (buo *BucketUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BucketUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (buo *BucketUpdateOne) sqlSave(ctx context.Context) (b *Bucket, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   bucket.Table,
+			Columns: bucket.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: bucket.FieldID,
+			},
+		},
+	}
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Bucket.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if buo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucket.UserTable,
+			Columns: []string{bucket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bucket.UserTable,
+			Columns: []string{bucket.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := buo.mutation.RemovedFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bucket.FilesTable,
+			Columns: []string{bucket.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := buo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bucket.FilesTable,
+			Columns: []string{bucket.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	b = &Bucket{config: buo.config}
+	_spec.Assign = b.assignValues
+	_spec.ScanValues = b.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bucket.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return b, nil
+}
@@ -10,9 +10,20 @@ import (
 	"github.com/google/uuid"
 	"github.com/sthorer/api/ent/migrate"
 
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
 
 	"github.com/facebookincubator/ent/dialect"
 	"github.com/facebookincubator/ent/dialect/sql"
@@ -24,12 +35,34 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Bucket is the client for interacting with the Bucket builders.
+	Bucket *BucketClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileKey is the client for interacting with the FileKey builders.
+	FileKey *FileKeyClient
+	// FileMedia is the client for interacting with the FileMedia builders.
+	FileMedia *FileMediaClient
+	// FileProperty is the client for interacting with the FileProperty builders.
+	FileProperty *FilePropertyClient
+	// FileTag is the client for interacting with the FileTag builders.
+	FileTag *FileTagClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
+	// Thumbnail is the client for interacting with the Thumbnail builders.
+	Thumbnail *ThumbnailClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -43,9 +76,20 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditLog = NewAuditLogClient(c.config)
+	c.Bucket = NewBucketClient(c.config)
 	c.File = NewFileClient(c.config)
+	c.FileKey = NewFileKeyClient(c.config)
+	c.FileMedia = NewFileMediaClient(c.config)
+	c.FileProperty = NewFilePropertyClient(c.config)
+	c.FileTag = NewFileTagClient(c.config)
+	c.Folder = NewFolderClient(c.config)
+	c.Share = NewShareClient(c.config)
+	c.Thumbnail = NewThumbnailClient(c.config)
 	c.Token = NewTokenClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.Webhook = NewWebhookClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
 }
 
 // Open opens a connection to the database specified by the driver name and a
@@ -75,10 +119,21 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	}
 	cfg := config{driver: tx, log: c.log, debug: c.debug, hooks: c.hooks}
 	return &Tx{
-		config: cfg,
-		File:   NewFileClient(cfg),
-		Token:  NewTokenClient(cfg),
-		User:   NewUserClient(cfg),
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		Bucket:          NewBucketClient(cfg),
+		File:            NewFileClient(cfg),
+		FileKey:         NewFileKeyClient(cfg),
+		FileMedia:       NewFileMediaClient(cfg),
+		FileProperty:    NewFilePropertyClient(cfg),
+		FileTag:         NewFileTagClient(cfg),
+		Folder:          NewFolderClient(cfg),
+		Share:           NewShareClient(cfg),
+		Thumbnail:       NewThumbnailClient(cfg),
+		Token:           NewTokenClient(cfg),
+		User:            NewUserClient(cfg),
+		Webhook:         NewWebhookClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
@@ -93,17 +148,28 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}
 	cfg := config{driver: &txDriver{tx: tx, drv: c.driver}, log: c.log, debug: c.debug, hooks: c.hooks}
 	return &Tx{
-		config: cfg,
-		File:   NewFileClient(cfg),
-		Token:  NewTokenClient(cfg),
-		User:   NewUserClient(cfg),
+		config:          cfg,
+		AuditLog:        NewAuditLogClient(cfg),
+		Bucket:          NewBucketClient(cfg),
+		File:            NewFileClient(cfg),
+		FileKey:         NewFileKeyClient(cfg),
+		FileMedia:       NewFileMediaClient(cfg),
+		FileProperty:    NewFilePropertyClient(cfg),
+		FileTag:         NewFileTagClient(cfg),
+		Folder:          NewFolderClient(cfg),
+		Share:           NewShareClient(cfg),
+		Thumbnail:       NewThumbnailClient(cfg),
+		Token:           NewTokenClient(cfg),
+		User:            NewUserClient(cfg),
+		Webhook:         NewWebhookClient(cfg),
+		WebhookDelivery: NewWebhookDeliveryClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		File.
+//		AuditLog.
 //		Query().
 //		Count(ctx)
 //
@@ -125,9 +191,235 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.AuditLog.Use(hooks...)
+	c.Bucket.Use(hooks...)
 	c.File.Use(hooks...)
+	c.FileKey.Use(hooks...)
+	c.FileMedia.Use(hooks...)
+	c.FileProperty.Use(hooks...)
+	c.FileTag.Use(hooks...)
+	c.Folder.Use(hooks...)
+	c.Share.Use(hooks...)
+	c.Thumbnail.Use(hooks...)
 	c.Token.Use(hooks...)
 	c.User.Use(hooks...)
+	c.Webhook.Use(hooks...)
+	c.WebhookDelivery.Use(hooks...)
+}
+
+// AuditLogClient is a client for the AuditLog schema.
+type AuditLogClient struct {
+	config
+}
+
+// NewAuditLogClient returns a client for the AuditLog from the given config.
+func NewAuditLogClient(c config) *AuditLogClient {
+	return &AuditLogClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditlog.Hooks(f(g(h())))`.
+func (c *AuditLogClient) Use(hooks ...Hook) {
+	c.hooks.AuditLog = append(c.hooks.AuditLog, hooks...)
+}
+
+// Create returns a create builder for AuditLog.
+func (c *AuditLogClient) Create() *AuditLogCreate {
+	mutation := newAuditLogMutation(c.config, OpCreate)
+	return &AuditLogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for AuditLog.
+func (c *AuditLogClient) Update() *AuditLogUpdate {
+	mutation := newAuditLogMutation(c.config, OpUpdate)
+	return &AuditLogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditLogClient) UpdateOne(al *AuditLog) *AuditLogUpdateOne {
+	return c.UpdateOneID(al.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditLogClient) UpdateOneID(id int) *AuditLogUpdateOne {
+	mutation := newAuditLogMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &AuditLogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditLog.
+func (c *AuditLogClient) Delete() *AuditLogDelete {
+	mutation := newAuditLogMutation(c.config, OpDelete)
+	return &AuditLogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *AuditLogClient) DeleteOne(al *AuditLog) *AuditLogDeleteOne {
+	return c.DeleteOneID(al.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *AuditLogClient) DeleteOneID(id int) *AuditLogDeleteOne {
+	builder := c.Delete().Where(auditlog.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditLogDeleteOne{builder}
+}
+
+// Create returns a query builder for AuditLog.
+func (c *AuditLogClient) Query() *AuditLogQuery {
+	return &AuditLogQuery{config: c.config}
+}
+
+// Get returns a AuditLog entity by its id.
+func (c *AuditLogClient) Get(ctx context.Context, id int) (*AuditLog, error) {
+	return c.Query().Where(auditlog.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditLogClient) GetX(ctx context.Context, id int) *AuditLog {
+	al, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return al
+}
+
+// QueryUser queries the user edge of a AuditLog.
+func (c *AuditLogClient) QueryUser(al *AuditLog) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := al.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(auditlog.Table, auditlog.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditlog.UserTable, auditlog.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(al.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AuditLogClient) Hooks() []Hook {
+	hooks := c.hooks.AuditLog
+	return append(hooks[:len(hooks):len(hooks)], auditlog.Hooks[:]...)
+}
+
+// BucketClient is a client for the Bucket schema.
+type BucketClient struct {
+	config
+}
+
+// NewBucketClient returns a client for the Bucket from the given config.
+func NewBucketClient(c config) *BucketClient {
+	return &BucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bucket.Hooks(f(g(h())))`.
+func (c *BucketClient) Use(hooks ...Hook) {
+	c.hooks.Bucket = append(c.hooks.Bucket, hooks...)
+}
+
+// Create returns a create builder for Bucket.
+func (c *BucketClient) Create() *BucketCreate {
+	mutation := newBucketMutation(c.config, OpCreate)
+	return &BucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for Bucket.
+func (c *BucketClient) Update() *BucketUpdate {
+	mutation := newBucketMutation(c.config, OpUpdate)
+	return &BucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BucketClient) UpdateOne(b *Bucket) *BucketUpdateOne {
+	return c.UpdateOneID(b.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BucketClient) UpdateOneID(id uuid.UUID) *BucketUpdateOne {
+	mutation := newBucketMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &BucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Bucket.
+func (c *BucketClient) Delete() *BucketDelete {
+	mutation := newBucketMutation(c.config, OpDelete)
+	return &BucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *BucketClient) DeleteOne(b *Bucket) *BucketDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *BucketClient) DeleteOneID(id uuid.UUID) *BucketDeleteOne {
+	builder := c.Delete().Where(bucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BucketDeleteOne{builder}
+}
+
+// Create returns a query builder for Bucket.
+func (c *BucketClient) Query() *BucketQuery {
+	return &BucketQuery{config: c.config}
+}
+
+// Get returns a Bucket entity by its id.
+func (c *BucketClient) Get(ctx context.Context, id uuid.UUID) (*Bucket, error) {
+	return c.Query().Where(bucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BucketClient) GetX(ctx context.Context, id uuid.UUID) *Bucket {
+	b, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+// QueryUser queries the user edge of a Bucket.
+func (c *BucketClient) QueryUser(b *Bucket) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bucket.Table, bucket.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bucket.UserTable, bucket.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Bucket.
+func (c *BucketClient) QueryFiles(b *Bucket) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := b.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bucket.Table, bucket.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bucket.FilesTable, bucket.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(b.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BucketClient) Hooks() []Hook {
+	return c.hooks.Bucket
 }
 
 // FileClient is a client for the File schema.
@@ -135,98 +427,983 @@ type FileClient struct {
 	config
 }
 
-// NewFileClient returns a client for the File from the given config.
-func NewFileClient(c config) *FileClient {
-	return &FileClient{config: c}
+// NewFileClient returns a client for the File from the given config.
+func NewFileClient(c config) *FileClient {
+	return &FileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `file.Hooks(f(g(h())))`.
+func (c *FileClient) Use(hooks ...Hook) {
+	c.hooks.File = append(c.hooks.File, hooks...)
+}
+
+// Create returns a create builder for File.
+func (c *FileClient) Create() *FileCreate {
+	mutation := newFileMutation(c.config, OpCreate)
+	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for File.
+func (c *FileClient) Update() *FileUpdate {
+	mutation := newFileMutation(c.config, OpUpdate)
+	return &FileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileClient) UpdateOne(f *File) *FileUpdateOne {
+	return c.UpdateOneID(f.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileClient) UpdateOneID(id uuid.UUID) *FileUpdateOne {
+	mutation := newFileMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for File.
+func (c *FileClient) Delete() *FileDelete {
+	mutation := newFileMutation(c.config, OpDelete)
+	return &FileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FileClient) DeleteOne(f *File) *FileDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FileClient) DeleteOneID(id uuid.UUID) *FileDeleteOne {
+	builder := c.Delete().Where(file.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileDeleteOne{builder}
+}
+
+// Create returns a query builder for File.
+func (c *FileClient) Query() *FileQuery {
+	return &FileQuery{config: c.config}
+}
+
+// Get returns a File entity by its id.
+func (c *FileClient) Get(ctx context.Context, id uuid.UUID) (*File, error) {
+	return c.Query().Where(file.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileClient) GetX(ctx context.Context, id uuid.UUID) *File {
+	f, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// QueryUser queries the user edge of a File.
+func (c *FileClient) QueryUser(f *File) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.UserTable, file.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBucket queries the bucket edge of a File.
+func (c *FileClient) QueryBucket(f *File) *BucketQuery {
+	query := &BucketQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(bucket.Table, bucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.BucketTable, file.BucketColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolder queries the folder edge of a File.
+func (c *FileClient) QueryFolder(f *File) *FolderQuery {
+	query := &FolderQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.FolderTable, file.FolderColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIndexedTags queries the indexed_tags edge of a File.
+func (c *FileClient) QueryIndexedTags(f *File) *FileTagQuery {
+	query := &FileTagQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(filetag.Table, filetag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.IndexedTagsTable, file.IndexedTagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIndexedProperties queries the indexed_properties edge of a File.
+func (c *FileClient) QueryIndexedProperties(f *File) *FilePropertyQuery {
+	query := &FilePropertyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(fileproperty.Table, fileproperty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.IndexedPropertiesTable, file.IndexedPropertiesColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryIndexedMedia queries the indexed_media edge of a File.
+func (c *FileClient) QueryIndexedMedia(f *File) *FileMediaQuery {
+	query := &FileMediaQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(filemedia.Table, filemedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, file.IndexedMediaTable, file.IndexedMediaColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryThumbnails queries the thumbnails edge of a File.
+func (c *FileClient) QueryThumbnails(f *File) *ThumbnailQuery {
+	query := &ThumbnailQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(thumbnail.Table, thumbnail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.ThumbnailsTable, file.ThumbnailsColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShares queries the shares edge of a File.
+func (c *FileClient) QueryShares(f *File) *ShareQuery {
+	query := &ShareQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.SharesTable, file.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryEncryptionKey queries the encryption_key edge of a File.
+func (c *FileClient) QueryEncryptionKey(f *File) *FileKeyQuery {
+	query := &FileKeyQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, id),
+			sqlgraph.To(filekey.Table, filekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, file.EncryptionKeyTable, file.EncryptionKeyColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileClient) Hooks() []Hook {
+	return c.hooks.File
+}
+
+// FileKeyClient is a client for the FileKey schema.
+type FileKeyClient struct {
+	config
+}
+
+// NewFileKeyClient returns a client for the FileKey from the given config.
+func NewFileKeyClient(c config) *FileKeyClient {
+	return &FileKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filekey.Hooks(f(g(h())))`.
+func (c *FileKeyClient) Use(hooks ...Hook) {
+	c.hooks.FileKey = append(c.hooks.FileKey, hooks...)
+}
+
+// Create returns a create builder for FileKey.
+func (c *FileKeyClient) Create() *FileKeyCreate {
+	mutation := newFileKeyMutation(c.config, OpCreate)
+	return &FileKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for FileKey.
+func (c *FileKeyClient) Update() *FileKeyUpdate {
+	mutation := newFileKeyMutation(c.config, OpUpdate)
+	return &FileKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileKeyClient) UpdateOne(fk *FileKey) *FileKeyUpdateOne {
+	return c.UpdateOneID(fk.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileKeyClient) UpdateOneID(id int) *FileKeyUpdateOne {
+	mutation := newFileKeyMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FileKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileKey.
+func (c *FileKeyClient) Delete() *FileKeyDelete {
+	mutation := newFileKeyMutation(c.config, OpDelete)
+	return &FileKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FileKeyClient) DeleteOne(fk *FileKey) *FileKeyDeleteOne {
+	return c.DeleteOneID(fk.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FileKeyClient) DeleteOneID(id int) *FileKeyDeleteOne {
+	builder := c.Delete().Where(filekey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileKeyDeleteOne{builder}
+}
+
+// Create returns a query builder for FileKey.
+func (c *FileKeyClient) Query() *FileKeyQuery {
+	return &FileKeyQuery{config: c.config}
+}
+
+// Get returns a FileKey entity by its id.
+func (c *FileKeyClient) Get(ctx context.Context, id int) (*FileKey, error) {
+	return c.Query().Where(filekey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileKeyClient) GetX(ctx context.Context, id int) *FileKey {
+	fk, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return fk
+}
+
+// QueryFile queries the file edge of a FileKey.
+func (c *FileKeyClient) QueryFile(fk *FileKey) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := fk.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filekey.Table, filekey.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, filekey.FileTable, filekey.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(fk.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileKeyClient) Hooks() []Hook {
+	return c.hooks.FileKey
+}
+
+// FileMediaClient is a client for the FileMedia schema.
+type FileMediaClient struct {
+	config
+}
+
+// NewFileMediaClient returns a client for the FileMedia from the given config.
+func NewFileMediaClient(c config) *FileMediaClient {
+	return &FileMediaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filemedia.Hooks(f(g(h())))`.
+func (c *FileMediaClient) Use(hooks ...Hook) {
+	c.hooks.FileMedia = append(c.hooks.FileMedia, hooks...)
+}
+
+// Create returns a create builder for FileMedia.
+func (c *FileMediaClient) Create() *FileMediaCreate {
+	mutation := newFileMediaMutation(c.config, OpCreate)
+	return &FileMediaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for FileMedia.
+func (c *FileMediaClient) Update() *FileMediaUpdate {
+	mutation := newFileMediaMutation(c.config, OpUpdate)
+	return &FileMediaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileMediaClient) UpdateOne(fm *FileMedia) *FileMediaUpdateOne {
+	return c.UpdateOneID(fm.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileMediaClient) UpdateOneID(id int) *FileMediaUpdateOne {
+	mutation := newFileMediaMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FileMediaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileMedia.
+func (c *FileMediaClient) Delete() *FileMediaDelete {
+	mutation := newFileMediaMutation(c.config, OpDelete)
+	return &FileMediaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FileMediaClient) DeleteOne(fm *FileMedia) *FileMediaDeleteOne {
+	return c.DeleteOneID(fm.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FileMediaClient) DeleteOneID(id int) *FileMediaDeleteOne {
+	builder := c.Delete().Where(filemedia.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileMediaDeleteOne{builder}
+}
+
+// Create returns a query builder for FileMedia.
+func (c *FileMediaClient) Query() *FileMediaQuery {
+	return &FileMediaQuery{config: c.config}
+}
+
+// Get returns a FileMedia entity by its id.
+func (c *FileMediaClient) Get(ctx context.Context, id int) (*FileMedia, error) {
+	return c.Query().Where(filemedia.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileMediaClient) GetX(ctx context.Context, id int) *FileMedia {
+	fm, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return fm
+}
+
+// QueryFile queries the file edge of a FileMedia.
+func (c *FileMediaClient) QueryFile(fm *FileMedia) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := fm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filemedia.Table, filemedia.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, filemedia.FileTable, filemedia.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(fm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileMediaClient) Hooks() []Hook {
+	return c.hooks.FileMedia
+}
+
+// FilePropertyClient is a client for the FileProperty schema.
+type FilePropertyClient struct {
+	config
+}
+
+// NewFilePropertyClient returns a client for the FileProperty from the given config.
+func NewFilePropertyClient(c config) *FilePropertyClient {
+	return &FilePropertyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `fileproperty.Hooks(f(g(h())))`.
+func (c *FilePropertyClient) Use(hooks ...Hook) {
+	c.hooks.FileProperty = append(c.hooks.FileProperty, hooks...)
+}
+
+// Create returns a create builder for FileProperty.
+func (c *FilePropertyClient) Create() *FilePropertyCreate {
+	mutation := newFilePropertyMutation(c.config, OpCreate)
+	return &FilePropertyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for FileProperty.
+func (c *FilePropertyClient) Update() *FilePropertyUpdate {
+	mutation := newFilePropertyMutation(c.config, OpUpdate)
+	return &FilePropertyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FilePropertyClient) UpdateOne(fp *FileProperty) *FilePropertyUpdateOne {
+	return c.UpdateOneID(fp.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FilePropertyClient) UpdateOneID(id int) *FilePropertyUpdateOne {
+	mutation := newFilePropertyMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FilePropertyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileProperty.
+func (c *FilePropertyClient) Delete() *FilePropertyDelete {
+	mutation := newFilePropertyMutation(c.config, OpDelete)
+	return &FilePropertyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FilePropertyClient) DeleteOne(fp *FileProperty) *FilePropertyDeleteOne {
+	return c.DeleteOneID(fp.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FilePropertyClient) DeleteOneID(id int) *FilePropertyDeleteOne {
+	builder := c.Delete().Where(fileproperty.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FilePropertyDeleteOne{builder}
+}
+
+// Create returns a query builder for FileProperty.
+func (c *FilePropertyClient) Query() *FilePropertyQuery {
+	return &FilePropertyQuery{config: c.config}
+}
+
+// Get returns a FileProperty entity by its id.
+func (c *FilePropertyClient) Get(ctx context.Context, id int) (*FileProperty, error) {
+	return c.Query().Where(fileproperty.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FilePropertyClient) GetX(ctx context.Context, id int) *FileProperty {
+	fp, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return fp
+}
+
+// QueryFile queries the file edge of a FileProperty.
+func (c *FilePropertyClient) QueryFile(fp *FileProperty) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := fp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileproperty.Table, fileproperty.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileproperty.FileTable, fileproperty.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(fp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FilePropertyClient) Hooks() []Hook {
+	return c.hooks.FileProperty
+}
+
+// FileTagClient is a client for the FileTag schema.
+type FileTagClient struct {
+	config
+}
+
+// NewFileTagClient returns a client for the FileTag from the given config.
+func NewFileTagClient(c config) *FileTagClient {
+	return &FileTagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `filetag.Hooks(f(g(h())))`.
+func (c *FileTagClient) Use(hooks ...Hook) {
+	c.hooks.FileTag = append(c.hooks.FileTag, hooks...)
+}
+
+// Create returns a create builder for FileTag.
+func (c *FileTagClient) Create() *FileTagCreate {
+	mutation := newFileTagMutation(c.config, OpCreate)
+	return &FileTagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for FileTag.
+func (c *FileTagClient) Update() *FileTagUpdate {
+	mutation := newFileTagMutation(c.config, OpUpdate)
+	return &FileTagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FileTagClient) UpdateOne(ft *FileTag) *FileTagUpdateOne {
+	return c.UpdateOneID(ft.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FileTagClient) UpdateOneID(id int) *FileTagUpdateOne {
+	mutation := newFileTagMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FileTagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FileTag.
+func (c *FileTagClient) Delete() *FileTagDelete {
+	mutation := newFileTagMutation(c.config, OpDelete)
+	return &FileTagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FileTagClient) DeleteOne(ft *FileTag) *FileTagDeleteOne {
+	return c.DeleteOneID(ft.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FileTagClient) DeleteOneID(id int) *FileTagDeleteOne {
+	builder := c.Delete().Where(filetag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FileTagDeleteOne{builder}
+}
+
+// Create returns a query builder for FileTag.
+func (c *FileTagClient) Query() *FileTagQuery {
+	return &FileTagQuery{config: c.config}
+}
+
+// Get returns a FileTag entity by its id.
+func (c *FileTagClient) Get(ctx context.Context, id int) (*FileTag, error) {
+	return c.Query().Where(filetag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FileTagClient) GetX(ctx context.Context, id int) *FileTag {
+	ft, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return ft
+}
+
+// QueryFile queries the file edge of a FileTag.
+func (c *FileTagClient) QueryFile(ft *FileTag) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := ft.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filetag.Table, filetag.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, filetag.FileTable, filetag.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(ft.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FileTagClient) Hooks() []Hook {
+	return c.hooks.FileTag
+}
+
+// FolderClient is a client for the Folder schema.
+type FolderClient struct {
+	config
+}
+
+// NewFolderClient returns a client for the Folder from the given config.
+func NewFolderClient(c config) *FolderClient {
+	return &FolderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `folder.Hooks(f(g(h())))`.
+func (c *FolderClient) Use(hooks ...Hook) {
+	c.hooks.Folder = append(c.hooks.Folder, hooks...)
+}
+
+// Create returns a create builder for Folder.
+func (c *FolderClient) Create() *FolderCreate {
+	mutation := newFolderMutation(c.config, OpCreate)
+	return &FolderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for Folder.
+func (c *FolderClient) Update() *FolderUpdate {
+	mutation := newFolderMutation(c.config, OpUpdate)
+	return &FolderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FolderClient) UpdateOne(f *Folder) *FolderUpdateOne {
+	return c.UpdateOneID(f.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FolderClient) UpdateOneID(id uuid.UUID) *FolderUpdateOne {
+	mutation := newFolderMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &FolderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Folder.
+func (c *FolderClient) Delete() *FolderDelete {
+	mutation := newFolderMutation(c.config, OpDelete)
+	return &FolderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *FolderClient) DeleteOne(f *Folder) *FolderDeleteOne {
+	return c.DeleteOneID(f.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *FolderClient) DeleteOneID(id uuid.UUID) *FolderDeleteOne {
+	builder := c.Delete().Where(folder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FolderDeleteOne{builder}
+}
+
+// Create returns a query builder for Folder.
+func (c *FolderClient) Query() *FolderQuery {
+	return &FolderQuery{config: c.config}
+}
+
+// Get returns a Folder entity by its id.
+func (c *FolderClient) Get(ctx context.Context, id uuid.UUID) (*Folder, error) {
+	return c.Query().Where(folder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FolderClient) GetX(ctx context.Context, id uuid.UUID) *Folder {
+	f, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// QueryUser queries the user edge of a Folder.
+func (c *FolderClient) QueryUser(f *Folder) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.UserTable, folder.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Folder.
+func (c *FolderClient) QueryParent(f *Folder) *FolderQuery {
+	query := &FolderQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Folder.
+func (c *FolderClient) QueryChildren(f *Folder) *FolderQuery {
+	query := &FolderQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFiles queries the files edge of a Folder.
+func (c *FolderClient) QueryFiles(f *Folder) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := f.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.FilesTable, folder.FilesColumn),
+		)
+		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FolderClient) Hooks() []Hook {
+	return c.hooks.Folder
+}
+
+// ShareClient is a client for the Share schema.
+type ShareClient struct {
+	config
+}
+
+// NewShareClient returns a client for the Share from the given config.
+func NewShareClient(c config) *ShareClient {
+	return &ShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `share.Hooks(f(g(h())))`.
+func (c *ShareClient) Use(hooks ...Hook) {
+	c.hooks.Share = append(c.hooks.Share, hooks...)
+}
+
+// Create returns a create builder for Share.
+func (c *ShareClient) Create() *ShareCreate {
+	mutation := newShareMutation(c.config, OpCreate)
+	return &ShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for Share.
+func (c *ShareClient) Update() *ShareUpdate {
+	mutation := newShareMutation(c.config, OpUpdate)
+	return &ShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShareClient) UpdateOne(s *Share) *ShareUpdateOne {
+	return c.UpdateOneID(s.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShareClient) UpdateOneID(id uuid.UUID) *ShareUpdateOne {
+	mutation := newShareMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &ShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Share.
+func (c *ShareClient) Delete() *ShareDelete {
+	mutation := newShareMutation(c.config, OpDelete)
+	return &ShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *ShareClient) DeleteOne(s *Share) *ShareDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *ShareClient) DeleteOneID(id uuid.UUID) *ShareDeleteOne {
+	builder := c.Delete().Where(share.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShareDeleteOne{builder}
+}
+
+// Create returns a query builder for Share.
+func (c *ShareClient) Query() *ShareQuery {
+	return &ShareQuery{config: c.config}
+}
+
+// Get returns a Share entity by its id.
+func (c *ShareClient) Get(ctx context.Context, id uuid.UUID) (*Share, error) {
+	return c.Query().Where(share.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShareClient) GetX(ctx context.Context, id uuid.UUID) *Share {
+	s, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// QueryUser queries the user edge of a Share.
+func (c *ShareClient) QueryUser(s *Share) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(share.Table, share.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, share.UserTable, share.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFile queries the file edge of a Share.
+func (c *ShareClient) QueryFile(s *Share) *FileQuery {
+	query := &FileQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(share.Table, share.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, share.FileTable, share.FileColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShareClient) Hooks() []Hook {
+	return c.hooks.Share
+}
+
+// ThumbnailClient is a client for the Thumbnail schema.
+type ThumbnailClient struct {
+	config
+}
+
+// NewThumbnailClient returns a client for the Thumbnail from the given config.
+func NewThumbnailClient(c config) *ThumbnailClient {
+	return &ThumbnailClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `file.Hooks(f(g(h())))`.
-func (c *FileClient) Use(hooks ...Hook) {
-	c.hooks.File = append(c.hooks.File, hooks...)
+// A call to `Use(f, g, h)` equals to `thumbnail.Hooks(f(g(h())))`.
+func (c *ThumbnailClient) Use(hooks ...Hook) {
+	c.hooks.Thumbnail = append(c.hooks.Thumbnail, hooks...)
 }
 
-// Create returns a create builder for File.
-func (c *FileClient) Create() *FileCreate {
-	mutation := newFileMutation(c.config, OpCreate)
-	return &FileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a create builder for Thumbnail.
+func (c *ThumbnailClient) Create() *ThumbnailCreate {
+	mutation := newThumbnailMutation(c.config, OpCreate)
+	return &ThumbnailCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Update returns an update builder for File.
-func (c *FileClient) Update() *FileUpdate {
-	mutation := newFileMutation(c.config, OpUpdate)
-	return &FileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Thumbnail.
+func (c *ThumbnailClient) Update() *ThumbnailUpdate {
+	mutation := newThumbnailMutation(c.config, OpUpdate)
+	return &ThumbnailUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *FileClient) UpdateOne(f *File) *FileUpdateOne {
-	return c.UpdateOneID(f.ID)
+func (c *ThumbnailClient) UpdateOne(t *Thumbnail) *ThumbnailUpdateOne {
+	return c.UpdateOneID(t.ID)
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *FileClient) UpdateOneID(id uuid.UUID) *FileUpdateOne {
-	mutation := newFileMutation(c.config, OpUpdateOne)
+func (c *ThumbnailClient) UpdateOneID(id int) *ThumbnailUpdateOne {
+	mutation := newThumbnailMutation(c.config, OpUpdateOne)
 	mutation.id = &id
-	return &FileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+	return &ThumbnailUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for File.
-func (c *FileClient) Delete() *FileDelete {
-	mutation := newFileMutation(c.config, OpDelete)
-	return &FileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Thumbnail.
+func (c *ThumbnailClient) Delete() *ThumbnailDelete {
+	mutation := newThumbnailMutation(c.config, OpDelete)
+	return &ThumbnailDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a delete builder for the given entity.
-func (c *FileClient) DeleteOne(f *File) *FileDeleteOne {
-	return c.DeleteOneID(f.ID)
+func (c *ThumbnailClient) DeleteOne(t *Thumbnail) *ThumbnailDeleteOne {
+	return c.DeleteOneID(t.ID)
 }
 
 // DeleteOneID returns a delete builder for the given id.
-func (c *FileClient) DeleteOneID(id uuid.UUID) *FileDeleteOne {
-	builder := c.Delete().Where(file.ID(id))
+func (c *ThumbnailClient) DeleteOneID(id int) *ThumbnailDeleteOne {
+	builder := c.Delete().Where(thumbnail.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &FileDeleteOne{builder}
+	return &ThumbnailDeleteOne{builder}
 }
 
-// Create returns a query builder for File.
-func (c *FileClient) Query() *FileQuery {
-	return &FileQuery{config: c.config}
+// Create returns a query builder for Thumbnail.
+func (c *ThumbnailClient) Query() *ThumbnailQuery {
+	return &ThumbnailQuery{config: c.config}
 }
 
-// Get returns a File entity by its id.
-func (c *FileClient) Get(ctx context.Context, id uuid.UUID) (*File, error) {
-	return c.Query().Where(file.ID(id)).Only(ctx)
+// Get returns a Thumbnail entity by its id.
+func (c *ThumbnailClient) Get(ctx context.Context, id int) (*Thumbnail, error) {
+	return c.Query().Where(thumbnail.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *FileClient) GetX(ctx context.Context, id uuid.UUID) *File {
-	f, err := c.Get(ctx, id)
+func (c *ThumbnailClient) GetX(ctx context.Context, id int) *Thumbnail {
+	t, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
 	}
-	return f
+	return t
 }
 
-// QueryUser queries the user edge of a File.
-func (c *FileClient) QueryUser(f *File) *UserQuery {
-	query := &UserQuery{config: c.config}
+// QueryFile queries the file edge of a Thumbnail.
+func (c *ThumbnailClient) QueryFile(t *Thumbnail) *FileQuery {
+	query := &FileQuery{config: c.config}
 	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
-		id := f.ID
+		id := t.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(file.Table, file.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, file.UserTable, file.UserColumn),
+			sqlgraph.From(thumbnail.Table, thumbnail.FieldID, id),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, thumbnail.FileTable, thumbnail.FileColumn),
 		)
-		fromV = sqlgraph.Neighbors(f.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(t.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *FileClient) Hooks() []Hook {
-	return c.hooks.File
+func (c *ThumbnailClient) Hooks() []Hook {
+	return c.hooks.Thumbnail
 }
 
 // TokenClient is a client for the Token schema.
@@ -438,7 +1615,301 @@ func (c *UserClient) QueryFiles(u *User) *FileQuery {
 	return query
 }
 
+// QueryAuditLogs queries the audit_logs edge of a User.
+func (c *UserClient) QueryAuditLogs(u *User) *AuditLogQuery {
+	query := &AuditLogQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhooks queries the webhooks edge of a User.
+func (c *UserClient) QueryWebhooks(u *User) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebhooksTable, user.WebhooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBuckets queries the buckets edge of a User.
+func (c *UserClient) QueryBuckets(u *User) *BucketQuery {
+	query := &BucketQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(bucket.Table, bucket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BucketsTable, user.BucketsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFolders queries the folders edge of a User.
+func (c *UserClient) QueryFolders(u *User) *FolderQuery {
+	query := &FolderQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FoldersTable, user.FoldersColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShares queries the shares edge of a User.
+func (c *UserClient) QueryShares(u *User) *ShareQuery {
+	query := &ShareQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharesTable, user.SharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
 }
+
+// WebhookClient is a client for the Webhook schema.
+type WebhookClient struct {
+	config
+}
+
+// NewWebhookClient returns a client for the Webhook from the given config.
+func NewWebhookClient(c config) *WebhookClient {
+	return &WebhookClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhook.Hooks(f(g(h())))`.
+func (c *WebhookClient) Use(hooks ...Hook) {
+	c.hooks.Webhook = append(c.hooks.Webhook, hooks...)
+}
+
+// Create returns a create builder for Webhook.
+func (c *WebhookClient) Create() *WebhookCreate {
+	mutation := newWebhookMutation(c.config, OpCreate)
+	return &WebhookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for Webhook.
+func (c *WebhookClient) Update() *WebhookUpdate {
+	mutation := newWebhookMutation(c.config, OpUpdate)
+	return &WebhookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookClient) UpdateOne(w *Webhook) *WebhookUpdateOne {
+	return c.UpdateOneID(w.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookClient) UpdateOneID(id uuid.UUID) *WebhookUpdateOne {
+	mutation := newWebhookMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &WebhookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Webhook.
+func (c *WebhookClient) Delete() *WebhookDelete {
+	mutation := newWebhookMutation(c.config, OpDelete)
+	return &WebhookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookClient) DeleteOne(w *Webhook) *WebhookDeleteOne {
+	return c.DeleteOneID(w.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookClient) DeleteOneID(id uuid.UUID) *WebhookDeleteOne {
+	builder := c.Delete().Where(webhook.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeleteOne{builder}
+}
+
+// Create returns a query builder for Webhook.
+func (c *WebhookClient) Query() *WebhookQuery {
+	return &WebhookQuery{config: c.config}
+}
+
+// Get returns a Webhook entity by its id.
+func (c *WebhookClient) Get(ctx context.Context, id uuid.UUID) (*Webhook, error) {
+	return c.Query().Where(webhook.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookClient) GetX(ctx context.Context, id uuid.UUID) *Webhook {
+	w, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// QueryUser queries the user edge of a Webhook.
+func (c *WebhookClient) QueryUser(w *Webhook) *UserQuery {
+	query := &UserQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.UserTable, webhook.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeliveries queries the deliveries edge of a Webhook.
+func (c *WebhookClient) QueryDeliveries(w *Webhook) *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookClient) Hooks() []Hook {
+	return c.hooks.Webhook
+}
+
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
+	config
+}
+
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
+}
+
+// Create returns a create builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookDeliveryClient) UpdateOne(wd *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	return c.UpdateOneID(wd.ID)
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookDeliveryClient) UpdateOneID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne)
+	mutation.id = &id
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a delete builder for the given entity.
+func (c *WebhookDeliveryClient) DeleteOne(wd *WebhookDelivery) *WebhookDeliveryDeleteOne {
+	return c.DeleteOneID(wd.ID)
+}
+
+// DeleteOneID returns a delete builder for the given id.
+func (c *WebhookDeliveryClient) DeleteOneID(id uuid.UUID) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookDeliveryDeleteOne{builder}
+}
+
+// Create returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{config: c.config}
+}
+
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id uuid.UUID) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id uuid.UUID) *WebhookDelivery {
+	wd, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return wd
+}
+
+// QueryWebhook queries the webhook edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryWebhook(wd *WebhookDelivery) *WebhookQuery {
+	query := &WebhookQuery{config: c.config}
+	query.path = func(ctx context.Context) (fromV *sql.Selector, _ error) {
+		id := wd.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.WebhookTable, webhookdelivery.WebhookColumn),
+		)
+		fromV = sqlgraph.Neighbors(wd.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
+}
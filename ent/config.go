@@ -24,9 +24,20 @@ type config struct {
 
 // hooks per client, for fast access.
 type hooks struct {
-	File  []ent.Hook
-	Token []ent.Hook
-	User  []ent.Hook
+	AuditLog        []ent.Hook
+	Bucket          []ent.Hook
+	File            []ent.Hook
+	FileKey         []ent.Hook
+	FileMedia       []ent.Hook
+	FileProperty    []ent.Hook
+	FileTag         []ent.Hook
+	Folder          []ent.Hook
+	Share           []ent.Hook
+	Thumbnail       []ent.Hook
+	Token           []ent.Hook
+	User            []ent.Hook
+	Webhook         []ent.Hook
+	WebhookDelivery []ent.Hook
 }
 
 // Options applies the options on the config object.
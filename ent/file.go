@@ -10,7 +10,11 @@ import (
 
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/folder"
 	"github.com/sthorer/api/ent/user"
 )
 
@@ -26,22 +30,50 @@ type File struct {
 	// PinnedAt holds the value of the "pinned_at" field.
 	PinnedAt time.Time `json:"pinned_at,omitempty"`
 	// UnpinnedAt holds the value of the "unpinned_at" field.
-	UnpinnedAt time.Time `json:"unpinned_at,omitempty"`
+	UnpinnedAt *time.Time `json:"unpinned_at,omitempty"`
 	// Metadata holds the value of the "metadata" field.
 	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Etag holds the value of the "etag" field.
+	Etag string `json:"etag,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FileQuery when eager-loading is set.
-	Edges      FileEdges `json:"edges"`
-	user_files *int
+	Edges        FileEdges `json:"edges"`
+	bucket_files *uuid.UUID
+	folder_files *uuid.UUID
+	user_files   *int
 }
 
 // FileEdges holds the relations/edges for other nodes in the graph.
 type FileEdges struct {
 	// User holds the value of the user edge.
 	User *User
+	// Bucket holds the value of the bucket edge.
+	Bucket *Bucket
+	// Folder holds the value of the folder edge.
+	Folder *Folder
+	// IndexedTags holds the value of the indexed_tags edge.
+	IndexedTags []*FileTag
+	// IndexedProperties holds the value of the indexed_properties edge.
+	IndexedProperties []*FileProperty
+	// IndexedMedia holds the value of the indexed_media edge.
+	IndexedMedia *FileMedia
+	// Thumbnails holds the value of the thumbnails edge.
+	Thumbnails []*Thumbnail
+	// Shares holds the value of the shares edge.
+	Shares []*Share
+	// EncryptionKey holds the value of the encryption_key edge.
+	EncryptionKey *FileKey
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [9]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -58,6 +90,98 @@ func (e FileEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// BucketOrErr returns the Bucket value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) BucketOrErr() (*Bucket, error) {
+	if e.loadedTypes[1] {
+		if e.Bucket == nil {
+			// The edge bucket was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: bucket.Label}
+		}
+		return e.Bucket, nil
+	}
+	return nil, &NotLoadedError{edge: "bucket"}
+}
+
+// FolderOrErr returns the Folder value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) FolderOrErr() (*Folder, error) {
+	if e.loadedTypes[2] {
+		if e.Folder == nil {
+			// The edge folder was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: folder.Label}
+		}
+		return e.Folder, nil
+	}
+	return nil, &NotLoadedError{edge: "folder"}
+}
+
+// IndexedTagsOrErr returns the IndexedTags value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) IndexedTagsOrErr() ([]*FileTag, error) {
+	if e.loadedTypes[3] {
+		return e.IndexedTags, nil
+	}
+	return nil, &NotLoadedError{edge: "indexed_tags"}
+}
+
+// IndexedPropertiesOrErr returns the IndexedProperties value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) IndexedPropertiesOrErr() ([]*FileProperty, error) {
+	if e.loadedTypes[4] {
+		return e.IndexedProperties, nil
+	}
+	return nil, &NotLoadedError{edge: "indexed_properties"}
+}
+
+// IndexedMediaOrErr returns the IndexedMedia value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) IndexedMediaOrErr() (*FileMedia, error) {
+	if e.loadedTypes[5] {
+		if e.IndexedMedia == nil {
+			// The edge indexed_media was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: filemedia.Label}
+		}
+		return e.IndexedMedia, nil
+	}
+	return nil, &NotLoadedError{edge: "indexed_media"}
+}
+
+// ThumbnailsOrErr returns the Thumbnails value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) ThumbnailsOrErr() ([]*Thumbnail, error) {
+	if e.loadedTypes[6] {
+		return e.Thumbnails, nil
+	}
+	return nil, &NotLoadedError{edge: "thumbnails"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e FileEdges) SharesOrErr() ([]*Share, error) {
+	if e.loadedTypes[7] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// EncryptionKeyOrErr returns the EncryptionKey value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileEdges) EncryptionKeyOrErr() (*FileKey, error) {
+	if e.loadedTypes[8] {
+		if e.EncryptionKey == nil {
+			// The edge encryption_key was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: filekey.Label}
+		}
+		return e.EncryptionKey, nil
+	}
+	return nil, &NotLoadedError{edge: "encryption_key"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*File) scanValues() []interface{} {
 	return []interface{}{
@@ -67,12 +191,19 @@ func (*File) scanValues() []interface{} {
 		&sql.NullTime{},   // pinned_at
 		&sql.NullTime{},   // unpinned_at
 		&[]byte{},         // metadata
+		&sql.NullString{}, // key
+		&sql.NullString{}, // etag
+		&sql.NullString{}, // name
+		&[]byte{},         // tags
+		&sql.NullString{}, // content_type
 	}
 }
 
 // fkValues returns the types for scanning foreign-keys values from sql.Rows.
 func (*File) fkValues() []interface{} {
 	return []interface{}{
+		&uuid.UUID{},     // bucket_files
+		&uuid.UUID{},     // folder_files
 		&sql.NullInt64{}, // user_files
 	}
 }
@@ -107,7 +238,8 @@ func (f *File) assignValues(values ...interface{}) error {
 	if value, ok := values[3].(*sql.NullTime); !ok {
 		return fmt.Errorf("unexpected type %T for field unpinned_at", values[3])
 	} else if value.Valid {
-		f.UnpinnedAt = value.Time
+		f.UnpinnedAt = new(time.Time)
+		*f.UnpinnedAt = value.Time
 	}
 
 	if value, ok := values[4].(*[]byte); !ok {
@@ -117,9 +249,47 @@ func (f *File) assignValues(values ...interface{}) error {
 			return fmt.Errorf("unmarshal field metadata: %v", err)
 		}
 	}
-	values = values[5:]
+	if value, ok := values[5].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field key", values[5])
+	} else if value.Valid {
+		f.Key = value.String
+	}
+	if value, ok := values[6].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field etag", values[6])
+	} else if value.Valid {
+		f.Etag = value.String
+	}
+	if value, ok := values[7].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[7])
+	} else if value.Valid {
+		f.Name = value.String
+	}
+
+	if value, ok := values[8].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field tags", values[8])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &f.Tags); err != nil {
+			return fmt.Errorf("unmarshal field tags: %v", err)
+		}
+	}
+	if value, ok := values[9].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field content_type", values[9])
+	} else if value.Valid {
+		f.ContentType = value.String
+	}
+	values = values[10:]
 	if len(values) == len(file.ForeignKeys) {
-		if value, ok := values[0].(*sql.NullInt64); !ok {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field bucket_files", values[0])
+		} else if value != nil {
+			f.bucket_files = value
+		}
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field folder_files", values[0])
+		} else if value != nil {
+			f.folder_files = value
+		}
+		if value, ok := values[2].(*sql.NullInt64); !ok {
 			return fmt.Errorf("unexpected type %T for edge-field user_files", value)
 		} else if value.Valid {
 			f.user_files = new(int)
@@ -134,6 +304,46 @@ func (f *File) QueryUser() *UserQuery {
 	return (&FileClient{config: f.config}).QueryUser(f)
 }
 
+// QueryBucket queries the bucket edge of the File.
+func (f *File) QueryBucket() *BucketQuery {
+	return (&FileClient{config: f.config}).QueryBucket(f)
+}
+
+// QueryFolder queries the folder edge of the File.
+func (f *File) QueryFolder() *FolderQuery {
+	return (&FileClient{config: f.config}).QueryFolder(f)
+}
+
+// QueryIndexedTags queries the indexed_tags edge of the File.
+func (f *File) QueryIndexedTags() *FileTagQuery {
+	return (&FileClient{config: f.config}).QueryIndexedTags(f)
+}
+
+// QueryIndexedProperties queries the indexed_properties edge of the File.
+func (f *File) QueryIndexedProperties() *FilePropertyQuery {
+	return (&FileClient{config: f.config}).QueryIndexedProperties(f)
+}
+
+// QueryIndexedMedia queries the indexed_media edge of the File.
+func (f *File) QueryIndexedMedia() *FileMediaQuery {
+	return (&FileClient{config: f.config}).QueryIndexedMedia(f)
+}
+
+// QueryThumbnails queries the thumbnails edge of the File.
+func (f *File) QueryThumbnails() *ThumbnailQuery {
+	return (&FileClient{config: f.config}).QueryThumbnails(f)
+}
+
+// QueryShares queries the shares edge of the File.
+func (f *File) QueryShares() *ShareQuery {
+	return (&FileClient{config: f.config}).QueryShares(f)
+}
+
+// QueryEncryptionKey queries the encryption_key edge of the File.
+func (f *File) QueryEncryptionKey() *FileKeyQuery {
+	return (&FileClient{config: f.config}).QueryEncryptionKey(f)
+}
+
 // Update returns a builder for updating this File.
 // Note that, you need to call File.Unwrap() before calling this method, if this File
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -163,10 +373,22 @@ func (f *File) String() string {
 	builder.WriteString(fmt.Sprintf("%v", f.Size))
 	builder.WriteString(", pinned_at=")
 	builder.WriteString(f.PinnedAt.Format(time.ANSIC))
-	builder.WriteString(", unpinned_at=")
-	builder.WriteString(f.UnpinnedAt.Format(time.ANSIC))
+	if v := f.UnpinnedAt; v != nil {
+		builder.WriteString(", unpinned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteString(", metadata=")
 	builder.WriteString(fmt.Sprintf("%v", f.Metadata))
+	builder.WriteString(", key=")
+	builder.WriteString(f.Key)
+	builder.WriteString(", etag=")
+	builder.WriteString(f.Etag)
+	builder.WriteString(", name=")
+	builder.WriteString(f.Name)
+	builder.WriteString(", tags=")
+	builder.WriteString(fmt.Sprintf("%v", f.Tags))
+	builder.WriteString(", content_type=")
+	builder.WriteString(f.ContentType)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -10,15 +10,36 @@ const (
 	// Label holds the string label denoting the file type in the database.
 	Label = "file"
 	// FieldID holds the string denoting the id field in the database.
-	FieldID         = "id"          // FieldHash holds the string denoting the hash vertex property in the database.
-	FieldHash       = "hash"        // FieldSize holds the string denoting the size vertex property in the database.
-	FieldSize       = "size"        // FieldPinnedAt holds the string denoting the pinned_at vertex property in the database.
-	FieldPinnedAt   = "pinned_at"   // FieldUnpinnedAt holds the string denoting the unpinned_at vertex property in the database.
-	FieldUnpinnedAt = "unpinned_at" // FieldMetadata holds the string denoting the metadata vertex property in the database.
-	FieldMetadata   = "metadata"
+	FieldID          = "id"          // FieldHash holds the string denoting the hash vertex property in the database.
+	FieldHash        = "hash"        // FieldSize holds the string denoting the size vertex property in the database.
+	FieldSize        = "size"        // FieldPinnedAt holds the string denoting the pinned_at vertex property in the database.
+	FieldPinnedAt    = "pinned_at"   // FieldUnpinnedAt holds the string denoting the unpinned_at vertex property in the database.
+	FieldUnpinnedAt  = "unpinned_at" // FieldMetadata holds the string denoting the metadata vertex property in the database.
+	FieldMetadata    = "metadata"    // FieldKey holds the string denoting the key vertex property in the database.
+	FieldKey         = "key"         // FieldEtag holds the string denoting the etag vertex property in the database.
+	FieldEtag        = "etag"        // FieldName holds the string denoting the name vertex property in the database.
+	FieldName        = "name"        // FieldTags holds the string denoting the tags vertex property in the database.
+	FieldTags        = "tags"        // FieldContentType holds the string denoting the content_type vertex property in the database.
+	FieldContentType = "content_type"
 
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeBucket holds the string denoting the bucket edge name in mutations.
+	EdgeBucket = "bucket"
+	// EdgeFolder holds the string denoting the folder edge name in mutations.
+	EdgeFolder = "folder"
+	// EdgeIndexedTags holds the string denoting the indexed_tags edge name in mutations.
+	EdgeIndexedTags = "indexed_tags"
+	// EdgeIndexedProperties holds the string denoting the indexed_properties edge name in mutations.
+	EdgeIndexedProperties = "indexed_properties"
+	// EdgeIndexedMedia holds the string denoting the indexed_media edge name in mutations.
+	EdgeIndexedMedia = "indexed_media"
+	// EdgeThumbnails holds the string denoting the thumbnails edge name in mutations.
+	EdgeThumbnails = "thumbnails"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
+	// EdgeEncryptionKey holds the string denoting the encryption_key edge name in mutations.
+	EdgeEncryptionKey = "encryption_key"
 
 	// Table holds the table name of the file in the database.
 	Table = "files"
@@ -29,6 +50,62 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_files"
+	// BucketTable is the table the holds the bucket relation/edge.
+	BucketTable = "files"
+	// BucketInverseTable is the table name for the Bucket entity.
+	// It exists in this package in order to avoid circular dependency with the "bucket" package.
+	BucketInverseTable = "buckets"
+	// BucketColumn is the table column denoting the bucket relation/edge.
+	BucketColumn = "bucket_files"
+	// FolderTable is the table the holds the folder relation/edge.
+	FolderTable = "files"
+	// FolderInverseTable is the table name for the Folder entity.
+	// It exists in this package in order to avoid circular dependency with the "folder" package.
+	FolderInverseTable = "folders"
+	// FolderColumn is the table column denoting the folder relation/edge.
+	FolderColumn = "folder_files"
+	// IndexedTagsTable is the table the holds the indexed_tags relation/edge.
+	IndexedTagsTable = "file_tags"
+	// IndexedTagsInverseTable is the table name for the FileTag entity.
+	// It exists in this package in order to avoid circular dependency with the "filetag" package.
+	IndexedTagsInverseTable = "file_tags"
+	// IndexedTagsColumn is the table column denoting the indexed_tags relation/edge.
+	IndexedTagsColumn = "file_indexed_tags"
+	// IndexedPropertiesTable is the table the holds the indexed_properties relation/edge.
+	IndexedPropertiesTable = "file_properties"
+	// IndexedPropertiesInverseTable is the table name for the FileProperty entity.
+	// It exists in this package in order to avoid circular dependency with the "fileproperty" package.
+	IndexedPropertiesInverseTable = "file_properties"
+	// IndexedPropertiesColumn is the table column denoting the indexed_properties relation/edge.
+	IndexedPropertiesColumn = "file_indexed_properties"
+	// IndexedMediaTable is the table the holds the indexed_media relation/edge.
+	IndexedMediaTable = "file_media"
+	// IndexedMediaInverseTable is the table name for the FileMedia entity.
+	// It exists in this package in order to avoid circular dependency with the "filemedia" package.
+	IndexedMediaInverseTable = "file_media"
+	// IndexedMediaColumn is the table column denoting the indexed_media relation/edge.
+	IndexedMediaColumn = "file_indexed_media"
+	// ThumbnailsTable is the table the holds the thumbnails relation/edge.
+	ThumbnailsTable = "thumbnails"
+	// ThumbnailsInverseTable is the table name for the Thumbnail entity.
+	// It exists in this package in order to avoid circular dependency with the "thumbnail" package.
+	ThumbnailsInverseTable = "thumbnails"
+	// ThumbnailsColumn is the table column denoting the thumbnails relation/edge.
+	ThumbnailsColumn = "file_thumbnails"
+	// SharesTable is the table the holds the shares relation/edge.
+	SharesTable = "shares"
+	// SharesInverseTable is the table name for the Share entity.
+	// It exists in this package in order to avoid circular dependency with the "share" package.
+	SharesInverseTable = "shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "file_shares"
+	// EncryptionKeyTable is the table the holds the encryption_key relation/edge.
+	EncryptionKeyTable = "file_keys"
+	// EncryptionKeyInverseTable is the table name for the FileKey entity.
+	// It exists in this package in order to avoid circular dependency with the "filekey" package.
+	EncryptionKeyInverseTable = "file_keys"
+	// EncryptionKeyColumn is the table column denoting the encryption_key relation/edge.
+	EncryptionKeyColumn = "file_encryption_key"
 )
 
 // Columns holds all SQL columns for file fields.
@@ -39,10 +116,17 @@ var Columns = []string{
 	FieldPinnedAt,
 	FieldUnpinnedAt,
 	FieldMetadata,
+	FieldKey,
+	FieldEtag,
+	FieldName,
+	FieldTags,
+	FieldContentType,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the File type.
 var ForeignKeys = []string{
+	"bucket_files",
+	"folder_files",
 	"user_files",
 }
 
@@ -53,4 +137,10 @@ var (
 	SizeValidator func(int64) error
 	// DefaultPinnedAt holds the default value on creation for the pinned_at field.
 	DefaultPinnedAt func() time.Time
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	ContentTypeValidator func(string) error
 )
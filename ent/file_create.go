@@ -11,7 +11,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
 	"github.com/sthorer/api/ent/user"
 )
 
@@ -54,12 +62,82 @@ func (fc *FileCreate) SetUnpinnedAt(t time.Time) *FileCreate {
 	return fc
 }
 
+// SetNillableUnpinnedAt sets the unpinned_at field if the given value is not nil.
+func (fc *FileCreate) SetNillableUnpinnedAt(t *time.Time) *FileCreate {
+	if t != nil {
+		fc.SetUnpinnedAt(*t)
+	}
+	return fc
+}
+
 // SetMetadata sets the metadata field.
 func (fc *FileCreate) SetMetadata(m map[string]interface{}) *FileCreate {
 	fc.mutation.SetMetadata(m)
 	return fc
 }
 
+// SetKey sets the key field.
+func (fc *FileCreate) SetKey(s string) *FileCreate {
+	fc.mutation.SetKey(s)
+	return fc
+}
+
+// SetNillableKey sets the key field if the given value is not nil.
+func (fc *FileCreate) SetNillableKey(s *string) *FileCreate {
+	if s != nil {
+		fc.SetKey(*s)
+	}
+	return fc
+}
+
+// SetEtag sets the etag field.
+func (fc *FileCreate) SetEtag(s string) *FileCreate {
+	fc.mutation.SetEtag(s)
+	return fc
+}
+
+// SetNillableEtag sets the etag field if the given value is not nil.
+func (fc *FileCreate) SetNillableEtag(s *string) *FileCreate {
+	if s != nil {
+		fc.SetEtag(*s)
+	}
+	return fc
+}
+
+// SetName sets the name field.
+func (fc *FileCreate) SetName(s string) *FileCreate {
+	fc.mutation.SetName(s)
+	return fc
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (fc *FileCreate) SetNillableName(s *string) *FileCreate {
+	if s != nil {
+		fc.SetName(*s)
+	}
+	return fc
+}
+
+// SetTags sets the tags field.
+func (fc *FileCreate) SetTags(s []string) *FileCreate {
+	fc.mutation.SetTags(s)
+	return fc
+}
+
+// SetContentType sets the content_type field.
+func (fc *FileCreate) SetContentType(s string) *FileCreate {
+	fc.mutation.SetContentType(s)
+	return fc
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (fc *FileCreate) SetNillableContentType(s *string) *FileCreate {
+	if s != nil {
+		fc.SetContentType(*s)
+	}
+	return fc
+}
+
 // SetID sets the id field.
 func (fc *FileCreate) SetID(u uuid.UUID) *FileCreate {
 	fc.mutation.SetID(u)
@@ -77,6 +155,142 @@ func (fc *FileCreate) SetUser(u *User) *FileCreate {
 	return fc.SetUserID(u.ID)
 }
 
+// SetBucketID sets the bucket edge to Bucket by id.
+func (fc *FileCreate) SetBucketID(id uuid.UUID) *FileCreate {
+	fc.mutation.SetBucketID(id)
+	return fc
+}
+
+// SetNillableBucketID sets the bucket edge to Bucket by id if the given value is not nil.
+func (fc *FileCreate) SetNillableBucketID(id *uuid.UUID) *FileCreate {
+	if id != nil {
+		fc = fc.SetBucketID(*id)
+	}
+	return fc
+}
+
+// SetBucket sets the bucket edge to Bucket.
+func (fc *FileCreate) SetBucket(b *Bucket) *FileCreate {
+	return fc.SetBucketID(b.ID)
+}
+
+// SetFolderID sets the folder edge to Folder by id.
+func (fc *FileCreate) SetFolderID(id uuid.UUID) *FileCreate {
+	fc.mutation.SetFolderID(id)
+	return fc
+}
+
+// SetNillableFolderID sets the folder edge to Folder by id if the given value is not nil.
+func (fc *FileCreate) SetNillableFolderID(id *uuid.UUID) *FileCreate {
+	if id != nil {
+		fc = fc.SetFolderID(*id)
+	}
+	return fc
+}
+
+// SetFolder sets the folder edge to Folder.
+func (fc *FileCreate) SetFolder(f *Folder) *FileCreate {
+	return fc.SetFolderID(f.ID)
+}
+
+// AddIndexedTagIDs adds the indexed_tags edge to FileTag by ids.
+func (fc *FileCreate) AddIndexedTagIDs(ids ...int) *FileCreate {
+	fc.mutation.AddIndexedTagIDs(ids...)
+	return fc
+}
+
+// AddIndexedTags adds the indexed_tags edges to FileTag.
+func (fc *FileCreate) AddIndexedTags(f ...*FileTag) *FileCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddIndexedTagIDs(ids...)
+}
+
+// AddIndexedPropertyIDs adds the indexed_properties edge to FileProperty by ids.
+func (fc *FileCreate) AddIndexedPropertyIDs(ids ...int) *FileCreate {
+	fc.mutation.AddIndexedPropertyIDs(ids...)
+	return fc
+}
+
+// AddIndexedProperties adds the indexed_properties edges to FileProperty.
+func (fc *FileCreate) AddIndexedProperties(f ...*FileProperty) *FileCreate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddIndexedPropertyIDs(ids...)
+}
+
+// SetIndexedMediaID sets the indexed_media edge to FileMedia by id.
+func (fc *FileCreate) SetIndexedMediaID(id int) *FileCreate {
+	fc.mutation.SetIndexedMediaID(id)
+	return fc
+}
+
+// SetNillableIndexedMediaID sets the indexed_media edge to FileMedia by id if the given value is not nil.
+func (fc *FileCreate) SetNillableIndexedMediaID(id *int) *FileCreate {
+	if id != nil {
+		fc = fc.SetIndexedMediaID(*id)
+	}
+	return fc
+}
+
+// SetIndexedMedia sets the indexed_media edge to FileMedia.
+func (fc *FileCreate) SetIndexedMedia(f *FileMedia) *FileCreate {
+	return fc.SetIndexedMediaID(f.ID)
+}
+
+// AddThumbnailIDs adds the thumbnails edge to Thumbnail by ids.
+func (fc *FileCreate) AddThumbnailIDs(ids ...int) *FileCreate {
+	fc.mutation.AddThumbnailIDs(ids...)
+	return fc
+}
+
+// AddThumbnails adds the thumbnails edges to Thumbnail.
+func (fc *FileCreate) AddThumbnails(t ...*Thumbnail) *FileCreate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fc.AddThumbnailIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (fc *FileCreate) AddShareIDs(ids ...uuid.UUID) *FileCreate {
+	fc.mutation.AddShareIDs(ids...)
+	return fc
+}
+
+// AddShares adds the shares edges to Share.
+func (fc *FileCreate) AddShares(s ...*Share) *FileCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fc.AddShareIDs(ids...)
+}
+
+// SetEncryptionKeyID sets the encryption_key edge to FileKey by id.
+func (fc *FileCreate) SetEncryptionKeyID(id int) *FileCreate {
+	fc.mutation.SetEncryptionKeyID(id)
+	return fc
+}
+
+// SetNillableEncryptionKeyID sets the encryption_key edge to FileKey by id if the given value is not nil.
+func (fc *FileCreate) SetNillableEncryptionKeyID(id *int) *FileCreate {
+	if id != nil {
+		fc = fc.SetEncryptionKeyID(*id)
+	}
+	return fc
+}
+
+// SetEncryptionKey sets the encryption_key edge to FileKey.
+func (fc *FileCreate) SetEncryptionKey(f *FileKey) *FileCreate {
+	return fc.SetEncryptionKeyID(f.ID)
+}
+
 // Save creates the File in the database.
 func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
 	if _, ok := fc.mutation.Hash(); !ok {
@@ -99,8 +313,20 @@ func (fc *FileCreate) Save(ctx context.Context) (*File, error) {
 		v := file.DefaultPinnedAt()
 		fc.mutation.SetPinnedAt(v)
 	}
-	if _, ok := fc.mutation.UnpinnedAt(); !ok {
-		return nil, errors.New("ent: missing required field \"unpinned_at\"")
+	if v, ok := fc.mutation.Key(); ok {
+		if err := file.KeyValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"key\": %v", err)
+		}
+	}
+	if v, ok := fc.mutation.Name(); ok {
+		if err := file.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if v, ok := fc.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"content_type\": %v", err)
+		}
 	}
 	if _, ok := fc.mutation.UserID(); !ok {
 		return nil, errors.New("ent: missing required edge \"user\"")
@@ -185,7 +411,7 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 			Value:  value,
 			Column: file.FieldUnpinnedAt,
 		})
-		f.UnpinnedAt = value
+		f.UnpinnedAt = &value
 	}
 	if value, ok := fc.mutation.Metadata(); ok {
 		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
@@ -195,6 +421,46 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		})
 		f.Metadata = value
 	}
+	if value, ok := fc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldKey,
+		})
+		f.Key = value
+	}
+	if value, ok := fc.mutation.Etag(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldEtag,
+		})
+		f.Etag = value
+	}
+	if value, ok := fc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldName,
+		})
+		f.Name = value
+	}
+	if value, ok := fc.mutation.Tags(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+		f.Tags = value
+	}
+	if value, ok := fc.mutation.ContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldContentType,
+		})
+		f.ContentType = value
+	}
 	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -214,6 +480,158 @@ func (fc *FileCreate) sqlSave(ctx context.Context) (*File, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := fc.mutation.BucketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.BucketTable,
+			Columns: []string{file.BucketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.FolderTable,
+			Columns: []string{file.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.IndexedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedTagsTable,
+			Columns: []string{file.IndexedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.IndexedPropertiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedPropertiesTable,
+			Columns: []string{file.IndexedPropertiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fileproperty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.IndexedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.IndexedMediaTable,
+			Columns: []string{file.IndexedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filemedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: thumbnail.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.EncryptionKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.EncryptionKeyTable,
+			Columns: []string{file.EncryptionKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filekey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
 		if cerr, ok := isSQLConstraintError(err); ok {
 			err = cerr
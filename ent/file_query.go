@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"errors"
 	"fmt"
 	"math"
@@ -12,8 +13,16 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
 	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
 	"github.com/sthorer/api/ent/user"
 )
 
@@ -26,8 +35,16 @@ type FileQuery struct {
 	unique     []string
 	predicates []predicate.File
 	// eager-loading edges.
-	withUser *UserQuery
-	withFKs  bool
+	withUser              *UserQuery
+	withBucket            *BucketQuery
+	withFolder            *FolderQuery
+	withIndexedTags       *FileTagQuery
+	withIndexedProperties *FilePropertyQuery
+	withIndexedMedia      *FileMediaQuery
+	withThumbnails        *ThumbnailQuery
+	withShares            *ShareQuery
+	withEncryptionKey     *FileKeyQuery
+	withFKs               bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -75,6 +92,150 @@ func (fq *FileQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryBucket chains the current query on the bucket edge.
+func (fq *FileQuery) QueryBucket() *BucketQuery {
+	query := &BucketQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(bucket.Table, bucket.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.BucketTable, file.BucketColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolder chains the current query on the folder edge.
+func (fq *FileQuery) QueryFolder() *FolderQuery {
+	query := &FolderQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, file.FolderTable, file.FolderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIndexedTags chains the current query on the indexed_tags edge.
+func (fq *FileQuery) QueryIndexedTags() *FileTagQuery {
+	query := &FileTagQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(filetag.Table, filetag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.IndexedTagsTable, file.IndexedTagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIndexedProperties chains the current query on the indexed_properties edge.
+func (fq *FileQuery) QueryIndexedProperties() *FilePropertyQuery {
+	query := &FilePropertyQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(fileproperty.Table, fileproperty.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.IndexedPropertiesTable, file.IndexedPropertiesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryIndexedMedia chains the current query on the indexed_media edge.
+func (fq *FileQuery) QueryIndexedMedia() *FileMediaQuery {
+	query := &FileMediaQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(filemedia.Table, filemedia.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, file.IndexedMediaTable, file.IndexedMediaColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryThumbnails chains the current query on the thumbnails edge.
+func (fq *FileQuery) QueryThumbnails() *ThumbnailQuery {
+	query := &ThumbnailQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(thumbnail.Table, thumbnail.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.ThumbnailsTable, file.ThumbnailsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShares chains the current query on the shares edge.
+func (fq *FileQuery) QueryShares() *ShareQuery {
+	query := &ShareQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, file.SharesTable, file.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEncryptionKey chains the current query on the encryption_key edge.
+func (fq *FileQuery) QueryEncryptionKey() *FileKeyQuery {
+	query := &FileKeyQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(file.Table, file.FieldID, fq.sqlQuery()),
+			sqlgraph.To(filekey.Table, filekey.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, file.EncryptionKeyTable, file.EncryptionKeyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first File entity in the query. Returns *NotFoundError when no file was found.
 func (fq *FileQuery) First(ctx context.Context) (*File, error) {
 	fs, err := fq.Limit(1).All(ctx)
@@ -265,6 +426,94 @@ func (fq *FileQuery) WithUser(opts ...func(*UserQuery)) *FileQuery {
 	return fq
 }
 
+//  WithBucket tells the query-builder to eager-loads the nodes that are connected to
+// the "bucket" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithBucket(opts ...func(*BucketQuery)) *FileQuery {
+	query := &BucketQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withBucket = query
+	return fq
+}
+
+//  WithFolder tells the query-builder to eager-loads the nodes that are connected to
+// the "folder" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithFolder(opts ...func(*FolderQuery)) *FileQuery {
+	query := &FolderQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withFolder = query
+	return fq
+}
+
+//  WithIndexedTags tells the query-builder to eager-loads the nodes that are connected to
+// the "indexed_tags" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithIndexedTags(opts ...func(*FileTagQuery)) *FileQuery {
+	query := &FileTagQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withIndexedTags = query
+	return fq
+}
+
+//  WithIndexedProperties tells the query-builder to eager-loads the nodes that are connected to
+// the "indexed_properties" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithIndexedProperties(opts ...func(*FilePropertyQuery)) *FileQuery {
+	query := &FilePropertyQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withIndexedProperties = query
+	return fq
+}
+
+//  WithIndexedMedia tells the query-builder to eager-loads the nodes that are connected to
+// the "indexed_media" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithIndexedMedia(opts ...func(*FileMediaQuery)) *FileQuery {
+	query := &FileMediaQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withIndexedMedia = query
+	return fq
+}
+
+//  WithThumbnails tells the query-builder to eager-loads the nodes that are connected to
+// the "thumbnails" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithThumbnails(opts ...func(*ThumbnailQuery)) *FileQuery {
+	query := &ThumbnailQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withThumbnails = query
+	return fq
+}
+
+//  WithShares tells the query-builder to eager-loads the nodes that are connected to
+// the "shares" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithShares(opts ...func(*ShareQuery)) *FileQuery {
+	query := &ShareQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withShares = query
+	return fq
+}
+
+//  WithEncryptionKey tells the query-builder to eager-loads the nodes that are connected to
+// the "encryption_key" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FileQuery) WithEncryptionKey(opts ...func(*FileKeyQuery)) *FileQuery {
+	query := &FileKeyQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withEncryptionKey = query
+	return fq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,11 +581,19 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 		nodes       = []*File{}
 		withFKs     = fq.withFKs
 		_spec       = fq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [9]bool{
 			fq.withUser != nil,
+			fq.withBucket != nil,
+			fq.withFolder != nil,
+			fq.withIndexedTags != nil,
+			fq.withIndexedProperties != nil,
+			fq.withIndexedMedia != nil,
+			fq.withThumbnails != nil,
+			fq.withShares != nil,
+			fq.withEncryptionKey != nil,
 		}
 	)
-	if fq.withUser != nil {
+	if fq.withUser != nil || fq.withBucket != nil || fq.withFolder != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -391,6 +648,224 @@ func (fq *FileQuery) sqlAll(ctx context.Context) ([]*File, error) {
 		}
 	}
 
+	if query := fq.withBucket; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*File)
+		for i := range nodes {
+			if fk := nodes[i].bucket_files; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(bucket.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "bucket_files" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Bucket = n
+			}
+		}
+	}
+
+	if query := fq.withFolder; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*File)
+		for i := range nodes {
+			if fk := nodes[i].folder_files; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(folder.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "folder_files" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Folder = n
+			}
+		}
+	}
+
+	if query := fq.withIndexedTags; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.FileTag(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.IndexedTagsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_indexed_tags
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_indexed_tags" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_tags" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.IndexedTags = append(node.Edges.IndexedTags, n)
+		}
+	}
+
+	if query := fq.withIndexedProperties; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.FileProperty(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.IndexedPropertiesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_indexed_properties
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_indexed_properties" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_properties" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.IndexedProperties = append(node.Edges.IndexedProperties, n)
+		}
+	}
+
+	if query := fq.withIndexedMedia; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.FileMedia(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.IndexedMediaColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_indexed_media
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_indexed_media" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_media" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.IndexedMedia = n
+		}
+	}
+
+	if query := fq.withThumbnails; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Thumbnail(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.ThumbnailsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_thumbnails
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_thumbnails" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_thumbnails" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Thumbnails = append(node.Edges.Thumbnails, n)
+		}
+	}
+
+	if query := fq.withShares; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Share(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.SharesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_shares
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_shares" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_shares" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Shares = append(node.Edges.Shares, n)
+		}
+	}
+
+	if query := fq.withEncryptionKey; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*File)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.FileKey(func(s *sql.Selector) {
+			s.Where(sql.InValues(file.EncryptionKeyColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.file_encryption_key
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "file_encryption_key" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_encryption_key" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.EncryptionKey = n
+		}
+	}
+
 	return nodes, nil
 }
 
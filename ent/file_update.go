@@ -11,8 +11,17 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
 	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
 	"github.com/sthorer/api/ent/user"
 )
 
@@ -49,6 +58,20 @@ func (fu *FileUpdate) SetUnpinnedAt(t time.Time) *FileUpdate {
 	return fu
 }
 
+// SetNillableUnpinnedAt sets the unpinned_at field if the given value is not nil.
+func (fu *FileUpdate) SetNillableUnpinnedAt(t *time.Time) *FileUpdate {
+	if t != nil {
+		fu.SetUnpinnedAt(*t)
+	}
+	return fu
+}
+
+// ClearUnpinnedAt clears the value of unpinned_at.
+func (fu *FileUpdate) ClearUnpinnedAt() *FileUpdate {
+	fu.mutation.ClearUnpinnedAt()
+	return fu
+}
+
 // SetMetadata sets the metadata field.
 func (fu *FileUpdate) SetMetadata(m map[string]interface{}) *FileUpdate {
 	fu.mutation.SetMetadata(m)
@@ -61,6 +84,58 @@ func (fu *FileUpdate) ClearMetadata() *FileUpdate {
 	return fu
 }
 
+// SetName sets the name field.
+func (fu *FileUpdate) SetName(s string) *FileUpdate {
+	fu.mutation.SetName(s)
+	return fu
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (fu *FileUpdate) SetNillableName(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetName(*s)
+	}
+	return fu
+}
+
+// ClearName clears the value of name.
+func (fu *FileUpdate) ClearName() *FileUpdate {
+	fu.mutation.ClearName()
+	return fu
+}
+
+// SetTags sets the tags field.
+func (fu *FileUpdate) SetTags(s []string) *FileUpdate {
+	fu.mutation.SetTags(s)
+	return fu
+}
+
+// ClearTags clears the value of tags.
+func (fu *FileUpdate) ClearTags() *FileUpdate {
+	fu.mutation.ClearTags()
+	return fu
+}
+
+// SetContentType sets the content_type field.
+func (fu *FileUpdate) SetContentType(s string) *FileUpdate {
+	fu.mutation.SetContentType(s)
+	return fu
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (fu *FileUpdate) SetNillableContentType(s *string) *FileUpdate {
+	if s != nil {
+		fu.SetContentType(*s)
+	}
+	return fu
+}
+
+// ClearContentType clears the value of content_type.
+func (fu *FileUpdate) ClearContentType() *FileUpdate {
+	fu.mutation.ClearContentType()
+	return fu
+}
+
 // SetUserID sets the user edge to User by id.
 func (fu *FileUpdate) SetUserID(id int) *FileUpdate {
 	fu.mutation.SetUserID(id)
@@ -72,12 +147,232 @@ func (fu *FileUpdate) SetUser(u *User) *FileUpdate {
 	return fu.SetUserID(u.ID)
 }
 
+// SetBucketID sets the bucket edge to Bucket by id.
+func (fu *FileUpdate) SetBucketID(id uuid.UUID) *FileUpdate {
+	fu.mutation.SetBucketID(id)
+	return fu
+}
+
+// SetNillableBucketID sets the bucket edge to Bucket by id if the given value is not nil.
+func (fu *FileUpdate) SetNillableBucketID(id *uuid.UUID) *FileUpdate {
+	if id != nil {
+		fu = fu.SetBucketID(*id)
+	}
+	return fu
+}
+
+// SetBucket sets the bucket edge to Bucket.
+func (fu *FileUpdate) SetBucket(b *Bucket) *FileUpdate {
+	return fu.SetBucketID(b.ID)
+}
+
+// SetFolderID sets the folder edge to Folder by id.
+func (fu *FileUpdate) SetFolderID(id uuid.UUID) *FileUpdate {
+	fu.mutation.SetFolderID(id)
+	return fu
+}
+
+// SetNillableFolderID sets the folder edge to Folder by id if the given value is not nil.
+func (fu *FileUpdate) SetNillableFolderID(id *uuid.UUID) *FileUpdate {
+	if id != nil {
+		fu = fu.SetFolderID(*id)
+	}
+	return fu
+}
+
+// SetFolder sets the folder edge to Folder.
+func (fu *FileUpdate) SetFolder(f *Folder) *FileUpdate {
+	return fu.SetFolderID(f.ID)
+}
+
+// AddIndexedTagIDs adds the indexed_tags edge to FileTag by ids.
+func (fu *FileUpdate) AddIndexedTagIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddIndexedTagIDs(ids...)
+	return fu
+}
+
+// AddIndexedTags adds the indexed_tags edges to FileTag.
+func (fu *FileUpdate) AddIndexedTags(f ...*FileTag) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddIndexedTagIDs(ids...)
+}
+
+// AddIndexedPropertyIDs adds the indexed_properties edge to FileProperty by ids.
+func (fu *FileUpdate) AddIndexedPropertyIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddIndexedPropertyIDs(ids...)
+	return fu
+}
+
+// AddIndexedProperties adds the indexed_properties edges to FileProperty.
+func (fu *FileUpdate) AddIndexedProperties(f ...*FileProperty) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddIndexedPropertyIDs(ids...)
+}
+
+// SetIndexedMediaID sets the indexed_media edge to FileMedia by id.
+func (fu *FileUpdate) SetIndexedMediaID(id int) *FileUpdate {
+	fu.mutation.SetIndexedMediaID(id)
+	return fu
+}
+
+// SetNillableIndexedMediaID sets the indexed_media edge to FileMedia by id if the given value is not nil.
+func (fu *FileUpdate) SetNillableIndexedMediaID(id *int) *FileUpdate {
+	if id != nil {
+		fu = fu.SetIndexedMediaID(*id)
+	}
+	return fu
+}
+
+// SetIndexedMedia sets the indexed_media edge to FileMedia.
+func (fu *FileUpdate) SetIndexedMedia(f *FileMedia) *FileUpdate {
+	return fu.SetIndexedMediaID(f.ID)
+}
+
+// AddThumbnailIDs adds the thumbnails edge to Thumbnail by ids.
+func (fu *FileUpdate) AddThumbnailIDs(ids ...int) *FileUpdate {
+	fu.mutation.AddThumbnailIDs(ids...)
+	return fu
+}
+
+// AddThumbnails adds the thumbnails edges to Thumbnail.
+func (fu *FileUpdate) AddThumbnails(t ...*Thumbnail) *FileUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fu.AddThumbnailIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (fu *FileUpdate) AddShareIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.AddShareIDs(ids...)
+	return fu
+}
+
+// AddShares adds the shares edges to Share.
+func (fu *FileUpdate) AddShares(s ...*Share) *FileUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fu.AddShareIDs(ids...)
+}
+
+// SetEncryptionKeyID sets the encryption_key edge to FileKey by id.
+func (fu *FileUpdate) SetEncryptionKeyID(id int) *FileUpdate {
+	fu.mutation.SetEncryptionKeyID(id)
+	return fu
+}
+
+// SetNillableEncryptionKeyID sets the encryption_key edge to FileKey by id if the given value is not nil.
+func (fu *FileUpdate) SetNillableEncryptionKeyID(id *int) *FileUpdate {
+	if id != nil {
+		fu = fu.SetEncryptionKeyID(*id)
+	}
+	return fu
+}
+
+// SetEncryptionKey sets the encryption_key edge to FileKey.
+func (fu *FileUpdate) SetEncryptionKey(f *FileKey) *FileUpdate {
+	return fu.SetEncryptionKeyID(f.ID)
+}
+
 // ClearUser clears the user edge to User.
 func (fu *FileUpdate) ClearUser() *FileUpdate {
 	fu.mutation.ClearUser()
 	return fu
 }
 
+// ClearBucket clears the bucket edge to Bucket.
+func (fu *FileUpdate) ClearBucket() *FileUpdate {
+	fu.mutation.ClearBucket()
+	return fu
+}
+
+// ClearFolder clears the folder edge to Folder.
+func (fu *FileUpdate) ClearFolder() *FileUpdate {
+	fu.mutation.ClearFolder()
+	return fu
+}
+
+// RemoveIndexedTagIDs removes the indexed_tags edge to FileTag by ids.
+func (fu *FileUpdate) RemoveIndexedTagIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveIndexedTagIDs(ids...)
+	return fu
+}
+
+// RemoveIndexedTags removes indexed_tags edges to FileTag.
+func (fu *FileUpdate) RemoveIndexedTags(f ...*FileTag) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveIndexedTagIDs(ids...)
+}
+
+// RemoveIndexedPropertyIDs removes the indexed_properties edge to FileProperty by ids.
+func (fu *FileUpdate) RemoveIndexedPropertyIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveIndexedPropertyIDs(ids...)
+	return fu
+}
+
+// RemoveIndexedProperties removes indexed_properties edges to FileProperty.
+func (fu *FileUpdate) RemoveIndexedProperties(f ...*FileProperty) *FileUpdate {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveIndexedPropertyIDs(ids...)
+}
+
+// ClearIndexedMedia clears the indexed_media edge to FileMedia.
+func (fu *FileUpdate) ClearIndexedMedia() *FileUpdate {
+	fu.mutation.ClearIndexedMedia()
+	return fu
+}
+
+// RemoveThumbnailIDs removes the thumbnails edge to Thumbnail by ids.
+func (fu *FileUpdate) RemoveThumbnailIDs(ids ...int) *FileUpdate {
+	fu.mutation.RemoveThumbnailIDs(ids...)
+	return fu
+}
+
+// RemoveThumbnails removes thumbnails edges to Thumbnail.
+func (fu *FileUpdate) RemoveThumbnails(t ...*Thumbnail) *FileUpdate {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fu.RemoveThumbnailIDs(ids...)
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (fu *FileUpdate) RemoveShareIDs(ids ...uuid.UUID) *FileUpdate {
+	fu.mutation.RemoveShareIDs(ids...)
+	return fu
+}
+
+// RemoveShares removes shares edges to Share.
+func (fu *FileUpdate) RemoveShares(s ...*Share) *FileUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fu.RemoveShareIDs(ids...)
+}
+
+// ClearEncryptionKey clears the encryption_key edge to FileKey.
+func (fu *FileUpdate) ClearEncryptionKey() *FileUpdate {
+	fu.mutation.ClearEncryptionKey()
+	return fu
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 	if v, ok := fu.mutation.Size(); ok {
@@ -85,10 +380,21 @@ func (fu *FileUpdate) Save(ctx context.Context) (int, error) {
 			return 0, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
 		}
 	}
+	if v, ok := fu.mutation.Name(); ok {
+		if err := file.NameValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if v, ok := fu.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"content_type\": %v", err)
+		}
+	}
 
 	if _, ok := fu.mutation.UserID(); fu.mutation.UserCleared() && !ok {
 		return 0, errors.New("ent: clearing a unique edge \"user\"")
 	}
+
 	var (
 		err      error
 		affected int
@@ -176,6 +482,12 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: file.FieldUnpinnedAt,
 		})
 	}
+	if fu.mutation.UnpinnedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: file.FieldUnpinnedAt,
+		})
+	}
 	if value, ok := fu.mutation.Metadata(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -189,6 +501,57 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			Column: file.FieldMetadata,
 		})
 	}
+	if fu.mutation.KeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldKey,
+		})
+	}
+	if fu.mutation.EtagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldEtag,
+		})
+	}
+	if value, ok := fu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldName,
+		})
+	}
+	if fu.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldName,
+		})
+	}
+	if value, ok := fu.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+	}
+	if fu.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldTags,
+		})
+	}
+	if value, ok := fu.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldContentType,
+		})
+	}
+	if fu.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldContentType,
+		})
+	}
 	if fu.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -224,83 +587,672 @@ func (fu *FileUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{file.Label}
-		} else if cerr, ok := isSQLConstraintError(err); ok {
-			err = cerr
+	if fu.mutation.BucketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.BucketTable,
+			Columns: []string{file.BucketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return n, nil
-}
-
-// FileUpdateOne is the builder for updating a single File entity.
-type FileUpdateOne struct {
-	config
-	hooks    []Hook
-	mutation *FileMutation
-}
-
-// SetSize sets the size field.
-func (fuo *FileUpdateOne) SetSize(i int64) *FileUpdateOne {
-	fuo.mutation.ResetSize()
-	fuo.mutation.SetSize(i)
-	return fuo
-}
-
-// AddSize adds i to size.
-func (fuo *FileUpdateOne) AddSize(i int64) *FileUpdateOne {
-	fuo.mutation.AddSize(i)
-	return fuo
-}
-
-// SetUnpinnedAt sets the unpinned_at field.
-func (fuo *FileUpdateOne) SetUnpinnedAt(t time.Time) *FileUpdateOne {
-	fuo.mutation.SetUnpinnedAt(t)
-	return fuo
-}
-
-// SetMetadata sets the metadata field.
-func (fuo *FileUpdateOne) SetMetadata(m map[string]interface{}) *FileUpdateOne {
-	fuo.mutation.SetMetadata(m)
-	return fuo
-}
-
-// ClearMetadata clears the value of metadata.
-func (fuo *FileUpdateOne) ClearMetadata() *FileUpdateOne {
-	fuo.mutation.ClearMetadata()
-	return fuo
-}
-
-// SetUserID sets the user edge to User by id.
-func (fuo *FileUpdateOne) SetUserID(id int) *FileUpdateOne {
-	fuo.mutation.SetUserID(id)
-	return fuo
-}
-
-// SetUser sets the user edge to User.
-func (fuo *FileUpdateOne) SetUser(u *User) *FileUpdateOne {
-	return fuo.SetUserID(u.ID)
-}
-
-// ClearUser clears the user edge to User.
-func (fuo *FileUpdateOne) ClearUser() *FileUpdateOne {
-	fuo.mutation.ClearUser()
-	return fuo
-}
-
-// Save executes the query and returns the updated entity.
-func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
-	if v, ok := fuo.mutation.Size(); ok {
-		if err := file.SizeValidator(v); err != nil {
-			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+	if nodes := fu.mutation.BucketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.BucketTable,
+			Columns: []string{file.BucketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
 		}
-	}
-
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.FolderTable,
+			Columns: []string{file.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.FolderTable,
+			Columns: []string{file.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedIndexedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedTagsTable,
+			Columns: []string{file.IndexedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.IndexedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedTagsTable,
+			Columns: []string{file.IndexedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedIndexedPropertiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedPropertiesTable,
+			Columns: []string{file.IndexedPropertiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fileproperty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.IndexedPropertiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedPropertiesTable,
+			Columns: []string{file.IndexedPropertiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fileproperty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.IndexedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.IndexedMediaTable,
+			Columns: []string{file.IndexedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filemedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.IndexedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.IndexedMediaTable,
+			Columns: []string{file.IndexedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filemedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: thumbnail.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: thumbnail.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.EncryptionKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.EncryptionKeyTable,
+			Columns: []string{file.EncryptionKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filekey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.EncryptionKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.EncryptionKeyTable,
+			Columns: []string{file.EncryptionKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filekey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{file.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FileUpdateOne is the builder for updating a single File entity.
+type FileUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FileMutation
+}
+
+// SetSize sets the size field.
+func (fuo *FileUpdateOne) SetSize(i int64) *FileUpdateOne {
+	fuo.mutation.ResetSize()
+	fuo.mutation.SetSize(i)
+	return fuo
+}
+
+// AddSize adds i to size.
+func (fuo *FileUpdateOne) AddSize(i int64) *FileUpdateOne {
+	fuo.mutation.AddSize(i)
+	return fuo
+}
+
+// SetUnpinnedAt sets the unpinned_at field.
+func (fuo *FileUpdateOne) SetUnpinnedAt(t time.Time) *FileUpdateOne {
+	fuo.mutation.SetUnpinnedAt(t)
+	return fuo
+}
+
+// SetNillableUnpinnedAt sets the unpinned_at field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableUnpinnedAt(t *time.Time) *FileUpdateOne {
+	if t != nil {
+		fuo.SetUnpinnedAt(*t)
+	}
+	return fuo
+}
+
+// ClearUnpinnedAt clears the value of unpinned_at.
+func (fuo *FileUpdateOne) ClearUnpinnedAt() *FileUpdateOne {
+	fuo.mutation.ClearUnpinnedAt()
+	return fuo
+}
+
+// SetMetadata sets the metadata field.
+func (fuo *FileUpdateOne) SetMetadata(m map[string]interface{}) *FileUpdateOne {
+	fuo.mutation.SetMetadata(m)
+	return fuo
+}
+
+// ClearMetadata clears the value of metadata.
+func (fuo *FileUpdateOne) ClearMetadata() *FileUpdateOne {
+	fuo.mutation.ClearMetadata()
+	return fuo
+}
+
+// SetName sets the name field.
+func (fuo *FileUpdateOne) SetName(s string) *FileUpdateOne {
+	fuo.mutation.SetName(s)
+	return fuo
+}
+
+// SetNillableName sets the name field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableName(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetName(*s)
+	}
+	return fuo
+}
+
+// ClearName clears the value of name.
+func (fuo *FileUpdateOne) ClearName() *FileUpdateOne {
+	fuo.mutation.ClearName()
+	return fuo
+}
+
+// SetTags sets the tags field.
+func (fuo *FileUpdateOne) SetTags(s []string) *FileUpdateOne {
+	fuo.mutation.SetTags(s)
+	return fuo
+}
+
+// ClearTags clears the value of tags.
+func (fuo *FileUpdateOne) ClearTags() *FileUpdateOne {
+	fuo.mutation.ClearTags()
+	return fuo
+}
+
+// SetContentType sets the content_type field.
+func (fuo *FileUpdateOne) SetContentType(s string) *FileUpdateOne {
+	fuo.mutation.SetContentType(s)
+	return fuo
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableContentType(s *string) *FileUpdateOne {
+	if s != nil {
+		fuo.SetContentType(*s)
+	}
+	return fuo
+}
+
+// ClearContentType clears the value of content_type.
+func (fuo *FileUpdateOne) ClearContentType() *FileUpdateOne {
+	fuo.mutation.ClearContentType()
+	return fuo
+}
+
+// SetUserID sets the user edge to User by id.
+func (fuo *FileUpdateOne) SetUserID(id int) *FileUpdateOne {
+	fuo.mutation.SetUserID(id)
+	return fuo
+}
+
+// SetUser sets the user edge to User.
+func (fuo *FileUpdateOne) SetUser(u *User) *FileUpdateOne {
+	return fuo.SetUserID(u.ID)
+}
+
+// SetBucketID sets the bucket edge to Bucket by id.
+func (fuo *FileUpdateOne) SetBucketID(id uuid.UUID) *FileUpdateOne {
+	fuo.mutation.SetBucketID(id)
+	return fuo
+}
+
+// SetNillableBucketID sets the bucket edge to Bucket by id if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableBucketID(id *uuid.UUID) *FileUpdateOne {
+	if id != nil {
+		fuo = fuo.SetBucketID(*id)
+	}
+	return fuo
+}
+
+// SetBucket sets the bucket edge to Bucket.
+func (fuo *FileUpdateOne) SetBucket(b *Bucket) *FileUpdateOne {
+	return fuo.SetBucketID(b.ID)
+}
+
+// SetFolderID sets the folder edge to Folder by id.
+func (fuo *FileUpdateOne) SetFolderID(id uuid.UUID) *FileUpdateOne {
+	fuo.mutation.SetFolderID(id)
+	return fuo
+}
+
+// SetNillableFolderID sets the folder edge to Folder by id if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableFolderID(id *uuid.UUID) *FileUpdateOne {
+	if id != nil {
+		fuo = fuo.SetFolderID(*id)
+	}
+	return fuo
+}
+
+// SetFolder sets the folder edge to Folder.
+func (fuo *FileUpdateOne) SetFolder(f *Folder) *FileUpdateOne {
+	return fuo.SetFolderID(f.ID)
+}
+
+// AddIndexedTagIDs adds the indexed_tags edge to FileTag by ids.
+func (fuo *FileUpdateOne) AddIndexedTagIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddIndexedTagIDs(ids...)
+	return fuo
+}
+
+// AddIndexedTags adds the indexed_tags edges to FileTag.
+func (fuo *FileUpdateOne) AddIndexedTags(f ...*FileTag) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddIndexedTagIDs(ids...)
+}
+
+// AddIndexedPropertyIDs adds the indexed_properties edge to FileProperty by ids.
+func (fuo *FileUpdateOne) AddIndexedPropertyIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddIndexedPropertyIDs(ids...)
+	return fuo
+}
+
+// AddIndexedProperties adds the indexed_properties edges to FileProperty.
+func (fuo *FileUpdateOne) AddIndexedProperties(f ...*FileProperty) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddIndexedPropertyIDs(ids...)
+}
+
+// SetIndexedMediaID sets the indexed_media edge to FileMedia by id.
+func (fuo *FileUpdateOne) SetIndexedMediaID(id int) *FileUpdateOne {
+	fuo.mutation.SetIndexedMediaID(id)
+	return fuo
+}
+
+// SetNillableIndexedMediaID sets the indexed_media edge to FileMedia by id if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableIndexedMediaID(id *int) *FileUpdateOne {
+	if id != nil {
+		fuo = fuo.SetIndexedMediaID(*id)
+	}
+	return fuo
+}
+
+// SetIndexedMedia sets the indexed_media edge to FileMedia.
+func (fuo *FileUpdateOne) SetIndexedMedia(f *FileMedia) *FileUpdateOne {
+	return fuo.SetIndexedMediaID(f.ID)
+}
+
+// AddThumbnailIDs adds the thumbnails edge to Thumbnail by ids.
+func (fuo *FileUpdateOne) AddThumbnailIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.AddThumbnailIDs(ids...)
+	return fuo
+}
+
+// AddThumbnails adds the thumbnails edges to Thumbnail.
+func (fuo *FileUpdateOne) AddThumbnails(t ...*Thumbnail) *FileUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fuo.AddThumbnailIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (fuo *FileUpdateOne) AddShareIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.AddShareIDs(ids...)
+	return fuo
+}
+
+// AddShares adds the shares edges to Share.
+func (fuo *FileUpdateOne) AddShares(s ...*Share) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fuo.AddShareIDs(ids...)
+}
+
+// SetEncryptionKeyID sets the encryption_key edge to FileKey by id.
+func (fuo *FileUpdateOne) SetEncryptionKeyID(id int) *FileUpdateOne {
+	fuo.mutation.SetEncryptionKeyID(id)
+	return fuo
+}
+
+// SetNillableEncryptionKeyID sets the encryption_key edge to FileKey by id if the given value is not nil.
+func (fuo *FileUpdateOne) SetNillableEncryptionKeyID(id *int) *FileUpdateOne {
+	if id != nil {
+		fuo = fuo.SetEncryptionKeyID(*id)
+	}
+	return fuo
+}
+
+// SetEncryptionKey sets the encryption_key edge to FileKey.
+func (fuo *FileUpdateOne) SetEncryptionKey(f *FileKey) *FileUpdateOne {
+	return fuo.SetEncryptionKeyID(f.ID)
+}
+
+// ClearUser clears the user edge to User.
+func (fuo *FileUpdateOne) ClearUser() *FileUpdateOne {
+	fuo.mutation.ClearUser()
+	return fuo
+}
+
+// ClearBucket clears the bucket edge to Bucket.
+func (fuo *FileUpdateOne) ClearBucket() *FileUpdateOne {
+	fuo.mutation.ClearBucket()
+	return fuo
+}
+
+// ClearFolder clears the folder edge to Folder.
+func (fuo *FileUpdateOne) ClearFolder() *FileUpdateOne {
+	fuo.mutation.ClearFolder()
+	return fuo
+}
+
+// RemoveIndexedTagIDs removes the indexed_tags edge to FileTag by ids.
+func (fuo *FileUpdateOne) RemoveIndexedTagIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveIndexedTagIDs(ids...)
+	return fuo
+}
+
+// RemoveIndexedTags removes indexed_tags edges to FileTag.
+func (fuo *FileUpdateOne) RemoveIndexedTags(f ...*FileTag) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveIndexedTagIDs(ids...)
+}
+
+// RemoveIndexedPropertyIDs removes the indexed_properties edge to FileProperty by ids.
+func (fuo *FileUpdateOne) RemoveIndexedPropertyIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveIndexedPropertyIDs(ids...)
+	return fuo
+}
+
+// RemoveIndexedProperties removes indexed_properties edges to FileProperty.
+func (fuo *FileUpdateOne) RemoveIndexedProperties(f ...*FileProperty) *FileUpdateOne {
+	ids := make([]int, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveIndexedPropertyIDs(ids...)
+}
+
+// ClearIndexedMedia clears the indexed_media edge to FileMedia.
+func (fuo *FileUpdateOne) ClearIndexedMedia() *FileUpdateOne {
+	fuo.mutation.ClearIndexedMedia()
+	return fuo
+}
+
+// RemoveThumbnailIDs removes the thumbnails edge to Thumbnail by ids.
+func (fuo *FileUpdateOne) RemoveThumbnailIDs(ids ...int) *FileUpdateOne {
+	fuo.mutation.RemoveThumbnailIDs(ids...)
+	return fuo
+}
+
+// RemoveThumbnails removes thumbnails edges to Thumbnail.
+func (fuo *FileUpdateOne) RemoveThumbnails(t ...*Thumbnail) *FileUpdateOne {
+	ids := make([]int, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return fuo.RemoveThumbnailIDs(ids...)
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (fuo *FileUpdateOne) RemoveShareIDs(ids ...uuid.UUID) *FileUpdateOne {
+	fuo.mutation.RemoveShareIDs(ids...)
+	return fuo
+}
+
+// RemoveShares removes shares edges to Share.
+func (fuo *FileUpdateOne) RemoveShares(s ...*Share) *FileUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return fuo.RemoveShareIDs(ids...)
+}
+
+// ClearEncryptionKey clears the encryption_key edge to FileKey.
+func (fuo *FileUpdateOne) ClearEncryptionKey() *FileUpdateOne {
+	fuo.mutation.ClearEncryptionKey()
+	return fuo
+}
+
+// Save executes the query and returns the updated entity.
+func (fuo *FileUpdateOne) Save(ctx context.Context) (*File, error) {
+	if v, ok := fuo.mutation.Size(); ok {
+		if err := file.SizeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+		}
+	}
+	if v, ok := fuo.mutation.Name(); ok {
+		if err := file.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if v, ok := fuo.mutation.ContentType(); ok {
+		if err := file.ContentTypeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"content_type\": %v", err)
+		}
+	}
+
 	if _, ok := fuo.mutation.UserID(); fuo.mutation.UserCleared() && !ok {
 		return nil, errors.New("ent: clearing a unique edge \"user\"")
 	}
+
 	var (
 		err  error
 		node *File
@@ -386,6 +1338,12 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 			Column: file.FieldUnpinnedAt,
 		})
 	}
+	if fuo.mutation.UnpinnedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: file.FieldUnpinnedAt,
+		})
+	}
 	if value, ok := fuo.mutation.Metadata(); ok {
 		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
 			Type:   field.TypeJSON,
@@ -399,6 +1357,57 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 			Column: file.FieldMetadata,
 		})
 	}
+	if fuo.mutation.KeyCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldKey,
+		})
+	}
+	if fuo.mutation.EtagCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldEtag,
+		})
+	}
+	if value, ok := fuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldName,
+		})
+	}
+	if fuo.mutation.NameCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldName,
+		})
+	}
+	if value, ok := fuo.mutation.Tags(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: file.FieldTags,
+		})
+	}
+	if fuo.mutation.TagsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Column: file.FieldTags,
+		})
+	}
+	if value, ok := fuo.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: file.FieldContentType,
+		})
+	}
+	if fuo.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: file.FieldContentType,
+		})
+	}
 	if fuo.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -434,6 +1443,298 @@ func (fuo *FileUpdateOne) sqlSave(ctx context.Context) (f *File, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if fuo.mutation.BucketCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.BucketTable,
+			Columns: []string{file.BucketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.BucketIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.BucketTable,
+			Columns: []string{file.BucketColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.FolderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.FolderTable,
+			Columns: []string{file.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.FolderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   file.FolderTable,
+			Columns: []string{file.FolderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedIndexedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedTagsTable,
+			Columns: []string{file.IndexedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.IndexedTagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedTagsTable,
+			Columns: []string{file.IndexedTagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filetag.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedIndexedPropertiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedPropertiesTable,
+			Columns: []string{file.IndexedPropertiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fileproperty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.IndexedPropertiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.IndexedPropertiesTable,
+			Columns: []string{file.IndexedPropertiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: fileproperty.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.IndexedMediaCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.IndexedMediaTable,
+			Columns: []string{file.IndexedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filemedia.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.IndexedMediaIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.IndexedMediaTable,
+			Columns: []string{file.IndexedMediaColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filemedia.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: thumbnail.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ThumbnailsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.ThumbnailsTable,
+			Columns: []string{file.ThumbnailsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: thumbnail.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   file.SharesTable,
+			Columns: []string{file.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.EncryptionKeyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.EncryptionKeyTable,
+			Columns: []string{file.EncryptionKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filekey.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.EncryptionKeyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   file.EncryptionKeyTable,
+			Columns: []string{file.EncryptionKeyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: filekey.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	f = &File{config: fuo.config}
 	_spec.Assign = f.assignValues
 	_spec.ScanValues = f.scanValues()
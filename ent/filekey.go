@@ -0,0 +1,176 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+)
+
+// FileKey is the model entity for the FileKey schema.
+type FileKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider filekey.Provider `json:"provider,omitempty"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID string `json:"key_id,omitempty"`
+	// WrappedKey holds the value of the "wrapped_key" field.
+	WrappedKey []byte `json:"-"`
+	// SegmentSize holds the value of the "segment_size" field.
+	SegmentSize int `json:"segment_size,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileKeyQuery when eager-loading is set.
+	Edges               FileKeyEdges `json:"edges"`
+	file_encryption_key *uuid.UUID
+}
+
+// FileKeyEdges holds the relations/edges for other nodes in the graph.
+type FileKeyEdges struct {
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileKeyEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileKey) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // provider
+		&sql.NullString{}, // key_id
+		&[]byte{},         // wrapped_key
+		&sql.NullInt64{},  // segment_size
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*FileKey) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // file_encryption_key
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileKey fields.
+func (fk *FileKey) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(filekey.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	fk.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field provider", values[0])
+	} else if value.Valid {
+		fk.Provider = filekey.Provider(value.String)
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field key_id", values[1])
+	} else if value.Valid {
+		fk.KeyID = value.String
+	}
+	if value, ok := values[2].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field wrapped_key", values[2])
+	} else if value != nil {
+		fk.WrappedKey = *value
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field segment_size", values[3])
+	} else if value.Valid {
+		fk.SegmentSize = int(value.Int64)
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[4])
+	} else if value.Valid {
+		fk.CreatedAt = value.Time
+	}
+	values = values[5:]
+	if len(values) == len(filekey.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_encryption_key", values[0])
+		} else if value != nil {
+			fk.file_encryption_key = value
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the file edge of the FileKey.
+func (fk *FileKey) QueryFile() *FileQuery {
+	return (&FileKeyClient{config: fk.config}).QueryFile(fk)
+}
+
+// Update returns a builder for updating this FileKey.
+// Note that, you need to call FileKey.Unwrap() before calling this method, if this FileKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fk *FileKey) Update() *FileKeyUpdateOne {
+	return (&FileKeyClient{config: fk.config}).UpdateOne(fk)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (fk *FileKey) Unwrap() *FileKey {
+	tx, ok := fk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileKey is not a transactional entity")
+	}
+	fk.config.driver = tx.drv
+	return fk
+}
+
+// String implements the fmt.Stringer.
+func (fk *FileKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileKey(")
+	builder.WriteString(fmt.Sprintf("id=%v", fk.ID))
+	builder.WriteString(", provider=")
+	builder.WriteString(fmt.Sprintf("%v", fk.Provider))
+	builder.WriteString(", key_id=")
+	builder.WriteString(fk.KeyID)
+	builder.WriteString(", wrapped_key=")
+	builder.WriteString(fmt.Sprintf("%v", fk.WrappedKey))
+	builder.WriteString(", segment_size=")
+	builder.WriteString(fmt.Sprintf("%v", fk.SegmentSize))
+	builder.WriteString(", created_at=")
+	builder.WriteString(fk.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileKeys is a parsable slice of FileKey.
+type FileKeys []*FileKey
+
+func (fk FileKeys) config(cfg config) {
+	for _i := range fk {
+		fk[_i].config = cfg
+	}
+}
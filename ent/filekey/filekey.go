@@ -0,0 +1,80 @@
+// github.com/sthorer/api
+
+package filekey
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the filekey type in the database.
+	Label = "file_key"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID          = "id"           // FieldProvider holds the string denoting the provider vertex property in the database.
+	FieldProvider    = "provider"     // FieldKeyID holds the string denoting the key_id vertex property in the database.
+	FieldKeyID       = "key_id"       // FieldWrappedKey holds the string denoting the wrapped_key vertex property in the database.
+	FieldWrappedKey  = "wrapped_key"  // FieldSegmentSize holds the string denoting the segment_size vertex property in the database.
+	FieldSegmentSize = "segment_size" // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt   = "created_at"
+
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the filekey in the database.
+	Table = "file_keys"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "file_keys"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_encryption_key"
+)
+
+// Columns holds all SQL columns for filekey fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldKeyID,
+	FieldWrappedKey,
+	FieldSegmentSize,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the FileKey type.
+var ForeignKeys = []string{
+	"file_encryption_key",
+}
+
+var (
+	// KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	KeyIDValidator func(string) error
+	// SegmentSizeValidator is a validator for the "segment_size" field. It is called by the builders before save.
+	SegmentSizeValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Provider defines the type for the provider enum field.
+type Provider string
+
+// Provider values.
+const (
+	ProviderPassphrase Provider = "Passphrase"
+	ProviderKMS        Provider = "KMS"
+)
+
+func (s Provider) String() string {
+	return string(s)
+}
+
+// ProviderValidator is a validator for the "pr" field enum values. It is called by the builders before save.
+func ProviderValidator(pr Provider) error {
+	switch pr {
+	case ProviderPassphrase, ProviderKMS:
+		return nil
+	default:
+		return fmt.Errorf("filekey: invalid enum value for provider field: %q", pr)
+	}
+}
@@ -0,0 +1,569 @@
+// github.com/sthorer/api
+
+package filekey
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyID), v))
+	})
+}
+
+// WrappedKey applies equality check predicate on the "wrapped_key" field. It's identical to WrappedKeyEQ.
+func WrappedKey(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWrappedKey), v))
+	})
+}
+
+// SegmentSize applies equality check predicate on the "segment_size" field. It's identical to SegmentSizeEQ.
+func SegmentSize(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSegmentSize), v))
+	})
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v Provider) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v Provider) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldProvider), v))
+	})
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...Provider) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldProvider), v...))
+	})
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...Provider) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldProvider), v...))
+	})
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldKeyID), v...))
+	})
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldKeyID), v...))
+	})
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.Contains(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.HasPrefix(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.HasSuffix(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EqualFold(s.C(FieldKeyID), v))
+	})
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.ContainsFold(s.C(FieldKeyID), v))
+	})
+}
+
+// WrappedKeyEQ applies the EQ predicate on the "wrapped_key" field.
+func WrappedKeyEQ(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWrappedKey), v))
+	})
+}
+
+// WrappedKeyNEQ applies the NEQ predicate on the "wrapped_key" field.
+func WrappedKeyNEQ(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWrappedKey), v))
+	})
+}
+
+// WrappedKeyIn applies the In predicate on the "wrapped_key" field.
+func WrappedKeyIn(vs ...[]byte) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWrappedKey), v...))
+	})
+}
+
+// WrappedKeyNotIn applies the NotIn predicate on the "wrapped_key" field.
+func WrappedKeyNotIn(vs ...[]byte) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWrappedKey), v...))
+	})
+}
+
+// WrappedKeyGT applies the GT predicate on the "wrapped_key" field.
+func WrappedKeyGT(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWrappedKey), v))
+	})
+}
+
+// WrappedKeyGTE applies the GTE predicate on the "wrapped_key" field.
+func WrappedKeyGTE(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWrappedKey), v))
+	})
+}
+
+// WrappedKeyLT applies the LT predicate on the "wrapped_key" field.
+func WrappedKeyLT(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWrappedKey), v))
+	})
+}
+
+// WrappedKeyLTE applies the LTE predicate on the "wrapped_key" field.
+func WrappedKeyLTE(v []byte) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWrappedKey), v))
+	})
+}
+
+// SegmentSizeEQ applies the EQ predicate on the "segment_size" field.
+func SegmentSizeEQ(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldSegmentSize), v))
+	})
+}
+
+// SegmentSizeNEQ applies the NEQ predicate on the "segment_size" field.
+func SegmentSizeNEQ(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldSegmentSize), v))
+	})
+}
+
+// SegmentSizeIn applies the In predicate on the "segment_size" field.
+func SegmentSizeIn(vs ...int) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldSegmentSize), v...))
+	})
+}
+
+// SegmentSizeNotIn applies the NotIn predicate on the "segment_size" field.
+func SegmentSizeNotIn(vs ...int) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldSegmentSize), v...))
+	})
+}
+
+// SegmentSizeGT applies the GT predicate on the "segment_size" field.
+func SegmentSizeGT(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldSegmentSize), v))
+	})
+}
+
+// SegmentSizeGTE applies the GTE predicate on the "segment_size" field.
+func SegmentSizeGTE(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldSegmentSize), v))
+	})
+}
+
+// SegmentSizeLT applies the LT predicate on the "segment_size" field.
+func SegmentSizeLT(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldSegmentSize), v))
+	})
+}
+
+// SegmentSizeLTE applies the LTE predicate on the "segment_size" field.
+func SegmentSizeLTE(v int) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldSegmentSize), v))
+	})
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FileKey {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileKey(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldCreatedAt), v...))
+	})
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldCreatedAt), v))
+	})
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldCreatedAt), v))
+	})
+}
+
+// HasFile applies the HasEdge predicate on the "file" edge.
+func HasFile() predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FileTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileWith applies the HasEdge predicate on the "file" edge with a given conditions (other predicates).
+func HasFileWith(preds ...predicate.File) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FileInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.FileKey) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.FileKey) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileKey) predicate.FileKey {
+	return predicate.FileKey(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
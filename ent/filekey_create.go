@@ -0,0 +1,224 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+)
+
+// FileKeyCreate is the builder for creating a FileKey entity.
+type FileKeyCreate struct {
+	config
+	mutation *FileKeyMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the provider field.
+func (fkc *FileKeyCreate) SetProvider(f filekey.Provider) *FileKeyCreate {
+	fkc.mutation.SetProvider(f)
+	return fkc
+}
+
+// SetKeyID sets the key_id field.
+func (fkc *FileKeyCreate) SetKeyID(s string) *FileKeyCreate {
+	fkc.mutation.SetKeyID(s)
+	return fkc
+}
+
+// SetWrappedKey sets the wrapped_key field.
+func (fkc *FileKeyCreate) SetWrappedKey(b []byte) *FileKeyCreate {
+	fkc.mutation.SetWrappedKey(b)
+	return fkc
+}
+
+// SetSegmentSize sets the segment_size field.
+func (fkc *FileKeyCreate) SetSegmentSize(i int) *FileKeyCreate {
+	fkc.mutation.SetSegmentSize(i)
+	return fkc
+}
+
+// SetCreatedAt sets the created_at field.
+func (fkc *FileKeyCreate) SetCreatedAt(t time.Time) *FileKeyCreate {
+	fkc.mutation.SetCreatedAt(t)
+	return fkc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (fkc *FileKeyCreate) SetNillableCreatedAt(t *time.Time) *FileKeyCreate {
+	if t != nil {
+		fkc.SetCreatedAt(*t)
+	}
+	return fkc
+}
+
+// SetFileID sets the file edge to File by id.
+func (fkc *FileKeyCreate) SetFileID(id uuid.UUID) *FileKeyCreate {
+	fkc.mutation.SetFileID(id)
+	return fkc
+}
+
+// SetFile sets the file edge to File.
+func (fkc *FileKeyCreate) SetFile(f *File) *FileKeyCreate {
+	return fkc.SetFileID(f.ID)
+}
+
+// Save creates the FileKey in the database.
+func (fkc *FileKeyCreate) Save(ctx context.Context) (*FileKey, error) {
+	if _, ok := fkc.mutation.Provider(); !ok {
+		return nil, errors.New("ent: missing required field \"provider\"")
+	}
+	if v, ok := fkc.mutation.Provider(); ok {
+		if err := filekey.ProviderValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"provider\": %v", err)
+		}
+	}
+	if _, ok := fkc.mutation.KeyID(); !ok {
+		return nil, errors.New("ent: missing required field \"key_id\"")
+	}
+	if v, ok := fkc.mutation.KeyID(); ok {
+		if err := filekey.KeyIDValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"key_id\": %v", err)
+		}
+	}
+	if _, ok := fkc.mutation.WrappedKey(); !ok {
+		return nil, errors.New("ent: missing required field \"wrapped_key\"")
+	}
+	if _, ok := fkc.mutation.SegmentSize(); !ok {
+		return nil, errors.New("ent: missing required field \"segment_size\"")
+	}
+	if v, ok := fkc.mutation.SegmentSize(); ok {
+		if err := filekey.SegmentSizeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"segment_size\": %v", err)
+		}
+	}
+	if _, ok := fkc.mutation.CreatedAt(); !ok {
+		v := filekey.DefaultCreatedAt()
+		fkc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fkc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileKey
+	)
+	if len(fkc.hooks) == 0 {
+		node, err = fkc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fkc.mutation = mutation
+			node, err = fkc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fkc.hooks) - 1; i >= 0; i-- {
+			mut = fkc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fkc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fkc *FileKeyCreate) SaveX(ctx context.Context) *FileKey {
+	v, err := fkc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fkc *FileKeyCreate) sqlSave(ctx context.Context) (*FileKey, error) {
+	var (
+		fk    = &FileKey{config: fkc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: filekey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filekey.FieldID,
+			},
+		}
+	)
+	if value, ok := fkc.mutation.Provider(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: filekey.FieldProvider,
+		})
+		fk.Provider = value
+	}
+	if value, ok := fkc.mutation.KeyID(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filekey.FieldKeyID,
+		})
+		fk.KeyID = value
+	}
+	if value, ok := fkc.mutation.WrappedKey(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBytes,
+			Value:  value,
+			Column: filekey.FieldWrappedKey,
+		})
+		fk.WrappedKey = value
+	}
+	if value, ok := fkc.mutation.SegmentSize(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filekey.FieldSegmentSize,
+		})
+		fk.SegmentSize = value
+	}
+	if value, ok := fkc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: filekey.FieldCreatedAt,
+		})
+		fk.CreatedAt = value
+	}
+	if nodes := fkc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filekey.FileTable,
+			Columns: []string{filekey.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, fkc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	fk.ID = int(id)
+	return fk, nil
+}
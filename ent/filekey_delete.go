@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileKeyDelete is the builder for deleting a FileKey entity.
+type FileKeyDelete struct {
+	config
+	hooks      []Hook
+	mutation   *FileKeyMutation
+	predicates []predicate.FileKey
+}
+
+// Where adds a new predicate to the delete builder.
+func (fkd *FileKeyDelete) Where(ps ...predicate.FileKey) *FileKeyDelete {
+	fkd.predicates = append(fkd.predicates, ps...)
+	return fkd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fkd *FileKeyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fkd.hooks) == 0 {
+		affected, err = fkd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fkd.mutation = mutation
+			affected, err = fkd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(fkd.hooks) - 1; i >= 0; i-- {
+			mut = fkd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fkd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fkd *FileKeyDelete) ExecX(ctx context.Context) int {
+	n, err := fkd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fkd *FileKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: filekey.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filekey.FieldID,
+			},
+		},
+	}
+	if ps := fkd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, fkd.driver, _spec)
+}
+
+// FileKeyDeleteOne is the builder for deleting a single FileKey entity.
+type FileKeyDeleteOne struct {
+	fkd *FileKeyDelete
+}
+
+// Exec executes the deletion query.
+func (fkdo *FileKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := fkdo.fkd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{filekey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fkdo *FileKeyDeleteOne) ExecX(ctx context.Context) {
+	fkdo.fkd.ExecX(ctx)
+}
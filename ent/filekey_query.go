@@ -0,0 +1,732 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileKeyQuery is the builder for querying FileKey entities.
+type FileKeyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.FileKey
+	// eager-loading edges.
+	withFile *FileQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (fkq *FileKeyQuery) Where(ps ...predicate.FileKey) *FileKeyQuery {
+	fkq.predicates = append(fkq.predicates, ps...)
+	return fkq
+}
+
+// Limit adds a limit step to the query.
+func (fkq *FileKeyQuery) Limit(limit int) *FileKeyQuery {
+	fkq.limit = &limit
+	return fkq
+}
+
+// Offset adds an offset step to the query.
+func (fkq *FileKeyQuery) Offset(offset int) *FileKeyQuery {
+	fkq.offset = &offset
+	return fkq
+}
+
+// Order adds an order step to the query.
+func (fkq *FileKeyQuery) Order(o ...Order) *FileKeyQuery {
+	fkq.order = append(fkq.order, o...)
+	return fkq
+}
+
+// QueryFile chains the current query on the file edge.
+func (fkq *FileKeyQuery) QueryFile() *FileQuery {
+	query := &FileQuery{config: fkq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filekey.Table, filekey.FieldID, fkq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, filekey.FileTable, filekey.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fkq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FileKey entity in the query. Returns *NotFoundError when no filekey was found.
+func (fkq *FileKeyQuery) First(ctx context.Context) (*FileKey, error) {
+	fks, err := fkq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(fks) == 0 {
+		return nil, &NotFoundError{filekey.Label}
+	}
+	return fks[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fkq *FileKeyQuery) FirstX(ctx context.Context) *FileKey {
+	fk, err := fkq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return fk
+}
+
+// FirstID returns the first FileKey id in the query. Returns *NotFoundError when no id was found.
+func (fkq *FileKeyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fkq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{filekey.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (fkq *FileKeyQuery) FirstXID(ctx context.Context) int {
+	id, err := fkq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only FileKey entity in the query, returns an error if not exactly one entity was returned.
+func (fkq *FileKeyQuery) Only(ctx context.Context) (*FileKey, error) {
+	fks, err := fkq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fks) {
+	case 1:
+		return fks[0], nil
+	case 0:
+		return nil, &NotFoundError{filekey.Label}
+	default:
+		return nil, &NotSingularError{filekey.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fkq *FileKeyQuery) OnlyX(ctx context.Context) *FileKey {
+	fk, err := fkq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fk
+}
+
+// OnlyID returns the only FileKey id in the query, returns an error if not exactly one id was returned.
+func (fkq *FileKeyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fkq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{filekey.Label}
+	default:
+		err = &NotSingularError{filekey.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (fkq *FileKeyQuery) OnlyXID(ctx context.Context) int {
+	id, err := fkq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileKeys.
+func (fkq *FileKeyQuery) All(ctx context.Context) ([]*FileKey, error) {
+	if err := fkq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fkq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fkq *FileKeyQuery) AllX(ctx context.Context) []*FileKey {
+	fks, err := fkq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fks
+}
+
+// IDs executes the query and returns a list of FileKey ids.
+func (fkq *FileKeyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := fkq.Select(filekey.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fkq *FileKeyQuery) IDsX(ctx context.Context) []int {
+	ids, err := fkq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fkq *FileKeyQuery) Count(ctx context.Context) (int, error) {
+	if err := fkq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fkq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fkq *FileKeyQuery) CountX(ctx context.Context) int {
+	count, err := fkq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fkq *FileKeyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fkq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fkq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fkq *FileKeyQuery) ExistX(ctx context.Context) bool {
+	exist, err := fkq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fkq *FileKeyQuery) Clone() *FileKeyQuery {
+	return &FileKeyQuery{
+		config:     fkq.config,
+		limit:      fkq.limit,
+		offset:     fkq.offset,
+		order:      append([]Order{}, fkq.order...),
+		unique:     append([]string{}, fkq.unique...),
+		predicates: append([]predicate.FileKey{}, fkq.predicates...),
+		// clone intermediate query.
+		sql:  fkq.sql.Clone(),
+		path: fkq.path,
+	}
+}
+
+//  WithFile tells the query-builder to eager-loads the nodes that are connected to
+// the "file" edge. The optional arguments used to configure the query builder of the edge.
+func (fkq *FileKeyQuery) WithFile(opts ...func(*FileQuery)) *FileKeyQuery {
+	query := &FileQuery{config: fkq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fkq.withFile = query
+	return fkq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider filekey.Provider `json:"provider,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileKey.Query().
+//		GroupBy(filekey.FieldProvider).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (fkq *FileKeyQuery) GroupBy(field string, fields ...string) *FileKeyGroupBy {
+	group := &FileKeyGroupBy{config: fkq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fkq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Provider filekey.Provider `json:"provider,omitempty"`
+//	}
+//
+//	client.FileKey.Query().
+//		Select(filekey.FieldProvider).
+//		Scan(ctx, &v)
+//
+func (fkq *FileKeyQuery) Select(field string, fields ...string) *FileKeySelect {
+	selector := &FileKeySelect{config: fkq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fkq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fkq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (fkq *FileKeyQuery) prepareQuery(ctx context.Context) error {
+	if fkq.path != nil {
+		prev, err := fkq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fkq.sql = prev
+	}
+	return nil
+}
+
+func (fkq *FileKeyQuery) sqlAll(ctx context.Context) ([]*FileKey, error) {
+	var (
+		nodes       = []*FileKey{}
+		withFKs     = fkq.withFKs
+		_spec       = fkq.querySpec()
+		loadedTypes = [1]bool{
+			fkq.withFile != nil,
+		}
+	)
+	if fkq.withFile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, filekey.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &FileKey{config: fkq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fkq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := fkq.withFile; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*FileKey)
+		for i := range nodes {
+			if fk := nodes[i].file_encryption_key; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(file.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_encryption_key" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.File = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (fkq *FileKeyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fkq.querySpec()
+	return sqlgraph.CountNodes(ctx, fkq.driver, _spec)
+}
+
+func (fkq *FileKeyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fkq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (fkq *FileKeyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filekey.Table,
+			Columns: filekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filekey.FieldID,
+			},
+		},
+		From:   fkq.sql,
+		Unique: true,
+	}
+	if ps := fkq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fkq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fkq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fkq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fkq *FileKeyQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(fkq.driver.Dialect())
+	t1 := builder.Table(filekey.Table)
+	selector := builder.Select(t1.Columns(filekey.Columns...)...).From(t1)
+	if fkq.sql != nil {
+		selector = fkq.sql
+		selector.Select(selector.Columns(filekey.Columns...)...)
+	}
+	for _, p := range fkq.predicates {
+		p(selector)
+	}
+	for _, p := range fkq.order {
+		p(selector)
+	}
+	if offset := fkq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fkq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileKeyGroupBy is the builder for group-by FileKey entities.
+type FileKeyGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fkgb *FileKeyGroupBy) Aggregate(fns ...Aggregate) *FileKeyGroupBy {
+	fkgb.fns = append(fkgb.fns, fns...)
+	return fkgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (fkgb *FileKeyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fkgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fkgb.sql = query
+	return fkgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fkgb *FileKeyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := fkgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (fkgb *FileKeyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(fkgb.fields) > 1 {
+		return nil, errors.New("ent: FileKeyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := fkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fkgb *FileKeyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := fkgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (fkgb *FileKeyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(fkgb.fields) > 1 {
+		return nil, errors.New("ent: FileKeyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := fkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fkgb *FileKeyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := fkgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (fkgb *FileKeyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fkgb.fields) > 1 {
+		return nil, errors.New("ent: FileKeyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := fkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fkgb *FileKeyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := fkgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (fkgb *FileKeyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(fkgb.fields) > 1 {
+		return nil, errors.New("ent: FileKeyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := fkgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fkgb *FileKeyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := fkgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fkgb *FileKeyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fkgb.sqlQuery().Query()
+	if err := fkgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fkgb *FileKeyGroupBy) sqlQuery() *sql.Selector {
+	selector := fkgb.sql
+	columns := make([]string, 0, len(fkgb.fields)+len(fkgb.fns))
+	columns = append(columns, fkgb.fields...)
+	for _, fn := range fkgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(fkgb.fields...)
+}
+
+// FileKeySelect is the builder for select fields of FileKey entities.
+type FileKeySelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (fks *FileKeySelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := fks.path(ctx)
+	if err != nil {
+		return err
+	}
+	fks.sql = query
+	return fks.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fks *FileKeySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := fks.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (fks *FileKeySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(fks.fields) > 1 {
+		return nil, errors.New("ent: FileKeySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := fks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fks *FileKeySelect) StringsX(ctx context.Context) []string {
+	v, err := fks.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (fks *FileKeySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(fks.fields) > 1 {
+		return nil, errors.New("ent: FileKeySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := fks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fks *FileKeySelect) IntsX(ctx context.Context) []int {
+	v, err := fks.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (fks *FileKeySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fks.fields) > 1 {
+		return nil, errors.New("ent: FileKeySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := fks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fks *FileKeySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := fks.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (fks *FileKeySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(fks.fields) > 1 {
+		return nil, errors.New("ent: FileKeySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := fks.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fks *FileKeySelect) BoolsX(ctx context.Context) []bool {
+	v, err := fks.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fks *FileKeySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fks.sqlQuery().Query()
+	if err := fks.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fks *FileKeySelect) sqlQuery() sql.Querier {
+	selector := fks.sql
+	selector.Select(selector.Columns(fks.fields...)...)
+	return selector
+}
@@ -0,0 +1,309 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileKeyUpdate is the builder for updating FileKey entities.
+type FileKeyUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *FileKeyMutation
+	predicates []predicate.FileKey
+}
+
+// Where adds a new predicate for the builder.
+func (fku *FileKeyUpdate) Where(ps ...predicate.FileKey) *FileKeyUpdate {
+	fku.predicates = append(fku.predicates, ps...)
+	return fku
+}
+
+// SetFileID sets the file edge to File by id.
+func (fku *FileKeyUpdate) SetFileID(id uuid.UUID) *FileKeyUpdate {
+	fku.mutation.SetFileID(id)
+	return fku
+}
+
+// SetFile sets the file edge to File.
+func (fku *FileKeyUpdate) SetFile(f *File) *FileKeyUpdate {
+	return fku.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fku *FileKeyUpdate) ClearFile() *FileKeyUpdate {
+	fku.mutation.ClearFile()
+	return fku
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (fku *FileKeyUpdate) Save(ctx context.Context) (int, error) {
+
+	if _, ok := fku.mutation.FileID(); fku.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(fku.hooks) == 0 {
+		affected, err = fku.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fku.mutation = mutation
+			affected, err = fku.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(fku.hooks) - 1; i >= 0; i-- {
+			mut = fku.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fku.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fku *FileKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := fku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fku *FileKeyUpdate) Exec(ctx context.Context) error {
+	_, err := fku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fku *FileKeyUpdate) ExecX(ctx context.Context) {
+	if err := fku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fku *FileKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filekey.Table,
+			Columns: filekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filekey.FieldID,
+			},
+		},
+	}
+	if ps := fku.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if fku.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filekey.FileTable,
+			Columns: []string{filekey.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fku.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filekey.FileTable,
+			Columns: []string{filekey.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filekey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FileKeyUpdateOne is the builder for updating a single FileKey entity.
+type FileKeyUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FileKeyMutation
+}
+
+// SetFileID sets the file edge to File by id.
+func (fkuo *FileKeyUpdateOne) SetFileID(id uuid.UUID) *FileKeyUpdateOne {
+	fkuo.mutation.SetFileID(id)
+	return fkuo
+}
+
+// SetFile sets the file edge to File.
+func (fkuo *FileKeyUpdateOne) SetFile(f *File) *FileKeyUpdateOne {
+	return fkuo.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fkuo *FileKeyUpdateOne) ClearFile() *FileKeyUpdateOne {
+	fkuo.mutation.ClearFile()
+	return fkuo
+}
+
+// Save executes the query and returns the updated entity.
+func (fkuo *FileKeyUpdateOne) Save(ctx context.Context) (*FileKey, error) {
+
+	if _, ok := fkuo.mutation.FileID(); fkuo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileKey
+	)
+	if len(fkuo.hooks) == 0 {
+		node, err = fkuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileKeyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fkuo.mutation = mutation
+			node, err = fkuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fkuo.hooks) - 1; i >= 0; i-- {
+			mut = fkuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fkuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fkuo *FileKeyUpdateOne) SaveX(ctx context.Context) *FileKey {
+	fk, err := fkuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fk
+}
+
+// Exec executes the query on the entity.
+func (fkuo *FileKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := fkuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fkuo *FileKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := fkuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fkuo *FileKeyUpdateOne) sqlSave(ctx context.Context) (fk *FileKey, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filekey.Table,
+			Columns: filekey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filekey.FieldID,
+			},
+		},
+	}
+	id, ok := fkuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing FileKey.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if fkuo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filekey.FileTable,
+			Columns: []string{filekey.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fkuo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filekey.FileTable,
+			Columns: []string{filekey.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	fk = &FileKey{config: fkuo.config}
+	_spec.Assign = fk.assignValues
+	_spec.ScanValues = fk.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, fkuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filekey.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return fk, nil
+}
@@ -0,0 +1,191 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+)
+
+// FileMedia is the model entity for the FileMedia schema.
+type FileMedia struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Width holds the value of the "width" field.
+	Width *int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height *int `json:"height,omitempty"`
+	// Duration holds the value of the "duration" field.
+	Duration *float64 `json:"duration,omitempty"`
+	// Pages holds the value of the "pages" field.
+	Pages *int `json:"pages,omitempty"`
+	// TakenAt holds the value of the "taken_at" field.
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileMediaQuery when eager-loading is set.
+	Edges              FileMediaEdges `json:"edges"`
+	file_indexed_media *uuid.UUID
+}
+
+// FileMediaEdges holds the relations/edges for other nodes in the graph.
+type FileMediaEdges struct {
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileMediaEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileMedia) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},   // id
+		&sql.NullInt64{},   // width
+		&sql.NullInt64{},   // height
+		&sql.NullFloat64{}, // duration
+		&sql.NullInt64{},   // pages
+		&sql.NullTime{},    // taken_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*FileMedia) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // file_indexed_media
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileMedia fields.
+func (fm *FileMedia) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(filemedia.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	fm.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field width", values[0])
+	} else if value.Valid {
+		fm.Width = new(int)
+		*fm.Width = int(value.Int64)
+	}
+	if value, ok := values[1].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field height", values[1])
+	} else if value.Valid {
+		fm.Height = new(int)
+		*fm.Height = int(value.Int64)
+	}
+	if value, ok := values[2].(*sql.NullFloat64); !ok {
+		return fmt.Errorf("unexpected type %T for field duration", values[2])
+	} else if value.Valid {
+		fm.Duration = new(float64)
+		*fm.Duration = value.Float64
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field pages", values[3])
+	} else if value.Valid {
+		fm.Pages = new(int)
+		*fm.Pages = int(value.Int64)
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field taken_at", values[4])
+	} else if value.Valid {
+		fm.TakenAt = new(time.Time)
+		*fm.TakenAt = value.Time
+	}
+	values = values[5:]
+	if len(values) == len(filemedia.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_indexed_media", values[0])
+		} else if value != nil {
+			fm.file_indexed_media = value
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the file edge of the FileMedia.
+func (fm *FileMedia) QueryFile() *FileQuery {
+	return (&FileMediaClient{config: fm.config}).QueryFile(fm)
+}
+
+// Update returns a builder for updating this FileMedia.
+// Note that, you need to call FileMedia.Unwrap() before calling this method, if this FileMedia
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fm *FileMedia) Update() *FileMediaUpdateOne {
+	return (&FileMediaClient{config: fm.config}).UpdateOne(fm)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (fm *FileMedia) Unwrap() *FileMedia {
+	tx, ok := fm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileMedia is not a transactional entity")
+	}
+	fm.config.driver = tx.drv
+	return fm
+}
+
+// String implements the fmt.Stringer.
+func (fm *FileMedia) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileMedia(")
+	builder.WriteString(fmt.Sprintf("id=%v", fm.ID))
+	if v := fm.Width; v != nil {
+		builder.WriteString(", width=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := fm.Height; v != nil {
+		builder.WriteString(", height=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := fm.Duration; v != nil {
+		builder.WriteString(", duration=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := fm.Pages; v != nil {
+		builder.WriteString(", pages=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	if v := fm.TakenAt; v != nil {
+		builder.WriteString(", taken_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileMediaSlice is a parsable slice of FileMedia.
+type FileMediaSlice []*FileMedia
+
+func (fm FileMediaSlice) config(cfg config) {
+	for _i := range fm {
+		fm[_i].config = cfg
+	}
+}
@@ -0,0 +1,54 @@
+// github.com/sthorer/api
+
+package filemedia
+
+const (
+	// Label holds the string label denoting the filemedia type in the database.
+	Label = "file_media"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID       = "id"       // FieldWidth holds the string denoting the width vertex property in the database.
+	FieldWidth    = "width"    // FieldHeight holds the string denoting the height vertex property in the database.
+	FieldHeight   = "height"   // FieldDuration holds the string denoting the duration vertex property in the database.
+	FieldDuration = "duration" // FieldPages holds the string denoting the pages vertex property in the database.
+	FieldPages    = "pages"    // FieldTakenAt holds the string denoting the taken_at vertex property in the database.
+	FieldTakenAt  = "taken_at"
+
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the filemedia in the database.
+	Table = "file_media"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "file_media"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_indexed_media"
+)
+
+// Columns holds all SQL columns for filemedia fields.
+var Columns = []string{
+	FieldID,
+	FieldWidth,
+	FieldHeight,
+	FieldDuration,
+	FieldPages,
+	FieldTakenAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the FileMedia type.
+var ForeignKeys = []string{
+	"file_indexed_media",
+}
+
+var (
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(int) error
+	// HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	HeightValidator func(int) error
+	// DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	DurationValidator func(float64) error
+	// PagesValidator is a validator for the "pages" field. It is called by the builders before save.
+	PagesValidator func(int) error
+)
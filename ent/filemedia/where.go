@@ -0,0 +1,639 @@
+// github.com/sthorer/api
+
+package filemedia
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// ID filters vertices based on their identifier.
+func ID(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldID), id))
+	})
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldID), id))
+	})
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.In(s.C(FieldID), v...))
+	})
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(ids) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		v := make([]interface{}, len(ids))
+		for i := range v {
+			v[i] = ids[i]
+		}
+		s.Where(sql.NotIn(s.C(FieldID), v...))
+	})
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldID), id))
+	})
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldID), id))
+	})
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldID), id))
+	})
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldID), id))
+	})
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWidth), v))
+	})
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeight), v))
+	})
+}
+
+// Duration applies equality check predicate on the "duration" field. It's identical to DurationEQ.
+func Duration(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), v))
+	})
+}
+
+// Pages applies equality check predicate on the "pages" field. It's identical to PagesEQ.
+func Pages(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPages), v))
+	})
+}
+
+// TakenAt applies equality check predicate on the "taken_at" field. It's identical to TakenAtEQ.
+func TakenAt(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTakenAt), v))
+	})
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldWidth), v))
+	})
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldWidth), v))
+	})
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldWidth), v...))
+	})
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldWidth), v...))
+	})
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldWidth), v))
+	})
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldWidth), v))
+	})
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldWidth), v))
+	})
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldWidth), v))
+	})
+}
+
+// WidthIsNil applies the IsNil predicate on the "width" field.
+func WidthIsNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldWidth)))
+	})
+}
+
+// WidthNotNil applies the NotNil predicate on the "width" field.
+func WidthNotNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldWidth)))
+	})
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldHeight), v))
+	})
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldHeight), v))
+	})
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldHeight), v...))
+	})
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldHeight), v...))
+	})
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldHeight), v))
+	})
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldHeight), v))
+	})
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldHeight), v))
+	})
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldHeight), v))
+	})
+}
+
+// HeightIsNil applies the IsNil predicate on the "height" field.
+func HeightIsNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldHeight)))
+	})
+}
+
+// HeightNotNil applies the NotNil predicate on the "height" field.
+func HeightNotNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldHeight)))
+	})
+}
+
+// DurationEQ applies the EQ predicate on the "duration" field.
+func DurationEQ(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldDuration), v))
+	})
+}
+
+// DurationNEQ applies the NEQ predicate on the "duration" field.
+func DurationNEQ(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldDuration), v))
+	})
+}
+
+// DurationIn applies the In predicate on the "duration" field.
+func DurationIn(vs ...float64) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationNotIn applies the NotIn predicate on the "duration" field.
+func DurationNotIn(vs ...float64) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldDuration), v...))
+	})
+}
+
+// DurationGT applies the GT predicate on the "duration" field.
+func DurationGT(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldDuration), v))
+	})
+}
+
+// DurationGTE applies the GTE predicate on the "duration" field.
+func DurationGTE(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldDuration), v))
+	})
+}
+
+// DurationLT applies the LT predicate on the "duration" field.
+func DurationLT(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldDuration), v))
+	})
+}
+
+// DurationLTE applies the LTE predicate on the "duration" field.
+func DurationLTE(v float64) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldDuration), v))
+	})
+}
+
+// DurationIsNil applies the IsNil predicate on the "duration" field.
+func DurationIsNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldDuration)))
+	})
+}
+
+// DurationNotNil applies the NotNil predicate on the "duration" field.
+func DurationNotNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldDuration)))
+	})
+}
+
+// PagesEQ applies the EQ predicate on the "pages" field.
+func PagesEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldPages), v))
+	})
+}
+
+// PagesNEQ applies the NEQ predicate on the "pages" field.
+func PagesNEQ(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldPages), v))
+	})
+}
+
+// PagesIn applies the In predicate on the "pages" field.
+func PagesIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldPages), v...))
+	})
+}
+
+// PagesNotIn applies the NotIn predicate on the "pages" field.
+func PagesNotIn(vs ...int) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldPages), v...))
+	})
+}
+
+// PagesGT applies the GT predicate on the "pages" field.
+func PagesGT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldPages), v))
+	})
+}
+
+// PagesGTE applies the GTE predicate on the "pages" field.
+func PagesGTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldPages), v))
+	})
+}
+
+// PagesLT applies the LT predicate on the "pages" field.
+func PagesLT(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldPages), v))
+	})
+}
+
+// PagesLTE applies the LTE predicate on the "pages" field.
+func PagesLTE(v int) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldPages), v))
+	})
+}
+
+// PagesIsNil applies the IsNil predicate on the "pages" field.
+func PagesIsNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldPages)))
+	})
+}
+
+// PagesNotNil applies the NotNil predicate on the "pages" field.
+func PagesNotNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldPages)))
+	})
+}
+
+// TakenAtEQ applies the EQ predicate on the "taken_at" field.
+func TakenAtEQ(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.EQ(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtNEQ applies the NEQ predicate on the "taken_at" field.
+func TakenAtNEQ(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NEQ(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtIn applies the In predicate on the "taken_at" field.
+func TakenAtIn(vs ...time.Time) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.In(s.C(FieldTakenAt), v...))
+	})
+}
+
+// TakenAtNotIn applies the NotIn predicate on the "taken_at" field.
+func TakenAtNotIn(vs ...time.Time) predicate.FileMedia {
+	v := make([]interface{}, len(vs))
+	for i := range v {
+		v[i] = vs[i]
+	}
+	return predicate.FileMedia(func(s *sql.Selector) {
+		// if not arguments were provided, append the FALSE constants,
+		// since we can't apply "IN ()". This will make this predicate falsy.
+		if len(vs) == 0 {
+			s.Where(sql.False())
+			return
+		}
+		s.Where(sql.NotIn(s.C(FieldTakenAt), v...))
+	})
+}
+
+// TakenAtGT applies the GT predicate on the "taken_at" field.
+func TakenAtGT(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GT(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtGTE applies the GTE predicate on the "taken_at" field.
+func TakenAtGTE(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.GTE(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtLT applies the LT predicate on the "taken_at" field.
+func TakenAtLT(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LT(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtLTE applies the LTE predicate on the "taken_at" field.
+func TakenAtLTE(v time.Time) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.LTE(s.C(FieldTakenAt), v))
+	})
+}
+
+// TakenAtIsNil applies the IsNil predicate on the "taken_at" field.
+func TakenAtIsNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.IsNull(s.C(FieldTakenAt)))
+	})
+}
+
+// TakenAtNotNil applies the NotNil predicate on the "taken_at" field.
+func TakenAtNotNil() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s.Where(sql.NotNull(s.C(FieldTakenAt)))
+	})
+}
+
+// HasFile applies the HasEdge predicate on the "file" edge.
+func HasFile() predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FileTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFileWith applies the HasEdge predicate on the "file" edge with a given conditions (other predicates).
+func HasFileWith(preds ...predicate.File) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.To(FileInverseTable, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, FileTable, FileColumn),
+		)
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups list of predicates with the AND operator between them.
+func And(predicates ...predicate.FileMedia) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups list of predicates with the OR operator between them.
+func Or(predicates ...predicate.FileMedia) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FileMedia) predicate.FileMedia {
+	return predicate.FileMedia(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
@@ -0,0 +1,245 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+)
+
+// FileMediaCreate is the builder for creating a FileMedia entity.
+type FileMediaCreate struct {
+	config
+	mutation *FileMediaMutation
+	hooks    []Hook
+}
+
+// SetWidth sets the width field.
+func (fmc *FileMediaCreate) SetWidth(i int) *FileMediaCreate {
+	fmc.mutation.SetWidth(i)
+	return fmc
+}
+
+// SetNillableWidth sets the width field if the given value is not nil.
+func (fmc *FileMediaCreate) SetNillableWidth(i *int) *FileMediaCreate {
+	if i != nil {
+		fmc.SetWidth(*i)
+	}
+	return fmc
+}
+
+// SetHeight sets the height field.
+func (fmc *FileMediaCreate) SetHeight(i int) *FileMediaCreate {
+	fmc.mutation.SetHeight(i)
+	return fmc
+}
+
+// SetNillableHeight sets the height field if the given value is not nil.
+func (fmc *FileMediaCreate) SetNillableHeight(i *int) *FileMediaCreate {
+	if i != nil {
+		fmc.SetHeight(*i)
+	}
+	return fmc
+}
+
+// SetDuration sets the duration field.
+func (fmc *FileMediaCreate) SetDuration(f float64) *FileMediaCreate {
+	fmc.mutation.SetDuration(f)
+	return fmc
+}
+
+// SetNillableDuration sets the duration field if the given value is not nil.
+func (fmc *FileMediaCreate) SetNillableDuration(f *float64) *FileMediaCreate {
+	if f != nil {
+		fmc.SetDuration(*f)
+	}
+	return fmc
+}
+
+// SetPages sets the pages field.
+func (fmc *FileMediaCreate) SetPages(i int) *FileMediaCreate {
+	fmc.mutation.SetPages(i)
+	return fmc
+}
+
+// SetNillablePages sets the pages field if the given value is not nil.
+func (fmc *FileMediaCreate) SetNillablePages(i *int) *FileMediaCreate {
+	if i != nil {
+		fmc.SetPages(*i)
+	}
+	return fmc
+}
+
+// SetTakenAt sets the taken_at field.
+func (fmc *FileMediaCreate) SetTakenAt(t time.Time) *FileMediaCreate {
+	fmc.mutation.SetTakenAt(t)
+	return fmc
+}
+
+// SetNillableTakenAt sets the taken_at field if the given value is not nil.
+func (fmc *FileMediaCreate) SetNillableTakenAt(t *time.Time) *FileMediaCreate {
+	if t != nil {
+		fmc.SetTakenAt(*t)
+	}
+	return fmc
+}
+
+// SetFileID sets the file edge to File by id.
+func (fmc *FileMediaCreate) SetFileID(id uuid.UUID) *FileMediaCreate {
+	fmc.mutation.SetFileID(id)
+	return fmc
+}
+
+// SetFile sets the file edge to File.
+func (fmc *FileMediaCreate) SetFile(f *File) *FileMediaCreate {
+	return fmc.SetFileID(f.ID)
+}
+
+// Save creates the FileMedia in the database.
+func (fmc *FileMediaCreate) Save(ctx context.Context) (*FileMedia, error) {
+	if v, ok := fmc.mutation.Width(); ok {
+		if err := filemedia.WidthValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if v, ok := fmc.mutation.Height(); ok {
+		if err := filemedia.HeightValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if v, ok := fmc.mutation.Duration(); ok {
+		if err := filemedia.DurationValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"duration\": %v", err)
+		}
+	}
+	if v, ok := fmc.mutation.Pages(); ok {
+		if err := filemedia.PagesValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"pages\": %v", err)
+		}
+	}
+	if _, ok := fmc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileMedia
+	)
+	if len(fmc.hooks) == 0 {
+		node, err = fmc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fmc.mutation = mutation
+			node, err = fmc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fmc.hooks) - 1; i >= 0; i-- {
+			mut = fmc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fmc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fmc *FileMediaCreate) SaveX(ctx context.Context) *FileMedia {
+	v, err := fmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fmc *FileMediaCreate) sqlSave(ctx context.Context) (*FileMedia, error) {
+	var (
+		fm    = &FileMedia{config: fmc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: filemedia.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filemedia.FieldID,
+			},
+		}
+	)
+	if value, ok := fmc.mutation.Width(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldWidth,
+		})
+		fm.Width = &value
+	}
+	if value, ok := fmc.mutation.Height(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldHeight,
+		})
+		fm.Height = &value
+	}
+	if value, ok := fmc.mutation.Duration(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: filemedia.FieldDuration,
+		})
+		fm.Duration = &value
+	}
+	if value, ok := fmc.mutation.Pages(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldPages,
+		})
+		fm.Pages = &value
+	}
+	if value, ok := fmc.mutation.TakenAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: filemedia.FieldTakenAt,
+		})
+		fm.TakenAt = &value
+	}
+	if nodes := fmc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filemedia.FileTable,
+			Columns: []string{filemedia.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, fmc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	fm.ID = int(id)
+	return fm, nil
+}
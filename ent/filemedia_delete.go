@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileMediaDelete is the builder for deleting a FileMedia entity.
+type FileMediaDelete struct {
+	config
+	hooks      []Hook
+	mutation   *FileMediaMutation
+	predicates []predicate.FileMedia
+}
+
+// Where adds a new predicate to the delete builder.
+func (fmd *FileMediaDelete) Where(ps ...predicate.FileMedia) *FileMediaDelete {
+	fmd.predicates = append(fmd.predicates, ps...)
+	return fmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fmd *FileMediaDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fmd.hooks) == 0 {
+		affected, err = fmd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fmd.mutation = mutation
+			affected, err = fmd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(fmd.hooks) - 1; i >= 0; i-- {
+			mut = fmd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fmd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmd *FileMediaDelete) ExecX(ctx context.Context) int {
+	n, err := fmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fmd *FileMediaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: filemedia.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filemedia.FieldID,
+			},
+		},
+	}
+	if ps := fmd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, fmd.driver, _spec)
+}
+
+// FileMediaDeleteOne is the builder for deleting a single FileMedia entity.
+type FileMediaDeleteOne struct {
+	fmd *FileMediaDelete
+}
+
+// Exec executes the deletion query.
+func (fmdo *FileMediaDeleteOne) Exec(ctx context.Context) error {
+	n, err := fmdo.fmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{filemedia.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmdo *FileMediaDeleteOne) ExecX(ctx context.Context) {
+	fmdo.fmd.ExecX(ctx)
+}
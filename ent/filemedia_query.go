@@ -0,0 +1,732 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileMediaQuery is the builder for querying FileMedia entities.
+type FileMediaQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.FileMedia
+	// eager-loading edges.
+	withFile *FileQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (fmq *FileMediaQuery) Where(ps ...predicate.FileMedia) *FileMediaQuery {
+	fmq.predicates = append(fmq.predicates, ps...)
+	return fmq
+}
+
+// Limit adds a limit step to the query.
+func (fmq *FileMediaQuery) Limit(limit int) *FileMediaQuery {
+	fmq.limit = &limit
+	return fmq
+}
+
+// Offset adds an offset step to the query.
+func (fmq *FileMediaQuery) Offset(offset int) *FileMediaQuery {
+	fmq.offset = &offset
+	return fmq
+}
+
+// Order adds an order step to the query.
+func (fmq *FileMediaQuery) Order(o ...Order) *FileMediaQuery {
+	fmq.order = append(fmq.order, o...)
+	return fmq
+}
+
+// QueryFile chains the current query on the file edge.
+func (fmq *FileMediaQuery) QueryFile() *FileQuery {
+	query := &FileQuery{config: fmq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filemedia.Table, filemedia.FieldID, fmq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, filemedia.FileTable, filemedia.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FileMedia entity in the query. Returns *NotFoundError when no filemedia was found.
+func (fmq *FileMediaQuery) First(ctx context.Context) (*FileMedia, error) {
+	fms, err := fmq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(fms) == 0 {
+		return nil, &NotFoundError{filemedia.Label}
+	}
+	return fms[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fmq *FileMediaQuery) FirstX(ctx context.Context) *FileMedia {
+	fm, err := fmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return fm
+}
+
+// FirstID returns the first FileMedia id in the query. Returns *NotFoundError when no id was found.
+func (fmq *FileMediaQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fmq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{filemedia.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (fmq *FileMediaQuery) FirstXID(ctx context.Context) int {
+	id, err := fmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only FileMedia entity in the query, returns an error if not exactly one entity was returned.
+func (fmq *FileMediaQuery) Only(ctx context.Context) (*FileMedia, error) {
+	fms, err := fmq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fms) {
+	case 1:
+		return fms[0], nil
+	case 0:
+		return nil, &NotFoundError{filemedia.Label}
+	default:
+		return nil, &NotSingularError{filemedia.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fmq *FileMediaQuery) OnlyX(ctx context.Context) *FileMedia {
+	fm, err := fmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fm
+}
+
+// OnlyID returns the only FileMedia id in the query, returns an error if not exactly one id was returned.
+func (fmq *FileMediaQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fmq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{filemedia.Label}
+	default:
+		err = &NotSingularError{filemedia.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (fmq *FileMediaQuery) OnlyXID(ctx context.Context) int {
+	id, err := fmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileMediaSlice.
+func (fmq *FileMediaQuery) All(ctx context.Context) ([]*FileMedia, error) {
+	if err := fmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fmq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fmq *FileMediaQuery) AllX(ctx context.Context) []*FileMedia {
+	fms, err := fmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fms
+}
+
+// IDs executes the query and returns a list of FileMedia ids.
+func (fmq *FileMediaQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := fmq.Select(filemedia.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fmq *FileMediaQuery) IDsX(ctx context.Context) []int {
+	ids, err := fmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fmq *FileMediaQuery) Count(ctx context.Context) (int, error) {
+	if err := fmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fmq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fmq *FileMediaQuery) CountX(ctx context.Context) int {
+	count, err := fmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fmq *FileMediaQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fmq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fmq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fmq *FileMediaQuery) ExistX(ctx context.Context) bool {
+	exist, err := fmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fmq *FileMediaQuery) Clone() *FileMediaQuery {
+	return &FileMediaQuery{
+		config:     fmq.config,
+		limit:      fmq.limit,
+		offset:     fmq.offset,
+		order:      append([]Order{}, fmq.order...),
+		unique:     append([]string{}, fmq.unique...),
+		predicates: append([]predicate.FileMedia{}, fmq.predicates...),
+		// clone intermediate query.
+		sql:  fmq.sql.Clone(),
+		path: fmq.path,
+	}
+}
+
+//  WithFile tells the query-builder to eager-loads the nodes that are connected to
+// the "file" edge. The optional arguments used to configure the query builder of the edge.
+func (fmq *FileMediaQuery) WithFile(opts ...func(*FileQuery)) *FileMediaQuery {
+	query := &FileQuery{config: fmq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fmq.withFile = query
+	return fmq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Width int `json:"width,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileMedia.Query().
+//		GroupBy(filemedia.FieldWidth).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (fmq *FileMediaQuery) GroupBy(field string, fields ...string) *FileMediaGroupBy {
+	group := &FileMediaGroupBy{config: fmq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fmq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Width int `json:"width,omitempty"`
+//	}
+//
+//	client.FileMedia.Query().
+//		Select(filemedia.FieldWidth).
+//		Scan(ctx, &v)
+//
+func (fmq *FileMediaQuery) Select(field string, fields ...string) *FileMediaSelect {
+	selector := &FileMediaSelect{config: fmq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fmq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (fmq *FileMediaQuery) prepareQuery(ctx context.Context) error {
+	if fmq.path != nil {
+		prev, err := fmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fmq.sql = prev
+	}
+	return nil
+}
+
+func (fmq *FileMediaQuery) sqlAll(ctx context.Context) ([]*FileMedia, error) {
+	var (
+		nodes       = []*FileMedia{}
+		withFKs     = fmq.withFKs
+		_spec       = fmq.querySpec()
+		loadedTypes = [1]bool{
+			fmq.withFile != nil,
+		}
+	)
+	if fmq.withFile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, filemedia.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &FileMedia{config: fmq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := fmq.withFile; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*FileMedia)
+		for i := range nodes {
+			if fk := nodes[i].file_indexed_media; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(file.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_media" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.File = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (fmq *FileMediaQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fmq.querySpec()
+	return sqlgraph.CountNodes(ctx, fmq.driver, _spec)
+}
+
+func (fmq *FileMediaQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fmq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (fmq *FileMediaQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filemedia.Table,
+			Columns: filemedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filemedia.FieldID,
+			},
+		},
+		From:   fmq.sql,
+		Unique: true,
+	}
+	if ps := fmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fmq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fmq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fmq *FileMediaQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(fmq.driver.Dialect())
+	t1 := builder.Table(filemedia.Table)
+	selector := builder.Select(t1.Columns(filemedia.Columns...)...).From(t1)
+	if fmq.sql != nil {
+		selector = fmq.sql
+		selector.Select(selector.Columns(filemedia.Columns...)...)
+	}
+	for _, p := range fmq.predicates {
+		p(selector)
+	}
+	for _, p := range fmq.order {
+		p(selector)
+	}
+	if offset := fmq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fmq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileMediaGroupBy is the builder for group-by FileMedia entities.
+type FileMediaGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fmgb *FileMediaGroupBy) Aggregate(fns ...Aggregate) *FileMediaGroupBy {
+	fmgb.fns = append(fmgb.fns, fns...)
+	return fmgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (fmgb *FileMediaGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fmgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fmgb.sql = query
+	return fmgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fmgb *FileMediaGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := fmgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (fmgb *FileMediaGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(fmgb.fields) > 1 {
+		return nil, errors.New("ent: FileMediaGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := fmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fmgb *FileMediaGroupBy) StringsX(ctx context.Context) []string {
+	v, err := fmgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (fmgb *FileMediaGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(fmgb.fields) > 1 {
+		return nil, errors.New("ent: FileMediaGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := fmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fmgb *FileMediaGroupBy) IntsX(ctx context.Context) []int {
+	v, err := fmgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (fmgb *FileMediaGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fmgb.fields) > 1 {
+		return nil, errors.New("ent: FileMediaGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := fmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fmgb *FileMediaGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := fmgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (fmgb *FileMediaGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(fmgb.fields) > 1 {
+		return nil, errors.New("ent: FileMediaGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := fmgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fmgb *FileMediaGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := fmgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fmgb *FileMediaGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fmgb.sqlQuery().Query()
+	if err := fmgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fmgb *FileMediaGroupBy) sqlQuery() *sql.Selector {
+	selector := fmgb.sql
+	columns := make([]string, 0, len(fmgb.fields)+len(fmgb.fns))
+	columns = append(columns, fmgb.fields...)
+	for _, fn := range fmgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(fmgb.fields...)
+}
+
+// FileMediaSelect is the builder for select fields of FileMedia entities.
+type FileMediaSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (fms *FileMediaSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := fms.path(ctx)
+	if err != nil {
+		return err
+	}
+	fms.sql = query
+	return fms.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fms *FileMediaSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := fms.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (fms *FileMediaSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(fms.fields) > 1 {
+		return nil, errors.New("ent: FileMediaSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := fms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fms *FileMediaSelect) StringsX(ctx context.Context) []string {
+	v, err := fms.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (fms *FileMediaSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(fms.fields) > 1 {
+		return nil, errors.New("ent: FileMediaSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := fms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fms *FileMediaSelect) IntsX(ctx context.Context) []int {
+	v, err := fms.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (fms *FileMediaSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fms.fields) > 1 {
+		return nil, errors.New("ent: FileMediaSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := fms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fms *FileMediaSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := fms.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (fms *FileMediaSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(fms.fields) > 1 {
+		return nil, errors.New("ent: FileMediaSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := fms.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fms *FileMediaSelect) BoolsX(ctx context.Context) []bool {
+	v, err := fms.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fms *FileMediaSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fms.sqlQuery().Query()
+	if err := fms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fms *FileMediaSelect) sqlQuery() sql.Querier {
+	selector := fms.sql
+	selector.Select(selector.Columns(fms.fields...)...)
+	return selector
+}
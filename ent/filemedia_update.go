@@ -0,0 +1,792 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileMediaUpdate is the builder for updating FileMedia entities.
+type FileMediaUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *FileMediaMutation
+	predicates []predicate.FileMedia
+}
+
+// Where adds a new predicate for the builder.
+func (fmu *FileMediaUpdate) Where(ps ...predicate.FileMedia) *FileMediaUpdate {
+	fmu.predicates = append(fmu.predicates, ps...)
+	return fmu
+}
+
+// SetWidth sets the width field.
+func (fmu *FileMediaUpdate) SetWidth(i int) *FileMediaUpdate {
+	fmu.mutation.ResetWidth()
+	fmu.mutation.SetWidth(i)
+	return fmu
+}
+
+// SetNillableWidth sets the width field if the given value is not nil.
+func (fmu *FileMediaUpdate) SetNillableWidth(i *int) *FileMediaUpdate {
+	if i != nil {
+		fmu.SetWidth(*i)
+	}
+	return fmu
+}
+
+// AddWidth adds i to width.
+func (fmu *FileMediaUpdate) AddWidth(i int) *FileMediaUpdate {
+	fmu.mutation.AddWidth(i)
+	return fmu
+}
+
+// ClearWidth clears the value of width.
+func (fmu *FileMediaUpdate) ClearWidth() *FileMediaUpdate {
+	fmu.mutation.ClearWidth()
+	return fmu
+}
+
+// SetHeight sets the height field.
+func (fmu *FileMediaUpdate) SetHeight(i int) *FileMediaUpdate {
+	fmu.mutation.ResetHeight()
+	fmu.mutation.SetHeight(i)
+	return fmu
+}
+
+// SetNillableHeight sets the height field if the given value is not nil.
+func (fmu *FileMediaUpdate) SetNillableHeight(i *int) *FileMediaUpdate {
+	if i != nil {
+		fmu.SetHeight(*i)
+	}
+	return fmu
+}
+
+// AddHeight adds i to height.
+func (fmu *FileMediaUpdate) AddHeight(i int) *FileMediaUpdate {
+	fmu.mutation.AddHeight(i)
+	return fmu
+}
+
+// ClearHeight clears the value of height.
+func (fmu *FileMediaUpdate) ClearHeight() *FileMediaUpdate {
+	fmu.mutation.ClearHeight()
+	return fmu
+}
+
+// SetDuration sets the duration field.
+func (fmu *FileMediaUpdate) SetDuration(f float64) *FileMediaUpdate {
+	fmu.mutation.ResetDuration()
+	fmu.mutation.SetDuration(f)
+	return fmu
+}
+
+// SetNillableDuration sets the duration field if the given value is not nil.
+func (fmu *FileMediaUpdate) SetNillableDuration(f *float64) *FileMediaUpdate {
+	if f != nil {
+		fmu.SetDuration(*f)
+	}
+	return fmu
+}
+
+// AddDuration adds f to duration.
+func (fmu *FileMediaUpdate) AddDuration(f float64) *FileMediaUpdate {
+	fmu.mutation.AddDuration(f)
+	return fmu
+}
+
+// ClearDuration clears the value of duration.
+func (fmu *FileMediaUpdate) ClearDuration() *FileMediaUpdate {
+	fmu.mutation.ClearDuration()
+	return fmu
+}
+
+// SetPages sets the pages field.
+func (fmu *FileMediaUpdate) SetPages(i int) *FileMediaUpdate {
+	fmu.mutation.ResetPages()
+	fmu.mutation.SetPages(i)
+	return fmu
+}
+
+// SetNillablePages sets the pages field if the given value is not nil.
+func (fmu *FileMediaUpdate) SetNillablePages(i *int) *FileMediaUpdate {
+	if i != nil {
+		fmu.SetPages(*i)
+	}
+	return fmu
+}
+
+// AddPages adds i to pages.
+func (fmu *FileMediaUpdate) AddPages(i int) *FileMediaUpdate {
+	fmu.mutation.AddPages(i)
+	return fmu
+}
+
+// ClearPages clears the value of pages.
+func (fmu *FileMediaUpdate) ClearPages() *FileMediaUpdate {
+	fmu.mutation.ClearPages()
+	return fmu
+}
+
+// SetTakenAt sets the taken_at field.
+func (fmu *FileMediaUpdate) SetTakenAt(t time.Time) *FileMediaUpdate {
+	fmu.mutation.SetTakenAt(t)
+	return fmu
+}
+
+// SetNillableTakenAt sets the taken_at field if the given value is not nil.
+func (fmu *FileMediaUpdate) SetNillableTakenAt(t *time.Time) *FileMediaUpdate {
+	if t != nil {
+		fmu.SetTakenAt(*t)
+	}
+	return fmu
+}
+
+// ClearTakenAt clears the value of taken_at.
+func (fmu *FileMediaUpdate) ClearTakenAt() *FileMediaUpdate {
+	fmu.mutation.ClearTakenAt()
+	return fmu
+}
+
+// SetFileID sets the file edge to File by id.
+func (fmu *FileMediaUpdate) SetFileID(id uuid.UUID) *FileMediaUpdate {
+	fmu.mutation.SetFileID(id)
+	return fmu
+}
+
+// SetFile sets the file edge to File.
+func (fmu *FileMediaUpdate) SetFile(f *File) *FileMediaUpdate {
+	return fmu.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fmu *FileMediaUpdate) ClearFile() *FileMediaUpdate {
+	fmu.mutation.ClearFile()
+	return fmu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (fmu *FileMediaUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := fmu.mutation.Width(); ok {
+		if err := filemedia.WidthValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if v, ok := fmu.mutation.Height(); ok {
+		if err := filemedia.HeightValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if v, ok := fmu.mutation.Duration(); ok {
+		if err := filemedia.DurationValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"duration\": %v", err)
+		}
+	}
+	if v, ok := fmu.mutation.Pages(); ok {
+		if err := filemedia.PagesValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"pages\": %v", err)
+		}
+	}
+
+	if _, ok := fmu.mutation.FileID(); fmu.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(fmu.hooks) == 0 {
+		affected, err = fmu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fmu.mutation = mutation
+			affected, err = fmu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(fmu.hooks) - 1; i >= 0; i-- {
+			mut = fmu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fmu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmu *FileMediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := fmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fmu *FileMediaUpdate) Exec(ctx context.Context) error {
+	_, err := fmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmu *FileMediaUpdate) ExecX(ctx context.Context) {
+	if err := fmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fmu *FileMediaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filemedia.Table,
+			Columns: filemedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filemedia.FieldID,
+			},
+		},
+	}
+	if ps := fmu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fmu.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if value, ok := fmu.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if fmu.mutation.WidthCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if value, ok := fmu.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if value, ok := fmu.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if fmu.mutation.HeightCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if value, ok := fmu.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if value, ok := fmu.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if fmu.mutation.DurationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if value, ok := fmu.mutation.Pages(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if value, ok := fmu.mutation.AddedPages(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if fmu.mutation.PagesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if value, ok := fmu.mutation.TakenAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: filemedia.FieldTakenAt,
+		})
+	}
+	if fmu.mutation.TakenAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: filemedia.FieldTakenAt,
+		})
+	}
+	if fmu.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filemedia.FileTable,
+			Columns: []string{filemedia.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fmu.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filemedia.FileTable,
+			Columns: []string{filemedia.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filemedia.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FileMediaUpdateOne is the builder for updating a single FileMedia entity.
+type FileMediaUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FileMediaMutation
+}
+
+// SetWidth sets the width field.
+func (fmuo *FileMediaUpdateOne) SetWidth(i int) *FileMediaUpdateOne {
+	fmuo.mutation.ResetWidth()
+	fmuo.mutation.SetWidth(i)
+	return fmuo
+}
+
+// SetNillableWidth sets the width field if the given value is not nil.
+func (fmuo *FileMediaUpdateOne) SetNillableWidth(i *int) *FileMediaUpdateOne {
+	if i != nil {
+		fmuo.SetWidth(*i)
+	}
+	return fmuo
+}
+
+// AddWidth adds i to width.
+func (fmuo *FileMediaUpdateOne) AddWidth(i int) *FileMediaUpdateOne {
+	fmuo.mutation.AddWidth(i)
+	return fmuo
+}
+
+// ClearWidth clears the value of width.
+func (fmuo *FileMediaUpdateOne) ClearWidth() *FileMediaUpdateOne {
+	fmuo.mutation.ClearWidth()
+	return fmuo
+}
+
+// SetHeight sets the height field.
+func (fmuo *FileMediaUpdateOne) SetHeight(i int) *FileMediaUpdateOne {
+	fmuo.mutation.ResetHeight()
+	fmuo.mutation.SetHeight(i)
+	return fmuo
+}
+
+// SetNillableHeight sets the height field if the given value is not nil.
+func (fmuo *FileMediaUpdateOne) SetNillableHeight(i *int) *FileMediaUpdateOne {
+	if i != nil {
+		fmuo.SetHeight(*i)
+	}
+	return fmuo
+}
+
+// AddHeight adds i to height.
+func (fmuo *FileMediaUpdateOne) AddHeight(i int) *FileMediaUpdateOne {
+	fmuo.mutation.AddHeight(i)
+	return fmuo
+}
+
+// ClearHeight clears the value of height.
+func (fmuo *FileMediaUpdateOne) ClearHeight() *FileMediaUpdateOne {
+	fmuo.mutation.ClearHeight()
+	return fmuo
+}
+
+// SetDuration sets the duration field.
+func (fmuo *FileMediaUpdateOne) SetDuration(f float64) *FileMediaUpdateOne {
+	fmuo.mutation.ResetDuration()
+	fmuo.mutation.SetDuration(f)
+	return fmuo
+}
+
+// SetNillableDuration sets the duration field if the given value is not nil.
+func (fmuo *FileMediaUpdateOne) SetNillableDuration(f *float64) *FileMediaUpdateOne {
+	if f != nil {
+		fmuo.SetDuration(*f)
+	}
+	return fmuo
+}
+
+// AddDuration adds f to duration.
+func (fmuo *FileMediaUpdateOne) AddDuration(f float64) *FileMediaUpdateOne {
+	fmuo.mutation.AddDuration(f)
+	return fmuo
+}
+
+// ClearDuration clears the value of duration.
+func (fmuo *FileMediaUpdateOne) ClearDuration() *FileMediaUpdateOne {
+	fmuo.mutation.ClearDuration()
+	return fmuo
+}
+
+// SetPages sets the pages field.
+func (fmuo *FileMediaUpdateOne) SetPages(i int) *FileMediaUpdateOne {
+	fmuo.mutation.ResetPages()
+	fmuo.mutation.SetPages(i)
+	return fmuo
+}
+
+// SetNillablePages sets the pages field if the given value is not nil.
+func (fmuo *FileMediaUpdateOne) SetNillablePages(i *int) *FileMediaUpdateOne {
+	if i != nil {
+		fmuo.SetPages(*i)
+	}
+	return fmuo
+}
+
+// AddPages adds i to pages.
+func (fmuo *FileMediaUpdateOne) AddPages(i int) *FileMediaUpdateOne {
+	fmuo.mutation.AddPages(i)
+	return fmuo
+}
+
+// ClearPages clears the value of pages.
+func (fmuo *FileMediaUpdateOne) ClearPages() *FileMediaUpdateOne {
+	fmuo.mutation.ClearPages()
+	return fmuo
+}
+
+// SetTakenAt sets the taken_at field.
+func (fmuo *FileMediaUpdateOne) SetTakenAt(t time.Time) *FileMediaUpdateOne {
+	fmuo.mutation.SetTakenAt(t)
+	return fmuo
+}
+
+// SetNillableTakenAt sets the taken_at field if the given value is not nil.
+func (fmuo *FileMediaUpdateOne) SetNillableTakenAt(t *time.Time) *FileMediaUpdateOne {
+	if t != nil {
+		fmuo.SetTakenAt(*t)
+	}
+	return fmuo
+}
+
+// ClearTakenAt clears the value of taken_at.
+func (fmuo *FileMediaUpdateOne) ClearTakenAt() *FileMediaUpdateOne {
+	fmuo.mutation.ClearTakenAt()
+	return fmuo
+}
+
+// SetFileID sets the file edge to File by id.
+func (fmuo *FileMediaUpdateOne) SetFileID(id uuid.UUID) *FileMediaUpdateOne {
+	fmuo.mutation.SetFileID(id)
+	return fmuo
+}
+
+// SetFile sets the file edge to File.
+func (fmuo *FileMediaUpdateOne) SetFile(f *File) *FileMediaUpdateOne {
+	return fmuo.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fmuo *FileMediaUpdateOne) ClearFile() *FileMediaUpdateOne {
+	fmuo.mutation.ClearFile()
+	return fmuo
+}
+
+// Save executes the query and returns the updated entity.
+func (fmuo *FileMediaUpdateOne) Save(ctx context.Context) (*FileMedia, error) {
+	if v, ok := fmuo.mutation.Width(); ok {
+		if err := filemedia.WidthValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if v, ok := fmuo.mutation.Height(); ok {
+		if err := filemedia.HeightValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if v, ok := fmuo.mutation.Duration(); ok {
+		if err := filemedia.DurationValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"duration\": %v", err)
+		}
+	}
+	if v, ok := fmuo.mutation.Pages(); ok {
+		if err := filemedia.PagesValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"pages\": %v", err)
+		}
+	}
+
+	if _, ok := fmuo.mutation.FileID(); fmuo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileMedia
+	)
+	if len(fmuo.hooks) == 0 {
+		node, err = fmuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileMediaMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fmuo.mutation = mutation
+			node, err = fmuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fmuo.hooks) - 1; i >= 0; i-- {
+			mut = fmuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fmuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fmuo *FileMediaUpdateOne) SaveX(ctx context.Context) *FileMedia {
+	fm, err := fmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fm
+}
+
+// Exec executes the query on the entity.
+func (fmuo *FileMediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := fmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fmuo *FileMediaUpdateOne) ExecX(ctx context.Context) {
+	if err := fmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fmuo *FileMediaUpdateOne) sqlSave(ctx context.Context) (fm *FileMedia, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filemedia.Table,
+			Columns: filemedia.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filemedia.FieldID,
+			},
+		},
+	}
+	id, ok := fmuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing FileMedia.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := fmuo.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if value, ok := fmuo.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if fmuo.mutation.WidthCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldWidth,
+		})
+	}
+	if value, ok := fmuo.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if value, ok := fmuo.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if fmuo.mutation.HeightCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldHeight,
+		})
+	}
+	if value, ok := fmuo.mutation.Duration(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if value, ok := fmuo.mutation.AddedDuration(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Value:  value,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if fmuo.mutation.DurationCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeFloat64,
+			Column: filemedia.FieldDuration,
+		})
+	}
+	if value, ok := fmuo.mutation.Pages(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if value, ok := fmuo.mutation.AddedPages(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if fmuo.mutation.PagesCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: filemedia.FieldPages,
+		})
+	}
+	if value, ok := fmuo.mutation.TakenAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: filemedia.FieldTakenAt,
+		})
+	}
+	if fmuo.mutation.TakenAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: filemedia.FieldTakenAt,
+		})
+	}
+	if fmuo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filemedia.FileTable,
+			Columns: []string{filemedia.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fmuo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   filemedia.FileTable,
+			Columns: []string{filemedia.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	fm = &FileMedia{config: fmuo.config}
+	_spec.Assign = fm.assignValues
+	_spec.ScanValues = fm.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, fmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filemedia.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return fm, nil
+}
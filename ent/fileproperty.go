@@ -0,0 +1,145 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/fileproperty"
+)
+
+// FileProperty is the model entity for the FileProperty schema.
+type FileProperty struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Key holds the value of the "key" field.
+	Key string `json:"key,omitempty"`
+	// Value holds the value of the "value" field.
+	Value string `json:"value,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FilePropertyQuery when eager-loading is set.
+	Edges                   FilePropertyEdges `json:"edges"`
+	file_indexed_properties *uuid.UUID
+}
+
+// FilePropertyEdges holds the relations/edges for other nodes in the graph.
+type FilePropertyEdges struct {
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FilePropertyEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileProperty) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // key
+		&sql.NullString{}, // value
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*FileProperty) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // file_indexed_properties
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileProperty fields.
+func (fp *FileProperty) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(fileproperty.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	fp.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field key", values[0])
+	} else if value.Valid {
+		fp.Key = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field value", values[1])
+	} else if value.Valid {
+		fp.Value = value.String
+	}
+	values = values[2:]
+	if len(values) == len(fileproperty.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_indexed_properties", values[0])
+		} else if value != nil {
+			fp.file_indexed_properties = value
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the file edge of the FileProperty.
+func (fp *FileProperty) QueryFile() *FileQuery {
+	return (&FilePropertyClient{config: fp.config}).QueryFile(fp)
+}
+
+// Update returns a builder for updating this FileProperty.
+// Note that, you need to call FileProperty.Unwrap() before calling this method, if this FileProperty
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (fp *FileProperty) Update() *FilePropertyUpdateOne {
+	return (&FilePropertyClient{config: fp.config}).UpdateOne(fp)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (fp *FileProperty) Unwrap() *FileProperty {
+	tx, ok := fp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileProperty is not a transactional entity")
+	}
+	fp.config.driver = tx.drv
+	return fp
+}
+
+// String implements the fmt.Stringer.
+func (fp *FileProperty) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileProperty(")
+	builder.WriteString(fmt.Sprintf("id=%v", fp.ID))
+	builder.WriteString(", key=")
+	builder.WriteString(fp.Key)
+	builder.WriteString(", value=")
+	builder.WriteString(fp.Value)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileProperties is a parsable slice of FileProperty.
+type FileProperties []*FileProperty
+
+func (fp FileProperties) config(cfg config) {
+	for _i := range fp {
+		fp[_i].config = cfg
+	}
+}
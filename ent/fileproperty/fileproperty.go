@@ -0,0 +1,44 @@
+// github.com/sthorer/api
+
+package fileproperty
+
+const (
+	// Label holds the string label denoting the fileproperty type in the database.
+	Label = "file_property"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID    = "id"  // FieldKey holds the string denoting the key vertex property in the database.
+	FieldKey   = "key" // FieldValue holds the string denoting the value vertex property in the database.
+	FieldValue = "value"
+
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the fileproperty in the database.
+	Table = "file_properties"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "file_properties"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_indexed_properties"
+)
+
+// Columns holds all SQL columns for fileproperty fields.
+var Columns = []string{
+	FieldID,
+	FieldKey,
+	FieldValue,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the FileProperty type.
+var ForeignKeys = []string{
+	"file_indexed_properties",
+}
+
+var (
+	// KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	KeyValidator func(string) error
+	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ValueValidator func(string) error
+)
@@ -0,0 +1,158 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/fileproperty"
+)
+
+// FilePropertyCreate is the builder for creating a FileProperty entity.
+type FilePropertyCreate struct {
+	config
+	mutation *FilePropertyMutation
+	hooks    []Hook
+}
+
+// SetKey sets the key field.
+func (fpc *FilePropertyCreate) SetKey(s string) *FilePropertyCreate {
+	fpc.mutation.SetKey(s)
+	return fpc
+}
+
+// SetValue sets the value field.
+func (fpc *FilePropertyCreate) SetValue(s string) *FilePropertyCreate {
+	fpc.mutation.SetValue(s)
+	return fpc
+}
+
+// SetFileID sets the file edge to File by id.
+func (fpc *FilePropertyCreate) SetFileID(id uuid.UUID) *FilePropertyCreate {
+	fpc.mutation.SetFileID(id)
+	return fpc
+}
+
+// SetFile sets the file edge to File.
+func (fpc *FilePropertyCreate) SetFile(f *File) *FilePropertyCreate {
+	return fpc.SetFileID(f.ID)
+}
+
+// Save creates the FileProperty in the database.
+func (fpc *FilePropertyCreate) Save(ctx context.Context) (*FileProperty, error) {
+	if _, ok := fpc.mutation.Key(); !ok {
+		return nil, errors.New("ent: missing required field \"key\"")
+	}
+	if v, ok := fpc.mutation.Key(); ok {
+		if err := fileproperty.KeyValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"key\": %v", err)
+		}
+	}
+	if _, ok := fpc.mutation.Value(); !ok {
+		return nil, errors.New("ent: missing required field \"value\"")
+	}
+	if v, ok := fpc.mutation.Value(); ok {
+		if err := fileproperty.ValueValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"value\": %v", err)
+		}
+	}
+	if _, ok := fpc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileProperty
+	)
+	if len(fpc.hooks) == 0 {
+		node, err = fpc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FilePropertyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fpc.mutation = mutation
+			node, err = fpc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fpc.hooks) - 1; i >= 0; i-- {
+			mut = fpc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fpc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fpc *FilePropertyCreate) SaveX(ctx context.Context) *FileProperty {
+	v, err := fpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fpc *FilePropertyCreate) sqlSave(ctx context.Context) (*FileProperty, error) {
+	var (
+		fp    = &FileProperty{config: fpc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: fileproperty.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fileproperty.FieldID,
+			},
+		}
+	)
+	if value, ok := fpc.mutation.Key(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldKey,
+		})
+		fp.Key = value
+	}
+	if value, ok := fpc.mutation.Value(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldValue,
+		})
+		fp.Value = value
+	}
+	if nodes := fpc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileproperty.FileTable,
+			Columns: []string{fileproperty.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, fpc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	fp.ID = int(id)
+	return fp, nil
+}
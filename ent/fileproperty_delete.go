@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FilePropertyDelete is the builder for deleting a FileProperty entity.
+type FilePropertyDelete struct {
+	config
+	hooks      []Hook
+	mutation   *FilePropertyMutation
+	predicates []predicate.FileProperty
+}
+
+// Where adds a new predicate to the delete builder.
+func (fpd *FilePropertyDelete) Where(ps ...predicate.FileProperty) *FilePropertyDelete {
+	fpd.predicates = append(fpd.predicates, ps...)
+	return fpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fpd *FilePropertyDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fpd.hooks) == 0 {
+		affected, err = fpd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FilePropertyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fpd.mutation = mutation
+			affected, err = fpd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(fpd.hooks) - 1; i >= 0; i-- {
+			mut = fpd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fpd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fpd *FilePropertyDelete) ExecX(ctx context.Context) int {
+	n, err := fpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fpd *FilePropertyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: fileproperty.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fileproperty.FieldID,
+			},
+		},
+	}
+	if ps := fpd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, fpd.driver, _spec)
+}
+
+// FilePropertyDeleteOne is the builder for deleting a single FileProperty entity.
+type FilePropertyDeleteOne struct {
+	fpd *FilePropertyDelete
+}
+
+// Exec executes the deletion query.
+func (fpdo *FilePropertyDeleteOne) Exec(ctx context.Context) error {
+	n, err := fpdo.fpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{fileproperty.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fpdo *FilePropertyDeleteOne) ExecX(ctx context.Context) {
+	fpdo.fpd.ExecX(ctx)
+}
@@ -0,0 +1,732 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FilePropertyQuery is the builder for querying FileProperty entities.
+type FilePropertyQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.FileProperty
+	// eager-loading edges.
+	withFile *FileQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (fpq *FilePropertyQuery) Where(ps ...predicate.FileProperty) *FilePropertyQuery {
+	fpq.predicates = append(fpq.predicates, ps...)
+	return fpq
+}
+
+// Limit adds a limit step to the query.
+func (fpq *FilePropertyQuery) Limit(limit int) *FilePropertyQuery {
+	fpq.limit = &limit
+	return fpq
+}
+
+// Offset adds an offset step to the query.
+func (fpq *FilePropertyQuery) Offset(offset int) *FilePropertyQuery {
+	fpq.offset = &offset
+	return fpq
+}
+
+// Order adds an order step to the query.
+func (fpq *FilePropertyQuery) Order(o ...Order) *FilePropertyQuery {
+	fpq.order = append(fpq.order, o...)
+	return fpq
+}
+
+// QueryFile chains the current query on the file edge.
+func (fpq *FilePropertyQuery) QueryFile() *FileQuery {
+	query := &FileQuery{config: fpq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(fileproperty.Table, fileproperty.FieldID, fpq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, fileproperty.FileTable, fileproperty.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FileProperty entity in the query. Returns *NotFoundError when no fileproperty was found.
+func (fpq *FilePropertyQuery) First(ctx context.Context) (*FileProperty, error) {
+	fps, err := fpq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(fps) == 0 {
+		return nil, &NotFoundError{fileproperty.Label}
+	}
+	return fps[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fpq *FilePropertyQuery) FirstX(ctx context.Context) *FileProperty {
+	fp, err := fpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return fp
+}
+
+// FirstID returns the first FileProperty id in the query. Returns *NotFoundError when no id was found.
+func (fpq *FilePropertyQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fpq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{fileproperty.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (fpq *FilePropertyQuery) FirstXID(ctx context.Context) int {
+	id, err := fpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only FileProperty entity in the query, returns an error if not exactly one entity was returned.
+func (fpq *FilePropertyQuery) Only(ctx context.Context) (*FileProperty, error) {
+	fps, err := fpq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fps) {
+	case 1:
+		return fps[0], nil
+	case 0:
+		return nil, &NotFoundError{fileproperty.Label}
+	default:
+		return nil, &NotSingularError{fileproperty.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fpq *FilePropertyQuery) OnlyX(ctx context.Context) *FileProperty {
+	fp, err := fpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fp
+}
+
+// OnlyID returns the only FileProperty id in the query, returns an error if not exactly one id was returned.
+func (fpq *FilePropertyQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = fpq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{fileproperty.Label}
+	default:
+		err = &NotSingularError{fileproperty.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (fpq *FilePropertyQuery) OnlyXID(ctx context.Context) int {
+	id, err := fpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileProperties.
+func (fpq *FilePropertyQuery) All(ctx context.Context) ([]*FileProperty, error) {
+	if err := fpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fpq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fpq *FilePropertyQuery) AllX(ctx context.Context) []*FileProperty {
+	fps, err := fpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fps
+}
+
+// IDs executes the query and returns a list of FileProperty ids.
+func (fpq *FilePropertyQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := fpq.Select(fileproperty.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fpq *FilePropertyQuery) IDsX(ctx context.Context) []int {
+	ids, err := fpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fpq *FilePropertyQuery) Count(ctx context.Context) (int, error) {
+	if err := fpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fpq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fpq *FilePropertyQuery) CountX(ctx context.Context) int {
+	count, err := fpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fpq *FilePropertyQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fpq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fpq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fpq *FilePropertyQuery) ExistX(ctx context.Context) bool {
+	exist, err := fpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fpq *FilePropertyQuery) Clone() *FilePropertyQuery {
+	return &FilePropertyQuery{
+		config:     fpq.config,
+		limit:      fpq.limit,
+		offset:     fpq.offset,
+		order:      append([]Order{}, fpq.order...),
+		unique:     append([]string{}, fpq.unique...),
+		predicates: append([]predicate.FileProperty{}, fpq.predicates...),
+		// clone intermediate query.
+		sql:  fpq.sql.Clone(),
+		path: fpq.path,
+	}
+}
+
+//  WithFile tells the query-builder to eager-loads the nodes that are connected to
+// the "file" edge. The optional arguments used to configure the query builder of the edge.
+func (fpq *FilePropertyQuery) WithFile(opts ...func(*FileQuery)) *FilePropertyQuery {
+	query := &FileQuery{config: fpq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fpq.withFile = query
+	return fpq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileProperty.Query().
+//		GroupBy(fileproperty.FieldKey).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (fpq *FilePropertyQuery) GroupBy(field string, fields ...string) *FilePropertyGroupBy {
+	group := &FilePropertyGroupBy{config: fpq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fpq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Key string `json:"key,omitempty"`
+//	}
+//
+//	client.FileProperty.Query().
+//		Select(fileproperty.FieldKey).
+//		Scan(ctx, &v)
+//
+func (fpq *FilePropertyQuery) Select(field string, fields ...string) *FilePropertySelect {
+	selector := &FilePropertySelect{config: fpq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fpq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (fpq *FilePropertyQuery) prepareQuery(ctx context.Context) error {
+	if fpq.path != nil {
+		prev, err := fpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fpq.sql = prev
+	}
+	return nil
+}
+
+func (fpq *FilePropertyQuery) sqlAll(ctx context.Context) ([]*FileProperty, error) {
+	var (
+		nodes       = []*FileProperty{}
+		withFKs     = fpq.withFKs
+		_spec       = fpq.querySpec()
+		loadedTypes = [1]bool{
+			fpq.withFile != nil,
+		}
+	)
+	if fpq.withFile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, fileproperty.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &FileProperty{config: fpq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := fpq.withFile; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*FileProperty)
+		for i := range nodes {
+			if fk := nodes[i].file_indexed_properties; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(file.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_properties" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.File = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (fpq *FilePropertyQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fpq.querySpec()
+	return sqlgraph.CountNodes(ctx, fpq.driver, _spec)
+}
+
+func (fpq *FilePropertyQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fpq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (fpq *FilePropertyQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fileproperty.Table,
+			Columns: fileproperty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fileproperty.FieldID,
+			},
+		},
+		From:   fpq.sql,
+		Unique: true,
+	}
+	if ps := fpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fpq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fpq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fpq *FilePropertyQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(fpq.driver.Dialect())
+	t1 := builder.Table(fileproperty.Table)
+	selector := builder.Select(t1.Columns(fileproperty.Columns...)...).From(t1)
+	if fpq.sql != nil {
+		selector = fpq.sql
+		selector.Select(selector.Columns(fileproperty.Columns...)...)
+	}
+	for _, p := range fpq.predicates {
+		p(selector)
+	}
+	for _, p := range fpq.order {
+		p(selector)
+	}
+	if offset := fpq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fpq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FilePropertyGroupBy is the builder for group-by FileProperty entities.
+type FilePropertyGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fpgb *FilePropertyGroupBy) Aggregate(fns ...Aggregate) *FilePropertyGroupBy {
+	fpgb.fns = append(fpgb.fns, fns...)
+	return fpgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (fpgb *FilePropertyGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fpgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fpgb.sql = query
+	return fpgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fpgb *FilePropertyGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := fpgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (fpgb *FilePropertyGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(fpgb.fields) > 1 {
+		return nil, errors.New("ent: FilePropertyGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := fpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fpgb *FilePropertyGroupBy) StringsX(ctx context.Context) []string {
+	v, err := fpgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (fpgb *FilePropertyGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(fpgb.fields) > 1 {
+		return nil, errors.New("ent: FilePropertyGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := fpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fpgb *FilePropertyGroupBy) IntsX(ctx context.Context) []int {
+	v, err := fpgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (fpgb *FilePropertyGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fpgb.fields) > 1 {
+		return nil, errors.New("ent: FilePropertyGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := fpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fpgb *FilePropertyGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := fpgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (fpgb *FilePropertyGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(fpgb.fields) > 1 {
+		return nil, errors.New("ent: FilePropertyGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := fpgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fpgb *FilePropertyGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := fpgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fpgb *FilePropertyGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fpgb.sqlQuery().Query()
+	if err := fpgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fpgb *FilePropertyGroupBy) sqlQuery() *sql.Selector {
+	selector := fpgb.sql
+	columns := make([]string, 0, len(fpgb.fields)+len(fpgb.fns))
+	columns = append(columns, fpgb.fields...)
+	for _, fn := range fpgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(fpgb.fields...)
+}
+
+// FilePropertySelect is the builder for select fields of FileProperty entities.
+type FilePropertySelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (fps *FilePropertySelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := fps.path(ctx)
+	if err != nil {
+		return err
+	}
+	fps.sql = query
+	return fps.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fps *FilePropertySelect) ScanX(ctx context.Context, v interface{}) {
+	if err := fps.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (fps *FilePropertySelect) Strings(ctx context.Context) ([]string, error) {
+	if len(fps.fields) > 1 {
+		return nil, errors.New("ent: FilePropertySelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := fps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fps *FilePropertySelect) StringsX(ctx context.Context) []string {
+	v, err := fps.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (fps *FilePropertySelect) Ints(ctx context.Context) ([]int, error) {
+	if len(fps.fields) > 1 {
+		return nil, errors.New("ent: FilePropertySelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := fps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fps *FilePropertySelect) IntsX(ctx context.Context) []int {
+	v, err := fps.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (fps *FilePropertySelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fps.fields) > 1 {
+		return nil, errors.New("ent: FilePropertySelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := fps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fps *FilePropertySelect) Float64sX(ctx context.Context) []float64 {
+	v, err := fps.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (fps *FilePropertySelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(fps.fields) > 1 {
+		return nil, errors.New("ent: FilePropertySelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := fps.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fps *FilePropertySelect) BoolsX(ctx context.Context) []bool {
+	v, err := fps.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fps *FilePropertySelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fps.sqlQuery().Query()
+	if err := fps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fps *FilePropertySelect) sqlQuery() sql.Querier {
+	selector := fps.sql
+	selector.Select(selector.Columns(fps.fields...)...)
+	return selector
+}
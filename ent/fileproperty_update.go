@@ -0,0 +1,381 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FilePropertyUpdate is the builder for updating FileProperty entities.
+type FilePropertyUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *FilePropertyMutation
+	predicates []predicate.FileProperty
+}
+
+// Where adds a new predicate for the builder.
+func (fpu *FilePropertyUpdate) Where(ps ...predicate.FileProperty) *FilePropertyUpdate {
+	fpu.predicates = append(fpu.predicates, ps...)
+	return fpu
+}
+
+// SetKey sets the key field.
+func (fpu *FilePropertyUpdate) SetKey(s string) *FilePropertyUpdate {
+	fpu.mutation.SetKey(s)
+	return fpu
+}
+
+// SetValue sets the value field.
+func (fpu *FilePropertyUpdate) SetValue(s string) *FilePropertyUpdate {
+	fpu.mutation.SetValue(s)
+	return fpu
+}
+
+// SetFileID sets the file edge to File by id.
+func (fpu *FilePropertyUpdate) SetFileID(id uuid.UUID) *FilePropertyUpdate {
+	fpu.mutation.SetFileID(id)
+	return fpu
+}
+
+// SetFile sets the file edge to File.
+func (fpu *FilePropertyUpdate) SetFile(f *File) *FilePropertyUpdate {
+	return fpu.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fpu *FilePropertyUpdate) ClearFile() *FilePropertyUpdate {
+	fpu.mutation.ClearFile()
+	return fpu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (fpu *FilePropertyUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := fpu.mutation.Key(); ok {
+		if err := fileproperty.KeyValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"key\": %v", err)
+		}
+	}
+	if v, ok := fpu.mutation.Value(); ok {
+		if err := fileproperty.ValueValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"value\": %v", err)
+		}
+	}
+
+	if _, ok := fpu.mutation.FileID(); fpu.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(fpu.hooks) == 0 {
+		affected, err = fpu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FilePropertyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fpu.mutation = mutation
+			affected, err = fpu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(fpu.hooks) - 1; i >= 0; i-- {
+			mut = fpu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fpu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fpu *FilePropertyUpdate) SaveX(ctx context.Context) int {
+	affected, err := fpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fpu *FilePropertyUpdate) Exec(ctx context.Context) error {
+	_, err := fpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fpu *FilePropertyUpdate) ExecX(ctx context.Context) {
+	if err := fpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fpu *FilePropertyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fileproperty.Table,
+			Columns: fileproperty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fileproperty.FieldID,
+			},
+		},
+	}
+	if ps := fpu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fpu.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldKey,
+		})
+	}
+	if value, ok := fpu.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldValue,
+		})
+	}
+	if fpu.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileproperty.FileTable,
+			Columns: []string{fileproperty.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fpu.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileproperty.FileTable,
+			Columns: []string{fileproperty.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileproperty.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FilePropertyUpdateOne is the builder for updating a single FileProperty entity.
+type FilePropertyUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FilePropertyMutation
+}
+
+// SetKey sets the key field.
+func (fpuo *FilePropertyUpdateOne) SetKey(s string) *FilePropertyUpdateOne {
+	fpuo.mutation.SetKey(s)
+	return fpuo
+}
+
+// SetValue sets the value field.
+func (fpuo *FilePropertyUpdateOne) SetValue(s string) *FilePropertyUpdateOne {
+	fpuo.mutation.SetValue(s)
+	return fpuo
+}
+
+// SetFileID sets the file edge to File by id.
+func (fpuo *FilePropertyUpdateOne) SetFileID(id uuid.UUID) *FilePropertyUpdateOne {
+	fpuo.mutation.SetFileID(id)
+	return fpuo
+}
+
+// SetFile sets the file edge to File.
+func (fpuo *FilePropertyUpdateOne) SetFile(f *File) *FilePropertyUpdateOne {
+	return fpuo.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (fpuo *FilePropertyUpdateOne) ClearFile() *FilePropertyUpdateOne {
+	fpuo.mutation.ClearFile()
+	return fpuo
+}
+
+// Save executes the query and returns the updated entity.
+func (fpuo *FilePropertyUpdateOne) Save(ctx context.Context) (*FileProperty, error) {
+	if v, ok := fpuo.mutation.Key(); ok {
+		if err := fileproperty.KeyValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"key\": %v", err)
+		}
+	}
+	if v, ok := fpuo.mutation.Value(); ok {
+		if err := fileproperty.ValueValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"value\": %v", err)
+		}
+	}
+
+	if _, ok := fpuo.mutation.FileID(); fpuo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileProperty
+	)
+	if len(fpuo.hooks) == 0 {
+		node, err = fpuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FilePropertyMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fpuo.mutation = mutation
+			node, err = fpuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fpuo.hooks) - 1; i >= 0; i-- {
+			mut = fpuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fpuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fpuo *FilePropertyUpdateOne) SaveX(ctx context.Context) *FileProperty {
+	fp, err := fpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fp
+}
+
+// Exec executes the query on the entity.
+func (fpuo *FilePropertyUpdateOne) Exec(ctx context.Context) error {
+	_, err := fpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fpuo *FilePropertyUpdateOne) ExecX(ctx context.Context) {
+	if err := fpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fpuo *FilePropertyUpdateOne) sqlSave(ctx context.Context) (fp *FileProperty, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   fileproperty.Table,
+			Columns: fileproperty.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: fileproperty.FieldID,
+			},
+		},
+	}
+	id, ok := fpuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing FileProperty.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := fpuo.mutation.Key(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldKey,
+		})
+	}
+	if value, ok := fpuo.mutation.Value(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: fileproperty.FieldValue,
+		})
+	}
+	if fpuo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileproperty.FileTable,
+			Columns: []string{fileproperty.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fpuo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   fileproperty.FileTable,
+			Columns: []string{fileproperty.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	fp = &FileProperty{config: fpuo.config}
+	_spec.Assign = fp.assignValues
+	_spec.ScanValues = fp.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, fpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{fileproperty.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return fp, nil
+}
@@ -0,0 +1,135 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filetag"
+)
+
+// FileTag is the model entity for the FileTag schema.
+type FileTag struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FileTagQuery when eager-loading is set.
+	Edges             FileTagEdges `json:"edges"`
+	file_indexed_tags *uuid.UUID
+}
+
+// FileTagEdges holds the relations/edges for other nodes in the graph.
+type FileTagEdges struct {
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FileTagEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FileTag) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullString{}, // name
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*FileTag) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // file_indexed_tags
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FileTag fields.
+func (ft *FileTag) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(filetag.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	ft.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		ft.Name = value.String
+	}
+	values = values[1:]
+	if len(values) == len(filetag.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_indexed_tags", values[0])
+		} else if value != nil {
+			ft.file_indexed_tags = value
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the file edge of the FileTag.
+func (ft *FileTag) QueryFile() *FileQuery {
+	return (&FileTagClient{config: ft.config}).QueryFile(ft)
+}
+
+// Update returns a builder for updating this FileTag.
+// Note that, you need to call FileTag.Unwrap() before calling this method, if this FileTag
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ft *FileTag) Update() *FileTagUpdateOne {
+	return (&FileTagClient{config: ft.config}).UpdateOne(ft)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (ft *FileTag) Unwrap() *FileTag {
+	tx, ok := ft.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FileTag is not a transactional entity")
+	}
+	ft.config.driver = tx.drv
+	return ft
+}
+
+// String implements the fmt.Stringer.
+func (ft *FileTag) String() string {
+	var builder strings.Builder
+	builder.WriteString("FileTag(")
+	builder.WriteString(fmt.Sprintf("id=%v", ft.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(ft.Name)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FileTags is a parsable slice of FileTag.
+type FileTags []*FileTag
+
+func (ft FileTags) config(cfg config) {
+	for _i := range ft {
+		ft[_i].config = cfg
+	}
+}
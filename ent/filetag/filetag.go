@@ -0,0 +1,40 @@
+// github.com/sthorer/api
+
+package filetag
+
+const (
+	// Label holds the string label denoting the filetag type in the database.
+	Label = "file_tag"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID   = "id" // FieldName holds the string denoting the name vertex property in the database.
+	FieldName = "name"
+
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the filetag in the database.
+	Table = "file_tags"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "file_tags"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_indexed_tags"
+)
+
+// Columns holds all SQL columns for filetag fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the FileTag type.
+var ForeignKeys = []string{
+	"file_indexed_tags",
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+)
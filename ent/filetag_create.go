@@ -0,0 +1,136 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filetag"
+)
+
+// FileTagCreate is the builder for creating a FileTag entity.
+type FileTagCreate struct {
+	config
+	mutation *FileTagMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (ftc *FileTagCreate) SetName(s string) *FileTagCreate {
+	ftc.mutation.SetName(s)
+	return ftc
+}
+
+// SetFileID sets the file edge to File by id.
+func (ftc *FileTagCreate) SetFileID(id uuid.UUID) *FileTagCreate {
+	ftc.mutation.SetFileID(id)
+	return ftc
+}
+
+// SetFile sets the file edge to File.
+func (ftc *FileTagCreate) SetFile(f *File) *FileTagCreate {
+	return ftc.SetFileID(f.ID)
+}
+
+// Save creates the FileTag in the database.
+func (ftc *FileTagCreate) Save(ctx context.Context) (*FileTag, error) {
+	if _, ok := ftc.mutation.Name(); !ok {
+		return nil, errors.New("ent: missing required field \"name\"")
+	}
+	if v, ok := ftc.mutation.Name(); ok {
+		if err := filetag.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if _, ok := ftc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileTag
+	)
+	if len(ftc.hooks) == 0 {
+		node, err = ftc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileTagMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ftc.mutation = mutation
+			node, err = ftc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(ftc.hooks) - 1; i >= 0; i-- {
+			mut = ftc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ftc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ftc *FileTagCreate) SaveX(ctx context.Context) *FileTag {
+	v, err := ftc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ftc *FileTagCreate) sqlSave(ctx context.Context) (*FileTag, error) {
+	var (
+		ft    = &FileTag{config: ftc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: filetag.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filetag.FieldID,
+			},
+		}
+	)
+	if value, ok := ftc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filetag.FieldName,
+		})
+		ft.Name = value
+	}
+	if nodes := ftc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filetag.FileTable,
+			Columns: []string{filetag.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, ftc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	ft.ID = int(id)
+	return ft, nil
+}
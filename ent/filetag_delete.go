@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileTagDelete is the builder for deleting a FileTag entity.
+type FileTagDelete struct {
+	config
+	hooks      []Hook
+	mutation   *FileTagMutation
+	predicates []predicate.FileTag
+}
+
+// Where adds a new predicate to the delete builder.
+func (ftd *FileTagDelete) Where(ps ...predicate.FileTag) *FileTagDelete {
+	ftd.predicates = append(ftd.predicates, ps...)
+	return ftd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ftd *FileTagDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(ftd.hooks) == 0 {
+		affected, err = ftd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileTagMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ftd.mutation = mutation
+			affected, err = ftd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(ftd.hooks) - 1; i >= 0; i-- {
+			mut = ftd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ftd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftd *FileTagDelete) ExecX(ctx context.Context) int {
+	n, err := ftd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ftd *FileTagDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: filetag.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filetag.FieldID,
+			},
+		},
+	}
+	if ps := ftd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, ftd.driver, _spec)
+}
+
+// FileTagDeleteOne is the builder for deleting a single FileTag entity.
+type FileTagDeleteOne struct {
+	ftd *FileTagDelete
+}
+
+// Exec executes the deletion query.
+func (ftdo *FileTagDeleteOne) Exec(ctx context.Context) error {
+	n, err := ftdo.ftd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{filetag.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftdo *FileTagDeleteOne) ExecX(ctx context.Context) {
+	ftdo.ftd.ExecX(ctx)
+}
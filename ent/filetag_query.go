@@ -0,0 +1,732 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileTagQuery is the builder for querying FileTag entities.
+type FileTagQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.FileTag
+	// eager-loading edges.
+	withFile *FileQuery
+	withFKs  bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (ftq *FileTagQuery) Where(ps ...predicate.FileTag) *FileTagQuery {
+	ftq.predicates = append(ftq.predicates, ps...)
+	return ftq
+}
+
+// Limit adds a limit step to the query.
+func (ftq *FileTagQuery) Limit(limit int) *FileTagQuery {
+	ftq.limit = &limit
+	return ftq
+}
+
+// Offset adds an offset step to the query.
+func (ftq *FileTagQuery) Offset(offset int) *FileTagQuery {
+	ftq.offset = &offset
+	return ftq
+}
+
+// Order adds an order step to the query.
+func (ftq *FileTagQuery) Order(o ...Order) *FileTagQuery {
+	ftq.order = append(ftq.order, o...)
+	return ftq
+}
+
+// QueryFile chains the current query on the file edge.
+func (ftq *FileTagQuery) QueryFile() *FileQuery {
+	query := &FileQuery{config: ftq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(filetag.Table, filetag.FieldID, ftq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, filetag.FileTable, filetag.FileColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ftq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first FileTag entity in the query. Returns *NotFoundError when no filetag was found.
+func (ftq *FileTagQuery) First(ctx context.Context) (*FileTag, error) {
+	fts, err := ftq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(fts) == 0 {
+		return nil, &NotFoundError{filetag.Label}
+	}
+	return fts[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ftq *FileTagQuery) FirstX(ctx context.Context) *FileTag {
+	ft, err := ftq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return ft
+}
+
+// FirstID returns the first FileTag id in the query. Returns *NotFoundError when no id was found.
+func (ftq *FileTagQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ftq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{filetag.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (ftq *FileTagQuery) FirstXID(ctx context.Context) int {
+	id, err := ftq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only FileTag entity in the query, returns an error if not exactly one entity was returned.
+func (ftq *FileTagQuery) Only(ctx context.Context) (*FileTag, error) {
+	fts, err := ftq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fts) {
+	case 1:
+		return fts[0], nil
+	case 0:
+		return nil, &NotFoundError{filetag.Label}
+	default:
+		return nil, &NotSingularError{filetag.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ftq *FileTagQuery) OnlyX(ctx context.Context) *FileTag {
+	ft, err := ftq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ft
+}
+
+// OnlyID returns the only FileTag id in the query, returns an error if not exactly one id was returned.
+func (ftq *FileTagQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ftq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{filetag.Label}
+	default:
+		err = &NotSingularError{filetag.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (ftq *FileTagQuery) OnlyXID(ctx context.Context) int {
+	id, err := ftq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of FileTags.
+func (ftq *FileTagQuery) All(ctx context.Context) ([]*FileTag, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return ftq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ftq *FileTagQuery) AllX(ctx context.Context) []*FileTag {
+	fts, err := ftq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fts
+}
+
+// IDs executes the query and returns a list of FileTag ids.
+func (ftq *FileTagQuery) IDs(ctx context.Context) ([]int, error) {
+	var ids []int
+	if err := ftq.Select(filetag.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ftq *FileTagQuery) IDsX(ctx context.Context) []int {
+	ids, err := ftq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ftq *FileTagQuery) Count(ctx context.Context) (int, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return ftq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ftq *FileTagQuery) CountX(ctx context.Context) int {
+	count, err := ftq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ftq *FileTagQuery) Exist(ctx context.Context) (bool, error) {
+	if err := ftq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return ftq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ftq *FileTagQuery) ExistX(ctx context.Context) bool {
+	exist, err := ftq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ftq *FileTagQuery) Clone() *FileTagQuery {
+	return &FileTagQuery{
+		config:     ftq.config,
+		limit:      ftq.limit,
+		offset:     ftq.offset,
+		order:      append([]Order{}, ftq.order...),
+		unique:     append([]string{}, ftq.unique...),
+		predicates: append([]predicate.FileTag{}, ftq.predicates...),
+		// clone intermediate query.
+		sql:  ftq.sql.Clone(),
+		path: ftq.path,
+	}
+}
+
+//  WithFile tells the query-builder to eager-loads the nodes that are connected to
+// the "file" edge. The optional arguments used to configure the query builder of the edge.
+func (ftq *FileTagQuery) WithFile(opts ...func(*FileQuery)) *FileTagQuery {
+	query := &FileQuery{config: ftq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	ftq.withFile = query
+	return ftq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.FileTag.Query().
+//		GroupBy(filetag.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (ftq *FileTagQuery) GroupBy(field string, fields ...string) *FileTagGroupBy {
+	group := &FileTagGroupBy{config: ftq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.FileTag.Query().
+//		Select(filetag.FieldName).
+//		Scan(ctx, &v)
+//
+func (ftq *FileTagQuery) Select(field string, fields ...string) *FileTagSelect {
+	selector := &FileTagSelect{config: ftq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := ftq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return ftq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (ftq *FileTagQuery) prepareQuery(ctx context.Context) error {
+	if ftq.path != nil {
+		prev, err := ftq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ftq.sql = prev
+	}
+	return nil
+}
+
+func (ftq *FileTagQuery) sqlAll(ctx context.Context) ([]*FileTag, error) {
+	var (
+		nodes       = []*FileTag{}
+		withFKs     = ftq.withFKs
+		_spec       = ftq.querySpec()
+		loadedTypes = [1]bool{
+			ftq.withFile != nil,
+		}
+	)
+	if ftq.withFile != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, filetag.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &FileTag{config: ftq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ftq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := ftq.withFile; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*FileTag)
+		for i := range nodes {
+			if fk := nodes[i].file_indexed_tags; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(file.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "file_indexed_tags" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.File = n
+			}
+		}
+	}
+
+	return nodes, nil
+}
+
+func (ftq *FileTagQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ftq.querySpec()
+	return sqlgraph.CountNodes(ctx, ftq.driver, _spec)
+}
+
+func (ftq *FileTagQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := ftq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (ftq *FileTagQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filetag.Table,
+			Columns: filetag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filetag.FieldID,
+			},
+		},
+		From:   ftq.sql,
+		Unique: true,
+	}
+	if ps := ftq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ftq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ftq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ftq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ftq *FileTagQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(ftq.driver.Dialect())
+	t1 := builder.Table(filetag.Table)
+	selector := builder.Select(t1.Columns(filetag.Columns...)...).From(t1)
+	if ftq.sql != nil {
+		selector = ftq.sql
+		selector.Select(selector.Columns(filetag.Columns...)...)
+	}
+	for _, p := range ftq.predicates {
+		p(selector)
+	}
+	for _, p := range ftq.order {
+		p(selector)
+	}
+	if offset := ftq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ftq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FileTagGroupBy is the builder for group-by FileTag entities.
+type FileTagGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ftgb *FileTagGroupBy) Aggregate(fns ...Aggregate) *FileTagGroupBy {
+	ftgb.fns = append(ftgb.fns, fns...)
+	return ftgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (ftgb *FileTagGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := ftgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	ftgb.sql = query
+	return ftgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ftgb *FileTagGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := ftgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (ftgb *FileTagGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(ftgb.fields) > 1 {
+		return nil, errors.New("ent: FileTagGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := ftgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ftgb *FileTagGroupBy) StringsX(ctx context.Context) []string {
+	v, err := ftgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (ftgb *FileTagGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(ftgb.fields) > 1 {
+		return nil, errors.New("ent: FileTagGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := ftgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ftgb *FileTagGroupBy) IntsX(ctx context.Context) []int {
+	v, err := ftgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (ftgb *FileTagGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ftgb.fields) > 1 {
+		return nil, errors.New("ent: FileTagGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := ftgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ftgb *FileTagGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := ftgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (ftgb *FileTagGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(ftgb.fields) > 1 {
+		return nil, errors.New("ent: FileTagGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := ftgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ftgb *FileTagGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := ftgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ftgb *FileTagGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ftgb.sqlQuery().Query()
+	if err := ftgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ftgb *FileTagGroupBy) sqlQuery() *sql.Selector {
+	selector := ftgb.sql
+	columns := make([]string, 0, len(ftgb.fields)+len(ftgb.fns))
+	columns = append(columns, ftgb.fields...)
+	for _, fn := range ftgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(ftgb.fields...)
+}
+
+// FileTagSelect is the builder for select fields of FileTag entities.
+type FileTagSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (fts *FileTagSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := fts.path(ctx)
+	if err != nil {
+		return err
+	}
+	fts.sql = query
+	return fts.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fts *FileTagSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := fts.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (fts *FileTagSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTagSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fts *FileTagSelect) StringsX(ctx context.Context) []string {
+	v, err := fts.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (fts *FileTagSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTagSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fts *FileTagSelect) IntsX(ctx context.Context) []int {
+	v, err := fts.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (fts *FileTagSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTagSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fts *FileTagSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := fts.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (fts *FileTagSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(fts.fields) > 1 {
+		return nil, errors.New("ent: FileTagSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := fts.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fts *FileTagSelect) BoolsX(ctx context.Context) []bool {
+	v, err := fts.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fts *FileTagSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fts.sqlQuery().Query()
+	if err := fts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fts *FileTagSelect) sqlQuery() sql.Querier {
+	selector := fts.sql
+	selector.Select(selector.Columns(fts.fields...)...)
+	return selector
+}
@@ -0,0 +1,345 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FileTagUpdate is the builder for updating FileTag entities.
+type FileTagUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *FileTagMutation
+	predicates []predicate.FileTag
+}
+
+// Where adds a new predicate for the builder.
+func (ftu *FileTagUpdate) Where(ps ...predicate.FileTag) *FileTagUpdate {
+	ftu.predicates = append(ftu.predicates, ps...)
+	return ftu
+}
+
+// SetName sets the name field.
+func (ftu *FileTagUpdate) SetName(s string) *FileTagUpdate {
+	ftu.mutation.SetName(s)
+	return ftu
+}
+
+// SetFileID sets the file edge to File by id.
+func (ftu *FileTagUpdate) SetFileID(id uuid.UUID) *FileTagUpdate {
+	ftu.mutation.SetFileID(id)
+	return ftu
+}
+
+// SetFile sets the file edge to File.
+func (ftu *FileTagUpdate) SetFile(f *File) *FileTagUpdate {
+	return ftu.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (ftu *FileTagUpdate) ClearFile() *FileTagUpdate {
+	ftu.mutation.ClearFile()
+	return ftu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (ftu *FileTagUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := ftu.mutation.Name(); ok {
+		if err := filetag.NameValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+
+	if _, ok := ftu.mutation.FileID(); ftu.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(ftu.hooks) == 0 {
+		affected, err = ftu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileTagMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ftu.mutation = mutation
+			affected, err = ftu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(ftu.hooks) - 1; i >= 0; i-- {
+			mut = ftu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ftu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftu *FileTagUpdate) SaveX(ctx context.Context) int {
+	affected, err := ftu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ftu *FileTagUpdate) Exec(ctx context.Context) error {
+	_, err := ftu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftu *FileTagUpdate) ExecX(ctx context.Context) {
+	if err := ftu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ftu *FileTagUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filetag.Table,
+			Columns: filetag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filetag.FieldID,
+			},
+		},
+	}
+	if ps := ftu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ftu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filetag.FieldName,
+		})
+	}
+	if ftu.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filetag.FileTable,
+			Columns: []string{filetag.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ftu.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filetag.FileTable,
+			Columns: []string{filetag.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ftu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filetag.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FileTagUpdateOne is the builder for updating a single FileTag entity.
+type FileTagUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FileTagMutation
+}
+
+// SetName sets the name field.
+func (ftuo *FileTagUpdateOne) SetName(s string) *FileTagUpdateOne {
+	ftuo.mutation.SetName(s)
+	return ftuo
+}
+
+// SetFileID sets the file edge to File by id.
+func (ftuo *FileTagUpdateOne) SetFileID(id uuid.UUID) *FileTagUpdateOne {
+	ftuo.mutation.SetFileID(id)
+	return ftuo
+}
+
+// SetFile sets the file edge to File.
+func (ftuo *FileTagUpdateOne) SetFile(f *File) *FileTagUpdateOne {
+	return ftuo.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (ftuo *FileTagUpdateOne) ClearFile() *FileTagUpdateOne {
+	ftuo.mutation.ClearFile()
+	return ftuo
+}
+
+// Save executes the query and returns the updated entity.
+func (ftuo *FileTagUpdateOne) Save(ctx context.Context) (*FileTag, error) {
+	if v, ok := ftuo.mutation.Name(); ok {
+		if err := filetag.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+
+	if _, ok := ftuo.mutation.FileID(); ftuo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *FileTag
+	)
+	if len(ftuo.hooks) == 0 {
+		node, err = ftuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FileTagMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			ftuo.mutation = mutation
+			node, err = ftuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(ftuo.hooks) - 1; i >= 0; i-- {
+			mut = ftuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, ftuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ftuo *FileTagUpdateOne) SaveX(ctx context.Context) *FileTag {
+	ft, err := ftuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ft
+}
+
+// Exec executes the query on the entity.
+func (ftuo *FileTagUpdateOne) Exec(ctx context.Context) error {
+	_, err := ftuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ftuo *FileTagUpdateOne) ExecX(ctx context.Context) {
+	if err := ftuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (ftuo *FileTagUpdateOne) sqlSave(ctx context.Context) (ft *FileTag, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   filetag.Table,
+			Columns: filetag.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: filetag.FieldID,
+			},
+		},
+	}
+	id, ok := ftuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing FileTag.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := ftuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: filetag.FieldName,
+		})
+	}
+	if ftuo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filetag.FileTable,
+			Columns: []string{filetag.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ftuo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   filetag.FileTable,
+			Columns: []string{filetag.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	ft = &FileTag{config: ftuo.config}
+	_spec.Assign = ft.assignValues
+	_spec.ScanValues = ft.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, ftuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{filetag.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return ft, nil
+}
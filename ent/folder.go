@@ -0,0 +1,217 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/user"
+)
+
+// Folder is the model entity for the Folder schema.
+type Folder struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FolderQuery when eager-loading is set.
+	Edges           FolderEdges `json:"edges"`
+	folder_children *uuid.UUID
+	user_folders    *int
+}
+
+// FolderEdges holds the relations/edges for other nodes in the graph.
+type FolderEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// Parent holds the value of the parent edge.
+	Parent *Folder
+	// Children holds the value of the children edge.
+	Children []*Folder
+	// Files holds the value of the files edge.
+	Files []*File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FolderEdges) ParentOrErr() (*Folder, error) {
+	if e.loadedTypes[1] {
+		if e.Parent == nil {
+			// The edge parent was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: folder.Label}
+		}
+		return e.Parent, nil
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) ChildrenOrErr() ([]*Folder, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// FilesOrErr returns the Files value or an error if the edge
+// was not loaded in eager-loading.
+func (e FolderEdges) FilesOrErr() ([]*File, error) {
+	if e.loadedTypes[3] {
+		return e.Files, nil
+	}
+	return nil, &NotLoadedError{edge: "files"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Folder) scanValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},      // id
+		&sql.NullString{}, // name
+		&sql.NullTime{},   // updated_at
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Folder) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},     // folder_children
+		&sql.NullInt64{}, // user_folders
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Folder fields.
+func (f *Folder) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(folder.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	if value, ok := values[0].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field id", values[0])
+	} else if value != nil {
+		f.ID = *value
+	}
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field name", values[0])
+	} else if value.Valid {
+		f.Name = value.String
+	}
+	if value, ok := values[1].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field updated_at", values[1])
+	} else if value.Valid {
+		f.UpdatedAt = value.Time
+	}
+	if value, ok := values[2].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[2])
+	} else if value.Valid {
+		f.CreatedAt = value.Time
+	}
+	values = values[3:]
+	if len(values) == len(folder.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field folder_children", values[0])
+		} else if value != nil {
+			f.folder_children = value
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_folders", value)
+		} else if value.Valid {
+			f.user_folders = new(int)
+			*f.user_folders = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the Folder.
+func (f *Folder) QueryUser() *UserQuery {
+	return (&FolderClient{config: f.config}).QueryUser(f)
+}
+
+// QueryParent queries the parent edge of the Folder.
+func (f *Folder) QueryParent() *FolderQuery {
+	return (&FolderClient{config: f.config}).QueryParent(f)
+}
+
+// QueryChildren queries the children edge of the Folder.
+func (f *Folder) QueryChildren() *FolderQuery {
+	return (&FolderClient{config: f.config}).QueryChildren(f)
+}
+
+// QueryFiles queries the files edge of the Folder.
+func (f *Folder) QueryFiles() *FileQuery {
+	return (&FolderClient{config: f.config}).QueryFiles(f)
+}
+
+// Update returns a builder for updating this Folder.
+// Note that, you need to call Folder.Unwrap() before calling this method, if this Folder
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Folder) Update() *FolderUpdateOne {
+	return (&FolderClient{config: f.config}).UpdateOne(f)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (f *Folder) Unwrap() *Folder {
+	tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Folder is not a transactional entity")
+	}
+	f.config.driver = tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Folder) String() string {
+	var builder strings.Builder
+	builder.WriteString("Folder(")
+	builder.WriteString(fmt.Sprintf("id=%v", f.ID))
+	builder.WriteString(", name=")
+	builder.WriteString(f.Name)
+	builder.WriteString(", updated_at=")
+	builder.WriteString(f.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Folders is a parsable slice of Folder.
+type Folders []*Folder
+
+func (f Folders) config(cfg config) {
+	for _i := range f {
+		f[_i].config = cfg
+	}
+}
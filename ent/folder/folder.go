@@ -0,0 +1,76 @@
+// github.com/sthorer/api
+
+package folder
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the folder type in the database.
+	Label = "folder"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID        = "id"         // FieldName holds the string denoting the name vertex property in the database.
+	FieldName      = "name"       // FieldUpdatedAt holds the string denoting the updated_at vertex property in the database.
+	FieldUpdatedAt = "updated_at" // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeFiles holds the string denoting the files edge name in mutations.
+	EdgeFiles = "files"
+
+	// Table holds the table name of the folder in the database.
+	Table = "folders"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "folders"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_folders"
+	// ParentTable is the table the holds the parent relation/edge.
+	ParentTable = "folders"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "folder_children"
+	// ChildrenTable is the table the holds the children relation/edge.
+	ChildrenTable = "folders"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "folder_children"
+	// FilesTable is the table the holds the files relation/edge.
+	FilesTable = "files"
+	// FilesInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FilesInverseTable = "files"
+	// FilesColumn is the table column denoting the files relation/edge.
+	FilesColumn = "folder_files"
+)
+
+// Columns holds all SQL columns for folder fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldUpdatedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Folder type.
+var ForeignKeys = []string{
+	"folder_children",
+	"user_folders",
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
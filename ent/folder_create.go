@@ -0,0 +1,304 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/user"
+)
+
+// FolderCreate is the builder for creating a Folder entity.
+type FolderCreate struct {
+	config
+	mutation *FolderMutation
+	hooks    []Hook
+}
+
+// SetName sets the name field.
+func (fc *FolderCreate) SetName(s string) *FolderCreate {
+	fc.mutation.SetName(s)
+	return fc
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (fc *FolderCreate) SetUpdatedAt(t time.Time) *FolderCreate {
+	fc.mutation.SetUpdatedAt(t)
+	return fc
+}
+
+// SetNillableUpdatedAt sets the updated_at field if the given value is not nil.
+func (fc *FolderCreate) SetNillableUpdatedAt(t *time.Time) *FolderCreate {
+	if t != nil {
+		fc.SetUpdatedAt(*t)
+	}
+	return fc
+}
+
+// SetCreatedAt sets the created_at field.
+func (fc *FolderCreate) SetCreatedAt(t time.Time) *FolderCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (fc *FolderCreate) SetNillableCreatedAt(t *time.Time) *FolderCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetID sets the id field.
+func (fc *FolderCreate) SetID(u uuid.UUID) *FolderCreate {
+	fc.mutation.SetID(u)
+	return fc
+}
+
+// SetUserID sets the user edge to User by id.
+func (fc *FolderCreate) SetUserID(id int) *FolderCreate {
+	fc.mutation.SetUserID(id)
+	return fc
+}
+
+// SetUser sets the user edge to User.
+func (fc *FolderCreate) SetUser(u *User) *FolderCreate {
+	return fc.SetUserID(u.ID)
+}
+
+// SetParentID sets the parent edge to Folder by id.
+func (fc *FolderCreate) SetParentID(id uuid.UUID) *FolderCreate {
+	fc.mutation.SetParentID(id)
+	return fc
+}
+
+// SetNillableParentID sets the parent edge to Folder by id if the given value is not nil.
+func (fc *FolderCreate) SetNillableParentID(id *uuid.UUID) *FolderCreate {
+	if id != nil {
+		fc = fc.SetParentID(*id)
+	}
+	return fc
+}
+
+// SetParent sets the parent edge to Folder.
+func (fc *FolderCreate) SetParent(f *Folder) *FolderCreate {
+	return fc.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the children edge to Folder by ids.
+func (fc *FolderCreate) AddChildIDs(ids ...uuid.UUID) *FolderCreate {
+	fc.mutation.AddChildIDs(ids...)
+	return fc
+}
+
+// AddChildren adds the children edges to Folder.
+func (fc *FolderCreate) AddChildren(f ...*Folder) *FolderCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddChildIDs(ids...)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (fc *FolderCreate) AddFileIDs(ids ...uuid.UUID) *FolderCreate {
+	fc.mutation.AddFileIDs(ids...)
+	return fc
+}
+
+// AddFiles adds the files edges to File.
+func (fc *FolderCreate) AddFiles(f ...*File) *FolderCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fc.AddFileIDs(ids...)
+}
+
+// Save creates the Folder in the database.
+func (fc *FolderCreate) Save(ctx context.Context) (*Folder, error) {
+	if _, ok := fc.mutation.Name(); !ok {
+		return nil, errors.New("ent: missing required field \"name\"")
+	}
+	if v, ok := fc.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		v := folder.DefaultUpdatedAt()
+		fc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		v := folder.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fc.mutation.UserID(); !ok {
+		return nil, errors.New("ent: missing required edge \"user\"")
+	}
+	var (
+		err  error
+		node *Folder
+	)
+	if len(fc.hooks) == 0 {
+		node, err = fc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FolderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fc.mutation = mutation
+			node, err = fc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fc.hooks) - 1; i >= 0; i-- {
+			mut = fc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FolderCreate) SaveX(ctx context.Context) *Folder {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fc *FolderCreate) sqlSave(ctx context.Context) (*Folder, error) {
+	var (
+		f     = &Folder{config: fc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: folder.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: folder.FieldID,
+			},
+		}
+	)
+	if id, ok := fc.mutation.ID(); ok {
+		f.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := fc.mutation.Name(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: folder.FieldName,
+		})
+		f.Name = value
+	}
+	if value, ok := fc.mutation.UpdatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: folder.FieldUpdatedAt,
+		})
+		f.UpdatedAt = value
+	}
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: folder.FieldCreatedAt,
+		})
+		f.CreatedAt = value
+	}
+	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.UserTable,
+			Columns: []string{folder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := fc.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.FilesTable,
+			Columns: []string{folder.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return f, nil
+}
@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/predicate"
+)
+
+// FolderDelete is the builder for deleting a Folder entity.
+type FolderDelete struct {
+	config
+	hooks      []Hook
+	mutation   *FolderMutation
+	predicates []predicate.Folder
+}
+
+// Where adds a new predicate to the delete builder.
+func (fd *FolderDelete) Where(ps ...predicate.Folder) *FolderDelete {
+	fd.predicates = append(fd.predicates, ps...)
+	return fd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (fd *FolderDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(fd.hooks) == 0 {
+		affected, err = fd.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FolderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fd.mutation = mutation
+			affected, err = fd.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(fd.hooks) - 1; i >= 0; i-- {
+			mut = fd.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fd.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fd *FolderDelete) ExecX(ctx context.Context) int {
+	n, err := fd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (fd *FolderDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: folder.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: folder.FieldID,
+			},
+		},
+	}
+	if ps := fd.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, fd.driver, _spec)
+}
+
+// FolderDeleteOne is the builder for deleting a single Folder entity.
+type FolderDeleteOne struct {
+	fd *FolderDelete
+}
+
+// Exec executes the deletion query.
+func (fdo *FolderDeleteOne) Exec(ctx context.Context) error {
+	n, err := fdo.fd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{folder.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fdo *FolderDeleteOne) ExecX(ctx context.Context) {
+	fdo.fd.ExecX(ctx)
+}
@@ -0,0 +1,908 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// FolderQuery is the builder for querying Folder entities.
+type FolderQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.Folder
+	// eager-loading edges.
+	withUser     *UserQuery
+	withParent   *FolderQuery
+	withChildren *FolderQuery
+	withFiles    *FileQuery
+	withFKs      bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (fq *FolderQuery) Where(ps ...predicate.Folder) *FolderQuery {
+	fq.predicates = append(fq.predicates, ps...)
+	return fq
+}
+
+// Limit adds a limit step to the query.
+func (fq *FolderQuery) Limit(limit int) *FolderQuery {
+	fq.limit = &limit
+	return fq
+}
+
+// Offset adds an offset step to the query.
+func (fq *FolderQuery) Offset(offset int) *FolderQuery {
+	fq.offset = &offset
+	return fq
+}
+
+// Order adds an order step to the query.
+func (fq *FolderQuery) Order(o ...Order) *FolderQuery {
+	fq.order = append(fq.order, o...)
+	return fq
+}
+
+// QueryUser chains the current query on the user edge.
+func (fq *FolderQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, fq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.UserTable, folder.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryParent chains the current query on the parent edge.
+func (fq *FolderQuery) QueryParent() *FolderQuery {
+	query := &FolderQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, fq.sqlQuery()),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, folder.ParentTable, folder.ParentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChildren chains the current query on the children edge.
+func (fq *FolderQuery) QueryChildren() *FolderQuery {
+	query := &FolderQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, fq.sqlQuery()),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.ChildrenTable, folder.ChildrenColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFiles chains the current query on the files edge.
+func (fq *FolderQuery) QueryFiles() *FileQuery {
+	query := &FileQuery{config: fq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(folder.Table, folder.FieldID, fq.sqlQuery()),
+			sqlgraph.To(file.Table, file.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, folder.FilesTable, folder.FilesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(fq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Folder entity in the query. Returns *NotFoundError when no folder was found.
+func (fq *FolderQuery) First(ctx context.Context) (*Folder, error) {
+	fs, err := fq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(fs) == 0 {
+		return nil, &NotFoundError{folder.Label}
+	}
+	return fs[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (fq *FolderQuery) FirstX(ctx context.Context) *Folder {
+	f, err := fq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return f
+}
+
+// FirstID returns the first Folder id in the query. Returns *NotFoundError when no id was found.
+func (fq *FolderQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{folder.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (fq *FolderQuery) FirstXID(ctx context.Context) uuid.UUID {
+	id, err := fq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Folder entity in the query, returns an error if not exactly one entity was returned.
+func (fq *FolderQuery) Only(ctx context.Context) (*Folder, error) {
+	fs, err := fq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(fs) {
+	case 1:
+		return fs[0], nil
+	case 0:
+		return nil, &NotFoundError{folder.Label}
+	default:
+		return nil, &NotSingularError{folder.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (fq *FolderQuery) OnlyX(ctx context.Context) *Folder {
+	f, err := fq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// OnlyID returns the only Folder id in the query, returns an error if not exactly one id was returned.
+func (fq *FolderQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = fq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{folder.Label}
+	default:
+		err = &NotSingularError{folder.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (fq *FolderQuery) OnlyXID(ctx context.Context) uuid.UUID {
+	id, err := fq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Folders.
+func (fq *FolderQuery) All(ctx context.Context) ([]*Folder, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return fq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (fq *FolderQuery) AllX(ctx context.Context) []*Folder {
+	fs, err := fq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return fs
+}
+
+// IDs executes the query and returns a list of Folder ids.
+func (fq *FolderQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := fq.Select(folder.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (fq *FolderQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := fq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (fq *FolderQuery) Count(ctx context.Context) (int, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return fq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (fq *FolderQuery) CountX(ctx context.Context) int {
+	count, err := fq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (fq *FolderQuery) Exist(ctx context.Context) (bool, error) {
+	if err := fq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return fq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (fq *FolderQuery) ExistX(ctx context.Context) bool {
+	exist, err := fq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (fq *FolderQuery) Clone() *FolderQuery {
+	return &FolderQuery{
+		config:     fq.config,
+		limit:      fq.limit,
+		offset:     fq.offset,
+		order:      append([]Order{}, fq.order...),
+		unique:     append([]string{}, fq.unique...),
+		predicates: append([]predicate.Folder{}, fq.predicates...),
+		// clone intermediate query.
+		sql:  fq.sql.Clone(),
+		path: fq.path,
+	}
+}
+
+//  WithUser tells the query-builder to eager-loads the nodes that are connected to
+// the "user" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FolderQuery) WithUser(opts ...func(*UserQuery)) *FolderQuery {
+	query := &UserQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withUser = query
+	return fq
+}
+
+//  WithParent tells the query-builder to eager-loads the nodes that are connected to
+// the "parent" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FolderQuery) WithParent(opts ...func(*FolderQuery)) *FolderQuery {
+	query := &FolderQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withParent = query
+	return fq
+}
+
+//  WithChildren tells the query-builder to eager-loads the nodes that are connected to
+// the "children" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FolderQuery) WithChildren(opts ...func(*FolderQuery)) *FolderQuery {
+	query := &FolderQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withChildren = query
+	return fq
+}
+
+//  WithFiles tells the query-builder to eager-loads the nodes that are connected to
+// the "files" edge. The optional arguments used to configure the query builder of the edge.
+func (fq *FolderQuery) WithFiles(opts ...func(*FileQuery)) *FolderQuery {
+	query := &FileQuery{config: fq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	fq.withFiles = query
+	return fq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Folder.Query().
+//		GroupBy(folder.FieldName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (fq *FolderQuery) GroupBy(field string, fields ...string) *FolderGroupBy {
+	group := &FolderGroupBy{config: fq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		Name string `json:"name,omitempty"`
+//	}
+//
+//	client.Folder.Query().
+//		Select(folder.FieldName).
+//		Scan(ctx, &v)
+//
+func (fq *FolderQuery) Select(field string, fields ...string) *FolderSelect {
+	selector := &FolderSelect{config: fq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := fq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return fq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (fq *FolderQuery) prepareQuery(ctx context.Context) error {
+	if fq.path != nil {
+		prev, err := fq.path(ctx)
+		if err != nil {
+			return err
+		}
+		fq.sql = prev
+	}
+	return nil
+}
+
+func (fq *FolderQuery) sqlAll(ctx context.Context) ([]*Folder, error) {
+	var (
+		nodes       = []*Folder{}
+		withFKs     = fq.withFKs
+		_spec       = fq.querySpec()
+		loadedTypes = [4]bool{
+			fq.withUser != nil,
+			fq.withParent != nil,
+			fq.withChildren != nil,
+			fq.withFiles != nil,
+		}
+	)
+	if fq.withUser != nil || fq.withParent != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, folder.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Folder{config: fq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, fq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := fq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Folder)
+		for i := range nodes {
+			if fk := nodes[i].user_folders; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_folders" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := fq.withParent; query != nil {
+		ids := make([]uuid.UUID, 0, len(nodes))
+		nodeids := make(map[uuid.UUID][]*Folder)
+		for i := range nodes {
+			if fk := nodes[i].folder_children; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(folder.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "folder_children" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.Parent = n
+			}
+		}
+	}
+
+	if query := fq.withChildren; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Folder)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Folder(func(s *sql.Selector) {
+			s.Where(sql.InValues(folder.ChildrenColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.folder_children
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "folder_children" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "folder_children" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Children = append(node.Edges.Children, n)
+		}
+	}
+
+	if query := fq.withFiles; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Folder)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.File(func(s *sql.Selector) {
+			s.Where(sql.InValues(folder.FilesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.folder_files
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "folder_files" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "folder_files" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Files = append(node.Edges.Files, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (fq *FolderQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := fq.querySpec()
+	return sqlgraph.CountNodes(ctx, fq.driver, _spec)
+}
+
+func (fq *FolderQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := fq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (fq *FolderQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   folder.Table,
+			Columns: folder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: folder.FieldID,
+			},
+		},
+		From:   fq.sql,
+		Unique: true,
+	}
+	if ps := fq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := fq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := fq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := fq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (fq *FolderQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(fq.driver.Dialect())
+	t1 := builder.Table(folder.Table)
+	selector := builder.Select(t1.Columns(folder.Columns...)...).From(t1)
+	if fq.sql != nil {
+		selector = fq.sql
+		selector.Select(selector.Columns(folder.Columns...)...)
+	}
+	for _, p := range fq.predicates {
+		p(selector)
+	}
+	for _, p := range fq.order {
+		p(selector)
+	}
+	if offset := fq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := fq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// FolderGroupBy is the builder for group-by Folder entities.
+type FolderGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (fgb *FolderGroupBy) Aggregate(fns ...Aggregate) *FolderGroupBy {
+	fgb.fns = append(fgb.fns, fns...)
+	return fgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (fgb *FolderGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := fgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	fgb.sql = query
+	return fgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fgb *FolderGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := fgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (fgb *FolderGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(fgb.fields) > 1 {
+		return nil, errors.New("ent: FolderGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := fgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fgb *FolderGroupBy) StringsX(ctx context.Context) []string {
+	v, err := fgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (fgb *FolderGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(fgb.fields) > 1 {
+		return nil, errors.New("ent: FolderGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := fgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fgb *FolderGroupBy) IntsX(ctx context.Context) []int {
+	v, err := fgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (fgb *FolderGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fgb.fields) > 1 {
+		return nil, errors.New("ent: FolderGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := fgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fgb *FolderGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := fgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (fgb *FolderGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(fgb.fields) > 1 {
+		return nil, errors.New("ent: FolderGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := fgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fgb *FolderGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := fgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fgb *FolderGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fgb.sqlQuery().Query()
+	if err := fgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fgb *FolderGroupBy) sqlQuery() *sql.Selector {
+	selector := fgb.sql
+	columns := make([]string, 0, len(fgb.fields)+len(fgb.fns))
+	columns = append(columns, fgb.fields...)
+	for _, fn := range fgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(fgb.fields...)
+}
+
+// FolderSelect is the builder for select fields of Folder entities.
+type FolderSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (fs *FolderSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := fs.path(ctx)
+	if err != nil {
+		return err
+	}
+	fs.sql = query
+	return fs.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (fs *FolderSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := fs.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (fs *FolderSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FolderSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (fs *FolderSelect) StringsX(ctx context.Context) []string {
+	v, err := fs.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (fs *FolderSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FolderSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (fs *FolderSelect) IntsX(ctx context.Context) []int {
+	v, err := fs.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (fs *FolderSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FolderSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (fs *FolderSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := fs.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (fs *FolderSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(fs.fields) > 1 {
+		return nil, errors.New("ent: FolderSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := fs.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (fs *FolderSelect) BoolsX(ctx context.Context) []bool {
+	v, err := fs.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (fs *FolderSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := fs.sqlQuery().Query()
+	if err := fs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (fs *FolderSelect) sqlQuery() sql.Querier {
+	selector := fs.sql
+	selector.Select(selector.Columns(fs.fields...)...)
+	return selector
+}
@@ -0,0 +1,775 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+)
+
+// FolderUpdate is the builder for updating Folder entities.
+type FolderUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *FolderMutation
+	predicates []predicate.Folder
+}
+
+// Where adds a new predicate for the builder.
+func (fu *FolderUpdate) Where(ps ...predicate.Folder) *FolderUpdate {
+	fu.predicates = append(fu.predicates, ps...)
+	return fu
+}
+
+// SetName sets the name field.
+func (fu *FolderUpdate) SetName(s string) *FolderUpdate {
+	fu.mutation.SetName(s)
+	return fu
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (fu *FolderUpdate) SetUpdatedAt(t time.Time) *FolderUpdate {
+	fu.mutation.SetUpdatedAt(t)
+	return fu
+}
+
+// SetUserID sets the user edge to User by id.
+func (fu *FolderUpdate) SetUserID(id int) *FolderUpdate {
+	fu.mutation.SetUserID(id)
+	return fu
+}
+
+// SetUser sets the user edge to User.
+func (fu *FolderUpdate) SetUser(u *User) *FolderUpdate {
+	return fu.SetUserID(u.ID)
+}
+
+// SetParentID sets the parent edge to Folder by id.
+func (fu *FolderUpdate) SetParentID(id uuid.UUID) *FolderUpdate {
+	fu.mutation.SetParentID(id)
+	return fu
+}
+
+// SetNillableParentID sets the parent edge to Folder by id if the given value is not nil.
+func (fu *FolderUpdate) SetNillableParentID(id *uuid.UUID) *FolderUpdate {
+	if id != nil {
+		fu = fu.SetParentID(*id)
+	}
+	return fu
+}
+
+// SetParent sets the parent edge to Folder.
+func (fu *FolderUpdate) SetParent(f *Folder) *FolderUpdate {
+	return fu.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the children edge to Folder by ids.
+func (fu *FolderUpdate) AddChildIDs(ids ...uuid.UUID) *FolderUpdate {
+	fu.mutation.AddChildIDs(ids...)
+	return fu
+}
+
+// AddChildren adds the children edges to Folder.
+func (fu *FolderUpdate) AddChildren(f ...*Folder) *FolderUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddChildIDs(ids...)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (fu *FolderUpdate) AddFileIDs(ids ...uuid.UUID) *FolderUpdate {
+	fu.mutation.AddFileIDs(ids...)
+	return fu
+}
+
+// AddFiles adds the files edges to File.
+func (fu *FolderUpdate) AddFiles(f ...*File) *FolderUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.AddFileIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (fu *FolderUpdate) ClearUser() *FolderUpdate {
+	fu.mutation.ClearUser()
+	return fu
+}
+
+// ClearParent clears the parent edge to Folder.
+func (fu *FolderUpdate) ClearParent() *FolderUpdate {
+	fu.mutation.ClearParent()
+	return fu
+}
+
+// RemoveChildIDs removes the children edge to Folder by ids.
+func (fu *FolderUpdate) RemoveChildIDs(ids ...uuid.UUID) *FolderUpdate {
+	fu.mutation.RemoveChildIDs(ids...)
+	return fu
+}
+
+// RemoveChildren removes children edges to Folder.
+func (fu *FolderUpdate) RemoveChildren(f ...*Folder) *FolderUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveChildIDs(ids...)
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (fu *FolderUpdate) RemoveFileIDs(ids ...uuid.UUID) *FolderUpdate {
+	fu.mutation.RemoveFileIDs(ids...)
+	return fu
+}
+
+// RemoveFiles removes files edges to File.
+func (fu *FolderUpdate) RemoveFiles(f ...*File) *FolderUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fu.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (fu *FolderUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := fu.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if _, ok := fu.mutation.UpdatedAt(); !ok {
+		v := folder.UpdateDefaultUpdatedAt()
+		fu.mutation.SetUpdatedAt(v)
+	}
+
+	if _, ok := fu.mutation.UserID(); fu.mutation.UserCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(fu.hooks) == 0 {
+		affected, err = fu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FolderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fu.mutation = mutation
+			affected, err = fu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(fu.hooks) - 1; i >= 0; i-- {
+			mut = fu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FolderUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FolderUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FolderUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fu *FolderUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   folder.Table,
+			Columns: folder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: folder.FieldID,
+			},
+		},
+	}
+	if ps := fu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: folder.FieldName,
+		})
+	}
+	if value, ok := fu.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: folder.FieldUpdatedAt,
+		})
+	}
+	if fu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.UserTable,
+			Columns: []string{folder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.UserTable,
+			Columns: []string{folder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fu.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fu.mutation.RemovedFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.FilesTable,
+			Columns: []string{folder.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.FilesTable,
+			Columns: []string{folder.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folder.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// FolderUpdateOne is the builder for updating a single Folder entity.
+type FolderUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *FolderMutation
+}
+
+// SetName sets the name field.
+func (fuo *FolderUpdateOne) SetName(s string) *FolderUpdateOne {
+	fuo.mutation.SetName(s)
+	return fuo
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (fuo *FolderUpdateOne) SetUpdatedAt(t time.Time) *FolderUpdateOne {
+	fuo.mutation.SetUpdatedAt(t)
+	return fuo
+}
+
+// SetUserID sets the user edge to User by id.
+func (fuo *FolderUpdateOne) SetUserID(id int) *FolderUpdateOne {
+	fuo.mutation.SetUserID(id)
+	return fuo
+}
+
+// SetUser sets the user edge to User.
+func (fuo *FolderUpdateOne) SetUser(u *User) *FolderUpdateOne {
+	return fuo.SetUserID(u.ID)
+}
+
+// SetParentID sets the parent edge to Folder by id.
+func (fuo *FolderUpdateOne) SetParentID(id uuid.UUID) *FolderUpdateOne {
+	fuo.mutation.SetParentID(id)
+	return fuo
+}
+
+// SetNillableParentID sets the parent edge to Folder by id if the given value is not nil.
+func (fuo *FolderUpdateOne) SetNillableParentID(id *uuid.UUID) *FolderUpdateOne {
+	if id != nil {
+		fuo = fuo.SetParentID(*id)
+	}
+	return fuo
+}
+
+// SetParent sets the parent edge to Folder.
+func (fuo *FolderUpdateOne) SetParent(f *Folder) *FolderUpdateOne {
+	return fuo.SetParentID(f.ID)
+}
+
+// AddChildIDs adds the children edge to Folder by ids.
+func (fuo *FolderUpdateOne) AddChildIDs(ids ...uuid.UUID) *FolderUpdateOne {
+	fuo.mutation.AddChildIDs(ids...)
+	return fuo
+}
+
+// AddChildren adds the children edges to Folder.
+func (fuo *FolderUpdateOne) AddChildren(f ...*Folder) *FolderUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddChildIDs(ids...)
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (fuo *FolderUpdateOne) AddFileIDs(ids ...uuid.UUID) *FolderUpdateOne {
+	fuo.mutation.AddFileIDs(ids...)
+	return fuo
+}
+
+// AddFiles adds the files edges to File.
+func (fuo *FolderUpdateOne) AddFiles(f ...*File) *FolderUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.AddFileIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (fuo *FolderUpdateOne) ClearUser() *FolderUpdateOne {
+	fuo.mutation.ClearUser()
+	return fuo
+}
+
+// ClearParent clears the parent edge to Folder.
+func (fuo *FolderUpdateOne) ClearParent() *FolderUpdateOne {
+	fuo.mutation.ClearParent()
+	return fuo
+}
+
+// RemoveChildIDs removes the children edge to Folder by ids.
+func (fuo *FolderUpdateOne) RemoveChildIDs(ids ...uuid.UUID) *FolderUpdateOne {
+	fuo.mutation.RemoveChildIDs(ids...)
+	return fuo
+}
+
+// RemoveChildren removes children edges to Folder.
+func (fuo *FolderUpdateOne) RemoveChildren(f ...*Folder) *FolderUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveChildIDs(ids...)
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (fuo *FolderUpdateOne) RemoveFileIDs(ids ...uuid.UUID) *FolderUpdateOne {
+	fuo.mutation.RemoveFileIDs(ids...)
+	return fuo
+}
+
+// RemoveFiles removes files edges to File.
+func (fuo *FolderUpdateOne) RemoveFiles(f ...*File) *FolderUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return fuo.RemoveFileIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (fuo *FolderUpdateOne) Save(ctx context.Context) (*Folder, error) {
+	if v, ok := fuo.mutation.Name(); ok {
+		if err := folder.NameValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"name\": %v", err)
+		}
+	}
+	if _, ok := fuo.mutation.UpdatedAt(); !ok {
+		v := folder.UpdateDefaultUpdatedAt()
+		fuo.mutation.SetUpdatedAt(v)
+	}
+
+	if _, ok := fuo.mutation.UserID(); fuo.mutation.UserCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err  error
+		node *Folder
+	)
+	if len(fuo.hooks) == 0 {
+		node, err = fuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*FolderMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			fuo.mutation = mutation
+			node, err = fuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(fuo.hooks) - 1; i >= 0; i-- {
+			mut = fuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, fuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FolderUpdateOne) SaveX(ctx context.Context) *Folder {
+	f, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return f
+}
+
+// Exec executes the query on the entity.
+func (fuo *FolderUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FolderUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (fuo *FolderUpdateOne) sqlSave(ctx context.Context) (f *Folder, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   folder.Table,
+			Columns: folder.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: folder.FieldID,
+			},
+		},
+	}
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Folder.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := fuo.mutation.Name(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: folder.FieldName,
+		})
+	}
+	if value, ok := fuo.mutation.UpdatedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: folder.FieldUpdatedAt,
+		})
+	}
+	if fuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.UserTable,
+			Columns: []string{folder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.UserTable,
+			Columns: []string{folder.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if fuo.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   folder.ParentTable,
+			Columns: []string{folder.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.ChildrenTable,
+			Columns: []string{folder.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := fuo.mutation.RemovedFilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.FilesTable,
+			Columns: []string{folder.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.FilesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   folder.FilesTable,
+			Columns: []string{folder.FilesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	f = &Folder{config: fuo.config}
+	_spec.Assign = f.assignValues
+	_spec.ScanValues = f.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{folder.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return f, nil
+}
@@ -9,6 +9,32 @@ import (
 	"github.com/sthorer/api/ent"
 )
 
+// The AuditLogFunc type is an adapter to allow the use of ordinary
+// function as AuditLog mutator.
+type AuditLogFunc func(context.Context, *ent.AuditLogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditLogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.AuditLogMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuditLogMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The BucketFunc type is an adapter to allow the use of ordinary
+// function as Bucket mutator.
+type BucketFunc func(context.Context, *ent.BucketMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BucketFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.BucketMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BucketMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The FileFunc type is an adapter to allow the use of ordinary
 // function as File mutator.
 type FileFunc func(context.Context, *ent.FileMutation) (ent.Value, error)
@@ -22,6 +48,97 @@ func (f FileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The FileKeyFunc type is an adapter to allow the use of ordinary
+// function as FileKey mutator.
+type FileKeyFunc func(context.Context, *ent.FileKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FileKeyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileKeyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FileMediaFunc type is an adapter to allow the use of ordinary
+// function as FileMedia mutator.
+type FileMediaFunc func(context.Context, *ent.FileMediaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileMediaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FileMediaMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileMediaMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FilePropertyFunc type is an adapter to allow the use of ordinary
+// function as FileProperty mutator.
+type FilePropertyFunc func(context.Context, *ent.FilePropertyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FilePropertyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FilePropertyMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FilePropertyMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FileTagFunc type is an adapter to allow the use of ordinary
+// function as FileTag mutator.
+type FileTagFunc func(context.Context, *ent.FileTagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FileTagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FileTagMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FileTagMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The FolderFunc type is an adapter to allow the use of ordinary
+// function as Folder mutator.
+type FolderFunc func(context.Context, *ent.FolderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FolderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.FolderMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FolderMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ShareFunc type is an adapter to allow the use of ordinary
+// function as Share mutator.
+type ShareFunc func(context.Context, *ent.ShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ShareMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShareMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The ThumbnailFunc type is an adapter to allow the use of ordinary
+// function as Thumbnail mutator.
+type ThumbnailFunc func(context.Context, *ent.ThumbnailMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ThumbnailFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.ThumbnailMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ThumbnailMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // The TokenFunc type is an adapter to allow the use of ordinary
 // function as Token mutator.
 type TokenFunc func(context.Context, *ent.TokenMutation) (ent.Value, error)
@@ -48,6 +165,32 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return f(ctx, mv)
 }
 
+// The WebhookFunc type is an adapter to allow the use of ordinary
+// function as Webhook mutator.
+type WebhookFunc func(context.Context, *ent.WebhookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WebhookMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookMutation", m)
+	}
+	return f(ctx, mv)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary
+// function as WebhookDelivery mutator.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WebhookDeliveryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	mv, ok := m.(*ent.WebhookDeliveryMutation)
+	if !ok {
+		return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WebhookDeliveryMutation", m)
+	}
+	return f(ctx, mv)
+}
+
 // On executes the given hook only of the given operation.
 //
 //	hook.On(Log, ent.Delete|ent.Create)
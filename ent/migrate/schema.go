@@ -8,14 +8,77 @@ import (
 )
 
 var (
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"Login", "Register", "TokenCreate", "TokenReset", "TokenRevoke", "Upload", "Unpin"}},
+		{Name: "token_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "request_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_audit_logs", Type: field.TypeInt, Nullable: true},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "audit_logs_users_audit_logs",
+				Columns: []*schema.Column{AuditLogsColumns[8]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// BucketsColumns holds the columns for the "buckets" table.
+	BucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 63},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_buckets", Type: field.TypeInt, Nullable: true},
+	}
+	// BucketsTable holds the schema information for the "buckets" table.
+	BucketsTable = &schema.Table{
+		Name:       "buckets",
+		Columns:    BucketsColumns,
+		PrimaryKey: []*schema.Column{BucketsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "buckets_users_buckets",
+				Columns: []*schema.Column{BucketsColumns[3]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bucket_name_user_buckets",
+				Unique:  true,
+				Columns: []*schema.Column{BucketsColumns[1], BucketsColumns[3]},
+			},
+		},
+	}
 	// FilesColumns holds the columns for the "files" table.
 	FilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "hash", Type: field.TypeString, Unique: true},
+		{Name: "hash", Type: field.TypeString},
 		{Name: "size", Type: field.TypeInt64},
 		{Name: "pinned_at", Type: field.TypeTime},
-		{Name: "unpinned_at", Type: field.TypeTime},
+		{Name: "unpinned_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "key", Type: field.TypeString, Nullable: true, Size: 1024},
+		{Name: "etag", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true, Size: 255},
+		{Name: "bucket_files", Type: field.TypeUUID, Nullable: true},
+		{Name: "folder_files", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_files", Type: field.TypeInt, Nullable: true},
 	}
 	// FilesTable holds the schema information for the "files" table.
@@ -24,14 +87,276 @@ var (
 		Columns:    FilesColumns,
 		PrimaryKey: []*schema.Column{FilesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "files_buckets_files",
+				Columns: []*schema.Column{FilesColumns[11]},
+
+				RefColumns: []*schema.Column{BucketsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "files_folders_files",
+				Columns: []*schema.Column{FilesColumns[12]},
+
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:  "files_users_files",
-				Columns: []*schema.Column{FilesColumns[6]},
+				Columns: []*schema.Column{FilesColumns[13]},
 
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "file_hash",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[1]},
+			},
+			{
+				Name:    "file_bucket_files",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[11]},
+			},
+			{
+				Name:    "file_name_folder_files",
+				Unique:  false,
+				Columns: []*schema.Column{FilesColumns[8], FilesColumns[12]},
+			},
+		},
+	}
+	// FileKeysColumns holds the columns for the "file_keys" table.
+	FileKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "provider", Type: field.TypeEnum, Enums: []string{"Passphrase", "KMS"}},
+		{Name: "key_id", Type: field.TypeString, Size: 255},
+		{Name: "wrapped_key", Type: field.TypeBytes, Size: 1024},
+		{Name: "segment_size", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "file_encryption_key", Type: field.TypeUUID, Unique: true, Nullable: true},
+	}
+	// FileKeysTable holds the schema information for the "file_keys" table.
+	FileKeysTable = &schema.Table{
+		Name:       "file_keys",
+		Columns:    FileKeysColumns,
+		PrimaryKey: []*schema.Column{FileKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "file_keys_files_encryption_key",
+				Columns: []*schema.Column{FileKeysColumns[6]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// FileMediaColumns holds the columns for the "file_media" table.
+	FileMediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "width", Type: field.TypeInt, Nullable: true},
+		{Name: "height", Type: field.TypeInt, Nullable: true},
+		{Name: "duration", Type: field.TypeFloat64, Nullable: true},
+		{Name: "pages", Type: field.TypeInt, Nullable: true},
+		{Name: "taken_at", Type: field.TypeTime, Nullable: true},
+		{Name: "file_indexed_media", Type: field.TypeUUID, Unique: true, Nullable: true},
+	}
+	// FileMediaTable holds the schema information for the "file_media" table.
+	FileMediaTable = &schema.Table{
+		Name:       "file_media",
+		Columns:    FileMediaColumns,
+		PrimaryKey: []*schema.Column{FileMediaColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "file_media_files_indexed_media",
+				Columns: []*schema.Column{FileMediaColumns[6]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// FilePropertiesColumns holds the columns for the "file_properties" table.
+	FilePropertiesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Size: 64},
+		{Name: "value", Type: field.TypeString, Size: 255},
+		{Name: "file_indexed_properties", Type: field.TypeUUID, Nullable: true},
+	}
+	// FilePropertiesTable holds the schema information for the "file_properties" table.
+	FilePropertiesTable = &schema.Table{
+		Name:       "file_properties",
+		Columns:    FilePropertiesColumns,
+		PrimaryKey: []*schema.Column{FilePropertiesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "file_properties_files_indexed_properties",
+				Columns: []*schema.Column{FilePropertiesColumns[3]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "fileproperty_key_value",
+				Unique:  false,
+				Columns: []*schema.Column{FilePropertiesColumns[1], FilePropertiesColumns[2]},
+			},
+			{
+				Name:    "fileproperty_file_indexed_properties",
+				Unique:  false,
+				Columns: []*schema.Column{FilePropertiesColumns[3]},
+			},
+		},
+	}
+	// FileTagsColumns holds the columns for the "file_tags" table.
+	FileTagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Size: 64},
+		{Name: "file_indexed_tags", Type: field.TypeUUID, Nullable: true},
+	}
+	// FileTagsTable holds the schema information for the "file_tags" table.
+	FileTagsTable = &schema.Table{
+		Name:       "file_tags",
+		Columns:    FileTagsColumns,
+		PrimaryKey: []*schema.Column{FileTagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "file_tags_files_indexed_tags",
+				Columns: []*schema.Column{FileTagsColumns[2]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "filetag_name_file_indexed_tags",
+				Unique:  true,
+				Columns: []*schema.Column{FileTagsColumns[1], FileTagsColumns[2]},
+			},
+		},
+	}
+	// FoldersColumns holds the columns for the "folders" table.
+	FoldersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Size: 255},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "folder_children", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_folders", Type: field.TypeInt, Nullable: true},
+	}
+	// FoldersTable holds the schema information for the "folders" table.
+	FoldersTable = &schema.Table{
+		Name:       "folders",
+		Columns:    FoldersColumns,
+		PrimaryKey: []*schema.Column{FoldersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "folders_folders_children",
+				Columns: []*schema.Column{FoldersColumns[4]},
+
+				RefColumns: []*schema.Column{FoldersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "folders_users_folders",
+				Columns: []*schema.Column{FoldersColumns[5]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "folder_name_folder_children",
+				Unique:  false,
+				Columns: []*schema.Column{FoldersColumns[1], FoldersColumns[4]},
+			},
+		},
+	}
+	// SharesColumns holds the columns for the "shares" table.
+	SharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "slug", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "password", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "max_downloads", Type: field.TypeInt, Nullable: true},
+		{Name: "downloads", Type: field.TypeInt},
+		{Name: "last_downloaded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "file_shares", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_shares", Type: field.TypeInt, Nullable: true},
+	}
+	// SharesTable holds the schema information for the "shares" table.
+	SharesTable = &schema.Table{
+		Name:       "shares",
+		Columns:    SharesColumns,
+		PrimaryKey: []*schema.Column{SharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "shares_files_shares",
+				Columns: []*schema.Column{SharesColumns[9]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:  "shares_users_shares",
+				Columns: []*schema.Column{SharesColumns[10]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "share_file_shares",
+				Unique:  false,
+				Columns: []*schema.Column{SharesColumns[9]},
+			},
+		},
+	}
+	// ThumbnailsColumns holds the columns for the "thumbnails" table.
+	ThumbnailsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "hash", Type: field.TypeString, Nullable: true},
+		{Name: "content_type", Type: field.TypeString, Nullable: true},
+		{Name: "bytes", Type: field.TypeInt64},
+		{Name: "file_thumbnails", Type: field.TypeUUID, Nullable: true},
+	}
+	// ThumbnailsTable holds the schema information for the "thumbnails" table.
+	ThumbnailsTable = &schema.Table{
+		Name:       "thumbnails",
+		Columns:    ThumbnailsColumns,
+		PrimaryKey: []*schema.Column{ThumbnailsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "thumbnails_files_thumbnails",
+				Columns: []*schema.Column{ThumbnailsColumns[7]},
+
+				RefColumns: []*schema.Column{FilesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "thumbnail_size_file_thumbnails",
+				Unique:  true,
+				Columns: []*schema.Column{ThumbnailsColumns[1], ThumbnailsColumns[7]},
+			},
+			{
+				Name:    "thumbnail_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ThumbnailsColumns[4]},
+			},
+		},
 	}
 	// TokensColumns holds the columns for the "tokens" table.
 	TokensColumns = []*schema.Column{
@@ -75,15 +400,95 @@ var (
 		PrimaryKey:  []*schema.Column{UsersColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{},
 	}
+	// WebhooksColumns holds the columns for the "webhooks" table.
+	WebhooksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "events", Type: field.TypeJSON},
+		{Name: "secret", Type: field.TypeString, Size: 80},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_webhooks", Type: field.TypeInt, Nullable: true},
+	}
+	// WebhooksTable holds the schema information for the "webhooks" table.
+	WebhooksTable = &schema.Table{
+		Name:       "webhooks",
+		Columns:    WebhooksColumns,
+		PrimaryKey: []*schema.Column{WebhooksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "webhooks_users_webhooks",
+				Columns: []*schema.Column{WebhooksColumns[6]},
+
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "event", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"Pending", "Succeeded", "Failed"}, Default: "Pending"},
+		{Name: "attempts", Type: field.TypeInt},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "response_status", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "webhook_deliveries", Type: field.TypeUUID, Nullable: true},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:  "webhook_deliveries_webhooks_deliveries",
+				Columns: []*schema.Column{WebhookDeliveriesColumns[10]},
+
+				RefColumns: []*schema.Column{WebhooksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditLogsTable,
+		BucketsTable,
 		FilesTable,
+		FileKeysTable,
+		FileMediaTable,
+		FilePropertiesTable,
+		FileTagsTable,
+		FoldersTable,
+		SharesTable,
+		ThumbnailsTable,
 		TokensTable,
 		UsersTable,
+		WebhooksTable,
+		WebhookDeliveriesTable,
 	}
 )
 
 func init() {
-	FilesTable.ForeignKeys[0].RefTable = UsersTable
+	AuditLogsTable.ForeignKeys[0].RefTable = UsersTable
+	BucketsTable.ForeignKeys[0].RefTable = UsersTable
+	FilesTable.ForeignKeys[0].RefTable = BucketsTable
+	FilesTable.ForeignKeys[1].RefTable = FoldersTable
+	FilesTable.ForeignKeys[2].RefTable = UsersTable
+	FileKeysTable.ForeignKeys[0].RefTable = FilesTable
+	FileMediaTable.ForeignKeys[0].RefTable = FilesTable
+	FilePropertiesTable.ForeignKeys[0].RefTable = FilesTable
+	FileTagsTable.ForeignKeys[0].RefTable = FilesTable
+	FoldersTable.ForeignKeys[0].RefTable = FoldersTable
+	FoldersTable.ForeignKeys[1].RefTable = UsersTable
+	SharesTable.ForeignKeys[0].RefTable = FilesTable
+	SharesTable.ForeignKeys[1].RefTable = UsersTable
+	ThumbnailsTable.ForeignKeys[0].RefTable = FilesTable
 	TokensTable.ForeignKeys[0].RefTable = UsersTable
+	WebhooksTable.ForeignKeys[0].RefTable = UsersTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhooksTable
 }
@@ -7,9 +7,20 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
 
 	"github.com/facebookincubator/ent"
 )
@@ -23,44 +34,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeFile  = "File"
-	TypeToken = "Token"
-	TypeUser  = "User"
+	TypeAuditLog        = "AuditLog"
+	TypeBucket          = "Bucket"
+	TypeFile            = "File"
+	TypeFileKey         = "FileKey"
+	TypeFileMedia       = "FileMedia"
+	TypeFileProperty    = "FileProperty"
+	TypeFileTag         = "FileTag"
+	TypeFolder          = "Folder"
+	TypeShare           = "Share"
+	TypeThumbnail       = "Thumbnail"
+	TypeToken           = "Token"
+	TypeUser            = "User"
+	TypeWebhook         = "Webhook"
+	TypeWebhookDelivery = "WebhookDelivery"
 )
 
-// FileMutation represents an operation that mutate the Files
+// AuditLogMutation represents an operation that mutate the AuditLogs
 // nodes in the graph.
-type FileMutation struct {
+type AuditLogMutation struct {
 	config
 	op            Op
 	typ           string
-	id            *uuid.UUID
-	hash          *string
-	size          *int64
-	addsize       *int64
-	pinned_at     *time.Time
-	unpinned_at   *time.Time
+	id            *int
+	action        *auditlog.Action
+	token_id      *uuid.UUID
+	request_id    *string
+	ip            *string
+	user_agent    *string
 	metadata      *map[string]interface{}
+	created_at    *time.Time
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
 }
 
-var _ ent.Mutation = (*FileMutation)(nil)
+var _ ent.Mutation = (*AuditLogMutation)(nil)
 
-// newFileMutation creates new mutation for $n.Name.
-func newFileMutation(c config, op Op) *FileMutation {
-	return &FileMutation{
+// newAuditLogMutation creates new mutation for $n.Name.
+func newAuditLogMutation(c config, op Op) *AuditLogMutation {
+	return &AuditLogMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFile,
+		typ:           TypeAuditLog,
 		clearedFields: make(map[string]struct{}),
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FileMutation) Client() *Client {
+func (m AuditLogMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -68,7 +91,7 @@ func (m FileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FileMutation) Tx() (*Tx, error) {
+func (m AuditLogMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -77,124 +100,169 @@ func (m FileMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that, this
-// operation is accepted only on File creation.
-func (m *FileMutation) SetID(id uuid.UUID) {
-	m.id = &id
-}
-
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
+func (m *AuditLogMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetHash sets the hash field.
-func (m *FileMutation) SetHash(s string) {
-	m.hash = &s
+// SetAction sets the action field.
+func (m *AuditLogMutation) SetAction(a auditlog.Action) {
+	m.action = &a
 }
 
-// Hash returns the hash value in the mutation.
-func (m *FileMutation) Hash() (r string, exists bool) {
-	v := m.hash
+// Action returns the action value in the mutation.
+func (m *AuditLogMutation) Action() (r auditlog.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetHash reset all changes of the hash field.
-func (m *FileMutation) ResetHash() {
-	m.hash = nil
+// ResetAction reset all changes of the action field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetSize sets the size field.
-func (m *FileMutation) SetSize(i int64) {
-	m.size = &i
-	m.addsize = nil
+// SetTokenID sets the token_id field.
+func (m *AuditLogMutation) SetTokenID(u uuid.UUID) {
+	m.token_id = &u
 }
 
-// Size returns the size value in the mutation.
-func (m *FileMutation) Size() (r int64, exists bool) {
-	v := m.size
+// TokenID returns the token_id value in the mutation.
+func (m *AuditLogMutation) TokenID() (r uuid.UUID, exists bool) {
+	v := m.token_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// AddSize adds i to size.
-func (m *FileMutation) AddSize(i int64) {
-	if m.addsize != nil {
-		*m.addsize += i
-	} else {
-		m.addsize = &i
-	}
+// ClearTokenID clears the value of token_id.
+func (m *AuditLogMutation) ClearTokenID() {
+	m.token_id = nil
+	m.clearedFields[auditlog.FieldTokenID] = struct{}{}
 }
 
-// AddedSize returns the value that was added to the size field in this mutation.
-func (m *FileMutation) AddedSize() (r int64, exists bool) {
-	v := m.addsize
+// TokenIDCleared returns if the field token_id was cleared in this mutation.
+func (m *AuditLogMutation) TokenIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTokenID]
+	return ok
+}
+
+// ResetTokenID reset all changes of the token_id field.
+func (m *AuditLogMutation) ResetTokenID() {
+	m.token_id = nil
+	delete(m.clearedFields, auditlog.FieldTokenID)
+}
+
+// SetRequestID sets the request_id field.
+func (m *AuditLogMutation) SetRequestID(s string) {
+	m.request_id = &s
+}
+
+// RequestID returns the request_id value in the mutation.
+func (m *AuditLogMutation) RequestID() (r string, exists bool) {
+	v := m.request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetSize reset all changes of the size field.
-func (m *FileMutation) ResetSize() {
-	m.size = nil
-	m.addsize = nil
+// ClearRequestID clears the value of request_id.
+func (m *AuditLogMutation) ClearRequestID() {
+	m.request_id = nil
+	m.clearedFields[auditlog.FieldRequestID] = struct{}{}
 }
 
-// SetPinnedAt sets the pinned_at field.
-func (m *FileMutation) SetPinnedAt(t time.Time) {
-	m.pinned_at = &t
+// RequestIDCleared returns if the field request_id was cleared in this mutation.
+func (m *AuditLogMutation) RequestIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldRequestID]
+	return ok
 }
 
-// PinnedAt returns the pinned_at value in the mutation.
-func (m *FileMutation) PinnedAt() (r time.Time, exists bool) {
-	v := m.pinned_at
+// ResetRequestID reset all changes of the request_id field.
+func (m *AuditLogMutation) ResetRequestID() {
+	m.request_id = nil
+	delete(m.clearedFields, auditlog.FieldRequestID)
+}
+
+// SetIP sets the ip field.
+func (m *AuditLogMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the ip value in the mutation.
+func (m *AuditLogMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPinnedAt reset all changes of the pinned_at field.
-func (m *FileMutation) ResetPinnedAt() {
-	m.pinned_at = nil
+// ClearIP clears the value of ip.
+func (m *AuditLogMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditlog.FieldIP] = struct{}{}
 }
 
-// SetUnpinnedAt sets the unpinned_at field.
-func (m *FileMutation) SetUnpinnedAt(t time.Time) {
-	m.unpinned_at = &t
+// IPCleared returns if the field ip was cleared in this mutation.
+func (m *AuditLogMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldIP]
+	return ok
 }
 
-// UnpinnedAt returns the unpinned_at value in the mutation.
-func (m *FileMutation) UnpinnedAt() (r time.Time, exists bool) {
-	v := m.unpinned_at
+// ResetIP reset all changes of the ip field.
+func (m *AuditLogMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditlog.FieldIP)
+}
+
+// SetUserAgent sets the user_agent field.
+func (m *AuditLogMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the user_agent value in the mutation.
+func (m *AuditLogMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUnpinnedAt reset all changes of the unpinned_at field.
-func (m *FileMutation) ResetUnpinnedAt() {
-	m.unpinned_at = nil
+// ClearUserAgent clears the value of user_agent.
+func (m *AuditLogMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[auditlog.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the field user_agent was cleared in this mutation.
+func (m *AuditLogMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent reset all changes of the user_agent field.
+func (m *AuditLogMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, auditlog.FieldUserAgent)
 }
 
 // SetMetadata sets the metadata field.
-func (m *FileMutation) SetMetadata(value map[string]interface{}) {
+func (m *AuditLogMutation) SetMetadata(value map[string]interface{}) {
 	m.metadata = &value
 }
 
 // Metadata returns the metadata value in the mutation.
-func (m *FileMutation) Metadata() (r map[string]interface{}, exists bool) {
+func (m *AuditLogMutation) Metadata() (r map[string]interface{}, exists bool) {
 	v := m.metadata
 	if v == nil {
 		return
@@ -203,40 +271,59 @@ func (m *FileMutation) Metadata() (r map[string]interface{}, exists bool) {
 }
 
 // ClearMetadata clears the value of metadata.
-func (m *FileMutation) ClearMetadata() {
+func (m *AuditLogMutation) ClearMetadata() {
 	m.metadata = nil
-	m.clearedFields[file.FieldMetadata] = struct{}{}
+	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
 }
 
 // MetadataCleared returns if the field metadata was cleared in this mutation.
-func (m *FileMutation) MetadataCleared() bool {
-	_, ok := m.clearedFields[file.FieldMetadata]
+func (m *AuditLogMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldMetadata]
 	return ok
 }
 
 // ResetMetadata reset all changes of the metadata field.
-func (m *FileMutation) ResetMetadata() {
+func (m *AuditLogMutation) ResetMetadata() {
 	m.metadata = nil
-	delete(m.clearedFields, file.FieldMetadata)
+	delete(m.clearedFields, auditlog.FieldMetadata)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
 // SetUserID sets the user edge to User by id.
-func (m *FileMutation) SetUserID(id int) {
+func (m *AuditLogMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the user edge to User.
-func (m *FileMutation) ClearUser() {
+func (m *AuditLogMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared returns if the edge user was cleared.
-func (m *FileMutation) UserCleared() bool {
+func (m *AuditLogMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the user id in the mutation.
-func (m *FileMutation) UserID() (id int, exists bool) {
+func (m *AuditLogMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -246,7 +333,7 @@ func (m *FileMutation) UserID() (id int, exists bool) {
 // UserIDs returns the user ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *FileMutation) UserIDs() (ids []int) {
+func (m *AuditLogMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -254,40 +341,46 @@ func (m *FileMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser reset all changes of the user edge.
-func (m *FileMutation) ResetUser() {
+func (m *AuditLogMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
 // Op returns the operation name.
-func (m *FileMutation) Op() Op {
+func (m *AuditLogMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (File).
-func (m *FileMutation) Type() string {
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *FileMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.hash != nil {
-		fields = append(fields, file.FieldHash)
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
 	}
-	if m.size != nil {
-		fields = append(fields, file.FieldSize)
+	if m.token_id != nil {
+		fields = append(fields, auditlog.FieldTokenID)
 	}
-	if m.pinned_at != nil {
-		fields = append(fields, file.FieldPinnedAt)
+	if m.request_id != nil {
+		fields = append(fields, auditlog.FieldRequestID)
 	}
-	if m.unpinned_at != nil {
-		fields = append(fields, file.FieldUnpinnedAt)
+	if m.ip != nil {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, auditlog.FieldUserAgent)
 	}
 	if m.metadata != nil {
-		fields = append(fields, file.FieldMetadata)
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -295,18 +388,22 @@ func (m *FileMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *FileMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case file.FieldHash:
-		return m.Hash()
-	case file.FieldSize:
-		return m.Size()
-	case file.FieldPinnedAt:
-		return m.PinnedAt()
-	case file.FieldUnpinnedAt:
-		return m.UnpinnedAt()
-	case file.FieldMetadata:
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldTokenID:
+		return m.TokenID()
+	case auditlog.FieldRequestID:
+		return m.RequestID()
+	case auditlog.FieldIP:
+		return m.IP()
+	case auditlog.FieldUserAgent:
+		return m.UserAgent()
+	case auditlog.FieldMetadata:
 		return m.Metadata()
+	case auditlog.FieldCreatedAt:
+		return m.CreatedAt()
 	}
 	return nil, false
 }
@@ -314,151 +411,180 @@ func (m *FileMutation) Field(name string) (ent.Value, bool) {
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *FileMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldHash:
-		v, ok := value.(string)
+	case auditlog.FieldAction:
+		v, ok := value.(auditlog.Action)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetHash(v)
+		m.SetAction(v)
 		return nil
-	case file.FieldSize:
-		v, ok := value.(int64)
+	case auditlog.FieldTokenID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSize(v)
+		m.SetTokenID(v)
 		return nil
-	case file.FieldPinnedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldRequestID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPinnedAt(v)
+		m.SetRequestID(v)
 		return nil
-	case file.FieldUnpinnedAt:
-		v, ok := value.(time.Time)
+	case auditlog.FieldIP:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUnpinnedAt(v)
+		m.SetIP(v)
 		return nil
-	case file.FieldMetadata:
+	case auditlog.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case auditlog.FieldMetadata:
 		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetMetadata(v)
 		return nil
+	case auditlog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *FileMutation) AddedFields() []string {
-	var fields []string
-	if m.addsize != nil {
-		fields = append(fields, file.FieldSize)
-	}
-	return fields
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case file.FieldSize:
-		return m.AddedSize()
-	}
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *FileMutation) AddField(name string, value ent.Value) error {
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case file.FieldSize:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSize(v)
-		return nil
 	}
-	return fmt.Errorf("unknown File numeric field %s", name)
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *FileMutation) ClearedFields() []string {
+func (m *AuditLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(file.FieldMetadata) {
-		fields = append(fields, file.FieldMetadata)
+	if m.FieldCleared(auditlog.FieldTokenID) {
+		fields = append(fields, auditlog.FieldTokenID)
+	}
+	if m.FieldCleared(auditlog.FieldRequestID) {
+		fields = append(fields, auditlog.FieldRequestID)
+	}
+	if m.FieldCleared(auditlog.FieldIP) {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.FieldCleared(auditlog.FieldUserAgent) {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	if m.FieldCleared(auditlog.FieldMetadata) {
+		fields = append(fields, auditlog.FieldMetadata)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *FileMutation) FieldCleared(name string) bool {
+func (m *AuditLogMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FileMutation) ClearField(name string) error {
+func (m *AuditLogMutation) ClearField(name string) error {
 	switch name {
-	case file.FieldMetadata:
+	case auditlog.FieldTokenID:
+		m.ClearTokenID()
+		return nil
+	case auditlog.FieldRequestID:
+		m.ClearRequestID()
+		return nil
+	case auditlog.FieldIP:
+		m.ClearIP()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case auditlog.FieldMetadata:
 		m.ClearMetadata()
 		return nil
 	}
-	return fmt.Errorf("unknown File nullable field %s", name)
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *FileMutation) ResetField(name string) error {
+func (m *AuditLogMutation) ResetField(name string) error {
 	switch name {
-	case file.FieldHash:
-		m.ResetHash()
+	case auditlog.FieldAction:
+		m.ResetAction()
 		return nil
-	case file.FieldSize:
-		m.ResetSize()
+	case auditlog.FieldTokenID:
+		m.ResetTokenID()
 		return nil
-	case file.FieldPinnedAt:
-		m.ResetPinnedAt()
+	case auditlog.FieldRequestID:
+		m.ResetRequestID()
 		return nil
-	case file.FieldUnpinnedAt:
-		m.ResetUnpinnedAt()
+	case auditlog.FieldIP:
+		m.ResetIP()
 		return nil
-	case file.FieldMetadata:
+	case auditlog.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case auditlog.FieldMetadata:
 		m.ResetMetadata()
 		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown File field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *FileMutation) AddedEdges() []string {
+func (m *AuditLogMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, file.EdgeUser)
+		edges = append(edges, auditlog.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *FileMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case file.EdgeUser:
+	case auditlog.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -468,14 +594,14 @@ func (m *FileMutation) AddedIDs(name string) []ent.Value {
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *FileMutation) RemovedEdges() []string {
+func (m *AuditLogMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
 	}
 	return nil
@@ -483,19 +609,19 @@ func (m *FileMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *FileMutation) ClearedEdges() []string {
+func (m *AuditLogMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, file.EdgeUser)
+		edges = append(edges, auditlog.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *FileMutation) EdgeCleared(name string) bool {
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
 	switch name {
-	case file.EdgeUser:
+	case auditlog.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -503,59 +629,58 @@ func (m *FileMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *FileMutation) ClearEdge(name string) error {
+func (m *AuditLogMutation) ClearEdge(name string) error {
 	switch name {
-	case file.EdgeUser:
+	case auditlog.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown File unique edge %s", name)
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *FileMutation) ResetEdge(name string) error {
+func (m *AuditLogMutation) ResetEdge(name string) error {
 	switch name {
-	case file.EdgeUser:
+	case auditlog.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown File edge %s", name)
+	return fmt.Errorf("unknown AuditLog edge %s", name)
 }
 
-// TokenMutation represents an operation that mutate the Tokens
+// BucketMutation represents an operation that mutate the Buckets
 // nodes in the graph.
-type TokenMutation struct {
+type BucketMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *uuid.UUID
 	name          *string
-	secret        *string
-	permissions   *token.Permissions
 	created_at    *time.Time
-	last_used     *time.Time
 	clearedFields map[string]struct{}
 	user          *int
 	cleareduser   bool
+	files         map[uuid.UUID]struct{}
+	removedfiles  map[uuid.UUID]struct{}
 }
 
-var _ ent.Mutation = (*TokenMutation)(nil)
+var _ ent.Mutation = (*BucketMutation)(nil)
 
-// newTokenMutation creates new mutation for $n.Name.
-func newTokenMutation(c config, op Op) *TokenMutation {
-	return &TokenMutation{
+// newBucketMutation creates new mutation for $n.Name.
+func newBucketMutation(c config, op Op) *BucketMutation {
+	return &BucketMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeToken,
+		typ:           TypeBucket,
 		clearedFields: make(map[string]struct{}),
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m TokenMutation) Client() *Client {
+func (m BucketMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -563,7 +688,7 @@ func (m TokenMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m TokenMutation) Tx() (*Tx, error) {
+func (m BucketMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -573,14 +698,14 @@ func (m TokenMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that, this
-// operation is accepted only on Token creation.
-func (m *TokenMutation) SetID(id uuid.UUID) {
+// operation is accepted only on Bucket creation.
+func (m *BucketMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BucketMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -588,12 +713,12 @@ func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
 }
 
 // SetName sets the name field.
-func (m *TokenMutation) SetName(s string) {
+func (m *BucketMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the name value in the mutation.
-func (m *TokenMutation) Name() (r string, exists bool) {
+func (m *BucketMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -602,55 +727,17 @@ func (m *TokenMutation) Name() (r string, exists bool) {
 }
 
 // ResetName reset all changes of the name field.
-func (m *TokenMutation) ResetName() {
+func (m *BucketMutation) ResetName() {
 	m.name = nil
 }
 
-// SetSecret sets the secret field.
-func (m *TokenMutation) SetSecret(s string) {
-	m.secret = &s
-}
-
-// Secret returns the secret value in the mutation.
-func (m *TokenMutation) Secret() (r string, exists bool) {
-	v := m.secret
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSecret reset all changes of the secret field.
-func (m *TokenMutation) ResetSecret() {
-	m.secret = nil
-}
-
-// SetPermissions sets the permissions field.
-func (m *TokenMutation) SetPermissions(t token.Permissions) {
-	m.permissions = &t
-}
-
-// Permissions returns the permissions value in the mutation.
-func (m *TokenMutation) Permissions() (r token.Permissions, exists bool) {
-	v := m.permissions
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPermissions reset all changes of the permissions field.
-func (m *TokenMutation) ResetPermissions() {
-	m.permissions = nil
-}
-
 // SetCreatedAt sets the created_at field.
-func (m *TokenMutation) SetCreatedAt(t time.Time) {
+func (m *BucketMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the created_at value in the mutation.
-func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BucketMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -659,59 +746,27 @@ func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
 }
 
 // ResetCreatedAt reset all changes of the created_at field.
-func (m *TokenMutation) ResetCreatedAt() {
+func (m *BucketMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetLastUsed sets the last_used field.
-func (m *TokenMutation) SetLastUsed(t time.Time) {
-	m.last_used = &t
-}
-
-// LastUsed returns the last_used value in the mutation.
-func (m *TokenMutation) LastUsed() (r time.Time, exists bool) {
-	v := m.last_used
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ClearLastUsed clears the value of last_used.
-func (m *TokenMutation) ClearLastUsed() {
-	m.last_used = nil
-	m.clearedFields[token.FieldLastUsed] = struct{}{}
-}
-
-// LastUsedCleared returns if the field last_used was cleared in this mutation.
-func (m *TokenMutation) LastUsedCleared() bool {
-	_, ok := m.clearedFields[token.FieldLastUsed]
-	return ok
-}
-
-// ResetLastUsed reset all changes of the last_used field.
-func (m *TokenMutation) ResetLastUsed() {
-	m.last_used = nil
-	delete(m.clearedFields, token.FieldLastUsed)
-}
-
 // SetUserID sets the user edge to User by id.
-func (m *TokenMutation) SetUserID(id int) {
+func (m *BucketMutation) SetUserID(id int) {
 	m.user = &id
 }
 
 // ClearUser clears the user edge to User.
-func (m *TokenMutation) ClearUser() {
+func (m *BucketMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared returns if the edge user was cleared.
-func (m *TokenMutation) UserCleared() bool {
+func (m *BucketMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the user id in the mutation.
-func (m *TokenMutation) UserID() (id int, exists bool) {
+func (m *BucketMutation) UserID() (id int, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -721,7 +776,7 @@ func (m *TokenMutation) UserID() (id int, exists bool) {
 // UserIDs returns the user ids in the mutation.
 // Note that ids always returns len(ids) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *TokenMutation) UserIDs() (ids []int) {
+func (m *BucketMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -729,40 +784,73 @@ func (m *TokenMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser reset all changes of the user edge.
-func (m *TokenMutation) ResetUser() {
+func (m *BucketMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
+// AddFileIDs adds the files edge to File by ids.
+func (m *BucketMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (m *BucketMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed ids of files.
+func (m *BucketMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the files ids in the mutation.
+func (m *BucketMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles reset all changes of the files edge.
+func (m *BucketMutation) ResetFiles() {
+	m.files = nil
+	m.removedfiles = nil
+}
+
 // Op returns the operation name.
-func (m *TokenMutation) Op() Op {
+func (m *BucketMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (Token).
-func (m *TokenMutation) Type() string {
+// Type returns the node type of this mutation (Bucket).
+func (m *BucketMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *TokenMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *BucketMutation) Fields() []string {
+	fields := make([]string, 0, 2)
 	if m.name != nil {
-		fields = append(fields, token.FieldName)
-	}
-	if m.secret != nil {
-		fields = append(fields, token.FieldSecret)
-	}
-	if m.permissions != nil {
-		fields = append(fields, token.FieldPermissions)
+		fields = append(fields, bucket.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, token.FieldCreatedAt)
-	}
-	if m.last_used != nil {
-		fields = append(fields, token.FieldLastUsed)
+		fields = append(fields, bucket.FieldCreatedAt)
 	}
 	return fields
 }
@@ -770,18 +858,12 @@ func (m *TokenMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+func (m *BucketMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case token.FieldName:
+	case bucket.FieldName:
 		return m.Name()
-	case token.FieldSecret:
-		return m.Secret()
-	case token.FieldPermissions:
-		return m.Permissions()
-	case token.FieldCreatedAt:
+	case bucket.FieldCreatedAt:
 		return m.CreatedAt()
-	case token.FieldLastUsed:
-		return m.LastUsed()
 	}
 	return nil, false
 }
@@ -789,173 +871,152 @@ func (m *TokenMutation) Field(name string) (ent.Value, bool) {
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *TokenMutation) SetField(name string, value ent.Value) error {
+func (m *BucketMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case token.FieldName:
+	case bucket.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case token.FieldSecret:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSecret(v)
-		return nil
-	case token.FieldPermissions:
-		v, ok := value.(token.Permissions)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPermissions(v)
-		return nil
-	case token.FieldCreatedAt:
+	case bucket.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case token.FieldLastUsed:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUsed(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown Bucket field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *TokenMutation) AddedFields() []string {
+func (m *BucketMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BucketMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *TokenMutation) AddField(name string, value ent.Value) error {
+func (m *BucketMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Token numeric field %s", name)
+	return fmt.Errorf("unknown Bucket numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *TokenMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(token.FieldLastUsed) {
-		fields = append(fields, token.FieldLastUsed)
-	}
-	return fields
+func (m *BucketMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *TokenMutation) FieldCleared(name string) bool {
+func (m *BucketMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *TokenMutation) ClearField(name string) error {
-	switch name {
-	case token.FieldLastUsed:
-		m.ClearLastUsed()
-		return nil
-	}
-	return fmt.Errorf("unknown Token nullable field %s", name)
+func (m *BucketMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Bucket nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *TokenMutation) ResetField(name string) error {
+func (m *BucketMutation) ResetField(name string) error {
 	switch name {
-	case token.FieldName:
+	case bucket.FieldName:
 		m.ResetName()
 		return nil
-	case token.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case token.FieldPermissions:
-		m.ResetPermissions()
-		return nil
-	case token.FieldCreatedAt:
+	case bucket.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case token.FieldLastUsed:
-		m.ResetLastUsed()
-		return nil
 	}
-	return fmt.Errorf("unknown Token field %s", name)
+	return fmt.Errorf("unknown Bucket field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *TokenMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *BucketMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.user != nil {
-		edges = append(edges, token.EdgeUser)
+		edges = append(edges, bucket.EdgeUser)
+	}
+	if m.files != nil {
+		edges = append(edges, bucket.EdgeFiles)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+func (m *BucketMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case token.EdgeUser:
+	case bucket.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case bucket.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *TokenMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *BucketMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedfiles != nil {
+		edges = append(edges, bucket.EdgeFiles)
+	}
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+func (m *BucketMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
+	case bucket.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *TokenMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *BucketMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.cleareduser {
-		edges = append(edges, token.EdgeUser)
+		edges = append(edges, bucket.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *TokenMutation) EdgeCleared(name string) bool {
+func (m *BucketMutation) EdgeCleared(name string) bool {
 	switch name {
-	case token.EdgeUser:
+	case bucket.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -963,62 +1024,6901 @@ func (m *TokenMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *TokenMutation) ClearEdge(name string) error {
+func (m *BucketMutation) ClearEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
+	case bucket.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Token unique edge %s", name)
+	return fmt.Errorf("unknown Bucket unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *TokenMutation) ResetEdge(name string) error {
+func (m *BucketMutation) ResetEdge(name string) error {
 	switch name {
-	case token.EdgeUser:
+	case bucket.EdgeUser:
 		m.ResetUser()
 		return nil
+	case bucket.EdgeFiles:
+		m.ResetFiles()
+		return nil
 	}
-	return fmt.Errorf("unknown Token edge %s", name)
+	return fmt.Errorf("unknown Bucket edge %s", name)
 }
 
-// UserMutation represents an operation that mutate the Users
+// FileMutation represents an operation that mutate the Files
 // nodes in the graph.
-type UserMutation struct {
+type FileMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	email         *string
-	password      *string
-	active        *bool
-	updated_at    *time.Time
-	created_at    *time.Time
-	plan          *user.Plan
-	clearedFields map[string]struct{}
-	tokens        map[uuid.UUID]struct{}
-	removedtokens map[uuid.UUID]struct{}
-	files         map[uuid.UUID]struct{}
-	removedfiles  map[uuid.UUID]struct{}
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	hash                      *string
+	size                      *int64
+	addsize                   *int64
+	pinned_at                 *time.Time
+	unpinned_at               *time.Time
+	metadata                  *map[string]interface{}
+	key                       *string
+	etag                      *string
+	name                      *string
+	tags                      *[]string
+	content_type              *string
+	clearedFields             map[string]struct{}
+	user                      *int
+	cleareduser               bool
+	bucket                    *uuid.UUID
+	clearedbucket             bool
+	folder                    *uuid.UUID
+	clearedfolder             bool
+	indexed_tags              map[int]struct{}
+	removedindexed_tags       map[int]struct{}
+	indexed_properties        map[int]struct{}
+	removedindexed_properties map[int]struct{}
+	indexed_media             *int
+	clearedindexed_media      bool
+	thumbnails                map[int]struct{}
+	removedthumbnails         map[int]struct{}
+	shares                    map[uuid.UUID]struct{}
+	removedshares             map[uuid.UUID]struct{}
+	encryption_key            *int
+	clearedencryption_key     bool
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*FileMutation)(nil)
 
-// newUserMutation creates new mutation for $n.Name.
+// newFileMutation creates new mutation for $n.Name.
+func newFileMutation(c config, op Op) *FileMutation {
+	return &FileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFile,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on File creation.
+func (m *FileMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FileMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetHash sets the hash field.
+func (m *FileMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the hash value in the mutation.
+func (m *FileMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHash reset all changes of the hash field.
+func (m *FileMutation) ResetHash() {
+	m.hash = nil
+}
+
+// SetSize sets the size field.
+func (m *FileMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the size value in the mutation.
+func (m *FileMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddSize adds i to size.
+func (m *FileMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the size field in this mutation.
+func (m *FileMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize reset all changes of the size field.
+func (m *FileMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetPinnedAt sets the pinned_at field.
+func (m *FileMutation) SetPinnedAt(t time.Time) {
+	m.pinned_at = &t
+}
+
+// PinnedAt returns the pinned_at value in the mutation.
+func (m *FileMutation) PinnedAt() (r time.Time, exists bool) {
+	v := m.pinned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPinnedAt reset all changes of the pinned_at field.
+func (m *FileMutation) ResetPinnedAt() {
+	m.pinned_at = nil
+}
+
+// SetUnpinnedAt sets the unpinned_at field.
+func (m *FileMutation) SetUnpinnedAt(t time.Time) {
+	m.unpinned_at = &t
+}
+
+// UnpinnedAt returns the unpinned_at value in the mutation.
+func (m *FileMutation) UnpinnedAt() (r time.Time, exists bool) {
+	v := m.unpinned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUnpinnedAt clears the value of unpinned_at.
+func (m *FileMutation) ClearUnpinnedAt() {
+	m.unpinned_at = nil
+	m.clearedFields[file.FieldUnpinnedAt] = struct{}{}
+}
+
+// UnpinnedAtCleared returns if the field unpinned_at was cleared in this mutation.
+func (m *FileMutation) UnpinnedAtCleared() bool {
+	_, ok := m.clearedFields[file.FieldUnpinnedAt]
+	return ok
+}
+
+// ResetUnpinnedAt reset all changes of the unpinned_at field.
+func (m *FileMutation) ResetUnpinnedAt() {
+	m.unpinned_at = nil
+	delete(m.clearedFields, file.FieldUnpinnedAt)
+}
+
+// SetMetadata sets the metadata field.
+func (m *FileMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the metadata value in the mutation.
+func (m *FileMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMetadata clears the value of metadata.
+func (m *FileMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[file.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the field metadata was cleared in this mutation.
+func (m *FileMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[file.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata reset all changes of the metadata field.
+func (m *FileMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, file.FieldMetadata)
+}
+
+// SetKey sets the key field.
+func (m *FileMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the key value in the mutation.
+func (m *FileMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearKey clears the value of key.
+func (m *FileMutation) ClearKey() {
+	m.key = nil
+	m.clearedFields[file.FieldKey] = struct{}{}
+}
+
+// KeyCleared returns if the field key was cleared in this mutation.
+func (m *FileMutation) KeyCleared() bool {
+	_, ok := m.clearedFields[file.FieldKey]
+	return ok
+}
+
+// ResetKey reset all changes of the key field.
+func (m *FileMutation) ResetKey() {
+	m.key = nil
+	delete(m.clearedFields, file.FieldKey)
+}
+
+// SetEtag sets the etag field.
+func (m *FileMutation) SetEtag(s string) {
+	m.etag = &s
+}
+
+// Etag returns the etag value in the mutation.
+func (m *FileMutation) Etag() (r string, exists bool) {
+	v := m.etag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEtag clears the value of etag.
+func (m *FileMutation) ClearEtag() {
+	m.etag = nil
+	m.clearedFields[file.FieldEtag] = struct{}{}
+}
+
+// EtagCleared returns if the field etag was cleared in this mutation.
+func (m *FileMutation) EtagCleared() bool {
+	_, ok := m.clearedFields[file.FieldEtag]
+	return ok
+}
+
+// ResetEtag reset all changes of the etag field.
+func (m *FileMutation) ResetEtag() {
+	m.etag = nil
+	delete(m.clearedFields, file.FieldEtag)
+}
+
+// SetName sets the name field.
+func (m *FileMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *FileMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearName clears the value of name.
+func (m *FileMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[file.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the field name was cleared in this mutation.
+func (m *FileMutation) NameCleared() bool {
+	_, ok := m.clearedFields[file.FieldName]
+	return ok
+}
+
+// ResetName reset all changes of the name field.
+func (m *FileMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, file.FieldName)
+}
+
+// SetTags sets the tags field.
+func (m *FileMutation) SetTags(s []string) {
+	m.tags = &s
+}
+
+// Tags returns the tags value in the mutation.
+func (m *FileMutation) Tags() (r []string, exists bool) {
+	v := m.tags
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTags clears the value of tags.
+func (m *FileMutation) ClearTags() {
+	m.tags = nil
+	m.clearedFields[file.FieldTags] = struct{}{}
+}
+
+// TagsCleared returns if the field tags was cleared in this mutation.
+func (m *FileMutation) TagsCleared() bool {
+	_, ok := m.clearedFields[file.FieldTags]
+	return ok
+}
+
+// ResetTags reset all changes of the tags field.
+func (m *FileMutation) ResetTags() {
+	m.tags = nil
+	delete(m.clearedFields, file.FieldTags)
+}
+
+// SetContentType sets the content_type field.
+func (m *FileMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the content_type value in the mutation.
+func (m *FileMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContentType clears the value of content_type.
+func (m *FileMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[file.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the field content_type was cleared in this mutation.
+func (m *FileMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[file.FieldContentType]
+	return ok
+}
+
+// ResetContentType reset all changes of the content_type field.
+func (m *FileMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, file.FieldContentType)
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *FileMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *FileMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *FileMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *FileMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the user edge.
+func (m *FileMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetBucketID sets the bucket edge to Bucket by id.
+func (m *FileMutation) SetBucketID(id uuid.UUID) {
+	m.bucket = &id
+}
+
+// ClearBucket clears the bucket edge to Bucket.
+func (m *FileMutation) ClearBucket() {
+	m.clearedbucket = true
+}
+
+// BucketCleared returns if the edge bucket was cleared.
+func (m *FileMutation) BucketCleared() bool {
+	return m.clearedbucket
+}
+
+// BucketID returns the bucket id in the mutation.
+func (m *FileMutation) BucketID() (id uuid.UUID, exists bool) {
+	if m.bucket != nil {
+		return *m.bucket, true
+	}
+	return
+}
+
+// BucketIDs returns the bucket ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// BucketID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) BucketIDs() (ids []uuid.UUID) {
+	if id := m.bucket; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBucket reset all changes of the bucket edge.
+func (m *FileMutation) ResetBucket() {
+	m.bucket = nil
+	m.clearedbucket = false
+}
+
+// SetFolderID sets the folder edge to Folder by id.
+func (m *FileMutation) SetFolderID(id uuid.UUID) {
+	m.folder = &id
+}
+
+// ClearFolder clears the folder edge to Folder.
+func (m *FileMutation) ClearFolder() {
+	m.clearedfolder = true
+}
+
+// FolderCleared returns if the edge folder was cleared.
+func (m *FileMutation) FolderCleared() bool {
+	return m.clearedfolder
+}
+
+// FolderID returns the folder id in the mutation.
+func (m *FileMutation) FolderID() (id uuid.UUID, exists bool) {
+	if m.folder != nil {
+		return *m.folder, true
+	}
+	return
+}
+
+// FolderIDs returns the folder ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FolderID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) FolderIDs() (ids []uuid.UUID) {
+	if id := m.folder; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFolder reset all changes of the folder edge.
+func (m *FileMutation) ResetFolder() {
+	m.folder = nil
+	m.clearedfolder = false
+}
+
+// AddIndexedTagIDs adds the indexed_tags edge to FileTag by ids.
+func (m *FileMutation) AddIndexedTagIDs(ids ...int) {
+	if m.indexed_tags == nil {
+		m.indexed_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.indexed_tags[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveIndexedTagIDs removes the indexed_tags edge to FileTag by ids.
+func (m *FileMutation) RemoveIndexedTagIDs(ids ...int) {
+	if m.removedindexed_tags == nil {
+		m.removedindexed_tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedindexed_tags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIndexedTags returns the removed ids of indexed_tags.
+func (m *FileMutation) RemovedIndexedTagsIDs() (ids []int) {
+	for id := range m.removedindexed_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IndexedTagsIDs returns the indexed_tags ids in the mutation.
+func (m *FileMutation) IndexedTagsIDs() (ids []int) {
+	for id := range m.indexed_tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIndexedTags reset all changes of the indexed_tags edge.
+func (m *FileMutation) ResetIndexedTags() {
+	m.indexed_tags = nil
+	m.removedindexed_tags = nil
+}
+
+// AddIndexedPropertyIDs adds the indexed_properties edge to FileProperty by ids.
+func (m *FileMutation) AddIndexedPropertyIDs(ids ...int) {
+	if m.indexed_properties == nil {
+		m.indexed_properties = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.indexed_properties[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveIndexedPropertyIDs removes the indexed_properties edge to FileProperty by ids.
+func (m *FileMutation) RemoveIndexedPropertyIDs(ids ...int) {
+	if m.removedindexed_properties == nil {
+		m.removedindexed_properties = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedindexed_properties[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedIndexedProperties returns the removed ids of indexed_properties.
+func (m *FileMutation) RemovedIndexedPropertiesIDs() (ids []int) {
+	for id := range m.removedindexed_properties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// IndexedPropertiesIDs returns the indexed_properties ids in the mutation.
+func (m *FileMutation) IndexedPropertiesIDs() (ids []int) {
+	for id := range m.indexed_properties {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetIndexedProperties reset all changes of the indexed_properties edge.
+func (m *FileMutation) ResetIndexedProperties() {
+	m.indexed_properties = nil
+	m.removedindexed_properties = nil
+}
+
+// SetIndexedMediaID sets the indexed_media edge to FileMedia by id.
+func (m *FileMutation) SetIndexedMediaID(id int) {
+	m.indexed_media = &id
+}
+
+// ClearIndexedMedia clears the indexed_media edge to FileMedia.
+func (m *FileMutation) ClearIndexedMedia() {
+	m.clearedindexed_media = true
+}
+
+// IndexedMediaCleared returns if the edge indexed_media was cleared.
+func (m *FileMutation) IndexedMediaCleared() bool {
+	return m.clearedindexed_media
+}
+
+// IndexedMediaID returns the indexed_media id in the mutation.
+func (m *FileMutation) IndexedMediaID() (id int, exists bool) {
+	if m.indexed_media != nil {
+		return *m.indexed_media, true
+	}
+	return
+}
+
+// IndexedMediaIDs returns the indexed_media ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// IndexedMediaID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) IndexedMediaIDs() (ids []int) {
+	if id := m.indexed_media; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetIndexedMedia reset all changes of the indexed_media edge.
+func (m *FileMutation) ResetIndexedMedia() {
+	m.indexed_media = nil
+	m.clearedindexed_media = false
+}
+
+// AddThumbnailIDs adds the thumbnails edge to Thumbnail by ids.
+func (m *FileMutation) AddThumbnailIDs(ids ...int) {
+	if m.thumbnails == nil {
+		m.thumbnails = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.thumbnails[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveThumbnailIDs removes the thumbnails edge to Thumbnail by ids.
+func (m *FileMutation) RemoveThumbnailIDs(ids ...int) {
+	if m.removedthumbnails == nil {
+		m.removedthumbnails = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedthumbnails[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedThumbnails returns the removed ids of thumbnails.
+func (m *FileMutation) RemovedThumbnailsIDs() (ids []int) {
+	for id := range m.removedthumbnails {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ThumbnailsIDs returns the thumbnails ids in the mutation.
+func (m *FileMutation) ThumbnailsIDs() (ids []int) {
+	for id := range m.thumbnails {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetThumbnails reset all changes of the thumbnails edge.
+func (m *FileMutation) ResetThumbnails() {
+	m.thumbnails = nil
+	m.removedthumbnails = nil
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (m *FileMutation) AddShareIDs(ids ...uuid.UUID) {
+	if m.shares == nil {
+		m.shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (m *FileMutation) RemoveShareIDs(ids ...uuid.UUID) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed ids of shares.
+func (m *FileMutation) RemovedSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the shares ids in the mutation.
+func (m *FileMutation) SharesIDs() (ids []uuid.UUID) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares reset all changes of the shares edge.
+func (m *FileMutation) ResetShares() {
+	m.shares = nil
+	m.removedshares = nil
+}
+
+// SetEncryptionKeyID sets the encryption_key edge to FileKey by id.
+func (m *FileMutation) SetEncryptionKeyID(id int) {
+	m.encryption_key = &id
+}
+
+// ClearEncryptionKey clears the encryption_key edge to FileKey.
+func (m *FileMutation) ClearEncryptionKey() {
+	m.clearedencryption_key = true
+}
+
+// EncryptionKeyCleared returns if the edge encryption_key was cleared.
+func (m *FileMutation) EncryptionKeyCleared() bool {
+	return m.clearedencryption_key
+}
+
+// EncryptionKeyID returns the encryption_key id in the mutation.
+func (m *FileMutation) EncryptionKeyID() (id int, exists bool) {
+	if m.encryption_key != nil {
+		return *m.encryption_key, true
+	}
+	return
+}
+
+// EncryptionKeyIDs returns the encryption_key ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// EncryptionKeyID instead. It exists only for internal usage by the builders.
+func (m *FileMutation) EncryptionKeyIDs() (ids []int) {
+	if id := m.encryption_key; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEncryptionKey reset all changes of the encryption_key edge.
+func (m *FileMutation) ResetEncryptionKey() {
+	m.encryption_key = nil
+	m.clearedencryption_key = false
+}
+
+// Op returns the operation name.
+func (m *FileMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (File).
+func (m *FileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FileMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.hash != nil {
+		fields = append(fields, file.FieldHash)
+	}
+	if m.size != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	if m.pinned_at != nil {
+		fields = append(fields, file.FieldPinnedAt)
+	}
+	if m.unpinned_at != nil {
+		fields = append(fields, file.FieldUnpinnedAt)
+	}
+	if m.metadata != nil {
+		fields = append(fields, file.FieldMetadata)
+	}
+	if m.key != nil {
+		fields = append(fields, file.FieldKey)
+	}
+	if m.etag != nil {
+		fields = append(fields, file.FieldEtag)
+	}
+	if m.name != nil {
+		fields = append(fields, file.FieldName)
+	}
+	if m.tags != nil {
+		fields = append(fields, file.FieldTags)
+	}
+	if m.content_type != nil {
+		fields = append(fields, file.FieldContentType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldHash:
+		return m.Hash()
+	case file.FieldSize:
+		return m.Size()
+	case file.FieldPinnedAt:
+		return m.PinnedAt()
+	case file.FieldUnpinnedAt:
+		return m.UnpinnedAt()
+	case file.FieldMetadata:
+		return m.Metadata()
+	case file.FieldKey:
+		return m.Key()
+	case file.FieldEtag:
+		return m.Etag()
+	case file.FieldName:
+		return m.Name()
+	case file.FieldTags:
+		return m.Tags()
+	case file.FieldContentType:
+		return m.ContentType()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case file.FieldPinnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPinnedAt(v)
+		return nil
+	case file.FieldUnpinnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnpinnedAt(v)
+		return nil
+	case file.FieldMetadata:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	case file.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case file.FieldEtag:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEtag(v)
+		return nil
+	case file.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case file.FieldTags:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTags(v)
+		return nil
+	case file.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FileMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, file.FieldSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case file.FieldSize:
+		return m.AddedSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case file.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown File numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FileMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(file.FieldUnpinnedAt) {
+		fields = append(fields, file.FieldUnpinnedAt)
+	}
+	if m.FieldCleared(file.FieldMetadata) {
+		fields = append(fields, file.FieldMetadata)
+	}
+	if m.FieldCleared(file.FieldKey) {
+		fields = append(fields, file.FieldKey)
+	}
+	if m.FieldCleared(file.FieldEtag) {
+		fields = append(fields, file.FieldEtag)
+	}
+	if m.FieldCleared(file.FieldName) {
+		fields = append(fields, file.FieldName)
+	}
+	if m.FieldCleared(file.FieldTags) {
+		fields = append(fields, file.FieldTags)
+	}
+	if m.FieldCleared(file.FieldContentType) {
+		fields = append(fields, file.FieldContentType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMutation) ClearField(name string) error {
+	switch name {
+	case file.FieldUnpinnedAt:
+		m.ClearUnpinnedAt()
+		return nil
+	case file.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case file.FieldKey:
+		m.ClearKey()
+		return nil
+	case file.FieldEtag:
+		m.ClearEtag()
+		return nil
+	case file.FieldName:
+		m.ClearName()
+		return nil
+	case file.FieldTags:
+		m.ClearTags()
+		return nil
+	case file.FieldContentType:
+		m.ClearContentType()
+		return nil
+	}
+	return fmt.Errorf("unknown File nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FileMutation) ResetField(name string) error {
+	switch name {
+	case file.FieldHash:
+		m.ResetHash()
+		return nil
+	case file.FieldSize:
+		m.ResetSize()
+		return nil
+	case file.FieldPinnedAt:
+		m.ResetPinnedAt()
+		return nil
+	case file.FieldUnpinnedAt:
+		m.ResetUnpinnedAt()
+		return nil
+	case file.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case file.FieldKey:
+		m.ResetKey()
+		return nil
+	case file.FieldEtag:
+		m.ResetEtag()
+		return nil
+	case file.FieldName:
+		m.ResetName()
+		return nil
+	case file.FieldTags:
+		m.ResetTags()
+		return nil
+	case file.FieldContentType:
+		m.ResetContentType()
+		return nil
+	}
+	return fmt.Errorf("unknown File field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.user != nil {
+		edges = append(edges, file.EdgeUser)
+	}
+	if m.bucket != nil {
+		edges = append(edges, file.EdgeBucket)
+	}
+	if m.folder != nil {
+		edges = append(edges, file.EdgeFolder)
+	}
+	if m.indexed_tags != nil {
+		edges = append(edges, file.EdgeIndexedTags)
+	}
+	if m.indexed_properties != nil {
+		edges = append(edges, file.EdgeIndexedProperties)
+	}
+	if m.indexed_media != nil {
+		edges = append(edges, file.EdgeIndexedMedia)
+	}
+	if m.thumbnails != nil {
+		edges = append(edges, file.EdgeThumbnails)
+	}
+	if m.shares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	if m.encryption_key != nil {
+		edges = append(edges, file.EdgeEncryptionKey)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FileMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeBucket:
+		if id := m.bucket; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeFolder:
+		if id := m.folder; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeIndexedTags:
+		ids := make([]ent.Value, 0, len(m.indexed_tags))
+		for id := range m.indexed_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeIndexedProperties:
+		ids := make([]ent.Value, 0, len(m.indexed_properties))
+		for id := range m.indexed_properties {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeIndexedMedia:
+		if id := m.indexed_media; id != nil {
+			return []ent.Value{*id}
+		}
+	case file.EdgeThumbnails:
+		ids := make([]ent.Value, 0, len(m.thumbnails))
+		for id := range m.thumbnails {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeEncryptionKey:
+		if id := m.encryption_key; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.removedindexed_tags != nil {
+		edges = append(edges, file.EdgeIndexedTags)
+	}
+	if m.removedindexed_properties != nil {
+		edges = append(edges, file.EdgeIndexedProperties)
+	}
+	if m.removedthumbnails != nil {
+		edges = append(edges, file.EdgeThumbnails)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, file.EdgeShares)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FileMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case file.EdgeIndexedTags:
+		ids := make([]ent.Value, 0, len(m.removedindexed_tags))
+		for id := range m.removedindexed_tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeIndexedProperties:
+		ids := make([]ent.Value, 0, len(m.removedindexed_properties))
+		for id := range m.removedindexed_properties {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeThumbnails:
+		ids := make([]ent.Value, 0, len(m.removedthumbnails))
+		for id := range m.removedthumbnails {
+			ids = append(ids, id)
+		}
+		return ids
+	case file.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 9)
+	if m.cleareduser {
+		edges = append(edges, file.EdgeUser)
+	}
+	if m.clearedbucket {
+		edges = append(edges, file.EdgeBucket)
+	}
+	if m.clearedfolder {
+		edges = append(edges, file.EdgeFolder)
+	}
+	if m.clearedindexed_media {
+		edges = append(edges, file.EdgeIndexedMedia)
+	}
+	if m.clearedencryption_key {
+		edges = append(edges, file.EdgeEncryptionKey)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FileMutation) EdgeCleared(name string) bool {
+	switch name {
+	case file.EdgeUser:
+		return m.cleareduser
+	case file.EdgeBucket:
+		return m.clearedbucket
+	case file.EdgeFolder:
+		return m.clearedfolder
+	case file.EdgeIndexedMedia:
+		return m.clearedindexed_media
+	case file.EdgeEncryptionKey:
+		return m.clearedencryption_key
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FileMutation) ClearEdge(name string) error {
+	switch name {
+	case file.EdgeUser:
+		m.ClearUser()
+		return nil
+	case file.EdgeBucket:
+		m.ClearBucket()
+		return nil
+	case file.EdgeFolder:
+		m.ClearFolder()
+		return nil
+	case file.EdgeIndexedMedia:
+		m.ClearIndexedMedia()
+		return nil
+	case file.EdgeEncryptionKey:
+		m.ClearEncryptionKey()
+		return nil
+	}
+	return fmt.Errorf("unknown File unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FileMutation) ResetEdge(name string) error {
+	switch name {
+	case file.EdgeUser:
+		m.ResetUser()
+		return nil
+	case file.EdgeBucket:
+		m.ResetBucket()
+		return nil
+	case file.EdgeFolder:
+		m.ResetFolder()
+		return nil
+	case file.EdgeIndexedTags:
+		m.ResetIndexedTags()
+		return nil
+	case file.EdgeIndexedProperties:
+		m.ResetIndexedProperties()
+		return nil
+	case file.EdgeIndexedMedia:
+		m.ResetIndexedMedia()
+		return nil
+	case file.EdgeThumbnails:
+		m.ResetThumbnails()
+		return nil
+	case file.EdgeShares:
+		m.ResetShares()
+		return nil
+	case file.EdgeEncryptionKey:
+		m.ResetEncryptionKey()
+		return nil
+	}
+	return fmt.Errorf("unknown File edge %s", name)
+}
+
+// FileKeyMutation represents an operation that mutate the FileKeys
+// nodes in the graph.
+type FileKeyMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	provider        *filekey.Provider
+	key_id          *string
+	wrapped_key     *[]byte
+	segment_size    *int
+	addsegment_size *int
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	file            *uuid.UUID
+	clearedfile     bool
+}
+
+var _ ent.Mutation = (*FileKeyMutation)(nil)
+
+// newFileKeyMutation creates new mutation for $n.Name.
+func newFileKeyMutation(c config, op Op) *FileKeyMutation {
+	return &FileKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileKey,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FileKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetProvider sets the provider field.
+func (m *FileKeyMutation) SetProvider(f filekey.Provider) {
+	m.provider = &f
+}
+
+// Provider returns the provider value in the mutation.
+func (m *FileKeyMutation) Provider() (r filekey.Provider, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetProvider reset all changes of the provider field.
+func (m *FileKeyMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetKeyID sets the key_id field.
+func (m *FileKeyMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the key_id value in the mutation.
+func (m *FileKeyMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKeyID reset all changes of the key_id field.
+func (m *FileKeyMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetWrappedKey sets the wrapped_key field.
+func (m *FileKeyMutation) SetWrappedKey(b []byte) {
+	m.wrapped_key = &b
+}
+
+// WrappedKey returns the wrapped_key value in the mutation.
+func (m *FileKeyMutation) WrappedKey() (r []byte, exists bool) {
+	v := m.wrapped_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWrappedKey reset all changes of the wrapped_key field.
+func (m *FileKeyMutation) ResetWrappedKey() {
+	m.wrapped_key = nil
+}
+
+// SetSegmentSize sets the segment_size field.
+func (m *FileKeyMutation) SetSegmentSize(i int) {
+	m.segment_size = &i
+	m.addsegment_size = nil
+}
+
+// SegmentSize returns the segment_size value in the mutation.
+func (m *FileKeyMutation) SegmentSize() (r int, exists bool) {
+	v := m.segment_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddSegmentSize adds i to segment_size.
+func (m *FileKeyMutation) AddSegmentSize(i int) {
+	if m.addsegment_size != nil {
+		*m.addsegment_size += i
+	} else {
+		m.addsegment_size = &i
+	}
+}
+
+// AddedSegmentSize returns the value that was added to the segment_size field in this mutation.
+func (m *FileKeyMutation) AddedSegmentSize() (r int, exists bool) {
+	v := m.addsegment_size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSegmentSize reset all changes of the segment_size field.
+func (m *FileKeyMutation) ResetSegmentSize() {
+	m.segment_size = nil
+	m.addsegment_size = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *FileKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *FileKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *FileKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *FileKeyMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *FileKeyMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *FileKeyMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *FileKeyMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileKeyMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *FileKeyMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *FileKeyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (FileKey).
+func (m *FileKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FileKeyMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.provider != nil {
+		fields = append(fields, filekey.FieldProvider)
+	}
+	if m.key_id != nil {
+		fields = append(fields, filekey.FieldKeyID)
+	}
+	if m.wrapped_key != nil {
+		fields = append(fields, filekey.FieldWrappedKey)
+	}
+	if m.segment_size != nil {
+		fields = append(fields, filekey.FieldSegmentSize)
+	}
+	if m.created_at != nil {
+		fields = append(fields, filekey.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FileKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filekey.FieldProvider:
+		return m.Provider()
+	case filekey.FieldKeyID:
+		return m.KeyID()
+	case filekey.FieldWrappedKey:
+		return m.WrappedKey()
+	case filekey.FieldSegmentSize:
+		return m.SegmentSize()
+	case filekey.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filekey.FieldProvider:
+		v, ok := value.(filekey.Provider)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case filekey.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case filekey.FieldWrappedKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWrappedKey(v)
+		return nil
+	case filekey.FieldSegmentSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSegmentSize(v)
+		return nil
+	case filekey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FileKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addsegment_size != nil {
+		fields = append(fields, filekey.FieldSegmentSize)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FileKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filekey.FieldSegmentSize:
+		return m.AddedSegmentSize()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case filekey.FieldSegmentSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSegmentSize(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FileKeyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FileKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileKeyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FileKeyMutation) ResetField(name string) error {
+	switch name {
+	case filekey.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case filekey.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case filekey.FieldWrappedKey:
+		m.ResetWrappedKey()
+		return nil
+	case filekey.FieldSegmentSize:
+		m.ResetSegmentSize()
+		return nil
+	case filekey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FileKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, filekey.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FileKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filekey.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FileKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FileKeyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FileKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, filekey.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FileKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filekey.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FileKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case filekey.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FileKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case filekey.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileKey edge %s", name)
+}
+
+// FileMediaMutation represents an operation that mutate the FileMediaSlice
+// nodes in the graph.
+type FileMediaMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	width         *int
+	addwidth      *int
+	height        *int
+	addheight     *int
+	duration      *float64
+	addduration   *float64
+	pages         *int
+	addpages      *int
+	taken_at      *time.Time
+	clearedFields map[string]struct{}
+	file          *uuid.UUID
+	clearedfile   bool
+}
+
+var _ ent.Mutation = (*FileMediaMutation)(nil)
+
+// newFileMediaMutation creates new mutation for $n.Name.
+func newFileMediaMutation(c config, op Op) *FileMediaMutation {
+	return &FileMediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileMediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileMediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FileMediaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetWidth sets the width field.
+func (m *FileMediaMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the width value in the mutation.
+func (m *FileMediaMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddWidth adds i to width.
+func (m *FileMediaMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the width field in this mutation.
+func (m *FileMediaMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWidth clears the value of width.
+func (m *FileMediaMutation) ClearWidth() {
+	m.width = nil
+	m.addwidth = nil
+	m.clearedFields[filemedia.FieldWidth] = struct{}{}
+}
+
+// WidthCleared returns if the field width was cleared in this mutation.
+func (m *FileMediaMutation) WidthCleared() bool {
+	_, ok := m.clearedFields[filemedia.FieldWidth]
+	return ok
+}
+
+// ResetWidth reset all changes of the width field.
+func (m *FileMediaMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+	delete(m.clearedFields, filemedia.FieldWidth)
+}
+
+// SetHeight sets the height field.
+func (m *FileMediaMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the height value in the mutation.
+func (m *FileMediaMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddHeight adds i to height.
+func (m *FileMediaMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the height field in this mutation.
+func (m *FileMediaMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHeight clears the value of height.
+func (m *FileMediaMutation) ClearHeight() {
+	m.height = nil
+	m.addheight = nil
+	m.clearedFields[filemedia.FieldHeight] = struct{}{}
+}
+
+// HeightCleared returns if the field height was cleared in this mutation.
+func (m *FileMediaMutation) HeightCleared() bool {
+	_, ok := m.clearedFields[filemedia.FieldHeight]
+	return ok
+}
+
+// ResetHeight reset all changes of the height field.
+func (m *FileMediaMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+	delete(m.clearedFields, filemedia.FieldHeight)
+}
+
+// SetDuration sets the duration field.
+func (m *FileMediaMutation) SetDuration(f float64) {
+	m.duration = &f
+	m.addduration = nil
+}
+
+// Duration returns the duration value in the mutation.
+func (m *FileMediaMutation) Duration() (r float64, exists bool) {
+	v := m.duration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddDuration adds f to duration.
+func (m *FileMediaMutation) AddDuration(f float64) {
+	if m.addduration != nil {
+		*m.addduration += f
+	} else {
+		m.addduration = &f
+	}
+}
+
+// AddedDuration returns the value that was added to the duration field in this mutation.
+func (m *FileMediaMutation) AddedDuration() (r float64, exists bool) {
+	v := m.addduration
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDuration clears the value of duration.
+func (m *FileMediaMutation) ClearDuration() {
+	m.duration = nil
+	m.addduration = nil
+	m.clearedFields[filemedia.FieldDuration] = struct{}{}
+}
+
+// DurationCleared returns if the field duration was cleared in this mutation.
+func (m *FileMediaMutation) DurationCleared() bool {
+	_, ok := m.clearedFields[filemedia.FieldDuration]
+	return ok
+}
+
+// ResetDuration reset all changes of the duration field.
+func (m *FileMediaMutation) ResetDuration() {
+	m.duration = nil
+	m.addduration = nil
+	delete(m.clearedFields, filemedia.FieldDuration)
+}
+
+// SetPages sets the pages field.
+func (m *FileMediaMutation) SetPages(i int) {
+	m.pages = &i
+	m.addpages = nil
+}
+
+// Pages returns the pages value in the mutation.
+func (m *FileMediaMutation) Pages() (r int, exists bool) {
+	v := m.pages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddPages adds i to pages.
+func (m *FileMediaMutation) AddPages(i int) {
+	if m.addpages != nil {
+		*m.addpages += i
+	} else {
+		m.addpages = &i
+	}
+}
+
+// AddedPages returns the value that was added to the pages field in this mutation.
+func (m *FileMediaMutation) AddedPages() (r int, exists bool) {
+	v := m.addpages
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPages clears the value of pages.
+func (m *FileMediaMutation) ClearPages() {
+	m.pages = nil
+	m.addpages = nil
+	m.clearedFields[filemedia.FieldPages] = struct{}{}
+}
+
+// PagesCleared returns if the field pages was cleared in this mutation.
+func (m *FileMediaMutation) PagesCleared() bool {
+	_, ok := m.clearedFields[filemedia.FieldPages]
+	return ok
+}
+
+// ResetPages reset all changes of the pages field.
+func (m *FileMediaMutation) ResetPages() {
+	m.pages = nil
+	m.addpages = nil
+	delete(m.clearedFields, filemedia.FieldPages)
+}
+
+// SetTakenAt sets the taken_at field.
+func (m *FileMediaMutation) SetTakenAt(t time.Time) {
+	m.taken_at = &t
+}
+
+// TakenAt returns the taken_at value in the mutation.
+func (m *FileMediaMutation) TakenAt() (r time.Time, exists bool) {
+	v := m.taken_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTakenAt clears the value of taken_at.
+func (m *FileMediaMutation) ClearTakenAt() {
+	m.taken_at = nil
+	m.clearedFields[filemedia.FieldTakenAt] = struct{}{}
+}
+
+// TakenAtCleared returns if the field taken_at was cleared in this mutation.
+func (m *FileMediaMutation) TakenAtCleared() bool {
+	_, ok := m.clearedFields[filemedia.FieldTakenAt]
+	return ok
+}
+
+// ResetTakenAt reset all changes of the taken_at field.
+func (m *FileMediaMutation) ResetTakenAt() {
+	m.taken_at = nil
+	delete(m.clearedFields, filemedia.FieldTakenAt)
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *FileMediaMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *FileMediaMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *FileMediaMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *FileMediaMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileMediaMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *FileMediaMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *FileMediaMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (FileMedia).
+func (m *FileMediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FileMediaMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.width != nil {
+		fields = append(fields, filemedia.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, filemedia.FieldHeight)
+	}
+	if m.duration != nil {
+		fields = append(fields, filemedia.FieldDuration)
+	}
+	if m.pages != nil {
+		fields = append(fields, filemedia.FieldPages)
+	}
+	if m.taken_at != nil {
+		fields = append(fields, filemedia.FieldTakenAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FileMediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filemedia.FieldWidth:
+		return m.Width()
+	case filemedia.FieldHeight:
+		return m.Height()
+	case filemedia.FieldDuration:
+		return m.Duration()
+	case filemedia.FieldPages:
+		return m.Pages()
+	case filemedia.FieldTakenAt:
+		return m.TakenAt()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileMediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filemedia.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case filemedia.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case filemedia.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDuration(v)
+		return nil
+	case filemedia.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPages(v)
+		return nil
+	case filemedia.FieldTakenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakenAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FileMediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addwidth != nil {
+		fields = append(fields, filemedia.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, filemedia.FieldHeight)
+	}
+	if m.addduration != nil {
+		fields = append(fields, filemedia.FieldDuration)
+	}
+	if m.addpages != nil {
+		fields = append(fields, filemedia.FieldPages)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FileMediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case filemedia.FieldWidth:
+		return m.AddedWidth()
+	case filemedia.FieldHeight:
+		return m.AddedHeight()
+	case filemedia.FieldDuration:
+		return m.AddedDuration()
+	case filemedia.FieldPages:
+		return m.AddedPages()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileMediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case filemedia.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case filemedia.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case filemedia.FieldDuration:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDuration(v)
+		return nil
+	case filemedia.FieldPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPages(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FileMediaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(filemedia.FieldWidth) {
+		fields = append(fields, filemedia.FieldWidth)
+	}
+	if m.FieldCleared(filemedia.FieldHeight) {
+		fields = append(fields, filemedia.FieldHeight)
+	}
+	if m.FieldCleared(filemedia.FieldDuration) {
+		fields = append(fields, filemedia.FieldDuration)
+	}
+	if m.FieldCleared(filemedia.FieldPages) {
+		fields = append(fields, filemedia.FieldPages)
+	}
+	if m.FieldCleared(filemedia.FieldTakenAt) {
+		fields = append(fields, filemedia.FieldTakenAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FileMediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileMediaMutation) ClearField(name string) error {
+	switch name {
+	case filemedia.FieldWidth:
+		m.ClearWidth()
+		return nil
+	case filemedia.FieldHeight:
+		m.ClearHeight()
+		return nil
+	case filemedia.FieldDuration:
+		m.ClearDuration()
+		return nil
+	case filemedia.FieldPages:
+		m.ClearPages()
+		return nil
+	case filemedia.FieldTakenAt:
+		m.ClearTakenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FileMediaMutation) ResetField(name string) error {
+	switch name {
+	case filemedia.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case filemedia.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case filemedia.FieldDuration:
+		m.ResetDuration()
+		return nil
+	case filemedia.FieldPages:
+		m.ResetPages()
+		return nil
+	case filemedia.FieldTakenAt:
+		m.ResetTakenAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FileMediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, filemedia.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FileMediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filemedia.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FileMediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FileMediaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FileMediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, filemedia.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FileMediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filemedia.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FileMediaMutation) ClearEdge(name string) error {
+	switch name {
+	case filemedia.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FileMediaMutation) ResetEdge(name string) error {
+	switch name {
+	case filemedia.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileMedia edge %s", name)
+}
+
+// FilePropertyMutation represents an operation that mutate the FileProperties
+// nodes in the graph.
+type FilePropertyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	key           *string
+	value         *string
+	clearedFields map[string]struct{}
+	file          *uuid.UUID
+	clearedfile   bool
+}
+
+var _ ent.Mutation = (*FilePropertyMutation)(nil)
+
+// newFilePropertyMutation creates new mutation for $n.Name.
+func newFilePropertyMutation(c config, op Op) *FilePropertyMutation {
+	return &FilePropertyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileProperty,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FilePropertyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FilePropertyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FilePropertyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetKey sets the key field.
+func (m *FilePropertyMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the key value in the mutation.
+func (m *FilePropertyMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKey reset all changes of the key field.
+func (m *FilePropertyMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetValue sets the value field.
+func (m *FilePropertyMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value value in the mutation.
+func (m *FilePropertyMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetValue reset all changes of the value field.
+func (m *FilePropertyMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *FilePropertyMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *FilePropertyMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *FilePropertyMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *FilePropertyMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FilePropertyMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *FilePropertyMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *FilePropertyMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (FileProperty).
+func (m *FilePropertyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FilePropertyMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.key != nil {
+		fields = append(fields, fileproperty.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, fileproperty.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FilePropertyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case fileproperty.FieldKey:
+		return m.Key()
+	case fileproperty.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FilePropertyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case fileproperty.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case fileproperty.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileProperty field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FilePropertyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FilePropertyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FilePropertyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileProperty numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FilePropertyMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FilePropertyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FilePropertyMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileProperty nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FilePropertyMutation) ResetField(name string) error {
+	switch name {
+	case fileproperty.FieldKey:
+		m.ResetKey()
+		return nil
+	case fileproperty.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown FileProperty field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FilePropertyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, fileproperty.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FilePropertyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case fileproperty.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FilePropertyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FilePropertyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FilePropertyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, fileproperty.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FilePropertyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case fileproperty.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FilePropertyMutation) ClearEdge(name string) error {
+	switch name {
+	case fileproperty.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileProperty unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FilePropertyMutation) ResetEdge(name string) error {
+	switch name {
+	case fileproperty.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileProperty edge %s", name)
+}
+
+// FileTagMutation represents an operation that mutate the FileTags
+// nodes in the graph.
+type FileTagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	clearedFields map[string]struct{}
+	file          *uuid.UUID
+	clearedfile   bool
+}
+
+var _ ent.Mutation = (*FileTagMutation)(nil)
+
+// newFileTagMutation creates new mutation for $n.Name.
+func newFileTagMutation(c config, op Op) *FileTagMutation {
+	return &FileTagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFileTag,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FileTagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FileTagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FileTagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *FileTagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *FileTagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetName reset all changes of the name field.
+func (m *FileTagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *FileTagMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *FileTagMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *FileTagMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *FileTagMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *FileTagMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *FileTagMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *FileTagMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (FileTag).
+func (m *FileTagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FileTagMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.name != nil {
+		fields = append(fields, filetag.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FileTagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case filetag.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileTagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case filetag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FileTag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FileTagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FileTagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FileTagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FileTag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FileTagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FileTagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FileTagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FileTag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FileTagMutation) ResetField(name string) error {
+	switch name {
+	case filetag.FieldName:
+		m.ResetName()
+		return nil
+	}
+	return fmt.Errorf("unknown FileTag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FileTagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, filetag.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FileTagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case filetag.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FileTagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FileTagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FileTagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, filetag.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FileTagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case filetag.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FileTagMutation) ClearEdge(name string) error {
+	switch name {
+	case filetag.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileTag unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FileTagMutation) ResetEdge(name string) error {
+	switch name {
+	case filetag.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown FileTag edge %s", name)
+}
+
+// FolderMutation represents an operation that mutate the Folders
+// nodes in the graph.
+type FolderMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	name            *string
+	updated_at      *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	parent          *uuid.UUID
+	clearedparent   bool
+	children        map[uuid.UUID]struct{}
+	removedchildren map[uuid.UUID]struct{}
+	files           map[uuid.UUID]struct{}
+	removedfiles    map[uuid.UUID]struct{}
+}
+
+var _ ent.Mutation = (*FolderMutation)(nil)
+
+// newFolderMutation creates new mutation for $n.Name.
+func newFolderMutation(c config, op Op) *FolderMutation {
+	return &FolderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFolder,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FolderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FolderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Folder creation.
+func (m *FolderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *FolderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *FolderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *FolderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetName reset all changes of the name field.
+func (m *FolderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (m *FolderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *FolderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt reset all changes of the updated_at field.
+func (m *FolderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *FolderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *FolderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *FolderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *FolderMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *FolderMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *FolderMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *FolderMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the user edge.
+func (m *FolderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetParentID sets the parent edge to Folder by id.
+func (m *FolderMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the parent edge to Folder.
+func (m *FolderMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared returns if the edge parent was cleared.
+func (m *FolderMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the parent id in the mutation.
+func (m *FolderMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the parent ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *FolderMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent reset all changes of the parent edge.
+func (m *FolderMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the children edge to Folder by ids.
+func (m *FolderMutation) AddChildIDs(ids ...uuid.UUID) {
+	if m.children == nil {
+		m.children = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveChildIDs removes the children edge to Folder by ids.
+func (m *FolderMutation) RemoveChildIDs(ids ...uuid.UUID) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed ids of children.
+func (m *FolderMutation) RemovedChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the children ids in the mutation.
+func (m *FolderMutation) ChildrenIDs() (ids []uuid.UUID) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren reset all changes of the children edge.
+func (m *FolderMutation) ResetChildren() {
+	m.children = nil
+	m.removedchildren = nil
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (m *FolderMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (m *FolderMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed ids of files.
+func (m *FolderMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the files ids in the mutation.
+func (m *FolderMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles reset all changes of the files edge.
+func (m *FolderMutation) ResetFiles() {
+	m.files = nil
+	m.removedfiles = nil
+}
+
+// Op returns the operation name.
+func (m *FolderMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Folder).
+func (m *FolderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *FolderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, folder.FieldName)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, folder.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, folder.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *FolderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case folder.FieldName:
+		return m.Name()
+	case folder.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case folder.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FolderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case folder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case folder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case folder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *FolderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *FolderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *FolderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Folder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *FolderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *FolderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FolderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Folder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *FolderMutation) ResetField(name string) error {
+	switch name {
+	case folder.FieldName:
+		m.ResetName()
+		return nil
+	case folder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case folder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *FolderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, folder.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.files != nil {
+		edges = append(edges, folder.EdgeFiles)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *FolderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *FolderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, folder.EdgeChildren)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, folder.EdgeFiles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *FolderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case folder.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case folder.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *FolderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, folder.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, folder.EdgeParent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *FolderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case folder.EdgeUser:
+		return m.cleareduser
+	case folder.EdgeParent:
+		return m.clearedparent
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *FolderMutation) ClearEdge(name string) error {
+	switch name {
+	case folder.EdgeUser:
+		m.ClearUser()
+		return nil
+	case folder.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *FolderMutation) ResetEdge(name string) error {
+	switch name {
+	case folder.EdgeUser:
+		m.ResetUser()
+		return nil
+	case folder.EdgeParent:
+		m.ResetParent()
+		return nil
+	case folder.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case folder.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	}
+	return fmt.Errorf("unknown Folder edge %s", name)
+}
+
+// ShareMutation represents an operation that mutate the Shares
+// nodes in the graph.
+type ShareMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	slug               *string
+	password           *string
+	expires_at         *time.Time
+	max_downloads      *int
+	addmax_downloads   *int
+	downloads          *int
+	adddownloads       *int
+	last_downloaded_at *time.Time
+	revoked_at         *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	user               *int
+	cleareduser        bool
+	file               *uuid.UUID
+	clearedfile        bool
+}
+
+var _ ent.Mutation = (*ShareMutation)(nil)
+
+// newShareMutation creates new mutation for $n.Name.
+func newShareMutation(c config, op Op) *ShareMutation {
+	return &ShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShare,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Share creation.
+func (m *ShareMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *ShareMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetSlug sets the slug field.
+func (m *ShareMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the slug value in the mutation.
+func (m *ShareMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSlug reset all changes of the slug field.
+func (m *ShareMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetPassword sets the password field.
+func (m *ShareMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the password value in the mutation.
+func (m *ShareMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPassword clears the value of password.
+func (m *ShareMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[share.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the field password was cleared in this mutation.
+func (m *ShareMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[share.FieldPassword]
+	return ok
+}
+
+// ResetPassword reset all changes of the password field.
+func (m *ShareMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, share.FieldPassword)
+}
+
+// SetExpiresAt sets the expires_at field.
+func (m *ShareMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the expires_at value in the mutation.
+func (m *ShareMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearExpiresAt clears the value of expires_at.
+func (m *ShareMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[share.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the field expires_at was cleared in this mutation.
+func (m *ShareMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt reset all changes of the expires_at field.
+func (m *ShareMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, share.FieldExpiresAt)
+}
+
+// SetMaxDownloads sets the max_downloads field.
+func (m *ShareMutation) SetMaxDownloads(i int) {
+	m.max_downloads = &i
+	m.addmax_downloads = nil
+}
+
+// MaxDownloads returns the max_downloads value in the mutation.
+func (m *ShareMutation) MaxDownloads() (r int, exists bool) {
+	v := m.max_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddMaxDownloads adds i to max_downloads.
+func (m *ShareMutation) AddMaxDownloads(i int) {
+	if m.addmax_downloads != nil {
+		*m.addmax_downloads += i
+	} else {
+		m.addmax_downloads = &i
+	}
+}
+
+// AddedMaxDownloads returns the value that was added to the max_downloads field in this mutation.
+func (m *ShareMutation) AddedMaxDownloads() (r int, exists bool) {
+	v := m.addmax_downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxDownloads clears the value of max_downloads.
+func (m *ShareMutation) ClearMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	m.clearedFields[share.FieldMaxDownloads] = struct{}{}
+}
+
+// MaxDownloadsCleared returns if the field max_downloads was cleared in this mutation.
+func (m *ShareMutation) MaxDownloadsCleared() bool {
+	_, ok := m.clearedFields[share.FieldMaxDownloads]
+	return ok
+}
+
+// ResetMaxDownloads reset all changes of the max_downloads field.
+func (m *ShareMutation) ResetMaxDownloads() {
+	m.max_downloads = nil
+	m.addmax_downloads = nil
+	delete(m.clearedFields, share.FieldMaxDownloads)
+}
+
+// SetDownloads sets the downloads field.
+func (m *ShareMutation) SetDownloads(i int) {
+	m.downloads = &i
+	m.adddownloads = nil
+}
+
+// Downloads returns the downloads value in the mutation.
+func (m *ShareMutation) Downloads() (r int, exists bool) {
+	v := m.downloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddDownloads adds i to downloads.
+func (m *ShareMutation) AddDownloads(i int) {
+	if m.adddownloads != nil {
+		*m.adddownloads += i
+	} else {
+		m.adddownloads = &i
+	}
+}
+
+// AddedDownloads returns the value that was added to the downloads field in this mutation.
+func (m *ShareMutation) AddedDownloads() (r int, exists bool) {
+	v := m.adddownloads
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDownloads reset all changes of the downloads field.
+func (m *ShareMutation) ResetDownloads() {
+	m.downloads = nil
+	m.adddownloads = nil
+}
+
+// SetLastDownloadedAt sets the last_downloaded_at field.
+func (m *ShareMutation) SetLastDownloadedAt(t time.Time) {
+	m.last_downloaded_at = &t
+}
+
+// LastDownloadedAt returns the last_downloaded_at value in the mutation.
+func (m *ShareMutation) LastDownloadedAt() (r time.Time, exists bool) {
+	v := m.last_downloaded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastDownloadedAt clears the value of last_downloaded_at.
+func (m *ShareMutation) ClearLastDownloadedAt() {
+	m.last_downloaded_at = nil
+	m.clearedFields[share.FieldLastDownloadedAt] = struct{}{}
+}
+
+// LastDownloadedAtCleared returns if the field last_downloaded_at was cleared in this mutation.
+func (m *ShareMutation) LastDownloadedAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldLastDownloadedAt]
+	return ok
+}
+
+// ResetLastDownloadedAt reset all changes of the last_downloaded_at field.
+func (m *ShareMutation) ResetLastDownloadedAt() {
+	m.last_downloaded_at = nil
+	delete(m.clearedFields, share.FieldLastDownloadedAt)
+}
+
+// SetRevokedAt sets the revoked_at field.
+func (m *ShareMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the revoked_at value in the mutation.
+func (m *ShareMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearRevokedAt clears the value of revoked_at.
+func (m *ShareMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[share.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the field revoked_at was cleared in this mutation.
+func (m *ShareMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[share.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt reset all changes of the revoked_at field.
+func (m *ShareMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, share.FieldRevokedAt)
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *ShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *ShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *ShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *ShareMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *ShareMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *ShareMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *ShareMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the user edge.
+func (m *ShareMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *ShareMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *ShareMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *ShareMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *ShareMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ShareMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *ShareMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *ShareMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Share).
+func (m *ShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *ShareMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.slug != nil {
+		fields = append(fields, share.FieldSlug)
+	}
+	if m.password != nil {
+		fields = append(fields, share.FieldPassword)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, share.FieldExpiresAt)
+	}
+	if m.max_downloads != nil {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.downloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	if m.last_downloaded_at != nil {
+		fields = append(fields, share.FieldLastDownloadedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, share.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, share.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *ShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case share.FieldSlug:
+		return m.Slug()
+	case share.FieldPassword:
+		return m.Password()
+	case share.FieldExpiresAt:
+		return m.ExpiresAt()
+	case share.FieldMaxDownloads:
+		return m.MaxDownloads()
+	case share.FieldDownloads:
+		return m.Downloads()
+	case share.FieldLastDownloadedAt:
+		return m.LastDownloadedAt()
+	case share.FieldRevokedAt:
+		return m.RevokedAt()
+	case share.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case share.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case share.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case share.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case share.FieldMaxDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxDownloads(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDownloads(v)
+		return nil
+	case share.FieldLastDownloadedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastDownloadedAt(v)
+		return nil
+	case share.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case share.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Share field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *ShareMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_downloads != nil {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.adddownloads != nil {
+		fields = append(fields, share.FieldDownloads)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *ShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case share.FieldMaxDownloads:
+		return m.AddedMaxDownloads()
+	case share.FieldDownloads:
+		return m.AddedDownloads()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case share.FieldMaxDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxDownloads(v)
+		return nil
+	case share.FieldDownloads:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDownloads(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Share numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *ShareMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(share.FieldPassword) {
+		fields = append(fields, share.FieldPassword)
+	}
+	if m.FieldCleared(share.FieldExpiresAt) {
+		fields = append(fields, share.FieldExpiresAt)
+	}
+	if m.FieldCleared(share.FieldMaxDownloads) {
+		fields = append(fields, share.FieldMaxDownloads)
+	}
+	if m.FieldCleared(share.FieldLastDownloadedAt) {
+		fields = append(fields, share.FieldLastDownloadedAt)
+	}
+	if m.FieldCleared(share.FieldRevokedAt) {
+		fields = append(fields, share.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *ShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShareMutation) ClearField(name string) error {
+	switch name {
+	case share.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case share.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case share.FieldMaxDownloads:
+		m.ClearMaxDownloads()
+		return nil
+	case share.FieldLastDownloadedAt:
+		m.ClearLastDownloadedAt()
+		return nil
+	case share.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Share nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *ShareMutation) ResetField(name string) error {
+	switch name {
+	case share.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case share.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case share.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case share.FieldMaxDownloads:
+		m.ResetMaxDownloads()
+		return nil
+	case share.FieldDownloads:
+		m.ResetDownloads()
+		return nil
+	case share.FieldLastDownloadedAt:
+		m.ResetLastDownloadedAt()
+		return nil
+	case share.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case share.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Share field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *ShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.file != nil {
+		edges = append(edges, share.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *ShareMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case share.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case share.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *ShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *ShareMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *ShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, share.EdgeUser)
+	}
+	if m.clearedfile {
+		edges = append(edges, share.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *ShareMutation) EdgeCleared(name string) bool {
+	switch name {
+	case share.EdgeUser:
+		return m.cleareduser
+	case share.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *ShareMutation) ClearEdge(name string) error {
+	switch name {
+	case share.EdgeUser:
+		m.ClearUser()
+		return nil
+	case share.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Share unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *ShareMutation) ResetEdge(name string) error {
+	switch name {
+	case share.EdgeUser:
+		m.ResetUser()
+		return nil
+	case share.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Share edge %s", name)
+}
+
+// ThumbnailMutation represents an operation that mutate the Thumbnails
+// nodes in the graph.
+type ThumbnailMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	size          *int
+	addsize       *int
+	width         *int
+	addwidth      *int
+	height        *int
+	addheight     *int
+	hash          *string
+	content_type  *string
+	bytes         *int64
+	addbytes      *int64
+	clearedFields map[string]struct{}
+	file          *uuid.UUID
+	clearedfile   bool
+}
+
+var _ ent.Mutation = (*ThumbnailMutation)(nil)
+
+// newThumbnailMutation creates new mutation for $n.Name.
+func newThumbnailMutation(c config, op Op) *ThumbnailMutation {
+	return &ThumbnailMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeThumbnail,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ThumbnailMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ThumbnailMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *ThumbnailMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetSize sets the size field.
+func (m *ThumbnailMutation) SetSize(i int) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the size value in the mutation.
+func (m *ThumbnailMutation) Size() (r int, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddSize adds i to size.
+func (m *ThumbnailMutation) AddSize(i int) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the size field in this mutation.
+func (m *ThumbnailMutation) AddedSize() (r int, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize reset all changes of the size field.
+func (m *ThumbnailMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetWidth sets the width field.
+func (m *ThumbnailMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the width value in the mutation.
+func (m *ThumbnailMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddWidth adds i to width.
+func (m *ThumbnailMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the width field in this mutation.
+func (m *ThumbnailMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth reset all changes of the width field.
+func (m *ThumbnailMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the height field.
+func (m *ThumbnailMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the height value in the mutation.
+func (m *ThumbnailMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddHeight adds i to height.
+func (m *ThumbnailMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the height field in this mutation.
+func (m *ThumbnailMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight reset all changes of the height field.
+func (m *ThumbnailMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetHash sets the hash field.
+func (m *ThumbnailMutation) SetHash(s string) {
+	m.hash = &s
+}
+
+// Hash returns the hash value in the mutation.
+func (m *ThumbnailMutation) Hash() (r string, exists bool) {
+	v := m.hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearHash clears the value of hash.
+func (m *ThumbnailMutation) ClearHash() {
+	m.hash = nil
+	m.clearedFields[thumbnail.FieldHash] = struct{}{}
+}
+
+// HashCleared returns if the field hash was cleared in this mutation.
+func (m *ThumbnailMutation) HashCleared() bool {
+	_, ok := m.clearedFields[thumbnail.FieldHash]
+	return ok
+}
+
+// ResetHash reset all changes of the hash field.
+func (m *ThumbnailMutation) ResetHash() {
+	m.hash = nil
+	delete(m.clearedFields, thumbnail.FieldHash)
+}
+
+// SetContentType sets the content_type field.
+func (m *ThumbnailMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the content_type value in the mutation.
+func (m *ThumbnailMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearContentType clears the value of content_type.
+func (m *ThumbnailMutation) ClearContentType() {
+	m.content_type = nil
+	m.clearedFields[thumbnail.FieldContentType] = struct{}{}
+}
+
+// ContentTypeCleared returns if the field content_type was cleared in this mutation.
+func (m *ThumbnailMutation) ContentTypeCleared() bool {
+	_, ok := m.clearedFields[thumbnail.FieldContentType]
+	return ok
+}
+
+// ResetContentType reset all changes of the content_type field.
+func (m *ThumbnailMutation) ResetContentType() {
+	m.content_type = nil
+	delete(m.clearedFields, thumbnail.FieldContentType)
+}
+
+// SetBytes sets the bytes field.
+func (m *ThumbnailMutation) SetBytes(i int64) {
+	m.bytes = &i
+	m.addbytes = nil
+}
+
+// Bytes returns the bytes value in the mutation.
+func (m *ThumbnailMutation) Bytes() (r int64, exists bool) {
+	v := m.bytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddBytes adds i to bytes.
+func (m *ThumbnailMutation) AddBytes(i int64) {
+	if m.addbytes != nil {
+		*m.addbytes += i
+	} else {
+		m.addbytes = &i
+	}
+}
+
+// AddedBytes returns the value that was added to the bytes field in this mutation.
+func (m *ThumbnailMutation) AddedBytes() (r int64, exists bool) {
+	v := m.addbytes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBytes reset all changes of the bytes field.
+func (m *ThumbnailMutation) ResetBytes() {
+	m.bytes = nil
+	m.addbytes = nil
+}
+
+// SetFileID sets the file edge to File by id.
+func (m *ThumbnailMutation) SetFileID(id uuid.UUID) {
+	m.file = &id
+}
+
+// ClearFile clears the file edge to File.
+func (m *ThumbnailMutation) ClearFile() {
+	m.clearedfile = true
+}
+
+// FileCleared returns if the edge file was cleared.
+func (m *ThumbnailMutation) FileCleared() bool {
+	return m.clearedfile
+}
+
+// FileID returns the file id in the mutation.
+func (m *ThumbnailMutation) FileID() (id uuid.UUID, exists bool) {
+	if m.file != nil {
+		return *m.file, true
+	}
+	return
+}
+
+// FileIDs returns the file ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// FileID instead. It exists only for internal usage by the builders.
+func (m *ThumbnailMutation) FileIDs() (ids []uuid.UUID) {
+	if id := m.file; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFile reset all changes of the file edge.
+func (m *ThumbnailMutation) ResetFile() {
+	m.file = nil
+	m.clearedfile = false
+}
+
+// Op returns the operation name.
+func (m *ThumbnailMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Thumbnail).
+func (m *ThumbnailMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *ThumbnailMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.size != nil {
+		fields = append(fields, thumbnail.FieldSize)
+	}
+	if m.width != nil {
+		fields = append(fields, thumbnail.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, thumbnail.FieldHeight)
+	}
+	if m.hash != nil {
+		fields = append(fields, thumbnail.FieldHash)
+	}
+	if m.content_type != nil {
+		fields = append(fields, thumbnail.FieldContentType)
+	}
+	if m.bytes != nil {
+		fields = append(fields, thumbnail.FieldBytes)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *ThumbnailMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case thumbnail.FieldSize:
+		return m.Size()
+	case thumbnail.FieldWidth:
+		return m.Width()
+	case thumbnail.FieldHeight:
+		return m.Height()
+	case thumbnail.FieldHash:
+		return m.Hash()
+	case thumbnail.FieldContentType:
+		return m.ContentType()
+	case thumbnail.FieldBytes:
+		return m.Bytes()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ThumbnailMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case thumbnail.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case thumbnail.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case thumbnail.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case thumbnail.FieldHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHash(v)
+		return nil
+	case thumbnail.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case thumbnail.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *ThumbnailMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, thumbnail.FieldSize)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, thumbnail.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, thumbnail.FieldHeight)
+	}
+	if m.addbytes != nil {
+		fields = append(fields, thumbnail.FieldBytes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *ThumbnailMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case thumbnail.FieldSize:
+		return m.AddedSize()
+	case thumbnail.FieldWidth:
+		return m.AddedWidth()
+	case thumbnail.FieldHeight:
+		return m.AddedHeight()
+	case thumbnail.FieldBytes:
+		return m.AddedBytes()
+	}
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *ThumbnailMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case thumbnail.FieldSize:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case thumbnail.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case thumbnail.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	case thumbnail.FieldBytes:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBytes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *ThumbnailMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(thumbnail.FieldHash) {
+		fields = append(fields, thumbnail.FieldHash)
+	}
+	if m.FieldCleared(thumbnail.FieldContentType) {
+		fields = append(fields, thumbnail.FieldContentType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *ThumbnailMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ThumbnailMutation) ClearField(name string) error {
+	switch name {
+	case thumbnail.FieldHash:
+		m.ClearHash()
+		return nil
+	case thumbnail.FieldContentType:
+		m.ClearContentType()
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *ThumbnailMutation) ResetField(name string) error {
+	switch name {
+	case thumbnail.FieldSize:
+		m.ResetSize()
+		return nil
+	case thumbnail.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case thumbnail.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case thumbnail.FieldHash:
+		m.ResetHash()
+		return nil
+	case thumbnail.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case thumbnail.FieldBytes:
+		m.ResetBytes()
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *ThumbnailMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.file != nil {
+		edges = append(edges, thumbnail.EdgeFile)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *ThumbnailMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case thumbnail.EdgeFile:
+		if id := m.file; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *ThumbnailMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *ThumbnailMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *ThumbnailMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfile {
+		edges = append(edges, thumbnail.EdgeFile)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *ThumbnailMutation) EdgeCleared(name string) bool {
+	switch name {
+	case thumbnail.EdgeFile:
+		return m.clearedfile
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *ThumbnailMutation) ClearEdge(name string) error {
+	switch name {
+	case thumbnail.EdgeFile:
+		m.ClearFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *ThumbnailMutation) ResetEdge(name string) error {
+	switch name {
+	case thumbnail.EdgeFile:
+		m.ResetFile()
+		return nil
+	}
+	return fmt.Errorf("unknown Thumbnail edge %s", name)
+}
+
+// TokenMutation represents an operation that mutate the Tokens
+// nodes in the graph.
+type TokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	secret        *string
+	permissions   *token.Permissions
+	created_at    *time.Time
+	last_used     *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+}
+
+var _ ent.Mutation = (*TokenMutation)(nil)
+
+// newTokenMutation creates new mutation for $n.Name.
+func newTokenMutation(c config, op Op) *TokenMutation {
+	return &TokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeToken,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Token creation.
+func (m *TokenMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *TokenMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetName sets the name field.
+func (m *TokenMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the name value in the mutation.
+func (m *TokenMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetName reset all changes of the name field.
+func (m *TokenMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSecret sets the secret field.
+func (m *TokenMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the secret value in the mutation.
+func (m *TokenMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSecret reset all changes of the secret field.
+func (m *TokenMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetPermissions sets the permissions field.
+func (m *TokenMutation) SetPermissions(t token.Permissions) {
+	m.permissions = &t
+}
+
+// Permissions returns the permissions value in the mutation.
+func (m *TokenMutation) Permissions() (r token.Permissions, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPermissions reset all changes of the permissions field.
+func (m *TokenMutation) ResetPermissions() {
+	m.permissions = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *TokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *TokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *TokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetLastUsed sets the last_used field.
+func (m *TokenMutation) SetLastUsed(t time.Time) {
+	m.last_used = &t
+}
+
+// LastUsed returns the last_used value in the mutation.
+func (m *TokenMutation) LastUsed() (r time.Time, exists bool) {
+	v := m.last_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastUsed clears the value of last_used.
+func (m *TokenMutation) ClearLastUsed() {
+	m.last_used = nil
+	m.clearedFields[token.FieldLastUsed] = struct{}{}
+}
+
+// LastUsedCleared returns if the field last_used was cleared in this mutation.
+func (m *TokenMutation) LastUsedCleared() bool {
+	_, ok := m.clearedFields[token.FieldLastUsed]
+	return ok
+}
+
+// ResetLastUsed reset all changes of the last_used field.
+func (m *TokenMutation) ResetLastUsed() {
+	m.last_used = nil
+	delete(m.clearedFields, token.FieldLastUsed)
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *TokenMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *TokenMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *TokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *TokenMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *TokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the user edge.
+func (m *TokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Op returns the operation name.
+func (m *TokenMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Token).
+func (m *TokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *TokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, token.FieldName)
+	}
+	if m.secret != nil {
+		fields = append(fields, token.FieldSecret)
+	}
+	if m.permissions != nil {
+		fields = append(fields, token.FieldPermissions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, token.FieldCreatedAt)
+	}
+	if m.last_used != nil {
+		fields = append(fields, token.FieldLastUsed)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *TokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case token.FieldName:
+		return m.Name()
+	case token.FieldSecret:
+		return m.Secret()
+	case token.FieldPermissions:
+		return m.Permissions()
+	case token.FieldCreatedAt:
+		return m.CreatedAt()
+	case token.FieldLastUsed:
+		return m.LastUsed()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *TokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case token.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case token.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case token.FieldPermissions:
+		v, ok := value.(token.Permissions)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case token.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case token.FieldLastUsed:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *TokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *TokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *TokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Token numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *TokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(token.FieldLastUsed) {
+		fields = append(fields, token.FieldLastUsed)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *TokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenMutation) ClearField(name string) error {
+	switch name {
+	case token.FieldLastUsed:
+		m.ClearLastUsed()
+		return nil
+	}
+	return fmt.Errorf("unknown Token nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *TokenMutation) ResetField(name string) error {
+	switch name {
+	case token.FieldName:
+		m.ResetName()
+		return nil
+	case token.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case token.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case token.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case token.FieldLastUsed:
+		m.ResetLastUsed()
+		return nil
+	}
+	return fmt.Errorf("unknown Token field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *TokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, token.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *TokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case token.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *TokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *TokenMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *TokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, token.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *TokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case token.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *TokenMutation) ClearEdge(name string) error {
+	switch name {
+	case token.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Token unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *TokenMutation) ResetEdge(name string) error {
+	switch name {
+	case token.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Token edge %s", name)
+}
+
+// UserMutation represents an operation that mutate the Users
+// nodes in the graph.
+type UserMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	email             *string
+	password          *string
+	active            *bool
+	updated_at        *time.Time
+	created_at        *time.Time
+	plan              *user.Plan
+	clearedFields     map[string]struct{}
+	tokens            map[uuid.UUID]struct{}
+	removedtokens     map[uuid.UUID]struct{}
+	files             map[uuid.UUID]struct{}
+	removedfiles      map[uuid.UUID]struct{}
+	audit_logs        map[int]struct{}
+	removedaudit_logs map[int]struct{}
+	webhooks          map[uuid.UUID]struct{}
+	removedwebhooks   map[uuid.UUID]struct{}
+	buckets           map[uuid.UUID]struct{}
+	removedbuckets    map[uuid.UUID]struct{}
+	folders           map[uuid.UUID]struct{}
+	removedfolders    map[uuid.UUID]struct{}
+	shares            map[uuid.UUID]struct{}
+	removedshares     map[uuid.UUID]struct{}
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// newUserMutation creates new mutation for $n.Name.
 func newUserMutation(c config, op Op) *UserMutation {
 	return &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetEmail sets the email field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the email value in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEmail reset all changes of the email field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the password field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the password value in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPassword reset all changes of the password field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetActive sets the active field.
+func (m *UserMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the active value in the mutation.
+func (m *UserMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActive reset all changes of the active field.
+func (m *UserMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetUpdatedAt sets the updated_at field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the updated_at value in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUpdatedAt reset all changes of the updated_at field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetPlan sets the plan field.
+func (m *UserMutation) SetPlan(u user.Plan) {
+	m.plan = &u
+}
+
+// Plan returns the plan value in the mutation.
+func (m *UserMutation) Plan() (r user.Plan, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPlan reset all changes of the plan field.
+func (m *UserMutation) ResetPlan() {
+	m.plan = nil
+}
+
+// AddTokenIDs adds the tokens edge to Token by ids.
+func (m *UserMutation) AddTokenIDs(ids ...uuid.UUID) {
+	if m.tokens == nil {
+		m.tokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveTokenIDs removes the tokens edge to Token by ids.
+func (m *UserMutation) RemoveTokenIDs(ids ...uuid.UUID) {
+	if m.removedtokens == nil {
+		m.removedtokens = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedtokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTokens returns the removed ids of tokens.
+func (m *UserMutation) RemovedTokensIDs() (ids []uuid.UUID) {
+	for id := range m.removedtokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TokensIDs returns the tokens ids in the mutation.
+func (m *UserMutation) TokensIDs() (ids []uuid.UUID) {
+	for id := range m.tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTokens reset all changes of the tokens edge.
+func (m *UserMutation) ResetTokens() {
+	m.tokens = nil
+	m.removedtokens = nil
+}
+
+// AddFileIDs adds the files edge to File by ids.
+func (m *UserMutation) AddFileIDs(ids ...uuid.UUID) {
+	if m.files == nil {
+		m.files = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.files[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveFileIDs removes the files edge to File by ids.
+func (m *UserMutation) RemoveFileIDs(ids ...uuid.UUID) {
+	if m.removedfiles == nil {
+		m.removedfiles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedfiles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFiles returns the removed ids of files.
+func (m *UserMutation) RemovedFilesIDs() (ids []uuid.UUID) {
+	for id := range m.removedfiles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FilesIDs returns the files ids in the mutation.
+func (m *UserMutation) FilesIDs() (ids []uuid.UUID) {
+	for id := range m.files {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFiles reset all changes of the files edge.
+func (m *UserMutation) ResetFiles() {
+	m.files = nil
+	m.removedfiles = nil
+}
+
+// AddAuditLogIDs adds the audit_logs edge to AuditLog by ids.
+func (m *UserMutation) AddAuditLogIDs(ids ...int) {
+	if m.audit_logs == nil {
+		m.audit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.audit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveAuditLogIDs removes the audit_logs edge to AuditLog by ids.
+func (m *UserMutation) RemoveAuditLogIDs(ids ...int) {
+	if m.removedaudit_logs == nil {
+		m.removedaudit_logs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.removedaudit_logs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuditLogs returns the removed ids of audit_logs.
+func (m *UserMutation) RemovedAuditLogsIDs() (ids []int) {
+	for id := range m.removedaudit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuditLogsIDs returns the audit_logs ids in the mutation.
+func (m *UserMutation) AuditLogsIDs() (ids []int) {
+	for id := range m.audit_logs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuditLogs reset all changes of the audit_logs edge.
+func (m *UserMutation) ResetAuditLogs() {
+	m.audit_logs = nil
+	m.removedaudit_logs = nil
+}
+
+// AddWebhookIDs adds the webhooks edge to Webhook by ids.
+func (m *UserMutation) AddWebhookIDs(ids ...uuid.UUID) {
+	if m.webhooks == nil {
+		m.webhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveWebhookIDs removes the webhooks edge to Webhook by ids.
+func (m *UserMutation) RemoveWebhookIDs(ids ...uuid.UUID) {
+	if m.removedwebhooks == nil {
+		m.removedwebhooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedwebhooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhooks returns the removed ids of webhooks.
+func (m *UserMutation) RemovedWebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhooksIDs returns the webhooks ids in the mutation.
+func (m *UserMutation) WebhooksIDs() (ids []uuid.UUID) {
+	for id := range m.webhooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhooks reset all changes of the webhooks edge.
+func (m *UserMutation) ResetWebhooks() {
+	m.webhooks = nil
+	m.removedwebhooks = nil
+}
+
+// AddBucketIDs adds the buckets edge to Bucket by ids.
+func (m *UserMutation) AddBucketIDs(ids ...uuid.UUID) {
+	if m.buckets == nil {
+		m.buckets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.buckets[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveBucketIDs removes the buckets edge to Bucket by ids.
+func (m *UserMutation) RemoveBucketIDs(ids ...uuid.UUID) {
+	if m.removedbuckets == nil {
+		m.removedbuckets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedbuckets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBuckets returns the removed ids of buckets.
+func (m *UserMutation) RemovedBucketsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbuckets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BucketsIDs returns the buckets ids in the mutation.
+func (m *UserMutation) BucketsIDs() (ids []uuid.UUID) {
+	for id := range m.buckets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBuckets reset all changes of the buckets edge.
+func (m *UserMutation) ResetBuckets() {
+	m.buckets = nil
+	m.removedbuckets = nil
+}
+
+// AddFolderIDs adds the folders edge to Folder by ids.
+func (m *UserMutation) AddFolderIDs(ids ...uuid.UUID) {
+	if m.folders == nil {
+		m.folders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.folders[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveFolderIDs removes the folders edge to Folder by ids.
+func (m *UserMutation) RemoveFolderIDs(ids ...uuid.UUID) {
+	if m.removedfolders == nil {
+		m.removedfolders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedfolders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFolders returns the removed ids of folders.
+func (m *UserMutation) RemovedFoldersIDs() (ids []uuid.UUID) {
+	for id := range m.removedfolders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FoldersIDs returns the folders ids in the mutation.
+func (m *UserMutation) FoldersIDs() (ids []uuid.UUID) {
+	for id := range m.folders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFolders reset all changes of the folders edge.
+func (m *UserMutation) ResetFolders() {
+	m.folders = nil
+	m.removedfolders = nil
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (m *UserMutation) AddShareIDs(ids ...uuid.UUID) {
+	if m.shares == nil {
+		m.shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (m *UserMutation) RemoveShareIDs(ids ...uuid.UUID) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed ids of shares.
+func (m *UserMutation) RemovedSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the shares ids in the mutation.
+func (m *UserMutation) SharesIDs() (ids []uuid.UUID) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares reset all changes of the shares edge.
+func (m *UserMutation) ResetShares() {
+	m.shares = nil
+	m.removedshares = nil
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.active != nil {
+		fields = append(fields, user.FieldActive)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.plan != nil {
+		fields = append(fields, user.FieldPlan)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldActive:
+		return m.Active()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldPlan:
+		return m.Plan()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldPlan:
+		v, ok := value.(user.Plan)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlan(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldActive:
+		m.ResetActive()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldPlan:
+		m.ResetPlan()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.tokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.files != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.audit_logs != nil {
+		edges = append(edges, user.EdgeAuditLogs)
+	}
+	if m.webhooks != nil {
+		edges = append(edges, user.EdgeWebhooks)
+	}
+	if m.buckets != nil {
+		edges = append(edges, user.EdgeBuckets)
+	}
+	if m.folders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.shares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.tokens))
+		for id := range m.tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.files))
+		for id := range m.files {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditLogs:
+		ids := make([]ent.Value, 0, len(m.audit_logs))
+		for id := range m.audit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.webhooks))
+		for id := range m.webhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBuckets:
+		ids := make([]ent.Value, 0, len(m.buckets))
+		for id := range m.buckets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.folders))
+		for id := range m.folders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedtokens != nil {
+		edges = append(edges, user.EdgeTokens)
+	}
+	if m.removedfiles != nil {
+		edges = append(edges, user.EdgeFiles)
+	}
+	if m.removedaudit_logs != nil {
+		edges = append(edges, user.EdgeAuditLogs)
+	}
+	if m.removedwebhooks != nil {
+		edges = append(edges, user.EdgeWebhooks)
+	}
+	if m.removedbuckets != nil {
+		edges = append(edges, user.EdgeBuckets)
+	}
+	if m.removedfolders != nil {
+		edges = append(edges, user.EdgeFolders)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, user.EdgeShares)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeTokens:
+		ids := make([]ent.Value, 0, len(m.removedtokens))
+		for id := range m.removedtokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFiles:
+		ids := make([]ent.Value, 0, len(m.removedfiles))
+		for id := range m.removedfiles {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAuditLogs:
+		ids := make([]ent.Value, 0, len(m.removedaudit_logs))
+		for id := range m.removedaudit_logs {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWebhooks:
+		ids := make([]ent.Value, 0, len(m.removedwebhooks))
+		for id := range m.removedwebhooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBuckets:
+		ids := make([]ent.Value, 0, len(m.removedbuckets))
+		for id := range m.removedbuckets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeFolders:
+		ids := make([]ent.Value, 0, len(m.removedfolders))
+		for id := range m.removedfolders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeTokens:
+		m.ResetTokens()
+		return nil
+	case user.EdgeFiles:
+		m.ResetFiles()
+		return nil
+	case user.EdgeAuditLogs:
+		m.ResetAuditLogs()
+		return nil
+	case user.EdgeWebhooks:
+		m.ResetWebhooks()
+		return nil
+	case user.EdgeBuckets:
+		m.ResetBuckets()
+		return nil
+	case user.EdgeFolders:
+		m.ResetFolders()
+		return nil
+	case user.EdgeShares:
+		m.ResetShares()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// WebhookMutation represents an operation that mutate the Webhooks
+// nodes in the graph.
+type WebhookMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	url               *string
+	events            *[]string
+	secret            *string
+	active            *bool
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	deliveries        map[uuid.UUID]struct{}
+	removeddeliveries map[uuid.UUID]struct{}
+}
+
+var _ ent.Mutation = (*WebhookMutation)(nil)
+
+// newWebhookMutation creates new mutation for $n.Name.
+func newWebhookMutation(c config, op Op) *WebhookMutation {
+	return &WebhookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhook,
+		clearedFields: make(map[string]struct{}),
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WebhookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WebhookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on Webhook creation.
+func (m *WebhookMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the id value in the mutation. Note that, the id
+// is available only if it was provided to the builder.
+func (m *WebhookMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// SetURL sets the url field.
+func (m *WebhookMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the url value in the mutation.
+func (m *WebhookMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetURL reset all changes of the url field.
+func (m *WebhookMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetEvents sets the events field.
+func (m *WebhookMutation) SetEvents(s []string) {
+	m.events = &s
+}
+
+// Events returns the events value in the mutation.
+func (m *WebhookMutation) Events() (r []string, exists bool) {
+	v := m.events
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEvents reset all changes of the events field.
+func (m *WebhookMutation) ResetEvents() {
+	m.events = nil
+}
+
+// SetSecret sets the secret field.
+func (m *WebhookMutation) SetSecret(s string) {
+	m.secret = &s
+}
+
+// Secret returns the secret value in the mutation.
+func (m *WebhookMutation) Secret() (r string, exists bool) {
+	v := m.secret
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSecret reset all changes of the secret field.
+func (m *WebhookMutation) ResetSecret() {
+	m.secret = nil
+}
+
+// SetActive sets the active field.
+func (m *WebhookMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the active value in the mutation.
+func (m *WebhookMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActive reset all changes of the active field.
+func (m *WebhookMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCreatedAt sets the created_at field.
+func (m *WebhookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the created_at value in the mutation.
+func (m *WebhookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCreatedAt reset all changes of the created_at field.
+func (m *WebhookMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the user edge to User by id.
+func (m *WebhookMutation) SetUserID(id int) {
+	m.user = &id
+}
+
+// ClearUser clears the user edge to User.
+func (m *WebhookMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared returns if the edge user was cleared.
+func (m *WebhookMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the user id in the mutation.
+func (m *WebhookMutation) UserID() (id int, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the user ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WebhookMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser reset all changes of the user edge.
+func (m *WebhookMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (m *WebhookMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.deliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (m *WebhookMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.removeddeliveries[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDeliveries returns the removed ids of deliveries.
+func (m *WebhookMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DeliveriesIDs returns the deliveries ids in the mutation.
+func (m *WebhookMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDeliveries reset all changes of the deliveries edge.
+func (m *WebhookMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.removeddeliveries = nil
+}
+
+// Op returns the operation name.
+func (m *WebhookMutation) Op() Op {
+	return m.op
+}
+
+// Type returns the node type of this mutation (Webhook).
+func (m *WebhookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during
+// this mutation. Note that, in order to get all numeric
+// fields that were in/decremented, call AddedFields().
+func (m *WebhookMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.url != nil {
+		fields = append(fields, webhook.FieldURL)
+	}
+	if m.events != nil {
+		fields = append(fields, webhook.FieldEvents)
+	}
+	if m.secret != nil {
+		fields = append(fields, webhook.FieldSecret)
+	}
+	if m.active != nil {
+		fields = append(fields, webhook.FieldActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, webhook.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name.
+// The second boolean value indicates that this field was
+// not set, or was not define in the schema.
+func (m *WebhookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case webhook.FieldURL:
+		return m.URL()
+	case webhook.FieldEvents:
+		return m.Events()
+	case webhook.FieldSecret:
+		return m.Secret()
+	case webhook.FieldActive:
+		return m.Active()
+	case webhook.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// SetField sets the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *WebhookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case webhook.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case webhook.FieldEvents:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEvents(v)
+		return nil
+	case webhook.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case webhook.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case webhook.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented
+// or decremented during this mutation.
+func (m *WebhookMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was in/decremented
+// from a field with the given name. The second value indicates
+// that this field was not set, or was not define in the schema.
+func (m *WebhookMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value for the given name. It returns an
+// error if the field is not defined in the schema, or if the
+// type mismatch the field type.
+func (m *WebhookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Webhook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared
+// during this mutation.
+func (m *WebhookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicates if this field was
+// cleared in this mutation.
+func (m *WebhookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value for the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WebhookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Webhook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation regarding the
+// given field name. It returns an error if the field is not
+// defined in the schema.
+func (m *WebhookMutation) ResetField(name string) error {
+	switch name {
+	case webhook.FieldURL:
+		m.ResetURL()
+		return nil
+	case webhook.FieldEvents:
+		m.ResetEvents()
+		return nil
+	case webhook.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case webhook.FieldActive:
+		m.ResetActive()
+		return nil
+	case webhook.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this
+// mutation.
+func (m *WebhookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, webhook.EdgeUser)
+	}
+	if m.deliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// AddedIDs returns all ids (to other nodes) that were added for
+// the given edge name.
+func (m *WebhookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this
+// mutation.
+func (m *WebhookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhook.EdgeDeliveries)
+	}
+	return edges
+}
+
+// RemovedIDs returns all ids (to other nodes) that were removed for
+// the given edge name.
+func (m *WebhookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case webhook.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this
+// mutation.
+func (m *WebhookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, webhook.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean indicates if this edge was
+// cleared in this mutation.
+func (m *WebhookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case webhook.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value for the given name. It returns an
+// error if the edge name is not defined in the schema.
+func (m *WebhookMutation) ClearEdge(name string) error {
+	switch name {
+	case webhook.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook unique edge %s", name)
+}
+
+// ResetEdge resets all changes in the mutation regarding the
+// given edge name. It returns an error if the edge is not
+// defined in the schema.
+func (m *WebhookMutation) ResetEdge(name string) error {
+	switch name {
+	case webhook.EdgeUser:
+		m.ResetUser()
+		return nil
+	case webhook.EdgeDeliveries:
+		m.ResetDeliveries()
+		return nil
+	}
+	return fmt.Errorf("unknown Webhook edge %s", name)
+}
+
+// WebhookDeliveryMutation represents an operation that mutate the WebhookDeliveries
+// nodes in the graph.
+type WebhookDeliveryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	event              *string
+	payload            *map[string]interface{}
+	status             *webhookdelivery.Status
+	attempts           *int
+	addattempts        *int
+	next_attempt_at    *time.Time
+	response_status    *int
+	addresponse_status *int
+	last_error         *string
+	created_at         *time.Time
+	delivered_at       *time.Time
+	clearedFields      map[string]struct{}
+	webhook            *uuid.UUID
+	clearedwebhook     bool
+}
+
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
+
+// newWebhookDeliveryMutation creates new mutation for $n.Name.
+func newWebhookDeliveryMutation(c config, op Op) *WebhookDeliveryMutation {
+	return &WebhookDeliveryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1026,7 +7926,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, fmt.Errorf("ent: mutation is not running in a transaction")
 	}
@@ -1035,98 +7935,228 @@ func (m UserMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that, this
+// operation is accepted only on WebhookDelivery creation.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the id value in the mutation. Note that, the id
 // is available only if it was provided to the builder.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
 	return *m.id, true
 }
 
-// SetEmail sets the email field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetEvent sets the event field.
+func (m *WebhookDeliveryMutation) SetEvent(s string) {
+	m.event = &s
 }
 
-// Email returns the email value in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// Event returns the event value in the mutation.
+func (m *WebhookDeliveryMutation) Event() (r string, exists bool) {
+	v := m.event
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetEmail reset all changes of the email field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetEvent reset all changes of the event field.
+func (m *WebhookDeliveryMutation) ResetEvent() {
+	m.event = nil
 }
 
-// SetPassword sets the password field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetPayload sets the payload field.
+func (m *WebhookDeliveryMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// Password returns the password value in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Payload returns the payload value in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPassword reset all changes of the password field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetPayload reset all changes of the payload field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetActive sets the active field.
-func (m *UserMutation) SetActive(b bool) {
-	m.active = &b
+// SetStatus sets the status field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
 }
 
-// Active returns the active value in the mutation.
-func (m *UserMutation) Active() (r bool, exists bool) {
-	v := m.active
+// Status returns the status value in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetActive reset all changes of the active field.
-func (m *UserMutation) ResetActive() {
-	m.active = nil
+// ResetStatus reset all changes of the status field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetUpdatedAt sets the updated_at field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetAttempts sets the attempts field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// UpdatedAt returns the updated_at value in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Attempts returns the attempts value in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddAttempts adds i to attempts.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the attempts field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts reset all changes of the attempts field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetNextAttemptAt sets the next_attempt_at field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the next_attempt_at value in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNextAttemptAt reset all changes of the next_attempt_at field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetResponseStatus sets the response_status field.
+func (m *WebhookDeliveryMutation) SetResponseStatus(i int) {
+	m.response_status = &i
+	m.addresponse_status = nil
+}
+
+// ResponseStatus returns the response_status value in the mutation.
+func (m *WebhookDeliveryMutation) ResponseStatus() (r int, exists bool) {
+	v := m.response_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddResponseStatus adds i to response_status.
+func (m *WebhookDeliveryMutation) AddResponseStatus(i int) {
+	if m.addresponse_status != nil {
+		*m.addresponse_status += i
+	} else {
+		m.addresponse_status = &i
+	}
+}
+
+// AddedResponseStatus returns the value that was added to the response_status field in this mutation.
+func (m *WebhookDeliveryMutation) AddedResponseStatus() (r int, exists bool) {
+	v := m.addresponse_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUpdatedAt reset all changes of the updated_at field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearResponseStatus clears the value of response_status.
+func (m *WebhookDeliveryMutation) ClearResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	m.clearedFields[webhookdelivery.FieldResponseStatus] = struct{}{}
+}
+
+// ResponseStatusCleared returns if the field response_status was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ResponseStatusCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldResponseStatus]
+	return ok
+}
+
+// ResetResponseStatus reset all changes of the response_status field.
+func (m *WebhookDeliveryMutation) ResetResponseStatus() {
+	m.response_status = nil
+	m.addresponse_status = nil
+	delete(m.clearedFields, webhookdelivery.FieldResponseStatus)
+}
+
+// SetLastError sets the last_error field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the last_error value in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastError clears the value of last_error.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the field last_error was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
+}
+
+// ResetLastError reset all changes of the last_error field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
 }
 
 // SetCreatedAt sets the created_at field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the created_at value in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1135,145 +8165,122 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 }
 
 // ResetCreatedAt reset all changes of the created_at field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetPlan sets the plan field.
-func (m *UserMutation) SetPlan(u user.Plan) {
-	m.plan = &u
+// SetDeliveredAt sets the delivered_at field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// Plan returns the plan value in the mutation.
-func (m *UserMutation) Plan() (r user.Plan, exists bool) {
-	v := m.plan
+// DeliveredAt returns the delivered_at value in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetPlan reset all changes of the plan field.
-func (m *UserMutation) ResetPlan() {
-	m.plan = nil
-}
-
-// AddTokenIDs adds the tokens edge to Token by ids.
-func (m *UserMutation) AddTokenIDs(ids ...uuid.UUID) {
-	if m.tokens == nil {
-		m.tokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.tokens[ids[i]] = struct{}{}
-	}
-}
-
-// RemoveTokenIDs removes the tokens edge to Token by ids.
-func (m *UserMutation) RemoveTokenIDs(ids ...uuid.UUID) {
-	if m.removedtokens == nil {
-		m.removedtokens = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.removedtokens[ids[i]] = struct{}{}
-	}
+// ClearDeliveredAt clears the value of delivered_at.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// RemovedTokens returns the removed ids of tokens.
-func (m *UserMutation) RemovedTokensIDs() (ids []uuid.UUID) {
-	for id := range m.removedtokens {
-		ids = append(ids, id)
-	}
-	return
+// DeliveredAtCleared returns if the field delivered_at was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
+	return ok
 }
 
-// TokensIDs returns the tokens ids in the mutation.
-func (m *UserMutation) TokensIDs() (ids []uuid.UUID) {
-	for id := range m.tokens {
-		ids = append(ids, id)
-	}
-	return
+// ResetDeliveredAt reset all changes of the delivered_at field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// ResetTokens reset all changes of the tokens edge.
-func (m *UserMutation) ResetTokens() {
-	m.tokens = nil
-	m.removedtokens = nil
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (m *WebhookDeliveryMutation) SetWebhookID(id uuid.UUID) {
+	m.webhook = &id
 }
 
-// AddFileIDs adds the files edge to File by ids.
-func (m *UserMutation) AddFileIDs(ids ...uuid.UUID) {
-	if m.files == nil {
-		m.files = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.files[ids[i]] = struct{}{}
-	}
+// ClearWebhook clears the webhook edge to Webhook.
+func (m *WebhookDeliveryMutation) ClearWebhook() {
+	m.clearedwebhook = true
 }
 
-// RemoveFileIDs removes the files edge to File by ids.
-func (m *UserMutation) RemoveFileIDs(ids ...uuid.UUID) {
-	if m.removedfiles == nil {
-		m.removedfiles = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.removedfiles[ids[i]] = struct{}{}
-	}
+// WebhookCleared returns if the edge webhook was cleared.
+func (m *WebhookDeliveryMutation) WebhookCleared() bool {
+	return m.clearedwebhook
 }
 
-// RemovedFiles returns the removed ids of files.
-func (m *UserMutation) RemovedFilesIDs() (ids []uuid.UUID) {
-	for id := range m.removedfiles {
-		ids = append(ids, id)
+// WebhookID returns the webhook id in the mutation.
+func (m *WebhookDeliveryMutation) WebhookID() (id uuid.UUID, exists bool) {
+	if m.webhook != nil {
+		return *m.webhook, true
 	}
 	return
 }
 
-// FilesIDs returns the files ids in the mutation.
-func (m *UserMutation) FilesIDs() (ids []uuid.UUID) {
-	for id := range m.files {
-		ids = append(ids, id)
+// WebhookIDs returns the webhook ids in the mutation.
+// Note that ids always returns len(ids) <= 1 for unique edges, and you should use
+// WebhookID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) WebhookIDs() (ids []uuid.UUID) {
+	if id := m.webhook; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFiles reset all changes of the files edge.
-func (m *UserMutation) ResetFiles() {
-	m.files = nil
-	m.removedfiles = nil
+// ResetWebhook reset all changes of the webhook edge.
+func (m *WebhookDeliveryMutation) ResetWebhook() {
+	m.webhook = nil
+	m.clearedwebhook = false
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during
 // this mutation. Note that, in order to get all numeric
 // fields that were in/decremented, call AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *WebhookDeliveryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.event != nil {
+		fields = append(fields, webhookdelivery.FieldEvent)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.active != nil {
-		fields = append(fields, user.FieldActive)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.response_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
-	if m.plan != nil {
-		fields = append(fields, user.FieldPlan)
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
 	return fields
 }
@@ -1281,20 +8288,26 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name.
 // The second boolean value indicates that this field was
 // not set, or was not define in the schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldActive:
-		return m.Active()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldEvent:
+		return m.Event()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
+		return m.Status()
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldResponseStatus:
+		return m.ResponseStatus()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldPlan:
-		return m.Plan()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
 	}
 	return nil, false
 }
@@ -1302,222 +8315,276 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // SetField sets the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
+	case webhookdelivery.FieldEvent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetEvent(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetPayload(v)
 		return nil
-	case user.FieldActive:
-		v, ok := value.(bool)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetStatus(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case webhookdelivery.FieldNextAttemptAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseStatus(v)
+		return nil
+	case webhookdelivery.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldPlan:
-		v, ok := value.(user.Plan)
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlan(v)
+		m.SetDeliveredAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented
 // or decremented during this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
+	}
+	if m.addresponse_status != nil {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was in/decremented
 // from a field with the given name. The second value indicates
 // that this field was not set, or was not define in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldResponseStatus:
+		return m.AddedResponseStatus()
+	}
 	return nil, false
 }
 
 // AddField adds the value for the given name. It returns an
 // error if the field is not defined in the schema, or if the
 // type mismatch the field type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResponseStatus(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared
 // during this mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(webhookdelivery.FieldResponseStatus) {
+		fields = append(fields, webhookdelivery.FieldResponseStatus)
+	}
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicates if this field was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value for the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
+	switch name {
+	case webhookdelivery.FieldResponseStatus:
+		m.ClearResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation regarding the
 // given field name. It returns an error if the field is not
 // defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhookdelivery.FieldEvent:
+		m.ResetEvent()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case user.FieldActive:
-		m.ResetActive()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case user.FieldCreatedAt:
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldResponseStatus:
+		m.ResetResponseStatus()
+		return nil
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldPlan:
-		m.ResetPlan()
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this
 // mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.tokens != nil {
-		edges = append(edges, user.EdgeTokens)
-	}
-	if m.files != nil {
-		edges = append(edges, user.EdgeFiles)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.webhook != nil {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
 	}
 	return edges
 }
 
 // AddedIDs returns all ids (to other nodes) that were added for
 // the given edge name.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.tokens))
-		for id := range m.tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.files))
-		for id := range m.files {
-			ids = append(ids, id)
+	case webhookdelivery.EdgeWebhook:
+		if id := m.webhook; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this
 // mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedtokens != nil {
-		edges = append(edges, user.EdgeTokens)
-	}
-	if m.removedfiles != nil {
-		edges = append(edges, user.EdgeFiles)
-	}
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all ids (to other nodes) that were removed for
 // the given edge name.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeTokens:
-		ids := make([]ent.Value, 0, len(m.removedtokens))
-		for id := range m.removedtokens {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeFiles:
-		ids := make([]ent.Value, 0, len(m.removedfiles))
-		for id := range m.removedfiles {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this
 // mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedwebhook {
+		edges = append(edges, webhookdelivery.EdgeWebhook)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean indicates if this edge was
 // cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		return m.clearedwebhook
 	}
 	return false
 }
 
 // ClearEdge clears the value for the given name. It returns an
 // error if the edge name is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookdelivery.EdgeWebhook:
+		m.ClearWebhook()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes in the mutation regarding the
 // given edge name. It returns an error if the edge is not
 // defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeTokens:
-		m.ResetTokens()
-		return nil
-	case user.EdgeFiles:
-		m.ResetFiles()
+	case webhookdelivery.EdgeWebhook:
+		m.ResetWebhook()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
@@ -6,11 +6,44 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 )
 
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// Bucket is the predicate function for bucket builders.
+type Bucket func(*sql.Selector)
+
 // File is the predicate function for file builders.
 type File func(*sql.Selector)
 
+// FileKey is the predicate function for filekey builders.
+type FileKey func(*sql.Selector)
+
+// FileMedia is the predicate function for filemedia builders.
+type FileMedia func(*sql.Selector)
+
+// FileProperty is the predicate function for fileproperty builders.
+type FileProperty func(*sql.Selector)
+
+// FileTag is the predicate function for filetag builders.
+type FileTag func(*sql.Selector)
+
+// Folder is the predicate function for folder builders.
+type Folder func(*sql.Selector)
+
+// Share is the predicate function for share builders.
+type Share func(*sql.Selector)
+
+// Thumbnail is the predicate function for thumbnail builders.
+type Thumbnail func(*sql.Selector)
+
 // Token is the predicate function for token builders.
 type Token func(*sql.Selector)
 
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Webhook is the predicate function for webhook builders.
+type Webhook func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
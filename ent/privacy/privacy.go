@@ -154,6 +154,54 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	})
 }
 
+// The AuditLogQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditLogQueryRuleFunc func(context.Context, *ent.AuditLogQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditLogQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditLogQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditLogQuery", q)
+}
+
+// The AuditLogMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditLogMutationRuleFunc func(context.Context, *ent.AuditLogMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditLogMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditLogMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditLogMutation", m)
+}
+
+// The BucketQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type BucketQueryRuleFunc func(context.Context, *ent.BucketQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f BucketQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BucketQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.BucketQuery", q)
+}
+
+// The BucketMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type BucketMutationRuleFunc func(context.Context, *ent.BucketMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f BucketMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.BucketMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.BucketMutation", m)
+}
+
 // The FileQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type FileQueryRuleFunc func(context.Context, *ent.FileQuery) error
@@ -178,6 +226,174 @@ func (f FileMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileMutation", m)
 }
 
+// The FileKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileKeyQueryRuleFunc func(context.Context, *ent.FileKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileKeyQuery", q)
+}
+
+// The FileKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileKeyMutationRuleFunc func(context.Context, *ent.FileKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileKeyMutation", m)
+}
+
+// The FileMediaQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileMediaQueryRuleFunc func(context.Context, *ent.FileMediaQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileMediaQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileMediaQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileMediaQuery", q)
+}
+
+// The FileMediaMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileMediaMutationRuleFunc func(context.Context, *ent.FileMediaMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileMediaMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileMediaMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileMediaMutation", m)
+}
+
+// The FilePropertyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FilePropertyQueryRuleFunc func(context.Context, *ent.FilePropertyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FilePropertyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FilePropertyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FilePropertyQuery", q)
+}
+
+// The FilePropertyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FilePropertyMutationRuleFunc func(context.Context, *ent.FilePropertyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FilePropertyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FilePropertyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FilePropertyMutation", m)
+}
+
+// The FileTagQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FileTagQueryRuleFunc func(context.Context, *ent.FileTagQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FileTagQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FileTagQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FileTagQuery", q)
+}
+
+// The FileTagMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FileTagMutationRuleFunc func(context.Context, *ent.FileTagMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FileTagMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FileTagMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FileTagMutation", m)
+}
+
+// The FolderQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type FolderQueryRuleFunc func(context.Context, *ent.FolderQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f FolderQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.FolderQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.FolderQuery", q)
+}
+
+// The FolderMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type FolderMutationRuleFunc func(context.Context, *ent.FolderMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f FolderMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.FolderMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.FolderMutation", m)
+}
+
+// The ShareQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ShareQueryRuleFunc func(context.Context, *ent.ShareQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ShareQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShareQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ShareQuery", q)
+}
+
+// The ShareMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ShareMutationRuleFunc func(context.Context, *ent.ShareMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ShareMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ShareMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ShareMutation", m)
+}
+
+// The ThumbnailQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type ThumbnailQueryRuleFunc func(context.Context, *ent.ThumbnailQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f ThumbnailQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ThumbnailQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.ThumbnailQuery", q)
+}
+
+// The ThumbnailMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type ThumbnailMutationRuleFunc func(context.Context, *ent.ThumbnailMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f ThumbnailMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.ThumbnailMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.ThumbnailMutation", m)
+}
+
 // The TokenQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type TokenQueryRuleFunc func(context.Context, *ent.TokenQuery) error
@@ -225,3 +441,51 @@ func (f UserMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation)
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.UserMutation", m)
 }
+
+// The WebhookQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookQueryRuleFunc func(context.Context, *ent.WebhookQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebhookQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WebhookQuery", q)
+}
+
+// The WebhookMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookMutationRuleFunc func(context.Context, *ent.WebhookMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WebhookMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WebhookMutation", m)
+}
+
+// The WebhookDeliveryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WebhookDeliveryQueryRuleFunc func(context.Context, *ent.WebhookDeliveryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WebhookDeliveryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WebhookDeliveryQuery", q)
+}
+
+// The WebhookDeliveryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WebhookDeliveryMutationRuleFunc func(context.Context, *ent.WebhookDeliveryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WebhookDeliveryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WebhookDeliveryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WebhookDeliveryMutation", m)
+}
@@ -2,7 +2,426 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/sthorer/api/ent/runtime.go
+import (
+	"time"
+
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/filekey"
+	"github.com/sthorer/api/ent/filemedia"
+	"github.com/sthorer/api/ent/fileproperty"
+	"github.com/sthorer/api/ent/filetag"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/schema"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/thumbnail"
+	"github.com/sthorer/api/ent/token"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// The init function reads all schema descriptors with runtime
+// code (default values, validators or hooks) and stitches it
+// to their package variables.
+func init() {
+	auditlogHooks := schema.AuditLog{}.Hooks()
+	auditlog.Hooks[0] = auditlogHooks[0]
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[6].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	bucketFields := schema.Bucket{}.Fields()
+	_ = bucketFields
+	// bucketDescName is the schema descriptor for name field.
+	bucketDescName := bucketFields[1].Descriptor()
+	// bucket.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	bucket.NameValidator = func() func(string) error {
+		validators := bucketDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// bucketDescCreatedAt is the schema descriptor for created_at field.
+	bucketDescCreatedAt := bucketFields[2].Descriptor()
+	// bucket.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bucket.DefaultCreatedAt = bucketDescCreatedAt.Default.(func() time.Time)
+	fileFields := schema.File{}.Fields()
+	_ = fileFields
+	// fileDescHash is the schema descriptor for hash field.
+	fileDescHash := fileFields[1].Descriptor()
+	// file.HashValidator is a validator for the "hash" field. It is called by the builders before save.
+	file.HashValidator = fileDescHash.Validators[0].(func(string) error)
+	// fileDescSize is the schema descriptor for size field.
+	fileDescSize := fileFields[2].Descriptor()
+	// file.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	file.SizeValidator = fileDescSize.Validators[0].(func(int64) error)
+	// fileDescPinnedAt is the schema descriptor for pinned_at field.
+	fileDescPinnedAt := fileFields[3].Descriptor()
+	// file.DefaultPinnedAt holds the default value on creation for the pinned_at field.
+	file.DefaultPinnedAt = fileDescPinnedAt.Default.(func() time.Time)
+	// fileDescKey is the schema descriptor for key field.
+	fileDescKey := fileFields[6].Descriptor()
+	// file.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	file.KeyValidator = fileDescKey.Validators[0].(func(string) error)
+	// fileDescName is the schema descriptor for name field.
+	fileDescName := fileFields[8].Descriptor()
+	// file.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	file.NameValidator = fileDescName.Validators[0].(func(string) error)
+	// fileDescContentType is the schema descriptor for content_type field.
+	fileDescContentType := fileFields[10].Descriptor()
+	// file.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	file.ContentTypeValidator = fileDescContentType.Validators[0].(func(string) error)
+	filekeyFields := schema.FileKey{}.Fields()
+	_ = filekeyFields
+	// filekeyDescKeyID is the schema descriptor for key_id field.
+	filekeyDescKeyID := filekeyFields[1].Descriptor()
+	// filekey.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	filekey.KeyIDValidator = func() func(string) error {
+		validators := filekeyDescKeyID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key_id string) error {
+			for _, fn := range fns {
+				if err := fn(key_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// filekeyDescSegmentSize is the schema descriptor for segment_size field.
+	filekeyDescSegmentSize := filekeyFields[3].Descriptor()
+	// filekey.SegmentSizeValidator is a validator for the "segment_size" field. It is called by the builders before save.
+	filekey.SegmentSizeValidator = filekeyDescSegmentSize.Validators[0].(func(int) error)
+	// filekeyDescCreatedAt is the schema descriptor for created_at field.
+	filekeyDescCreatedAt := filekeyFields[4].Descriptor()
+	// filekey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	filekey.DefaultCreatedAt = filekeyDescCreatedAt.Default.(func() time.Time)
+	filemediaFields := schema.FileMedia{}.Fields()
+	_ = filemediaFields
+	// filemediaDescWidth is the schema descriptor for width field.
+	filemediaDescWidth := filemediaFields[0].Descriptor()
+	// filemedia.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	filemedia.WidthValidator = filemediaDescWidth.Validators[0].(func(int) error)
+	// filemediaDescHeight is the schema descriptor for height field.
+	filemediaDescHeight := filemediaFields[1].Descriptor()
+	// filemedia.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	filemedia.HeightValidator = filemediaDescHeight.Validators[0].(func(int) error)
+	// filemediaDescDuration is the schema descriptor for duration field.
+	filemediaDescDuration := filemediaFields[2].Descriptor()
+	// filemedia.DurationValidator is a validator for the "duration" field. It is called by the builders before save.
+	filemedia.DurationValidator = filemediaDescDuration.Validators[0].(func(float64) error)
+	// filemediaDescPages is the schema descriptor for pages field.
+	filemediaDescPages := filemediaFields[3].Descriptor()
+	// filemedia.PagesValidator is a validator for the "pages" field. It is called by the builders before save.
+	filemedia.PagesValidator = filemediaDescPages.Validators[0].(func(int) error)
+	filepropertyFields := schema.FileProperty{}.Fields()
+	_ = filepropertyFields
+	// filepropertyDescKey is the schema descriptor for key field.
+	filepropertyDescKey := filepropertyFields[0].Descriptor()
+	// fileproperty.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	fileproperty.KeyValidator = func() func(string) error {
+		validators := filepropertyDescKey.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(key string) error {
+			for _, fn := range fns {
+				if err := fn(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// filepropertyDescValue is the schema descriptor for value field.
+	filepropertyDescValue := filepropertyFields[1].Descriptor()
+	// fileproperty.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	fileproperty.ValueValidator = filepropertyDescValue.Validators[0].(func(string) error)
+	filetagFields := schema.FileTag{}.Fields()
+	_ = filetagFields
+	// filetagDescName is the schema descriptor for name field.
+	filetagDescName := filetagFields[0].Descriptor()
+	// filetag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	filetag.NameValidator = func() func(string) error {
+		validators := filetagDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	folderFields := schema.Folder{}.Fields()
+	_ = folderFields
+	// folderDescName is the schema descriptor for name field.
+	folderDescName := folderFields[1].Descriptor()
+	// folder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	folder.NameValidator = func() func(string) error {
+		validators := folderDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// folderDescUpdatedAt is the schema descriptor for updated_at field.
+	folderDescUpdatedAt := folderFields[2].Descriptor()
+	// folder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	folder.DefaultUpdatedAt = folderDescUpdatedAt.Default.(func() time.Time)
+	// folder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	folder.UpdateDefaultUpdatedAt = folderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// folderDescCreatedAt is the schema descriptor for created_at field.
+	folderDescCreatedAt := folderFields[3].Descriptor()
+	// folder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	folder.DefaultCreatedAt = folderDescCreatedAt.Default.(func() time.Time)
+	shareFields := schema.Share{}.Fields()
+	_ = shareFields
+	// shareDescSlug is the schema descriptor for slug field.
+	shareDescSlug := shareFields[1].Descriptor()
+	// share.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	share.SlugValidator = func() func(string) error {
+		validators := shareDescSlug.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(slug string) error {
+			for _, fn := range fns {
+				if err := fn(slug); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// shareDescMaxDownloads is the schema descriptor for max_downloads field.
+	shareDescMaxDownloads := shareFields[4].Descriptor()
+	// share.MaxDownloadsValidator is a validator for the "max_downloads" field. It is called by the builders before save.
+	share.MaxDownloadsValidator = shareDescMaxDownloads.Validators[0].(func(int) error)
+	// shareDescDownloads is the schema descriptor for downloads field.
+	shareDescDownloads := shareFields[5].Descriptor()
+	// share.DefaultDownloads holds the default value on creation for the downloads field.
+	share.DefaultDownloads = shareDescDownloads.Default.(int)
+	// share.DownloadsValidator is a validator for the "downloads" field. It is called by the builders before save.
+	share.DownloadsValidator = shareDescDownloads.Validators[0].(func(int) error)
+	// shareDescCreatedAt is the schema descriptor for created_at field.
+	shareDescCreatedAt := shareFields[8].Descriptor()
+	// share.DefaultCreatedAt holds the default value on creation for the created_at field.
+	share.DefaultCreatedAt = shareDescCreatedAt.Default.(func() time.Time)
+	thumbnailFields := schema.Thumbnail{}.Fields()
+	_ = thumbnailFields
+	// thumbnailDescSize is the schema descriptor for size field.
+	thumbnailDescSize := thumbnailFields[0].Descriptor()
+	// thumbnail.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	thumbnail.SizeValidator = thumbnailDescSize.Validators[0].(func(int) error)
+	// thumbnailDescWidth is the schema descriptor for width field.
+	thumbnailDescWidth := thumbnailFields[1].Descriptor()
+	// thumbnail.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	thumbnail.WidthValidator = thumbnailDescWidth.Validators[0].(func(int) error)
+	// thumbnailDescHeight is the schema descriptor for height field.
+	thumbnailDescHeight := thumbnailFields[2].Descriptor()
+	// thumbnail.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	thumbnail.HeightValidator = thumbnailDescHeight.Validators[0].(func(int) error)
+	// thumbnailDescBytes is the schema descriptor for bytes field.
+	thumbnailDescBytes := thumbnailFields[5].Descriptor()
+	// thumbnail.BytesValidator is a validator for the "bytes" field. It is called by the builders before save.
+	thumbnail.BytesValidator = thumbnailDescBytes.Validators[0].(func(int64) error)
+	tokenFields := schema.Token{}.Fields()
+	_ = tokenFields
+	// tokenDescName is the schema descriptor for name field.
+	tokenDescName := tokenFields[1].Descriptor()
+	// token.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	token.NameValidator = func() func(string) error {
+		validators := tokenDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tokenDescSecret is the schema descriptor for secret field.
+	tokenDescSecret := tokenFields[2].Descriptor()
+	// token.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	token.SecretValidator = func() func(string) error {
+		validators := tokenDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// tokenDescCreatedAt is the schema descriptor for created_at field.
+	tokenDescCreatedAt := tokenFields[4].Descriptor()
+	// token.DefaultCreatedAt holds the default value on creation for the created_at field.
+	token.DefaultCreatedAt = tokenDescCreatedAt.Default.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescEmail is the schema descriptor for email field.
+	userDescEmail := userFields[0].Descriptor()
+	// user.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	user.EmailValidator = func() func(string) error {
+		validators := userDescEmail.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+			validators[3].(func(string) error),
+		}
+		return func(email string) error {
+			for _, fn := range fns {
+				if err := fn(email); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[1].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = func() func(string) error {
+		validators := userDescPassword.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(password string) error {
+			for _, fn := range fns {
+				if err := fn(password); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// userDescActive is the schema descriptor for active field.
+	userDescActive := userFields[2].Descriptor()
+	// user.DefaultActive holds the default value on creation for the active field.
+	user.DefaultActive = userDescActive.Default.(bool)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[3].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[4].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	webhookFields := schema.Webhook{}.Fields()
+	_ = webhookFields
+	// webhookDescURL is the schema descriptor for url field.
+	webhookDescURL := webhookFields[1].Descriptor()
+	// webhook.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhook.URLValidator = func() func(string) error {
+		validators := webhookDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescSecret is the schema descriptor for secret field.
+	webhookDescSecret := webhookFields[3].Descriptor()
+	// webhook.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhook.SecretValidator = func() func(string) error {
+		validators := webhookDescSecret.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+			validators[2].(func(string) error),
+		}
+		return func(secret string) error {
+			for _, fn := range fns {
+				if err := fn(secret); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookDescActive is the schema descriptor for active field.
+	webhookDescActive := webhookFields[4].Descriptor()
+	// webhook.DefaultActive holds the default value on creation for the active field.
+	webhook.DefaultActive = webhookDescActive.Default.(bool)
+	// webhookDescCreatedAt is the schema descriptor for created_at field.
+	webhookDescCreatedAt := webhookFields[5].Descriptor()
+	// webhook.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhook.DefaultCreatedAt = webhookDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEvent is the schema descriptor for event field.
+	webhookdeliveryDescEvent := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventValidator is a validator for the "event" field. It is called by the builders before save.
+	webhookdelivery.EventValidator = webhookdeliveryDescEvent.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[8].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+}
 
 const (
 	Version = "(devel)" // Version of ent codegen.
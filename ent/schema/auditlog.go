@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("action").
+			Immutable().
+			Values("Login", "Register", "TokenCreate", "TokenReset", "TokenRevoke", "Upload", "Unpin"),
+		field.UUID("token_id", uuid.UUID{}).
+			Immutable().
+			Optional(),
+		field.String("request_id").
+			Immutable().
+			Optional(),
+		field.String("ip").
+			Immutable().
+			Optional(),
+		field.String("user_agent").
+			Immutable().
+			Optional(),
+		field.JSON("metadata", map[string]interface{}{}).
+			Immutable().
+			Optional(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the AuditLog.
+func (AuditLog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("audit_logs").
+			Unique(),
+	}
+}
+
+// Hooks of the AuditLog. The audit log is append-only, so any
+// update or deletion is rejected.
+func (AuditLog) Hooks() []ent.Hook {
+	return []ent.Hook{
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if !m.Op().Is(ent.OpCreate) {
+					return nil, fmt.Errorf("%s operation is not allowed on audit logs", m.Op())
+				}
+				return next.Mutate(ctx, m)
+			})
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Bucket holds the schema definition for the Bucket entity. Buckets are
+// the namespaces of the files uploaded through the S3 gateway, named
+// uniquely per user.
+type Bucket struct {
+	ent.Schema
+}
+
+// Fields of the Bucket.
+func (Bucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			Immutable().
+			NotEmpty().
+			MaxLen(63),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Bucket.
+func (Bucket) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("buckets").
+			Unique().
+			Required(),
+		edge.To("files", File.Type),
+	}
+}
+
+// Indexes of the Bucket.
+func (Bucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("user").
+			Unique(),
+	}
+}
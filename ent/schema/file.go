@@ -9,6 +9,7 @@ import (
 
 	"github.com/facebookincubator/ent"
 	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
 )
 
 // File holds the schema definition for the File entity.
@@ -22,17 +23,33 @@ func (File) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Immutable(),
 		field.String("hash").
-			Unique().
 			Immutable().
 			NotEmpty(),
 		field.Int64("size").
-			Positive(),
+			NonNegative(),
 		field.Time("pinned_at").
 			Immutable().
 			Default(time.Now),
-		field.Time("unpinned_at"),
+		field.Time("unpinned_at").
+			Optional().
+			Nillable(),
 		field.JSON("metadata", map[string]interface{}{}).
 			Optional(),
+		field.String("key").
+			Optional().
+			Immutable().
+			MaxLen(1024),
+		field.String("etag").
+			Optional().
+			Immutable(),
+		field.String("name").
+			Optional().
+			MaxLen(255),
+		field.Strings("tags").
+			Optional(),
+		field.String("content_type").
+			Optional().
+			MaxLen(255),
 	}
 }
 
@@ -43,5 +60,30 @@ func (File) Edges() []ent.Edge {
 			Ref("files").
 			Unique().
 			Required(),
+		edge.From("bucket", Bucket.Type).
+			Ref("files").
+			Unique(),
+		edge.From("folder", Folder.Type).
+			Ref("files").
+			Unique(),
+		edge.To("indexed_tags", FileTag.Type),
+		edge.To("indexed_properties", FileProperty.Type),
+		edge.To("indexed_media", FileMedia.Type).
+			Unique(),
+		edge.To("thumbnails", Thumbnail.Type),
+		edge.To("shares", Share.Type),
+		edge.To("encryption_key", FileKey.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the File. Several files may have the same content, such as
+// copies of an object, the node pin is then shared.
+func (File) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("hash"),
+		index.Edges("bucket"),
+		index.Fields("name").
+			Edges("folder"),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// FileKey holds the schema definition for the FileKey entity. Encrypted
+// files are pinned as ciphertext, their file key holds the data key they
+// are encrypted with, wrapped by a master key which is never stored: one
+// derived from a passphrase of the user, or one of a key manager.
+type FileKey struct {
+	ent.Schema
+}
+
+// Fields of the FileKey.
+func (FileKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("provider").
+			Immutable().
+			Values("Passphrase", "KMS"),
+		// ID of the master key the data key is wrapped with
+		field.String("key_id").
+			Immutable().
+			NotEmpty().
+			MaxLen(255),
+		// Bytes cannot be sensitive, the wrapped key is kept out of JSON
+		field.Bytes("wrapped_key").
+			Immutable().
+			MaxLen(1024).
+			StructTag(`json:"-"`),
+		// Size of the segments of plaintext the content is encrypted in
+		field.Int("segment_size").
+			Immutable().
+			Positive(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the FileKey.
+func (FileKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("encryption_key").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+)
+
+// FileMedia holds the schema definition for the FileMedia entity. The
+// media metadata of files is recorded in their metadata, file media index
+// it for searches.
+type FileMedia struct {
+	ent.Schema
+}
+
+// Fields of the FileMedia.
+func (FileMedia) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("width").
+			Optional().
+			Nillable().
+			NonNegative(),
+		field.Int("height").
+			Optional().
+			Nillable().
+			NonNegative(),
+		// Duration in seconds
+		field.Float("duration").
+			Optional().
+			Nillable().
+			Min(0),
+		field.Int("pages").
+			Optional().
+			Nillable().
+			NonNegative(),
+		field.Time("taken_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the FileMedia.
+func (FileMedia) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("indexed_media").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,44 @@
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// FileProperty holds the schema definition for the FileProperty entity.
+// The metadata of users is kept with files, file properties index it for
+// searches.
+type FileProperty struct {
+	ent.Schema
+}
+
+// Fields of the FileProperty.
+func (FileProperty) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			NotEmpty().
+			MaxLen(64),
+		field.String("value").
+			MaxLen(255),
+	}
+}
+
+// Edges of the FileProperty.
+func (FileProperty) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("indexed_properties").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the FileProperty.
+func (FileProperty) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("key", "value"),
+		index.Edges("file"),
+	}
+}
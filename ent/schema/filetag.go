@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// FileTag holds the schema definition for the FileTag entity. Tags are
+// listed by files, file tags index them for searches.
+type FileTag struct {
+	ent.Schema
+}
+
+// Fields of the FileTag.
+func (FileTag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			NotEmpty().
+			MaxLen(64),
+	}
+}
+
+// Edges of the FileTag.
+func (FileTag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("indexed_tags").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the FileTag.
+func (FileTag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("file").
+			Unique(),
+	}
+}
@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Folder holds the schema definition for the Folder entity. Folders make a
+// tree per user, of which files without parent are at the root.
+type Folder struct {
+	ent.Schema
+}
+
+// Fields of the Folder.
+func (Folder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			MaxLen(255),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Folder.
+func (Folder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("folders").
+			Unique().
+			Required(),
+		edge.To("children", Folder.Type).
+			From("parent").
+			Unique(),
+		edge.To("files", File.Type),
+	}
+}
+
+// Indexes of the Folder.
+func (Folder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("parent"),
+	}
+}
@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Share holds the schema definition for the Share entity, a public link
+// to a pinned file. The password is a bcrypt hash.
+type Share struct {
+	ent.Schema
+}
+
+// Fields of the Share.
+func (Share) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("slug").
+			Immutable().
+			NotEmpty().
+			MaxLen(64).
+			Unique(),
+		field.String("password").
+			Optional().
+			Immutable().
+			Sensitive(),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.Int("max_downloads").
+			Optional().
+			Nillable().
+			Immutable().
+			Positive(),
+		field.Int("downloads").
+			Default(0).
+			NonNegative(),
+		field.Time("last_downloaded_at").
+			Optional().
+			Nillable(),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Share.
+func (Share) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("shares").
+			Unique().
+			Required(),
+		edge.From("file", File.Type).
+			Ref("shares").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Share.
+func (Share) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("file"),
+	}
+}
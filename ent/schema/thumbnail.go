@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/facebookincubator/ent/schema/index"
+)
+
+// Thumbnail holds the schema definition for the Thumbnail entity. Images
+// have a thumbnail for each of the configured sizes, pinned on the IPFS
+// node. Images which could not be decoded have a single thumbnail of size
+// 0 without content, so that they are not tried again.
+type Thumbnail struct {
+	ent.Schema
+}
+
+// Fields of the Thumbnail.
+func (Thumbnail) Fields() []ent.Field {
+	return []ent.Field{
+		// Size of the bounding square of the thumbnail, in pixels
+		field.Int("size").
+			NonNegative(),
+		field.Int("width").
+			NonNegative(),
+		field.Int("height").
+			NonNegative(),
+		// Images smaller than the size are their own thumbnail, sharing
+		// their hash
+		field.String("hash").
+			Optional(),
+		field.String("content_type").
+			Optional(),
+		field.Int64("bytes").
+			NonNegative(),
+	}
+}
+
+// Edges of the Thumbnail.
+func (Thumbnail) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("file", File.Type).
+			Ref("thumbnails").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Thumbnail.
+func (Thumbnail) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("size").
+			Edges("file").
+			Unique(),
+		index.Fields("hash"),
+	}
+}
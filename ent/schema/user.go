@@ -48,5 +48,10 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("tokens", Token.Type),
 		edge.To("files", File.Type),
+		edge.To("audit_logs", AuditLog.Type),
+		edge.To("webhooks", Webhook.Type),
+		edge.To("buckets", Bucket.Type),
+		edge.To("folders", Folder.Type),
+		edge.To("shares", Share.Type),
 	}
 }
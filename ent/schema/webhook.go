@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Webhook holds the schema definition for the Webhook entity.
+type Webhook struct {
+	ent.Schema
+}
+
+// Fields of the Webhook.
+func (Webhook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048),
+		field.JSON("events", []string{}),
+		field.String("secret").
+			NotEmpty().
+			MinLen(16).
+			MaxLen(80).
+			Sensitive(),
+		field.Bool("active").
+			Default(true),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Webhook.
+func (Webhook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("webhooks").
+			Unique().
+			Required(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
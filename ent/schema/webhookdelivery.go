@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/facebookincubator/ent"
+	"github.com/facebookincubator/ent/schema/edge"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Immutable(),
+		field.String("event").
+			Immutable().
+			NotEmpty(),
+		field.JSON("payload", map[string]interface{}{}).
+			Immutable(),
+		field.Enum("status").
+			Values("Pending", "Succeeded", "Failed").
+			Default("Pending"),
+		field.Int("attempts").
+			Default(0).
+			NonNegative(),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Int("response_status").
+			Optional(),
+		field.String("last_error").
+			Optional(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("webhook", Webhook.Type).
+			Ref("deliveries").
+			Unique().
+			Required(),
+	}
+}
@@ -0,0 +1,247 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/user"
+)
+
+// Share is the model entity for the Share schema.
+type Share struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// Password holds the value of the "password" field.
+	Password string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// MaxDownloads holds the value of the "max_downloads" field.
+	MaxDownloads *int `json:"max_downloads,omitempty"`
+	// Downloads holds the value of the "downloads" field.
+	Downloads int `json:"downloads,omitempty"`
+	// LastDownloadedAt holds the value of the "last_downloaded_at" field.
+	LastDownloadedAt *time.Time `json:"last_downloaded_at,omitempty"`
+	// RevokedAt holds the value of the "revoked_at" field.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShareQuery when eager-loading is set.
+	Edges       ShareEdges `json:"edges"`
+	file_shares *uuid.UUID
+	user_shares *int
+}
+
+// ShareEdges holds the relations/edges for other nodes in the graph.
+type ShareEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShareEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[1] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Share) scanValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},      // id
+		&sql.NullString{}, // slug
+		&sql.NullString{}, // password
+		&sql.NullTime{},   // expires_at
+		&sql.NullInt64{},  // max_downloads
+		&sql.NullInt64{},  // downloads
+		&sql.NullTime{},   // last_downloaded_at
+		&sql.NullTime{},   // revoked_at
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Share) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},     // file_shares
+		&sql.NullInt64{}, // user_shares
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Share fields.
+func (s *Share) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(share.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	if value, ok := values[0].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field id", values[0])
+	} else if value != nil {
+		s.ID = *value
+	}
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field slug", values[0])
+	} else if value.Valid {
+		s.Slug = value.String
+	}
+	if value, ok := values[1].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field password", values[1])
+	} else if value.Valid {
+		s.Password = value.String
+	}
+	if value, ok := values[2].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field expires_at", values[2])
+	} else if value.Valid {
+		s.ExpiresAt = new(time.Time)
+		*s.ExpiresAt = value.Time
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field max_downloads", values[3])
+	} else if value.Valid {
+		s.MaxDownloads = new(int)
+		*s.MaxDownloads = int(value.Int64)
+	}
+	if value, ok := values[4].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field downloads", values[4])
+	} else if value.Valid {
+		s.Downloads = int(value.Int64)
+	}
+	if value, ok := values[5].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field last_downloaded_at", values[5])
+	} else if value.Valid {
+		s.LastDownloadedAt = new(time.Time)
+		*s.LastDownloadedAt = value.Time
+	}
+	if value, ok := values[6].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field revoked_at", values[6])
+	} else if value.Valid {
+		s.RevokedAt = new(time.Time)
+		*s.RevokedAt = value.Time
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[7])
+	} else if value.Valid {
+		s.CreatedAt = value.Time
+	}
+	values = values[8:]
+	if len(values) == len(share.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_shares", values[0])
+		} else if value != nil {
+			s.file_shares = value
+		}
+		if value, ok := values[1].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_shares", value)
+		} else if value.Valid {
+			s.user_shares = new(int)
+			*s.user_shares = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the Share.
+func (s *Share) QueryUser() *UserQuery {
+	return (&ShareClient{config: s.config}).QueryUser(s)
+}
+
+// QueryFile queries the file edge of the Share.
+func (s *Share) QueryFile() *FileQuery {
+	return (&ShareClient{config: s.config}).QueryFile(s)
+}
+
+// Update returns a builder for updating this Share.
+// Note that, you need to call Share.Unwrap() before calling this method, if this Share
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Share) Update() *ShareUpdateOne {
+	return (&ShareClient{config: s.config}).UpdateOne(s)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (s *Share) Unwrap() *Share {
+	tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Share is not a transactional entity")
+	}
+	s.config.driver = tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Share) String() string {
+	var builder strings.Builder
+	builder.WriteString("Share(")
+	builder.WriteString(fmt.Sprintf("id=%v", s.ID))
+	builder.WriteString(", slug=")
+	builder.WriteString(s.Slug)
+	builder.WriteString(", password=<sensitive>")
+	if v := s.ExpiresAt; v != nil {
+		builder.WriteString(", expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := s.MaxDownloads; v != nil {
+		builder.WriteString(", max_downloads=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", downloads=")
+	builder.WriteString(fmt.Sprintf("%v", s.Downloads))
+	if v := s.LastDownloadedAt; v != nil {
+		builder.WriteString(", last_downloaded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	if v := s.RevokedAt; v != nil {
+		builder.WriteString(", revoked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shares is a parsable slice of Share.
+type Shares []*Share
+
+func (s Shares) config(cfg config) {
+	for _i := range s {
+		s[_i].config = cfg
+	}
+}
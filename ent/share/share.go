@@ -0,0 +1,76 @@
+// github.com/sthorer/api
+
+package share
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the share type in the database.
+	Label = "share"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID               = "id"                 // FieldSlug holds the string denoting the slug vertex property in the database.
+	FieldSlug             = "slug"               // FieldPassword holds the string denoting the password vertex property in the database.
+	FieldPassword         = "password"           // FieldExpiresAt holds the string denoting the expires_at vertex property in the database.
+	FieldExpiresAt        = "expires_at"         // FieldMaxDownloads holds the string denoting the max_downloads vertex property in the database.
+	FieldMaxDownloads     = "max_downloads"      // FieldDownloads holds the string denoting the downloads vertex property in the database.
+	FieldDownloads        = "downloads"          // FieldLastDownloadedAt holds the string denoting the last_downloaded_at vertex property in the database.
+	FieldLastDownloadedAt = "last_downloaded_at" // FieldRevokedAt holds the string denoting the revoked_at vertex property in the database.
+	FieldRevokedAt        = "revoked_at"         // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt        = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the share in the database.
+	Table = "shares"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "shares"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_shares"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "shares"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_shares"
+)
+
+// Columns holds all SQL columns for share fields.
+var Columns = []string{
+	FieldID,
+	FieldSlug,
+	FieldPassword,
+	FieldExpiresAt,
+	FieldMaxDownloads,
+	FieldDownloads,
+	FieldLastDownloadedAt,
+	FieldRevokedAt,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Share type.
+var ForeignKeys = []string{
+	"file_shares",
+	"user_shares",
+}
+
+var (
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// MaxDownloadsValidator is a validator for the "max_downloads" field. It is called by the builders before save.
+	MaxDownloadsValidator func(int) error
+	// DefaultDownloads holds the default value on creation for the downloads field.
+	DefaultDownloads int
+	// DownloadsValidator is a validator for the "downloads" field. It is called by the builders before save.
+	DownloadsValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
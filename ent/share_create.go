@@ -0,0 +1,351 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/user"
+)
+
+// ShareCreate is the builder for creating a Share entity.
+type ShareCreate struct {
+	config
+	mutation *ShareMutation
+	hooks    []Hook
+}
+
+// SetSlug sets the slug field.
+func (sc *ShareCreate) SetSlug(s string) *ShareCreate {
+	sc.mutation.SetSlug(s)
+	return sc
+}
+
+// SetPassword sets the password field.
+func (sc *ShareCreate) SetPassword(s string) *ShareCreate {
+	sc.mutation.SetPassword(s)
+	return sc
+}
+
+// SetNillablePassword sets the password field if the given value is not nil.
+func (sc *ShareCreate) SetNillablePassword(s *string) *ShareCreate {
+	if s != nil {
+		sc.SetPassword(*s)
+	}
+	return sc
+}
+
+// SetExpiresAt sets the expires_at field.
+func (sc *ShareCreate) SetExpiresAt(t time.Time) *ShareCreate {
+	sc.mutation.SetExpiresAt(t)
+	return sc
+}
+
+// SetNillableExpiresAt sets the expires_at field if the given value is not nil.
+func (sc *ShareCreate) SetNillableExpiresAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetExpiresAt(*t)
+	}
+	return sc
+}
+
+// SetMaxDownloads sets the max_downloads field.
+func (sc *ShareCreate) SetMaxDownloads(i int) *ShareCreate {
+	sc.mutation.SetMaxDownloads(i)
+	return sc
+}
+
+// SetNillableMaxDownloads sets the max_downloads field if the given value is not nil.
+func (sc *ShareCreate) SetNillableMaxDownloads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetMaxDownloads(*i)
+	}
+	return sc
+}
+
+// SetDownloads sets the downloads field.
+func (sc *ShareCreate) SetDownloads(i int) *ShareCreate {
+	sc.mutation.SetDownloads(i)
+	return sc
+}
+
+// SetNillableDownloads sets the downloads field if the given value is not nil.
+func (sc *ShareCreate) SetNillableDownloads(i *int) *ShareCreate {
+	if i != nil {
+		sc.SetDownloads(*i)
+	}
+	return sc
+}
+
+// SetLastDownloadedAt sets the last_downloaded_at field.
+func (sc *ShareCreate) SetLastDownloadedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetLastDownloadedAt(t)
+	return sc
+}
+
+// SetNillableLastDownloadedAt sets the last_downloaded_at field if the given value is not nil.
+func (sc *ShareCreate) SetNillableLastDownloadedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetLastDownloadedAt(*t)
+	}
+	return sc
+}
+
+// SetRevokedAt sets the revoked_at field.
+func (sc *ShareCreate) SetRevokedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetRevokedAt(t)
+	return sc
+}
+
+// SetNillableRevokedAt sets the revoked_at field if the given value is not nil.
+func (sc *ShareCreate) SetNillableRevokedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetRevokedAt(*t)
+	}
+	return sc
+}
+
+// SetCreatedAt sets the created_at field.
+func (sc *ShareCreate) SetCreatedAt(t time.Time) *ShareCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (sc *ShareCreate) SetNillableCreatedAt(t *time.Time) *ShareCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetID sets the id field.
+func (sc *ShareCreate) SetID(u uuid.UUID) *ShareCreate {
+	sc.mutation.SetID(u)
+	return sc
+}
+
+// SetUserID sets the user edge to User by id.
+func (sc *ShareCreate) SetUserID(id int) *ShareCreate {
+	sc.mutation.SetUserID(id)
+	return sc
+}
+
+// SetUser sets the user edge to User.
+func (sc *ShareCreate) SetUser(u *User) *ShareCreate {
+	return sc.SetUserID(u.ID)
+}
+
+// SetFileID sets the file edge to File by id.
+func (sc *ShareCreate) SetFileID(id uuid.UUID) *ShareCreate {
+	sc.mutation.SetFileID(id)
+	return sc
+}
+
+// SetFile sets the file edge to File.
+func (sc *ShareCreate) SetFile(f *File) *ShareCreate {
+	return sc.SetFileID(f.ID)
+}
+
+// Save creates the Share in the database.
+func (sc *ShareCreate) Save(ctx context.Context) (*Share, error) {
+	if _, ok := sc.mutation.Slug(); !ok {
+		return nil, errors.New("ent: missing required field \"slug\"")
+	}
+	if v, ok := sc.mutation.Slug(); ok {
+		if err := share.SlugValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"slug\": %v", err)
+		}
+	}
+	if v, ok := sc.mutation.MaxDownloads(); ok {
+		if err := share.MaxDownloadsValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"max_downloads\": %v", err)
+		}
+	}
+	if _, ok := sc.mutation.Downloads(); !ok {
+		v := share.DefaultDownloads
+		sc.mutation.SetDownloads(v)
+	}
+	if v, ok := sc.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"downloads\": %v", err)
+		}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := share.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UserID(); !ok {
+		return nil, errors.New("ent: missing required edge \"user\"")
+	}
+	if _, ok := sc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *Share
+	)
+	if len(sc.hooks) == 0 {
+		node, err = sc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			sc.mutation = mutation
+			node, err = sc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(sc.hooks) - 1; i >= 0; i-- {
+			mut = sc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, sc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *ShareCreate) SaveX(ctx context.Context) *Share {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (sc *ShareCreate) sqlSave(ctx context.Context) (*Share, error) {
+	var (
+		s     = &Share{config: sc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: share.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: share.FieldID,
+			},
+		}
+	)
+	if id, ok := sc.mutation.ID(); ok {
+		s.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := sc.mutation.Slug(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: share.FieldSlug,
+		})
+		s.Slug = value
+	}
+	if value, ok := sc.mutation.Password(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: share.FieldPassword,
+		})
+		s.Password = value
+	}
+	if value, ok := sc.mutation.ExpiresAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldExpiresAt,
+		})
+		s.ExpiresAt = &value
+	}
+	if value, ok := sc.mutation.MaxDownloads(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldMaxDownloads,
+		})
+		s.MaxDownloads = &value
+	}
+	if value, ok := sc.mutation.Downloads(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldDownloads,
+		})
+		s.Downloads = value
+	}
+	if value, ok := sc.mutation.LastDownloadedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldLastDownloadedAt,
+		})
+		s.LastDownloadedAt = &value
+	}
+	if value, ok := sc.mutation.RevokedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldRevokedAt,
+		})
+		s.RevokedAt = &value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldCreatedAt,
+		})
+		s.CreatedAt = value
+	}
+	if nodes := sc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := sc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return s, nil
+}
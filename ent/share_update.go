@@ -0,0 +1,671 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/share"
+	"github.com/sthorer/api/ent/user"
+)
+
+// ShareUpdate is the builder for updating Share entities.
+type ShareUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *ShareMutation
+	predicates []predicate.Share
+}
+
+// Where adds a new predicate for the builder.
+func (su *ShareUpdate) Where(ps ...predicate.Share) *ShareUpdate {
+	su.predicates = append(su.predicates, ps...)
+	return su
+}
+
+// SetDownloads sets the downloads field.
+func (su *ShareUpdate) SetDownloads(i int) *ShareUpdate {
+	su.mutation.ResetDownloads()
+	su.mutation.SetDownloads(i)
+	return su
+}
+
+// SetNillableDownloads sets the downloads field if the given value is not nil.
+func (su *ShareUpdate) SetNillableDownloads(i *int) *ShareUpdate {
+	if i != nil {
+		su.SetDownloads(*i)
+	}
+	return su
+}
+
+// AddDownloads adds i to downloads.
+func (su *ShareUpdate) AddDownloads(i int) *ShareUpdate {
+	su.mutation.AddDownloads(i)
+	return su
+}
+
+// SetLastDownloadedAt sets the last_downloaded_at field.
+func (su *ShareUpdate) SetLastDownloadedAt(t time.Time) *ShareUpdate {
+	su.mutation.SetLastDownloadedAt(t)
+	return su
+}
+
+// SetNillableLastDownloadedAt sets the last_downloaded_at field if the given value is not nil.
+func (su *ShareUpdate) SetNillableLastDownloadedAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetLastDownloadedAt(*t)
+	}
+	return su
+}
+
+// ClearLastDownloadedAt clears the value of last_downloaded_at.
+func (su *ShareUpdate) ClearLastDownloadedAt() *ShareUpdate {
+	su.mutation.ClearLastDownloadedAt()
+	return su
+}
+
+// SetRevokedAt sets the revoked_at field.
+func (su *ShareUpdate) SetRevokedAt(t time.Time) *ShareUpdate {
+	su.mutation.SetRevokedAt(t)
+	return su
+}
+
+// SetNillableRevokedAt sets the revoked_at field if the given value is not nil.
+func (su *ShareUpdate) SetNillableRevokedAt(t *time.Time) *ShareUpdate {
+	if t != nil {
+		su.SetRevokedAt(*t)
+	}
+	return su
+}
+
+// ClearRevokedAt clears the value of revoked_at.
+func (su *ShareUpdate) ClearRevokedAt() *ShareUpdate {
+	su.mutation.ClearRevokedAt()
+	return su
+}
+
+// SetUserID sets the user edge to User by id.
+func (su *ShareUpdate) SetUserID(id int) *ShareUpdate {
+	su.mutation.SetUserID(id)
+	return su
+}
+
+// SetUser sets the user edge to User.
+func (su *ShareUpdate) SetUser(u *User) *ShareUpdate {
+	return su.SetUserID(u.ID)
+}
+
+// SetFileID sets the file edge to File by id.
+func (su *ShareUpdate) SetFileID(id uuid.UUID) *ShareUpdate {
+	su.mutation.SetFileID(id)
+	return su
+}
+
+// SetFile sets the file edge to File.
+func (su *ShareUpdate) SetFile(f *File) *ShareUpdate {
+	return su.SetFileID(f.ID)
+}
+
+// ClearUser clears the user edge to User.
+func (su *ShareUpdate) ClearUser() *ShareUpdate {
+	su.mutation.ClearUser()
+	return su
+}
+
+// ClearFile clears the file edge to File.
+func (su *ShareUpdate) ClearFile() *ShareUpdate {
+	su.mutation.ClearFile()
+	return su
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (su *ShareUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := su.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"downloads\": %v", err)
+		}
+	}
+
+	if _, ok := su.mutation.UserID(); su.mutation.UserCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	if _, ok := su.mutation.FileID(); su.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(su.hooks) == 0 {
+		affected, err = su.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			su.mutation = mutation
+			affected, err = su.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(su.hooks) - 1; i >= 0; i-- {
+			mut = su.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, su.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShareUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShareUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (su *ShareUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   share.Table,
+			Columns: share.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: share.FieldID,
+			},
+		},
+	}
+	if ps := su.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if su.mutation.PasswordCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: share.FieldPassword,
+		})
+	}
+	if su.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldExpiresAt,
+		})
+	}
+	if su.mutation.MaxDownloadsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: share.FieldMaxDownloads,
+		})
+	}
+	if value, ok := su.mutation.Downloads(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldDownloads,
+		})
+	}
+	if value, ok := su.mutation.AddedDownloads(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldDownloads,
+		})
+	}
+	if value, ok := su.mutation.LastDownloadedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldLastDownloadedAt,
+		})
+	}
+	if su.mutation.LastDownloadedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldLastDownloadedAt,
+		})
+	}
+	if value, ok := su.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldRevokedAt,
+		})
+	}
+	if su.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldRevokedAt,
+		})
+	}
+	if su.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ShareUpdateOne is the builder for updating a single Share entity.
+type ShareUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ShareMutation
+}
+
+// SetDownloads sets the downloads field.
+func (suo *ShareUpdateOne) SetDownloads(i int) *ShareUpdateOne {
+	suo.mutation.ResetDownloads()
+	suo.mutation.SetDownloads(i)
+	return suo
+}
+
+// SetNillableDownloads sets the downloads field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableDownloads(i *int) *ShareUpdateOne {
+	if i != nil {
+		suo.SetDownloads(*i)
+	}
+	return suo
+}
+
+// AddDownloads adds i to downloads.
+func (suo *ShareUpdateOne) AddDownloads(i int) *ShareUpdateOne {
+	suo.mutation.AddDownloads(i)
+	return suo
+}
+
+// SetLastDownloadedAt sets the last_downloaded_at field.
+func (suo *ShareUpdateOne) SetLastDownloadedAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetLastDownloadedAt(t)
+	return suo
+}
+
+// SetNillableLastDownloadedAt sets the last_downloaded_at field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableLastDownloadedAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetLastDownloadedAt(*t)
+	}
+	return suo
+}
+
+// ClearLastDownloadedAt clears the value of last_downloaded_at.
+func (suo *ShareUpdateOne) ClearLastDownloadedAt() *ShareUpdateOne {
+	suo.mutation.ClearLastDownloadedAt()
+	return suo
+}
+
+// SetRevokedAt sets the revoked_at field.
+func (suo *ShareUpdateOne) SetRevokedAt(t time.Time) *ShareUpdateOne {
+	suo.mutation.SetRevokedAt(t)
+	return suo
+}
+
+// SetNillableRevokedAt sets the revoked_at field if the given value is not nil.
+func (suo *ShareUpdateOne) SetNillableRevokedAt(t *time.Time) *ShareUpdateOne {
+	if t != nil {
+		suo.SetRevokedAt(*t)
+	}
+	return suo
+}
+
+// ClearRevokedAt clears the value of revoked_at.
+func (suo *ShareUpdateOne) ClearRevokedAt() *ShareUpdateOne {
+	suo.mutation.ClearRevokedAt()
+	return suo
+}
+
+// SetUserID sets the user edge to User by id.
+func (suo *ShareUpdateOne) SetUserID(id int) *ShareUpdateOne {
+	suo.mutation.SetUserID(id)
+	return suo
+}
+
+// SetUser sets the user edge to User.
+func (suo *ShareUpdateOne) SetUser(u *User) *ShareUpdateOne {
+	return suo.SetUserID(u.ID)
+}
+
+// SetFileID sets the file edge to File by id.
+func (suo *ShareUpdateOne) SetFileID(id uuid.UUID) *ShareUpdateOne {
+	suo.mutation.SetFileID(id)
+	return suo
+}
+
+// SetFile sets the file edge to File.
+func (suo *ShareUpdateOne) SetFile(f *File) *ShareUpdateOne {
+	return suo.SetFileID(f.ID)
+}
+
+// ClearUser clears the user edge to User.
+func (suo *ShareUpdateOne) ClearUser() *ShareUpdateOne {
+	suo.mutation.ClearUser()
+	return suo
+}
+
+// ClearFile clears the file edge to File.
+func (suo *ShareUpdateOne) ClearFile() *ShareUpdateOne {
+	suo.mutation.ClearFile()
+	return suo
+}
+
+// Save executes the query and returns the updated entity.
+func (suo *ShareUpdateOne) Save(ctx context.Context) (*Share, error) {
+	if v, ok := suo.mutation.Downloads(); ok {
+		if err := share.DownloadsValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"downloads\": %v", err)
+		}
+	}
+
+	if _, ok := suo.mutation.UserID(); suo.mutation.UserCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	if _, ok := suo.mutation.FileID(); suo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *Share
+	)
+	if len(suo.hooks) == 0 {
+		node, err = suo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ShareMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			suo.mutation = mutation
+			node, err = suo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(suo.hooks) - 1; i >= 0; i-- {
+			mut = suo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, suo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShareUpdateOne) SaveX(ctx context.Context) *Share {
+	s, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// Exec executes the query on the entity.
+func (suo *ShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShareUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (suo *ShareUpdateOne) sqlSave(ctx context.Context) (s *Share, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   share.Table,
+			Columns: share.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: share.FieldID,
+			},
+		},
+	}
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Share.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if suo.mutation.PasswordCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: share.FieldPassword,
+		})
+	}
+	if suo.mutation.ExpiresAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldExpiresAt,
+		})
+	}
+	if suo.mutation.MaxDownloadsCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: share.FieldMaxDownloads,
+		})
+	}
+	if value, ok := suo.mutation.Downloads(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldDownloads,
+		})
+	}
+	if value, ok := suo.mutation.AddedDownloads(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: share.FieldDownloads,
+		})
+	}
+	if value, ok := suo.mutation.LastDownloadedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldLastDownloadedAt,
+		})
+	}
+	if suo.mutation.LastDownloadedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldLastDownloadedAt,
+		})
+	}
+	if value, ok := suo.mutation.RevokedAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: share.FieldRevokedAt,
+		})
+	}
+	if suo.mutation.RevokedAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: share.FieldRevokedAt,
+		})
+	}
+	if suo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.UserTable,
+			Columns: []string{share.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   share.FileTable,
+			Columns: []string{share.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	s = &Share{config: suo.config}
+	_spec.Assign = s.assignValues
+	_spec.ScanValues = s.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{share.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return s, nil
+}
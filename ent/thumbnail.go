@@ -0,0 +1,185 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/thumbnail"
+)
+
+// Thumbnail is the model entity for the Thumbnail schema.
+type Thumbnail struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// Width holds the value of the "width" field.
+	Width int `json:"width,omitempty"`
+	// Height holds the value of the "height" field.
+	Height int `json:"height,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Bytes holds the value of the "bytes" field.
+	Bytes int64 `json:"bytes,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ThumbnailQuery when eager-loading is set.
+	Edges           ThumbnailEdges `json:"edges"`
+	file_thumbnails *uuid.UUID
+}
+
+// ThumbnailEdges holds the relations/edges for other nodes in the graph.
+type ThumbnailEdges struct {
+	// File holds the value of the file edge.
+	File *File
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FileOrErr returns the File value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ThumbnailEdges) FileOrErr() (*File, error) {
+	if e.loadedTypes[0] {
+		if e.File == nil {
+			// The edge file was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: file.Label}
+		}
+		return e.File, nil
+	}
+	return nil, &NotLoadedError{edge: "file"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Thumbnail) scanValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{},  // id
+		&sql.NullInt64{},  // size
+		&sql.NullInt64{},  // width
+		&sql.NullInt64{},  // height
+		&sql.NullString{}, // hash
+		&sql.NullString{}, // content_type
+		&sql.NullInt64{},  // bytes
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Thumbnail) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // file_thumbnails
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Thumbnail fields.
+func (t *Thumbnail) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(thumbnail.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	value, ok := values[0].(*sql.NullInt64)
+	if !ok {
+		return fmt.Errorf("unexpected type %T for field id", value)
+	}
+	t.ID = int(value.Int64)
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field size", values[0])
+	} else if value.Valid {
+		t.Size = int(value.Int64)
+	}
+	if value, ok := values[1].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field width", values[1])
+	} else if value.Valid {
+		t.Width = int(value.Int64)
+	}
+	if value, ok := values[2].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field height", values[2])
+	} else if value.Valid {
+		t.Height = int(value.Int64)
+	}
+	if value, ok := values[3].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field hash", values[3])
+	} else if value.Valid {
+		t.Hash = value.String
+	}
+	if value, ok := values[4].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field content_type", values[4])
+	} else if value.Valid {
+		t.ContentType = value.String
+	}
+	if value, ok := values[5].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field bytes", values[5])
+	} else if value.Valid {
+		t.Bytes = value.Int64
+	}
+	values = values[6:]
+	if len(values) == len(thumbnail.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field file_thumbnails", values[0])
+		} else if value != nil {
+			t.file_thumbnails = value
+		}
+	}
+	return nil
+}
+
+// QueryFile queries the file edge of the Thumbnail.
+func (t *Thumbnail) QueryFile() *FileQuery {
+	return (&ThumbnailClient{config: t.config}).QueryFile(t)
+}
+
+// Update returns a builder for updating this Thumbnail.
+// Note that, you need to call Thumbnail.Unwrap() before calling this method, if this Thumbnail
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (t *Thumbnail) Update() *ThumbnailUpdateOne {
+	return (&ThumbnailClient{config: t.config}).UpdateOne(t)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (t *Thumbnail) Unwrap() *Thumbnail {
+	tx, ok := t.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Thumbnail is not a transactional entity")
+	}
+	t.config.driver = tx.drv
+	return t
+}
+
+// String implements the fmt.Stringer.
+func (t *Thumbnail) String() string {
+	var builder strings.Builder
+	builder.WriteString("Thumbnail(")
+	builder.WriteString(fmt.Sprintf("id=%v", t.ID))
+	builder.WriteString(", size=")
+	builder.WriteString(fmt.Sprintf("%v", t.Size))
+	builder.WriteString(", width=")
+	builder.WriteString(fmt.Sprintf("%v", t.Width))
+	builder.WriteString(", height=")
+	builder.WriteString(fmt.Sprintf("%v", t.Height))
+	builder.WriteString(", hash=")
+	builder.WriteString(t.Hash)
+	builder.WriteString(", content_type=")
+	builder.WriteString(t.ContentType)
+	builder.WriteString(", bytes=")
+	builder.WriteString(fmt.Sprintf("%v", t.Bytes))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Thumbnails is a parsable slice of Thumbnail.
+type Thumbnails []*Thumbnail
+
+func (t Thumbnails) config(cfg config) {
+	for _i := range t {
+		t[_i].config = cfg
+	}
+}
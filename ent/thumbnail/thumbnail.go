@@ -0,0 +1,56 @@
+// github.com/sthorer/api
+
+package thumbnail
+
+const (
+	// Label holds the string label denoting the thumbnail type in the database.
+	Label = "thumbnail"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID          = "id"           // FieldSize holds the string denoting the size vertex property in the database.
+	FieldSize        = "size"         // FieldWidth holds the string denoting the width vertex property in the database.
+	FieldWidth       = "width"        // FieldHeight holds the string denoting the height vertex property in the database.
+	FieldHeight      = "height"       // FieldHash holds the string denoting the hash vertex property in the database.
+	FieldHash        = "hash"         // FieldContentType holds the string denoting the content_type vertex property in the database.
+	FieldContentType = "content_type" // FieldBytes holds the string denoting the bytes vertex property in the database.
+	FieldBytes       = "bytes"
+
+	// EdgeFile holds the string denoting the file edge name in mutations.
+	EdgeFile = "file"
+
+	// Table holds the table name of the thumbnail in the database.
+	Table = "thumbnails"
+	// FileTable is the table the holds the file relation/edge.
+	FileTable = "thumbnails"
+	// FileInverseTable is the table name for the File entity.
+	// It exists in this package in order to avoid circular dependency with the "file" package.
+	FileInverseTable = "files"
+	// FileColumn is the table column denoting the file relation/edge.
+	FileColumn = "file_thumbnails"
+)
+
+// Columns holds all SQL columns for thumbnail fields.
+var Columns = []string{
+	FieldID,
+	FieldSize,
+	FieldWidth,
+	FieldHeight,
+	FieldHash,
+	FieldContentType,
+	FieldBytes,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Thumbnail type.
+var ForeignKeys = []string{
+	"file_thumbnails",
+}
+
+var (
+	// SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	SizeValidator func(int) error
+	// WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	WidthValidator func(int) error
+	// HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	HeightValidator func(int) error
+	// BytesValidator is a validator for the "bytes" field. It is called by the builders before save.
+	BytesValidator func(int64) error
+)
@@ -0,0 +1,246 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/thumbnail"
+)
+
+// ThumbnailCreate is the builder for creating a Thumbnail entity.
+type ThumbnailCreate struct {
+	config
+	mutation *ThumbnailMutation
+	hooks    []Hook
+}
+
+// SetSize sets the size field.
+func (tc *ThumbnailCreate) SetSize(i int) *ThumbnailCreate {
+	tc.mutation.SetSize(i)
+	return tc
+}
+
+// SetWidth sets the width field.
+func (tc *ThumbnailCreate) SetWidth(i int) *ThumbnailCreate {
+	tc.mutation.SetWidth(i)
+	return tc
+}
+
+// SetHeight sets the height field.
+func (tc *ThumbnailCreate) SetHeight(i int) *ThumbnailCreate {
+	tc.mutation.SetHeight(i)
+	return tc
+}
+
+// SetHash sets the hash field.
+func (tc *ThumbnailCreate) SetHash(s string) *ThumbnailCreate {
+	tc.mutation.SetHash(s)
+	return tc
+}
+
+// SetNillableHash sets the hash field if the given value is not nil.
+func (tc *ThumbnailCreate) SetNillableHash(s *string) *ThumbnailCreate {
+	if s != nil {
+		tc.SetHash(*s)
+	}
+	return tc
+}
+
+// SetContentType sets the content_type field.
+func (tc *ThumbnailCreate) SetContentType(s string) *ThumbnailCreate {
+	tc.mutation.SetContentType(s)
+	return tc
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (tc *ThumbnailCreate) SetNillableContentType(s *string) *ThumbnailCreate {
+	if s != nil {
+		tc.SetContentType(*s)
+	}
+	return tc
+}
+
+// SetBytes sets the bytes field.
+func (tc *ThumbnailCreate) SetBytes(i int64) *ThumbnailCreate {
+	tc.mutation.SetBytes(i)
+	return tc
+}
+
+// SetFileID sets the file edge to File by id.
+func (tc *ThumbnailCreate) SetFileID(id uuid.UUID) *ThumbnailCreate {
+	tc.mutation.SetFileID(id)
+	return tc
+}
+
+// SetFile sets the file edge to File.
+func (tc *ThumbnailCreate) SetFile(f *File) *ThumbnailCreate {
+	return tc.SetFileID(f.ID)
+}
+
+// Save creates the Thumbnail in the database.
+func (tc *ThumbnailCreate) Save(ctx context.Context) (*Thumbnail, error) {
+	if _, ok := tc.mutation.Size(); !ok {
+		return nil, errors.New("ent: missing required field \"size\"")
+	}
+	if v, ok := tc.mutation.Size(); ok {
+		if err := thumbnail.SizeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+		}
+	}
+	if _, ok := tc.mutation.Width(); !ok {
+		return nil, errors.New("ent: missing required field \"width\"")
+	}
+	if v, ok := tc.mutation.Width(); ok {
+		if err := thumbnail.WidthValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if _, ok := tc.mutation.Height(); !ok {
+		return nil, errors.New("ent: missing required field \"height\"")
+	}
+	if v, ok := tc.mutation.Height(); ok {
+		if err := thumbnail.HeightValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if _, ok := tc.mutation.Bytes(); !ok {
+		return nil, errors.New("ent: missing required field \"bytes\"")
+	}
+	if v, ok := tc.mutation.Bytes(); ok {
+		if err := thumbnail.BytesValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"bytes\": %v", err)
+		}
+	}
+	if _, ok := tc.mutation.FileID(); !ok {
+		return nil, errors.New("ent: missing required edge \"file\"")
+	}
+	var (
+		err  error
+		node *Thumbnail
+	)
+	if len(tc.hooks) == 0 {
+		node, err = tc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ThumbnailMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tc.mutation = mutation
+			node, err = tc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(tc.hooks) - 1; i >= 0; i-- {
+			mut = tc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tc *ThumbnailCreate) SaveX(ctx context.Context) *Thumbnail {
+	v, err := tc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (tc *ThumbnailCreate) sqlSave(ctx context.Context) (*Thumbnail, error) {
+	var (
+		t     = &Thumbnail{config: tc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: thumbnail.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: thumbnail.FieldID,
+			},
+		}
+	)
+	if value, ok := tc.mutation.Size(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldSize,
+		})
+		t.Size = value
+	}
+	if value, ok := tc.mutation.Width(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldWidth,
+		})
+		t.Width = value
+	}
+	if value, ok := tc.mutation.Height(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldHeight,
+		})
+		t.Height = value
+	}
+	if value, ok := tc.mutation.Hash(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldHash,
+		})
+		t.Hash = value
+	}
+	if value, ok := tc.mutation.ContentType(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldContentType,
+		})
+		t.ContentType = value
+	}
+	if value, ok := tc.mutation.Bytes(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: thumbnail.FieldBytes,
+		})
+		t.Bytes = value
+	}
+	if nodes := tc.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thumbnail.FileTable,
+			Columns: []string{thumbnail.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, tc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	t.ID = int(id)
+	return t, nil
+}
@@ -0,0 +1,108 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/thumbnail"
+)
+
+// ThumbnailDelete is the builder for deleting a Thumbnail entity.
+type ThumbnailDelete struct {
+	config
+	hooks      []Hook
+	mutation   *ThumbnailMutation
+	predicates []predicate.Thumbnail
+}
+
+// Where adds a new predicate to the delete builder.
+func (td *ThumbnailDelete) Where(ps ...predicate.Thumbnail) *ThumbnailDelete {
+	td.predicates = append(td.predicates, ps...)
+	return td
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (td *ThumbnailDelete) Exec(ctx context.Context) (int, error) {
+	var (
+		err      error
+		affected int
+	)
+	if len(td.hooks) == 0 {
+		affected, err = td.sqlExec(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ThumbnailMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			td.mutation = mutation
+			affected, err = td.sqlExec(ctx)
+			return affected, err
+		})
+		for i := len(td.hooks) - 1; i >= 0; i-- {
+			mut = td.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, td.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (td *ThumbnailDelete) ExecX(ctx context.Context) int {
+	n, err := td.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (td *ThumbnailDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := &sqlgraph.DeleteSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table: thumbnail.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: thumbnail.FieldID,
+			},
+		},
+	}
+	if ps := td.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return sqlgraph.DeleteNodes(ctx, td.driver, _spec)
+}
+
+// ThumbnailDeleteOne is the builder for deleting a single Thumbnail entity.
+type ThumbnailDeleteOne struct {
+	td *ThumbnailDelete
+}
+
+// Exec executes the deletion query.
+func (tdo *ThumbnailDeleteOne) Exec(ctx context.Context) error {
+	n, err := tdo.td.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{thumbnail.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdo *ThumbnailDeleteOne) ExecX(ctx context.Context) {
+	tdo.td.ExecX(ctx)
+}
@@ -0,0 +1,697 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/thumbnail"
+)
+
+// ThumbnailUpdate is the builder for updating Thumbnail entities.
+type ThumbnailUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *ThumbnailMutation
+	predicates []predicate.Thumbnail
+}
+
+// Where adds a new predicate for the builder.
+func (tu *ThumbnailUpdate) Where(ps ...predicate.Thumbnail) *ThumbnailUpdate {
+	tu.predicates = append(tu.predicates, ps...)
+	return tu
+}
+
+// SetSize sets the size field.
+func (tu *ThumbnailUpdate) SetSize(i int) *ThumbnailUpdate {
+	tu.mutation.ResetSize()
+	tu.mutation.SetSize(i)
+	return tu
+}
+
+// AddSize adds i to size.
+func (tu *ThumbnailUpdate) AddSize(i int) *ThumbnailUpdate {
+	tu.mutation.AddSize(i)
+	return tu
+}
+
+// SetWidth sets the width field.
+func (tu *ThumbnailUpdate) SetWidth(i int) *ThumbnailUpdate {
+	tu.mutation.ResetWidth()
+	tu.mutation.SetWidth(i)
+	return tu
+}
+
+// AddWidth adds i to width.
+func (tu *ThumbnailUpdate) AddWidth(i int) *ThumbnailUpdate {
+	tu.mutation.AddWidth(i)
+	return tu
+}
+
+// SetHeight sets the height field.
+func (tu *ThumbnailUpdate) SetHeight(i int) *ThumbnailUpdate {
+	tu.mutation.ResetHeight()
+	tu.mutation.SetHeight(i)
+	return tu
+}
+
+// AddHeight adds i to height.
+func (tu *ThumbnailUpdate) AddHeight(i int) *ThumbnailUpdate {
+	tu.mutation.AddHeight(i)
+	return tu
+}
+
+// SetHash sets the hash field.
+func (tu *ThumbnailUpdate) SetHash(s string) *ThumbnailUpdate {
+	tu.mutation.SetHash(s)
+	return tu
+}
+
+// SetNillableHash sets the hash field if the given value is not nil.
+func (tu *ThumbnailUpdate) SetNillableHash(s *string) *ThumbnailUpdate {
+	if s != nil {
+		tu.SetHash(*s)
+	}
+	return tu
+}
+
+// ClearHash clears the value of hash.
+func (tu *ThumbnailUpdate) ClearHash() *ThumbnailUpdate {
+	tu.mutation.ClearHash()
+	return tu
+}
+
+// SetContentType sets the content_type field.
+func (tu *ThumbnailUpdate) SetContentType(s string) *ThumbnailUpdate {
+	tu.mutation.SetContentType(s)
+	return tu
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (tu *ThumbnailUpdate) SetNillableContentType(s *string) *ThumbnailUpdate {
+	if s != nil {
+		tu.SetContentType(*s)
+	}
+	return tu
+}
+
+// ClearContentType clears the value of content_type.
+func (tu *ThumbnailUpdate) ClearContentType() *ThumbnailUpdate {
+	tu.mutation.ClearContentType()
+	return tu
+}
+
+// SetBytes sets the bytes field.
+func (tu *ThumbnailUpdate) SetBytes(i int64) *ThumbnailUpdate {
+	tu.mutation.ResetBytes()
+	tu.mutation.SetBytes(i)
+	return tu
+}
+
+// AddBytes adds i to bytes.
+func (tu *ThumbnailUpdate) AddBytes(i int64) *ThumbnailUpdate {
+	tu.mutation.AddBytes(i)
+	return tu
+}
+
+// SetFileID sets the file edge to File by id.
+func (tu *ThumbnailUpdate) SetFileID(id uuid.UUID) *ThumbnailUpdate {
+	tu.mutation.SetFileID(id)
+	return tu
+}
+
+// SetFile sets the file edge to File.
+func (tu *ThumbnailUpdate) SetFile(f *File) *ThumbnailUpdate {
+	return tu.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (tu *ThumbnailUpdate) ClearFile() *ThumbnailUpdate {
+	tu.mutation.ClearFile()
+	return tu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (tu *ThumbnailUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := tu.mutation.Size(); ok {
+		if err := thumbnail.SizeValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+		}
+	}
+	if v, ok := tu.mutation.Width(); ok {
+		if err := thumbnail.WidthValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if v, ok := tu.mutation.Height(); ok {
+		if err := thumbnail.HeightValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if v, ok := tu.mutation.Bytes(); ok {
+		if err := thumbnail.BytesValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"bytes\": %v", err)
+		}
+	}
+
+	if _, ok := tu.mutation.FileID(); tu.mutation.FileCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(tu.hooks) == 0 {
+		affected, err = tu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ThumbnailMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tu.mutation = mutation
+			affected, err = tu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(tu.hooks) - 1; i >= 0; i-- {
+			mut = tu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tu *ThumbnailUpdate) SaveX(ctx context.Context) int {
+	affected, err := tu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tu *ThumbnailUpdate) Exec(ctx context.Context) error {
+	_, err := tu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tu *ThumbnailUpdate) ExecX(ctx context.Context) {
+	if err := tu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tu *ThumbnailUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   thumbnail.Table,
+			Columns: thumbnail.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: thumbnail.FieldID,
+			},
+		},
+	}
+	if ps := tu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tu.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldSize,
+		})
+	}
+	if value, ok := tu.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldSize,
+		})
+	}
+	if value, ok := tu.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldWidth,
+		})
+	}
+	if value, ok := tu.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldWidth,
+		})
+	}
+	if value, ok := tu.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldHeight,
+		})
+	}
+	if value, ok := tu.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldHeight,
+		})
+	}
+	if value, ok := tu.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldHash,
+		})
+	}
+	if tu.mutation.HashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: thumbnail.FieldHash,
+		})
+	}
+	if value, ok := tu.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldContentType,
+		})
+	}
+	if tu.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: thumbnail.FieldContentType,
+		})
+	}
+	if value, ok := tu.mutation.Bytes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: thumbnail.FieldBytes,
+		})
+	}
+	if value, ok := tu.mutation.AddedBytes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: thumbnail.FieldBytes,
+		})
+	}
+	if tu.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thumbnail.FileTable,
+			Columns: []string{thumbnail.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tu.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thumbnail.FileTable,
+			Columns: []string{thumbnail.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thumbnail.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// ThumbnailUpdateOne is the builder for updating a single Thumbnail entity.
+type ThumbnailUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *ThumbnailMutation
+}
+
+// SetSize sets the size field.
+func (tuo *ThumbnailUpdateOne) SetSize(i int) *ThumbnailUpdateOne {
+	tuo.mutation.ResetSize()
+	tuo.mutation.SetSize(i)
+	return tuo
+}
+
+// AddSize adds i to size.
+func (tuo *ThumbnailUpdateOne) AddSize(i int) *ThumbnailUpdateOne {
+	tuo.mutation.AddSize(i)
+	return tuo
+}
+
+// SetWidth sets the width field.
+func (tuo *ThumbnailUpdateOne) SetWidth(i int) *ThumbnailUpdateOne {
+	tuo.mutation.ResetWidth()
+	tuo.mutation.SetWidth(i)
+	return tuo
+}
+
+// AddWidth adds i to width.
+func (tuo *ThumbnailUpdateOne) AddWidth(i int) *ThumbnailUpdateOne {
+	tuo.mutation.AddWidth(i)
+	return tuo
+}
+
+// SetHeight sets the height field.
+func (tuo *ThumbnailUpdateOne) SetHeight(i int) *ThumbnailUpdateOne {
+	tuo.mutation.ResetHeight()
+	tuo.mutation.SetHeight(i)
+	return tuo
+}
+
+// AddHeight adds i to height.
+func (tuo *ThumbnailUpdateOne) AddHeight(i int) *ThumbnailUpdateOne {
+	tuo.mutation.AddHeight(i)
+	return tuo
+}
+
+// SetHash sets the hash field.
+func (tuo *ThumbnailUpdateOne) SetHash(s string) *ThumbnailUpdateOne {
+	tuo.mutation.SetHash(s)
+	return tuo
+}
+
+// SetNillableHash sets the hash field if the given value is not nil.
+func (tuo *ThumbnailUpdateOne) SetNillableHash(s *string) *ThumbnailUpdateOne {
+	if s != nil {
+		tuo.SetHash(*s)
+	}
+	return tuo
+}
+
+// ClearHash clears the value of hash.
+func (tuo *ThumbnailUpdateOne) ClearHash() *ThumbnailUpdateOne {
+	tuo.mutation.ClearHash()
+	return tuo
+}
+
+// SetContentType sets the content_type field.
+func (tuo *ThumbnailUpdateOne) SetContentType(s string) *ThumbnailUpdateOne {
+	tuo.mutation.SetContentType(s)
+	return tuo
+}
+
+// SetNillableContentType sets the content_type field if the given value is not nil.
+func (tuo *ThumbnailUpdateOne) SetNillableContentType(s *string) *ThumbnailUpdateOne {
+	if s != nil {
+		tuo.SetContentType(*s)
+	}
+	return tuo
+}
+
+// ClearContentType clears the value of content_type.
+func (tuo *ThumbnailUpdateOne) ClearContentType() *ThumbnailUpdateOne {
+	tuo.mutation.ClearContentType()
+	return tuo
+}
+
+// SetBytes sets the bytes field.
+func (tuo *ThumbnailUpdateOne) SetBytes(i int64) *ThumbnailUpdateOne {
+	tuo.mutation.ResetBytes()
+	tuo.mutation.SetBytes(i)
+	return tuo
+}
+
+// AddBytes adds i to bytes.
+func (tuo *ThumbnailUpdateOne) AddBytes(i int64) *ThumbnailUpdateOne {
+	tuo.mutation.AddBytes(i)
+	return tuo
+}
+
+// SetFileID sets the file edge to File by id.
+func (tuo *ThumbnailUpdateOne) SetFileID(id uuid.UUID) *ThumbnailUpdateOne {
+	tuo.mutation.SetFileID(id)
+	return tuo
+}
+
+// SetFile sets the file edge to File.
+func (tuo *ThumbnailUpdateOne) SetFile(f *File) *ThumbnailUpdateOne {
+	return tuo.SetFileID(f.ID)
+}
+
+// ClearFile clears the file edge to File.
+func (tuo *ThumbnailUpdateOne) ClearFile() *ThumbnailUpdateOne {
+	tuo.mutation.ClearFile()
+	return tuo
+}
+
+// Save executes the query and returns the updated entity.
+func (tuo *ThumbnailUpdateOne) Save(ctx context.Context) (*Thumbnail, error) {
+	if v, ok := tuo.mutation.Size(); ok {
+		if err := thumbnail.SizeValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"size\": %v", err)
+		}
+	}
+	if v, ok := tuo.mutation.Width(); ok {
+		if err := thumbnail.WidthValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"width\": %v", err)
+		}
+	}
+	if v, ok := tuo.mutation.Height(); ok {
+		if err := thumbnail.HeightValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"height\": %v", err)
+		}
+	}
+	if v, ok := tuo.mutation.Bytes(); ok {
+		if err := thumbnail.BytesValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"bytes\": %v", err)
+		}
+	}
+
+	if _, ok := tuo.mutation.FileID(); tuo.mutation.FileCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"file\"")
+	}
+	var (
+		err  error
+		node *Thumbnail
+	)
+	if len(tuo.hooks) == 0 {
+		node, err = tuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*ThumbnailMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			tuo.mutation = mutation
+			node, err = tuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(tuo.hooks) - 1; i >= 0; i-- {
+			mut = tuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, tuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tuo *ThumbnailUpdateOne) SaveX(ctx context.Context) *Thumbnail {
+	t, err := tuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Exec executes the query on the entity.
+func (tuo *ThumbnailUpdateOne) Exec(ctx context.Context) error {
+	_, err := tuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tuo *ThumbnailUpdateOne) ExecX(ctx context.Context) {
+	if err := tuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (tuo *ThumbnailUpdateOne) sqlSave(ctx context.Context) (t *Thumbnail, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   thumbnail.Table,
+			Columns: thumbnail.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: thumbnail.FieldID,
+			},
+		},
+	}
+	id, ok := tuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Thumbnail.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := tuo.mutation.Size(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldSize,
+		})
+	}
+	if value, ok := tuo.mutation.AddedSize(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldSize,
+		})
+	}
+	if value, ok := tuo.mutation.Width(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldWidth,
+		})
+	}
+	if value, ok := tuo.mutation.AddedWidth(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldWidth,
+		})
+	}
+	if value, ok := tuo.mutation.Height(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldHeight,
+		})
+	}
+	if value, ok := tuo.mutation.AddedHeight(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: thumbnail.FieldHeight,
+		})
+	}
+	if value, ok := tuo.mutation.Hash(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldHash,
+		})
+	}
+	if tuo.mutation.HashCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: thumbnail.FieldHash,
+		})
+	}
+	if value, ok := tuo.mutation.ContentType(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: thumbnail.FieldContentType,
+		})
+	}
+	if tuo.mutation.ContentTypeCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: thumbnail.FieldContentType,
+		})
+	}
+	if value, ok := tuo.mutation.Bytes(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: thumbnail.FieldBytes,
+		})
+	}
+	if value, ok := tuo.mutation.AddedBytes(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt64,
+			Value:  value,
+			Column: thumbnail.FieldBytes,
+		})
+	}
+	if tuo.mutation.FileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thumbnail.FileTable,
+			Columns: []string{thumbnail.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tuo.mutation.FileIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   thumbnail.FileTable,
+			Columns: []string{thumbnail.FileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: file.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	t = &Thumbnail{config: tuo.config}
+	_spec.Assign = t.assignValues
+	_spec.ScanValues = t.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, tuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{thumbnail.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return t, nil
+}
@@ -12,12 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// Bucket is the client for interacting with the Bucket builders.
+	Bucket *BucketClient
 	// File is the client for interacting with the File builders.
 	File *FileClient
+	// FileKey is the client for interacting with the FileKey builders.
+	FileKey *FileKeyClient
+	// FileMedia is the client for interacting with the FileMedia builders.
+	FileMedia *FileMediaClient
+	// FileProperty is the client for interacting with the FileProperty builders.
+	FileProperty *FilePropertyClient
+	// FileTag is the client for interacting with the FileTag builders.
+	FileTag *FileTagClient
+	// Folder is the client for interacting with the Folder builders.
+	Folder *FolderClient
+	// Share is the client for interacting with the Share builders.
+	Share *ShareClient
+	// Thumbnail is the client for interacting with the Thumbnail builders.
+	Thumbnail *ThumbnailClient
 	// Token is the client for interacting with the Token builders.
 	Token *TokenClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Webhook is the client for interacting with the Webhook builders.
+	Webhook *WebhookClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
 
 	// lazily loaded.
 	client     *Client
@@ -75,9 +97,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.Bucket = NewBucketClient(tx.config)
 	tx.File = NewFileClient(tx.config)
+	tx.FileKey = NewFileKeyClient(tx.config)
+	tx.FileMedia = NewFileMediaClient(tx.config)
+	tx.FileProperty = NewFilePropertyClient(tx.config)
+	tx.FileTag = NewFileTagClient(tx.config)
+	tx.Folder = NewFolderClient(tx.config)
+	tx.Share = NewShareClient(tx.config)
+	tx.Thumbnail = NewThumbnailClient(tx.config)
 	tx.Token = NewTokenClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Webhook = NewWebhookClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -87,7 +120,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: File.QueryXXX(), the query will be executed
+// applies a query, for example: AuditLog.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -39,9 +39,19 @@ type UserEdges struct {
 	Tokens []*Token
 	// Files holds the value of the files edge.
 	Files []*File
+	// AuditLogs holds the value of the audit_logs edge.
+	AuditLogs []*AuditLog
+	// Webhooks holds the value of the webhooks edge.
+	Webhooks []*Webhook
+	// Buckets holds the value of the buckets edge.
+	Buckets []*Bucket
+	// Folders holds the value of the folders edge.
+	Folders []*Folder
+	// Shares holds the value of the shares edge.
+	Shares []*Share
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [7]bool
 }
 
 // TokensOrErr returns the Tokens value or an error if the edge
@@ -62,6 +72,51 @@ func (e UserEdges) FilesOrErr() ([]*File, error) {
 	return nil, &NotLoadedError{edge: "files"}
 }
 
+// AuditLogsOrErr returns the AuditLogs value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuditLogsOrErr() ([]*AuditLog, error) {
+	if e.loadedTypes[2] {
+		return e.AuditLogs, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_logs"}
+}
+
+// WebhooksOrErr returns the Webhooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WebhooksOrErr() ([]*Webhook, error) {
+	if e.loadedTypes[3] {
+		return e.Webhooks, nil
+	}
+	return nil, &NotLoadedError{edge: "webhooks"}
+}
+
+// BucketsOrErr returns the Buckets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BucketsOrErr() ([]*Bucket, error) {
+	if e.loadedTypes[4] {
+		return e.Buckets, nil
+	}
+	return nil, &NotLoadedError{edge: "buckets"}
+}
+
+// FoldersOrErr returns the Folders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FoldersOrErr() ([]*Folder, error) {
+	if e.loadedTypes[5] {
+		return e.Folders, nil
+	}
+	return nil, &NotLoadedError{edge: "folders"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SharesOrErr() ([]*Share, error) {
+	if e.loadedTypes[6] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues() []interface{} {
 	return []interface{}{
@@ -130,6 +185,31 @@ func (u *User) QueryFiles() *FileQuery {
 	return (&UserClient{config: u.config}).QueryFiles(u)
 }
 
+// QueryAuditLogs queries the audit_logs edge of the User.
+func (u *User) QueryAuditLogs() *AuditLogQuery {
+	return (&UserClient{config: u.config}).QueryAuditLogs(u)
+}
+
+// QueryWebhooks queries the webhooks edge of the User.
+func (u *User) QueryWebhooks() *WebhookQuery {
+	return (&UserClient{config: u.config}).QueryWebhooks(u)
+}
+
+// QueryBuckets queries the buckets edge of the User.
+func (u *User) QueryBuckets() *BucketQuery {
+	return (&UserClient{config: u.config}).QueryBuckets(u)
+}
+
+// QueryFolders queries the folders edge of the User.
+func (u *User) QueryFolders() *FolderQuery {
+	return (&UserClient{config: u.config}).QueryFolders(u)
+}
+
+// QueryShares queries the shares edge of the User.
+func (u *User) QueryShares() *ShareQuery {
+	return (&UserClient{config: u.config}).QueryShares(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that, you need to call User.Unwrap() before calling this method, if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
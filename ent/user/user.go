@@ -23,6 +23,16 @@ const (
 	EdgeTokens = "tokens"
 	// EdgeFiles holds the string denoting the files edge name in mutations.
 	EdgeFiles = "files"
+	// EdgeAuditLogs holds the string denoting the audit_logs edge name in mutations.
+	EdgeAuditLogs = "audit_logs"
+	// EdgeWebhooks holds the string denoting the webhooks edge name in mutations.
+	EdgeWebhooks = "webhooks"
+	// EdgeBuckets holds the string denoting the buckets edge name in mutations.
+	EdgeBuckets = "buckets"
+	// EdgeFolders holds the string denoting the folders edge name in mutations.
+	EdgeFolders = "folders"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
 
 	// Table holds the table name of the user in the database.
 	Table = "users"
@@ -40,6 +50,41 @@ const (
 	FilesInverseTable = "files"
 	// FilesColumn is the table column denoting the files relation/edge.
 	FilesColumn = "user_files"
+	// AuditLogsTable is the table the holds the audit_logs relation/edge.
+	AuditLogsTable = "audit_logs"
+	// AuditLogsInverseTable is the table name for the AuditLog entity.
+	// It exists in this package in order to avoid circular dependency with the "auditlog" package.
+	AuditLogsInverseTable = "audit_logs"
+	// AuditLogsColumn is the table column denoting the audit_logs relation/edge.
+	AuditLogsColumn = "user_audit_logs"
+	// WebhooksTable is the table the holds the webhooks relation/edge.
+	WebhooksTable = "webhooks"
+	// WebhooksInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhooksInverseTable = "webhooks"
+	// WebhooksColumn is the table column denoting the webhooks relation/edge.
+	WebhooksColumn = "user_webhooks"
+	// BucketsTable is the table the holds the buckets relation/edge.
+	BucketsTable = "buckets"
+	// BucketsInverseTable is the table name for the Bucket entity.
+	// It exists in this package in order to avoid circular dependency with the "bucket" package.
+	BucketsInverseTable = "buckets"
+	// BucketsColumn is the table column denoting the buckets relation/edge.
+	BucketsColumn = "user_buckets"
+	// FoldersTable is the table the holds the folders relation/edge.
+	FoldersTable = "folders"
+	// FoldersInverseTable is the table name for the Folder entity.
+	// It exists in this package in order to avoid circular dependency with the "folder" package.
+	FoldersInverseTable = "folders"
+	// FoldersColumn is the table column denoting the folders relation/edge.
+	FoldersColumn = "user_folders"
+	// SharesTable is the table the holds the shares relation/edge.
+	SharesTable = "shares"
+	// SharesInverseTable is the table name for the Share entity.
+	// It exists in this package in order to avoid circular dependency with the "share" package.
+	SharesInverseTable = "shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "user_shares"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -11,9 +11,14 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
+	"github.com/sthorer/api/ent/share"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -121,6 +126,81 @@ func (uc *UserCreate) AddFiles(f ...*File) *UserCreate {
 	return uc.AddFileIDs(ids...)
 }
 
+// AddAuditLogIDs adds the audit_logs edge to AuditLog by ids.
+func (uc *UserCreate) AddAuditLogIDs(ids ...int) *UserCreate {
+	uc.mutation.AddAuditLogIDs(ids...)
+	return uc
+}
+
+// AddAuditLogs adds the audit_logs edges to AuditLog.
+func (uc *UserCreate) AddAuditLogs(a ...*AuditLog) *UserCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uc.AddAuditLogIDs(ids...)
+}
+
+// AddWebhookIDs adds the webhooks edge to Webhook by ids.
+func (uc *UserCreate) AddWebhookIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddWebhookIDs(ids...)
+	return uc
+}
+
+// AddWebhooks adds the webhooks edges to Webhook.
+func (uc *UserCreate) AddWebhooks(w ...*Webhook) *UserCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uc.AddWebhookIDs(ids...)
+}
+
+// AddBucketIDs adds the buckets edge to Bucket by ids.
+func (uc *UserCreate) AddBucketIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddBucketIDs(ids...)
+	return uc
+}
+
+// AddBuckets adds the buckets edges to Bucket.
+func (uc *UserCreate) AddBuckets(b ...*Bucket) *UserCreate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uc.AddBucketIDs(ids...)
+}
+
+// AddFolderIDs adds the folders edge to Folder by ids.
+func (uc *UserCreate) AddFolderIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddFolderIDs(ids...)
+	return uc
+}
+
+// AddFolders adds the folders edges to Folder.
+func (uc *UserCreate) AddFolders(f ...*Folder) *UserCreate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uc.AddFolderIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (uc *UserCreate) AddShareIDs(ids ...uuid.UUID) *UserCreate {
+	uc.mutation.AddShareIDs(ids...)
+	return uc
+}
+
+// AddShares adds the shares edges to Share.
+func (uc *UserCreate) AddShares(s ...*Share) *UserCreate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uc.AddShareIDs(ids...)
+}
+
 // Save creates the User in the database.
 func (uc *UserCreate) Save(ctx context.Context) (*User, error) {
 	if _, ok := uc.mutation.Email(); !ok {
@@ -292,6 +372,101 @@ func (uc *UserCreate) sqlSave(ctx context.Context) (*User, error) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := uc.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.BucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BucketsTable,
+			Columns: []string{user.BucketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uc.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if err := sqlgraph.CreateNode(ctx, uc.driver, _spec); err != nil {
 		if cerr, ok := isSQLConstraintError(err); ok {
 			err = cerr
@@ -12,10 +12,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql"
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
 	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/share"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
 )
 
 // UserQuery is the builder for querying User entities.
@@ -27,8 +32,13 @@ type UserQuery struct {
 	unique     []string
 	predicates []predicate.User
 	// eager-loading edges.
-	withTokens *TokenQuery
-	withFiles  *FileQuery
+	withTokens    *TokenQuery
+	withFiles     *FileQuery
+	withAuditLogs *AuditLogQuery
+	withWebhooks  *WebhookQuery
+	withBuckets   *BucketQuery
+	withFolders   *FolderQuery
+	withShares    *ShareQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -94,6 +104,96 @@ func (uq *UserQuery) QueryFiles() *FileQuery {
 	return query
 }
 
+// QueryAuditLogs chains the current query on the audit_logs edge.
+func (uq *UserQuery) QueryAuditLogs() *AuditLogQuery {
+	query := &AuditLogQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(auditlog.Table, auditlog.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuditLogsTable, user.AuditLogsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhooks chains the current query on the webhooks edge.
+func (uq *UserQuery) QueryWebhooks() *WebhookQuery {
+	query := &WebhookQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(webhook.Table, webhook.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WebhooksTable, user.WebhooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBuckets chains the current query on the buckets edge.
+func (uq *UserQuery) QueryBuckets() *BucketQuery {
+	query := &BucketQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(bucket.Table, bucket.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BucketsTable, user.BucketsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFolders chains the current query on the folders edge.
+func (uq *UserQuery) QueryFolders() *FolderQuery {
+	query := &FolderQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(folder.Table, folder.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.FoldersTable, user.FoldersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShares chains the current query on the shares edge.
+func (uq *UserQuery) QueryShares() *ShareQuery {
+	query := &ShareQuery{config: uq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, uq.sqlQuery()),
+			sqlgraph.To(share.Table, share.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SharesTable, user.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity in the query. Returns *NotFoundError when no user was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
 	us, err := uq.Limit(1).All(ctx)
@@ -295,6 +395,61 @@ func (uq *UserQuery) WithFiles(opts ...func(*FileQuery)) *UserQuery {
 	return uq
 }
 
+//  WithAuditLogs tells the query-builder to eager-loads the nodes that are connected to
+// the "audit_logs" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithAuditLogs(opts ...func(*AuditLogQuery)) *UserQuery {
+	query := &AuditLogQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withAuditLogs = query
+	return uq
+}
+
+//  WithWebhooks tells the query-builder to eager-loads the nodes that are connected to
+// the "webhooks" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithWebhooks(opts ...func(*WebhookQuery)) *UserQuery {
+	query := &WebhookQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withWebhooks = query
+	return uq
+}
+
+//  WithBuckets tells the query-builder to eager-loads the nodes that are connected to
+// the "buckets" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithBuckets(opts ...func(*BucketQuery)) *UserQuery {
+	query := &BucketQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withBuckets = query
+	return uq
+}
+
+//  WithFolders tells the query-builder to eager-loads the nodes that are connected to
+// the "folders" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithFolders(opts ...func(*FolderQuery)) *UserQuery {
+	query := &FolderQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withFolders = query
+	return uq
+}
+
+//  WithShares tells the query-builder to eager-loads the nodes that are connected to
+// the "shares" edge. The optional arguments used to configure the query builder of the edge.
+func (uq *UserQuery) WithShares(opts ...func(*ShareQuery)) *UserQuery {
+	query := &ShareQuery{config: uq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withShares = query
+	return uq
+}
+
 // GroupBy used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -361,9 +516,14 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [7]bool{
 			uq.withTokens != nil,
 			uq.withFiles != nil,
+			uq.withAuditLogs != nil,
+			uq.withWebhooks != nil,
+			uq.withBuckets != nil,
+			uq.withFolders != nil,
+			uq.withShares != nil,
 		}
 	)
 	_spec.ScanValues = func() []interface{} {
@@ -443,6 +603,146 @@ func (uq *UserQuery) sqlAll(ctx context.Context) ([]*User, error) {
 		}
 	}
 
+	if query := uq.withAuditLogs; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.AuditLog(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.AuditLogsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_audit_logs
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_audit_logs" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_audit_logs" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.AuditLogs = append(node.Edges.AuditLogs, n)
+		}
+	}
+
+	if query := uq.withWebhooks; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Webhook(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.WebhooksColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_webhooks
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_webhooks" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_webhooks" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Webhooks = append(node.Edges.Webhooks, n)
+		}
+	}
+
+	if query := uq.withBuckets; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Bucket(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.BucketsColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_buckets
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_buckets" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_buckets" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Buckets = append(node.Edges.Buckets, n)
+		}
+	}
+
+	if query := uq.withFolders; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Folder(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.FoldersColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_folders
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_folders" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_folders" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Folders = append(node.Edges.Folders, n)
+		}
+	}
+
+	if query := uq.withShares; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[int]*User)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.Share(func(s *sql.Selector) {
+			s.Where(sql.InValues(user.SharesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.user_shares
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "user_shares" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_shares" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Shares = append(node.Edges.Shares, n)
+		}
+	}
+
 	return nodes, nil
 }
 
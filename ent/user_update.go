@@ -11,10 +11,15 @@ import (
 	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
 	"github.com/facebookincubator/ent/schema/field"
 	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/auditlog"
+	"github.com/sthorer/api/ent/bucket"
 	"github.com/sthorer/api/ent/file"
+	"github.com/sthorer/api/ent/folder"
 	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/share"
 	"github.com/sthorer/api/ent/token"
 	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -107,6 +112,81 @@ func (uu *UserUpdate) AddFiles(f ...*File) *UserUpdate {
 	return uu.AddFileIDs(ids...)
 }
 
+// AddAuditLogIDs adds the audit_logs edge to AuditLog by ids.
+func (uu *UserUpdate) AddAuditLogIDs(ids ...int) *UserUpdate {
+	uu.mutation.AddAuditLogIDs(ids...)
+	return uu
+}
+
+// AddAuditLogs adds the audit_logs edges to AuditLog.
+func (uu *UserUpdate) AddAuditLogs(a ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.AddAuditLogIDs(ids...)
+}
+
+// AddWebhookIDs adds the webhooks edge to Webhook by ids.
+func (uu *UserUpdate) AddWebhookIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddWebhookIDs(ids...)
+	return uu
+}
+
+// AddWebhooks adds the webhooks edges to Webhook.
+func (uu *UserUpdate) AddWebhooks(w ...*Webhook) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.AddWebhookIDs(ids...)
+}
+
+// AddBucketIDs adds the buckets edge to Bucket by ids.
+func (uu *UserUpdate) AddBucketIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddBucketIDs(ids...)
+	return uu
+}
+
+// AddBuckets adds the buckets edges to Bucket.
+func (uu *UserUpdate) AddBuckets(b ...*Bucket) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.AddBucketIDs(ids...)
+}
+
+// AddFolderIDs adds the folders edge to Folder by ids.
+func (uu *UserUpdate) AddFolderIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddFolderIDs(ids...)
+	return uu
+}
+
+// AddFolders adds the folders edges to Folder.
+func (uu *UserUpdate) AddFolders(f ...*Folder) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.AddFolderIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (uu *UserUpdate) AddShareIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.AddShareIDs(ids...)
+	return uu
+}
+
+// AddShares adds the shares edges to Share.
+func (uu *UserUpdate) AddShares(s ...*Share) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.AddShareIDs(ids...)
+}
+
 // RemoveTokenIDs removes the tokens edge to Token by ids.
 func (uu *UserUpdate) RemoveTokenIDs(ids ...uuid.UUID) *UserUpdate {
 	uu.mutation.RemoveTokenIDs(ids...)
@@ -137,6 +217,81 @@ func (uu *UserUpdate) RemoveFiles(f ...*File) *UserUpdate {
 	return uu.RemoveFileIDs(ids...)
 }
 
+// RemoveAuditLogIDs removes the audit_logs edge to AuditLog by ids.
+func (uu *UserUpdate) RemoveAuditLogIDs(ids ...int) *UserUpdate {
+	uu.mutation.RemoveAuditLogIDs(ids...)
+	return uu
+}
+
+// RemoveAuditLogs removes audit_logs edges to AuditLog.
+func (uu *UserUpdate) RemoveAuditLogs(a ...*AuditLog) *UserUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uu.RemoveAuditLogIDs(ids...)
+}
+
+// RemoveWebhookIDs removes the webhooks edge to Webhook by ids.
+func (uu *UserUpdate) RemoveWebhookIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveWebhookIDs(ids...)
+	return uu
+}
+
+// RemoveWebhooks removes webhooks edges to Webhook.
+func (uu *UserUpdate) RemoveWebhooks(w ...*Webhook) *UserUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uu.RemoveWebhookIDs(ids...)
+}
+
+// RemoveBucketIDs removes the buckets edge to Bucket by ids.
+func (uu *UserUpdate) RemoveBucketIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveBucketIDs(ids...)
+	return uu
+}
+
+// RemoveBuckets removes buckets edges to Bucket.
+func (uu *UserUpdate) RemoveBuckets(b ...*Bucket) *UserUpdate {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uu.RemoveBucketIDs(ids...)
+}
+
+// RemoveFolderIDs removes the folders edge to Folder by ids.
+func (uu *UserUpdate) RemoveFolderIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveFolderIDs(ids...)
+	return uu
+}
+
+// RemoveFolders removes folders edges to Folder.
+func (uu *UserUpdate) RemoveFolders(f ...*Folder) *UserUpdate {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uu.RemoveFolderIDs(ids...)
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (uu *UserUpdate) RemoveShareIDs(ids ...uuid.UUID) *UserUpdate {
+	uu.mutation.RemoveShareIDs(ids...)
+	return uu
+}
+
+// RemoveShares removes shares edges to Share.
+func (uu *UserUpdate) RemoveShares(s ...*Share) *UserUpdate {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uu.RemoveShareIDs(ids...)
+}
+
 // Save executes the query and returns the number of rows/vertices matched by this operation.
 func (uu *UserUpdate) Save(ctx context.Context) (int, error) {
 	if v, ok := uu.mutation.Email(); ok {
@@ -336,6 +491,196 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nodes := uu.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedWebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedBucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BucketsTable,
+			Columns: []string{user.BucketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.BucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BucketsTable,
+			Columns: []string{user.BucketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uu.mutation.RemovedSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uu.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -430,6 +775,81 @@ func (uuo *UserUpdateOne) AddFiles(f ...*File) *UserUpdateOne {
 	return uuo.AddFileIDs(ids...)
 }
 
+// AddAuditLogIDs adds the audit_logs edge to AuditLog by ids.
+func (uuo *UserUpdateOne) AddAuditLogIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.AddAuditLogIDs(ids...)
+	return uuo
+}
+
+// AddAuditLogs adds the audit_logs edges to AuditLog.
+func (uuo *UserUpdateOne) AddAuditLogs(a ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.AddAuditLogIDs(ids...)
+}
+
+// AddWebhookIDs adds the webhooks edge to Webhook by ids.
+func (uuo *UserUpdateOne) AddWebhookIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddWebhookIDs(ids...)
+	return uuo
+}
+
+// AddWebhooks adds the webhooks edges to Webhook.
+func (uuo *UserUpdateOne) AddWebhooks(w ...*Webhook) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.AddWebhookIDs(ids...)
+}
+
+// AddBucketIDs adds the buckets edge to Bucket by ids.
+func (uuo *UserUpdateOne) AddBucketIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddBucketIDs(ids...)
+	return uuo
+}
+
+// AddBuckets adds the buckets edges to Bucket.
+func (uuo *UserUpdateOne) AddBuckets(b ...*Bucket) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.AddBucketIDs(ids...)
+}
+
+// AddFolderIDs adds the folders edge to Folder by ids.
+func (uuo *UserUpdateOne) AddFolderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddFolderIDs(ids...)
+	return uuo
+}
+
+// AddFolders adds the folders edges to Folder.
+func (uuo *UserUpdateOne) AddFolders(f ...*Folder) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.AddFolderIDs(ids...)
+}
+
+// AddShareIDs adds the shares edge to Share by ids.
+func (uuo *UserUpdateOne) AddShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.AddShareIDs(ids...)
+	return uuo
+}
+
+// AddShares adds the shares edges to Share.
+func (uuo *UserUpdateOne) AddShares(s ...*Share) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.AddShareIDs(ids...)
+}
+
 // RemoveTokenIDs removes the tokens edge to Token by ids.
 func (uuo *UserUpdateOne) RemoveTokenIDs(ids ...uuid.UUID) *UserUpdateOne {
 	uuo.mutation.RemoveTokenIDs(ids...)
@@ -460,6 +880,81 @@ func (uuo *UserUpdateOne) RemoveFiles(f ...*File) *UserUpdateOne {
 	return uuo.RemoveFileIDs(ids...)
 }
 
+// RemoveAuditLogIDs removes the audit_logs edge to AuditLog by ids.
+func (uuo *UserUpdateOne) RemoveAuditLogIDs(ids ...int) *UserUpdateOne {
+	uuo.mutation.RemoveAuditLogIDs(ids...)
+	return uuo
+}
+
+// RemoveAuditLogs removes audit_logs edges to AuditLog.
+func (uuo *UserUpdateOne) RemoveAuditLogs(a ...*AuditLog) *UserUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return uuo.RemoveAuditLogIDs(ids...)
+}
+
+// RemoveWebhookIDs removes the webhooks edge to Webhook by ids.
+func (uuo *UserUpdateOne) RemoveWebhookIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveWebhookIDs(ids...)
+	return uuo
+}
+
+// RemoveWebhooks removes webhooks edges to Webhook.
+func (uuo *UserUpdateOne) RemoveWebhooks(w ...*Webhook) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return uuo.RemoveWebhookIDs(ids...)
+}
+
+// RemoveBucketIDs removes the buckets edge to Bucket by ids.
+func (uuo *UserUpdateOne) RemoveBucketIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveBucketIDs(ids...)
+	return uuo
+}
+
+// RemoveBuckets removes buckets edges to Bucket.
+func (uuo *UserUpdateOne) RemoveBuckets(b ...*Bucket) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(b))
+	for i := range b {
+		ids[i] = b[i].ID
+	}
+	return uuo.RemoveBucketIDs(ids...)
+}
+
+// RemoveFolderIDs removes the folders edge to Folder by ids.
+func (uuo *UserUpdateOne) RemoveFolderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveFolderIDs(ids...)
+	return uuo
+}
+
+// RemoveFolders removes folders edges to Folder.
+func (uuo *UserUpdateOne) RemoveFolders(f ...*Folder) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(f))
+	for i := range f {
+		ids[i] = f[i].ID
+	}
+	return uuo.RemoveFolderIDs(ids...)
+}
+
+// RemoveShareIDs removes the shares edge to Share by ids.
+func (uuo *UserUpdateOne) RemoveShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	uuo.mutation.RemoveShareIDs(ids...)
+	return uuo
+}
+
+// RemoveShares removes shares edges to Share.
+func (uuo *UserUpdateOne) RemoveShares(s ...*Share) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return uuo.RemoveShareIDs(ids...)
+}
+
 // Save executes the query and returns the updated entity.
 func (uuo *UserUpdateOne) Save(ctx context.Context) (*User, error) {
 	if v, ok := uuo.mutation.Email(); ok {
@@ -657,6 +1152,196 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (u *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if nodes := uuo.mutation.RemovedAuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.AuditLogsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuditLogsTable,
+			Columns: []string{user.AuditLogsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: auditlog.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedWebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.WebhooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WebhooksTable,
+			Columns: []string{user.WebhooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedBucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BucketsTable,
+			Columns: []string{user.BucketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.BucketsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BucketsTable,
+			Columns: []string{user.BucketsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: bucket.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedFoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.FoldersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.FoldersTable,
+			Columns: []string{user.FoldersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: folder.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := uuo.mutation.RemovedSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uuo.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SharesTable,
+			Columns: []string{user.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: share.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	u = &User{config: uuo.config}
 	_spec.Assign = u.assignValues
 	_spec.ScanValues = u.scanValues()
@@ -0,0 +1,196 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+)
+
+// Webhook is the model entity for the Webhook schema.
+type Webhook struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Events holds the value of the "events" field.
+	Events []string `json:"events,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookQuery when eager-loading is set.
+	Edges         WebhookEdges `json:"edges"`
+	user_webhooks *int
+}
+
+// WebhookEdges holds the relations/edges for other nodes in the graph.
+type WebhookEdges struct {
+	// User holds the value of the user edge.
+	User *User
+	// Deliveries holds the value of the deliveries edge.
+	Deliveries []*WebhookDelivery
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookEdges) UserOrErr() (*User, error) {
+	if e.loadedTypes[0] {
+		if e.User == nil {
+			// The edge user was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: user.Label}
+		}
+		return e.User, nil
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// DeliveriesOrErr returns the Deliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e WebhookEdges) DeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[1] {
+		return e.Deliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "deliveries"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Webhook) scanValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},      // id
+		&sql.NullString{}, // url
+		&[]byte{},         // events
+		&sql.NullString{}, // secret
+		&sql.NullBool{},   // active
+		&sql.NullTime{},   // created_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*Webhook) fkValues() []interface{} {
+	return []interface{}{
+		&sql.NullInt64{}, // user_webhooks
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Webhook fields.
+func (w *Webhook) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(webhook.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	if value, ok := values[0].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field id", values[0])
+	} else if value != nil {
+		w.ID = *value
+	}
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field url", values[0])
+	} else if value.Valid {
+		w.URL = value.String
+	}
+
+	if value, ok := values[1].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field events", values[1])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &w.Events); err != nil {
+			return fmt.Errorf("unmarshal field events: %v", err)
+		}
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field secret", values[2])
+	} else if value.Valid {
+		w.Secret = value.String
+	}
+	if value, ok := values[3].(*sql.NullBool); !ok {
+		return fmt.Errorf("unexpected type %T for field active", values[3])
+	} else if value.Valid {
+		w.Active = value.Bool
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[4])
+	} else if value.Valid {
+		w.CreatedAt = value.Time
+	}
+	values = values[5:]
+	if len(values) == len(webhook.ForeignKeys) {
+		if value, ok := values[0].(*sql.NullInt64); !ok {
+			return fmt.Errorf("unexpected type %T for edge-field user_webhooks", value)
+		} else if value.Valid {
+			w.user_webhooks = new(int)
+			*w.user_webhooks = int(value.Int64)
+		}
+	}
+	return nil
+}
+
+// QueryUser queries the user edge of the Webhook.
+func (w *Webhook) QueryUser() *UserQuery {
+	return (&WebhookClient{config: w.config}).QueryUser(w)
+}
+
+// QueryDeliveries queries the deliveries edge of the Webhook.
+func (w *Webhook) QueryDeliveries() *WebhookDeliveryQuery {
+	return (&WebhookClient{config: w.config}).QueryDeliveries(w)
+}
+
+// Update returns a builder for updating this Webhook.
+// Note that, you need to call Webhook.Unwrap() before calling this method, if this Webhook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (w *Webhook) Update() *WebhookUpdateOne {
+	return (&WebhookClient{config: w.config}).UpdateOne(w)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (w *Webhook) Unwrap() *Webhook {
+	tx, ok := w.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Webhook is not a transactional entity")
+	}
+	w.config.driver = tx.drv
+	return w
+}
+
+// String implements the fmt.Stringer.
+func (w *Webhook) String() string {
+	var builder strings.Builder
+	builder.WriteString("Webhook(")
+	builder.WriteString(fmt.Sprintf("id=%v", w.ID))
+	builder.WriteString(", url=")
+	builder.WriteString(w.URL)
+	builder.WriteString(", events=")
+	builder.WriteString(fmt.Sprintf("%v", w.Events))
+	builder.WriteString(", secret=<sensitive>")
+	builder.WriteString(", active=")
+	builder.WriteString(fmt.Sprintf("%v", w.Active))
+	builder.WriteString(", created_at=")
+	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Webhooks is a parsable slice of Webhook.
+type Webhooks []*Webhook
+
+func (w Webhooks) config(cfg config) {
+	for _i := range w {
+		w[_i].config = cfg
+	}
+}
@@ -0,0 +1,67 @@
+// github.com/sthorer/api
+
+package webhook
+
+import (
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the webhook type in the database.
+	Label = "webhook"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID        = "id"     // FieldURL holds the string denoting the url vertex property in the database.
+	FieldURL       = "url"    // FieldEvents holds the string denoting the events vertex property in the database.
+	FieldEvents    = "events" // FieldSecret holds the string denoting the secret vertex property in the database.
+	FieldSecret    = "secret" // FieldActive holds the string denoting the active vertex property in the database.
+	FieldActive    = "active" // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt = "created_at"
+
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeDeliveries holds the string denoting the deliveries edge name in mutations.
+	EdgeDeliveries = "deliveries"
+
+	// Table holds the table name of the webhook in the database.
+	Table = "webhooks"
+	// UserTable is the table the holds the user relation/edge.
+	UserTable = "webhooks"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_webhooks"
+	// DeliveriesTable is the table the holds the deliveries relation/edge.
+	DeliveriesTable = "webhook_deliveries"
+	// DeliveriesInverseTable is the table name for the WebhookDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "webhookdelivery" package.
+	DeliveriesInverseTable = "webhook_deliveries"
+	// DeliveriesColumn is the table column denoting the deliveries relation/edge.
+	DeliveriesColumn = "webhook_deliveries"
+)
+
+// Columns holds all SQL columns for webhook fields.
+var Columns = []string{
+	FieldID,
+	FieldURL,
+	FieldEvents,
+	FieldSecret,
+	FieldActive,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the Webhook type.
+var ForeignKeys = []string{
+	"user_webhooks",
+}
+
+var (
+	// URLValidator is a validator for the "url" field. It is called by the builders before save.
+	URLValidator func(string) error
+	// SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	SecretValidator func(string) error
+	// DefaultActive holds the default value on creation for the active field.
+	DefaultActive bool
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
@@ -0,0 +1,271 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookCreate is the builder for creating a Webhook entity.
+type WebhookCreate struct {
+	config
+	mutation *WebhookMutation
+	hooks    []Hook
+}
+
+// SetURL sets the url field.
+func (wc *WebhookCreate) SetURL(s string) *WebhookCreate {
+	wc.mutation.SetURL(s)
+	return wc
+}
+
+// SetEvents sets the events field.
+func (wc *WebhookCreate) SetEvents(s []string) *WebhookCreate {
+	wc.mutation.SetEvents(s)
+	return wc
+}
+
+// SetSecret sets the secret field.
+func (wc *WebhookCreate) SetSecret(s string) *WebhookCreate {
+	wc.mutation.SetSecret(s)
+	return wc
+}
+
+// SetActive sets the active field.
+func (wc *WebhookCreate) SetActive(b bool) *WebhookCreate {
+	wc.mutation.SetActive(b)
+	return wc
+}
+
+// SetNillableActive sets the active field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableActive(b *bool) *WebhookCreate {
+	if b != nil {
+		wc.SetActive(*b)
+	}
+	return wc
+}
+
+// SetCreatedAt sets the created_at field.
+func (wc *WebhookCreate) SetCreatedAt(t time.Time) *WebhookCreate {
+	wc.mutation.SetCreatedAt(t)
+	return wc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wc *WebhookCreate) SetNillableCreatedAt(t *time.Time) *WebhookCreate {
+	if t != nil {
+		wc.SetCreatedAt(*t)
+	}
+	return wc
+}
+
+// SetID sets the id field.
+func (wc *WebhookCreate) SetID(u uuid.UUID) *WebhookCreate {
+	wc.mutation.SetID(u)
+	return wc
+}
+
+// SetUserID sets the user edge to User by id.
+func (wc *WebhookCreate) SetUserID(id int) *WebhookCreate {
+	wc.mutation.SetUserID(id)
+	return wc
+}
+
+// SetUser sets the user edge to User.
+func (wc *WebhookCreate) SetUser(u *User) *WebhookCreate {
+	return wc.SetUserID(u.ID)
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wc *WebhookCreate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookCreate {
+	wc.mutation.AddDeliveryIDs(ids...)
+	return wc
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wc *WebhookCreate) AddDeliveries(w ...*WebhookDelivery) *WebhookCreate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wc.AddDeliveryIDs(ids...)
+}
+
+// Save creates the Webhook in the database.
+func (wc *WebhookCreate) Save(ctx context.Context) (*Webhook, error) {
+	if _, ok := wc.mutation.URL(); !ok {
+		return nil, errors.New("ent: missing required field \"url\"")
+	}
+	if v, ok := wc.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"url\": %v", err)
+		}
+	}
+	if _, ok := wc.mutation.Events(); !ok {
+		return nil, errors.New("ent: missing required field \"events\"")
+	}
+	if _, ok := wc.mutation.Secret(); !ok {
+		return nil, errors.New("ent: missing required field \"secret\"")
+	}
+	if v, ok := wc.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"secret\": %v", err)
+		}
+	}
+	if _, ok := wc.mutation.Active(); !ok {
+		v := webhook.DefaultActive
+		wc.mutation.SetActive(v)
+	}
+	if _, ok := wc.mutation.CreatedAt(); !ok {
+		v := webhook.DefaultCreatedAt()
+		wc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wc.mutation.UserID(); !ok {
+		return nil, errors.New("ent: missing required edge \"user\"")
+	}
+	var (
+		err  error
+		node *Webhook
+	)
+	if len(wc.hooks) == 0 {
+		node, err = wc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wc.mutation = mutation
+			node, err = wc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(wc.hooks) - 1; i >= 0; i-- {
+			mut = wc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wc *WebhookCreate) SaveX(ctx context.Context) *Webhook {
+	v, err := wc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wc *WebhookCreate) sqlSave(ctx context.Context) (*Webhook, error) {
+	var (
+		w     = &Webhook{config: wc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhook.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhook.FieldID,
+			},
+		}
+	)
+	if id, ok := wc.mutation.ID(); ok {
+		w.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wc.mutation.URL(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+		w.URL = value
+	}
+	if value, ok := wc.mutation.Events(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+		w.Events = value
+	}
+	if value, ok := wc.mutation.Secret(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+		w.Secret = value
+	}
+	if value, ok := wc.mutation.Active(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhook.FieldActive,
+		})
+		w.Active = value
+	}
+	if value, ok := wc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhook.FieldCreatedAt,
+		})
+		w.CreatedAt = value
+	}
+	if nodes := wc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.UserTable,
+			Columns: []string{webhook.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := wc.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, wc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return w, nil
+}
@@ -0,0 +1,793 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookQuery is the builder for querying Webhook entities.
+type WebhookQuery struct {
+	config
+	limit      *int
+	offset     *int
+	order      []Order
+	unique     []string
+	predicates []predicate.Webhook
+	// eager-loading edges.
+	withUser       *UserQuery
+	withDeliveries *WebhookDeliveryQuery
+	withFKs        bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the builder.
+func (wq *WebhookQuery) Where(ps ...predicate.Webhook) *WebhookQuery {
+	wq.predicates = append(wq.predicates, ps...)
+	return wq
+}
+
+// Limit adds a limit step to the query.
+func (wq *WebhookQuery) Limit(limit int) *WebhookQuery {
+	wq.limit = &limit
+	return wq
+}
+
+// Offset adds an offset step to the query.
+func (wq *WebhookQuery) Offset(offset int) *WebhookQuery {
+	wq.offset = &offset
+	return wq
+}
+
+// Order adds an order step to the query.
+func (wq *WebhookQuery) Order(o ...Order) *WebhookQuery {
+	wq.order = append(wq.order, o...)
+	return wq
+}
+
+// QueryUser chains the current query on the user edge.
+func (wq *WebhookQuery) QueryUser() *UserQuery {
+	query := &UserQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, wq.sqlQuery()),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhook.UserTable, webhook.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDeliveries chains the current query on the deliveries edge.
+func (wq *WebhookQuery) QueryDeliveries() *WebhookDeliveryQuery {
+	query := &WebhookDeliveryQuery{config: wq.config}
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(webhook.Table, webhook.FieldID, wq.sqlQuery()),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhook.DeliveriesTable, webhook.DeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Webhook entity in the query. Returns *NotFoundError when no webhook was found.
+func (wq *WebhookQuery) First(ctx context.Context) (*Webhook, error) {
+	ws, err := wq.Limit(1).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ws) == 0 {
+		return nil, &NotFoundError{webhook.Label}
+	}
+	return ws[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wq *WebhookQuery) FirstX(ctx context.Context) *Webhook {
+	w, err := wq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return w
+}
+
+// FirstID returns the first Webhook id in the query. Returns *NotFoundError when no id was found.
+func (wq *WebhookQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wq.Limit(1).IDs(ctx); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{webhook.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstXID is like FirstID, but panics if an error occurs.
+func (wq *WebhookQuery) FirstXID(ctx context.Context) uuid.UUID {
+	id, err := wq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns the only Webhook entity in the query, returns an error if not exactly one entity was returned.
+func (wq *WebhookQuery) Only(ctx context.Context) (*Webhook, error) {
+	ws, err := wq.Limit(2).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch len(ws) {
+	case 1:
+		return ws[0], nil
+	case 0:
+		return nil, &NotFoundError{webhook.Label}
+	default:
+		return nil, &NotSingularError{webhook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wq *WebhookQuery) OnlyX(ctx context.Context) *Webhook {
+	w, err := wq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// OnlyID returns the only Webhook id in the query, returns an error if not exactly one id was returned.
+func (wq *WebhookQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = wq.Limit(2).IDs(ctx); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{webhook.Label}
+	default:
+		err = &NotSingularError{webhook.Label}
+	}
+	return
+}
+
+// OnlyXID is like OnlyID, but panics if an error occurs.
+func (wq *WebhookQuery) OnlyXID(ctx context.Context) uuid.UUID {
+	id, err := wq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Webhooks.
+func (wq *WebhookQuery) All(ctx context.Context) ([]*Webhook, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	return wq.sqlAll(ctx)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wq *WebhookQuery) AllX(ctx context.Context) []*Webhook {
+	ws, err := wq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ws
+}
+
+// IDs executes the query and returns a list of Webhook ids.
+func (wq *WebhookQuery) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := wq.Select(webhook.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wq *WebhookQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := wq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wq *WebhookQuery) Count(ctx context.Context) (int, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return wq.sqlCount(ctx)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wq *WebhookQuery) CountX(ctx context.Context) int {
+	count, err := wq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wq *WebhookQuery) Exist(ctx context.Context) (bool, error) {
+	if err := wq.prepareQuery(ctx); err != nil {
+		return false, err
+	}
+	return wq.sqlExist(ctx)
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wq *WebhookQuery) ExistX(ctx context.Context) bool {
+	exist, err := wq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the query builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wq *WebhookQuery) Clone() *WebhookQuery {
+	return &WebhookQuery{
+		config:     wq.config,
+		limit:      wq.limit,
+		offset:     wq.offset,
+		order:      append([]Order{}, wq.order...),
+		unique:     append([]string{}, wq.unique...),
+		predicates: append([]predicate.Webhook{}, wq.predicates...),
+		// clone intermediate query.
+		sql:  wq.sql.Clone(),
+		path: wq.path,
+	}
+}
+
+//  WithUser tells the query-builder to eager-loads the nodes that are connected to
+// the "user" edge. The optional arguments used to configure the query builder of the edge.
+func (wq *WebhookQuery) WithUser(opts ...func(*UserQuery)) *WebhookQuery {
+	query := &UserQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withUser = query
+	return wq
+}
+
+//  WithDeliveries tells the query-builder to eager-loads the nodes that are connected to
+// the "deliveries" edge. The optional arguments used to configure the query builder of the edge.
+func (wq *WebhookQuery) WithDeliveries(opts ...func(*WebhookDeliveryQuery)) *WebhookQuery {
+	query := &WebhookDeliveryQuery{config: wq.config}
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withDeliveries = query
+	return wq
+}
+
+// GroupBy used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		URL string `json:"url,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Webhook.Query().
+//		GroupBy(webhook.FieldURL).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+//
+func (wq *WebhookQuery) GroupBy(field string, fields ...string) *WebhookGroupBy {
+	group := &WebhookGroupBy{config: wq.config}
+	group.fields = append([]string{field}, fields...)
+	group.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wq.sqlQuery(), nil
+	}
+	return group
+}
+
+// Select one or more fields from the given query.
+//
+// Example:
+//
+//	var v []struct {
+//		URL string `json:"url,omitempty"`
+//	}
+//
+//	client.Webhook.Query().
+//		Select(webhook.FieldURL).
+//		Scan(ctx, &v)
+//
+func (wq *WebhookQuery) Select(field string, fields ...string) *WebhookSelect {
+	selector := &WebhookSelect{config: wq.config}
+	selector.fields = append([]string{field}, fields...)
+	selector.path = func(ctx context.Context) (prev *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		return wq.sqlQuery(), nil
+	}
+	return selector
+}
+
+func (wq *WebhookQuery) prepareQuery(ctx context.Context) error {
+	if wq.path != nil {
+		prev, err := wq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wq.sql = prev
+	}
+	return nil
+}
+
+func (wq *WebhookQuery) sqlAll(ctx context.Context) ([]*Webhook, error) {
+	var (
+		nodes       = []*Webhook{}
+		withFKs     = wq.withFKs
+		_spec       = wq.querySpec()
+		loadedTypes = [2]bool{
+			wq.withUser != nil,
+			wq.withDeliveries != nil,
+		}
+	)
+	if wq.withUser != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, webhook.ForeignKeys...)
+	}
+	_spec.ScanValues = func() []interface{} {
+		node := &Webhook{config: wq.config}
+		nodes = append(nodes, node)
+		values := node.scanValues()
+		if withFKs {
+			values = append(values, node.fkValues()...)
+		}
+		return values
+	}
+	_spec.Assign = func(values ...interface{}) error {
+		if len(nodes) == 0 {
+			return fmt.Errorf("ent: Assign called without calling ScanValues")
+		}
+		node := nodes[len(nodes)-1]
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(values...)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+
+	if query := wq.withUser; query != nil {
+		ids := make([]int, 0, len(nodes))
+		nodeids := make(map[int][]*Webhook)
+		for i := range nodes {
+			if fk := nodes[i].user_webhooks; fk != nil {
+				ids = append(ids, *fk)
+				nodeids[*fk] = append(nodeids[*fk], nodes[i])
+			}
+		}
+		query.Where(user.IDIn(ids...))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			nodes, ok := nodeids[n.ID]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "user_webhooks" returned %v`, n.ID)
+			}
+			for i := range nodes {
+				nodes[i].Edges.User = n
+			}
+		}
+	}
+
+	if query := wq.withDeliveries; query != nil {
+		fks := make([]driver.Value, 0, len(nodes))
+		nodeids := make(map[uuid.UUID]*Webhook)
+		for i := range nodes {
+			fks = append(fks, nodes[i].ID)
+			nodeids[nodes[i].ID] = nodes[i]
+		}
+		query.withFKs = true
+		query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+			s.Where(sql.InValues(webhook.DeliveriesColumn, fks...))
+		}))
+		neighbors, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range neighbors {
+			fk := n.webhook_deliveries
+			if fk == nil {
+				return nil, fmt.Errorf(`foreign-key "webhook_deliveries" is nil for node %v`, n.ID)
+			}
+			node, ok := nodeids[*fk]
+			if !ok {
+				return nil, fmt.Errorf(`unexpected foreign-key "webhook_deliveries" returned %v for node %v`, *fk, n.ID)
+			}
+			node.Edges.Deliveries = append(node.Edges.Deliveries, n)
+		}
+	}
+
+	return nodes, nil
+}
+
+func (wq *WebhookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wq.querySpec()
+	return sqlgraph.CountNodes(ctx, wq.driver, _spec)
+}
+
+func (wq *WebhookQuery) sqlExist(ctx context.Context) (bool, error) {
+	n, err := wq.sqlCount(ctx)
+	if err != nil {
+		return false, fmt.Errorf("ent: check existence: %v", err)
+	}
+	return n > 0, nil
+}
+
+func (wq *WebhookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := &sqlgraph.QuerySpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhook.FieldID,
+			},
+		},
+		From:   wq.sql,
+		Unique: true,
+	}
+	if ps := wq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wq.limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wq.offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wq *WebhookQuery) sqlQuery() *sql.Selector {
+	builder := sql.Dialect(wq.driver.Dialect())
+	t1 := builder.Table(webhook.Table)
+	selector := builder.Select(t1.Columns(webhook.Columns...)...).From(t1)
+	if wq.sql != nil {
+		selector = wq.sql
+		selector.Select(selector.Columns(webhook.Columns...)...)
+	}
+	for _, p := range wq.predicates {
+		p(selector)
+	}
+	for _, p := range wq.order {
+		p(selector)
+	}
+	if offset := wq.offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wq.limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// WebhookGroupBy is the builder for group-by Webhook entities.
+type WebhookGroupBy struct {
+	config
+	fields []string
+	fns    []Aggregate
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wgb *WebhookGroupBy) Aggregate(fns ...Aggregate) *WebhookGroupBy {
+	wgb.fns = append(wgb.fns, fns...)
+	return wgb
+}
+
+// Scan applies the group-by query and scan the result into the given value.
+func (wgb *WebhookGroupBy) Scan(ctx context.Context, v interface{}) error {
+	query, err := wgb.path(ctx)
+	if err != nil {
+		return err
+	}
+	wgb.sql = query
+	return wgb.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (wgb *WebhookGroupBy) ScanX(ctx context.Context, v interface{}) {
+	if err := wgb.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Strings(ctx context.Context) ([]string, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Strings is not achievable when grouping more than 1 field")
+	}
+	var v []string
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (wgb *WebhookGroupBy) StringsX(ctx context.Context) []string {
+	v, err := wgb.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Ints(ctx context.Context) ([]int, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Ints is not achievable when grouping more than 1 field")
+	}
+	var v []int
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (wgb *WebhookGroupBy) IntsX(ctx context.Context) []int {
+	v, err := wgb.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Float64s(ctx context.Context) ([]float64, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Float64s is not achievable when grouping more than 1 field")
+	}
+	var v []float64
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (wgb *WebhookGroupBy) Float64sX(ctx context.Context) []float64 {
+	v, err := wgb.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from group-by. It is only allowed when querying group-by with one field.
+func (wgb *WebhookGroupBy) Bools(ctx context.Context) ([]bool, error) {
+	if len(wgb.fields) > 1 {
+		return nil, errors.New("ent: WebhookGroupBy.Bools is not achievable when grouping more than 1 field")
+	}
+	var v []bool
+	if err := wgb.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (wgb *WebhookGroupBy) BoolsX(ctx context.Context) []bool {
+	v, err := wgb.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wgb *WebhookGroupBy) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := wgb.sqlQuery().Query()
+	if err := wgb.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (wgb *WebhookGroupBy) sqlQuery() *sql.Selector {
+	selector := wgb.sql
+	columns := make([]string, 0, len(wgb.fields)+len(wgb.fns))
+	columns = append(columns, wgb.fields...)
+	for _, fn := range wgb.fns {
+		columns = append(columns, fn(selector))
+	}
+	return selector.Select(columns...).GroupBy(wgb.fields...)
+}
+
+// WebhookSelect is the builder for select fields of Webhook entities.
+type WebhookSelect struct {
+	config
+	fields []string
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Scan applies the selector query and scan the result into the given value.
+func (ws *WebhookSelect) Scan(ctx context.Context, v interface{}) error {
+	query, err := ws.path(ctx)
+	if err != nil {
+		return err
+	}
+	ws.sql = query
+	return ws.sqlScan(ctx, v)
+}
+
+// ScanX is like Scan, but panics if an error occurs.
+func (ws *WebhookSelect) ScanX(ctx context.Context, v interface{}) {
+	if err := ws.Scan(ctx, v); err != nil {
+		panic(err)
+	}
+}
+
+// Strings returns list of strings from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Strings(ctx context.Context) ([]string, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Strings is not achievable when selecting more than 1 field")
+	}
+	var v []string
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// StringsX is like Strings, but panics if an error occurs.
+func (ws *WebhookSelect) StringsX(ctx context.Context) []string {
+	v, err := ws.Strings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Ints returns list of ints from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Ints(ctx context.Context) ([]int, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Ints is not achievable when selecting more than 1 field")
+	}
+	var v []int
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// IntsX is like Ints, but panics if an error occurs.
+func (ws *WebhookSelect) IntsX(ctx context.Context) []int {
+	v, err := ws.Ints(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Float64s returns list of float64s from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Float64s(ctx context.Context) ([]float64, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Float64s is not achievable when selecting more than 1 field")
+	}
+	var v []float64
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Float64sX is like Float64s, but panics if an error occurs.
+func (ws *WebhookSelect) Float64sX(ctx context.Context) []float64 {
+	v, err := ws.Float64s(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Bools returns list of bools from selector. It is only allowed when selecting one field.
+func (ws *WebhookSelect) Bools(ctx context.Context) ([]bool, error) {
+	if len(ws.fields) > 1 {
+		return nil, errors.New("ent: WebhookSelect.Bools is not achievable when selecting more than 1 field")
+	}
+	var v []bool
+	if err := ws.Scan(ctx, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// BoolsX is like Bools, but panics if an error occurs.
+func (ws *WebhookSelect) BoolsX(ctx context.Context) []bool {
+	v, err := ws.Bools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (ws *WebhookSelect) sqlScan(ctx context.Context, v interface{}) error {
+	rows := &sql.Rows{}
+	query, args := ws.sqlQuery().Query()
+	if err := ws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+func (ws *WebhookSelect) sqlQuery() sql.Querier {
+	selector := ws.sql
+	selector.Select(selector.Columns(ws.fields...)...)
+	return selector
+}
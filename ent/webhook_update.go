@@ -0,0 +1,588 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/user"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookUpdate is the builder for updating Webhook entities.
+type WebhookUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *WebhookMutation
+	predicates []predicate.Webhook
+}
+
+// Where adds a new predicate for the builder.
+func (wu *WebhookUpdate) Where(ps ...predicate.Webhook) *WebhookUpdate {
+	wu.predicates = append(wu.predicates, ps...)
+	return wu
+}
+
+// SetURL sets the url field.
+func (wu *WebhookUpdate) SetURL(s string) *WebhookUpdate {
+	wu.mutation.SetURL(s)
+	return wu
+}
+
+// SetEvents sets the events field.
+func (wu *WebhookUpdate) SetEvents(s []string) *WebhookUpdate {
+	wu.mutation.SetEvents(s)
+	return wu
+}
+
+// SetSecret sets the secret field.
+func (wu *WebhookUpdate) SetSecret(s string) *WebhookUpdate {
+	wu.mutation.SetSecret(s)
+	return wu
+}
+
+// SetActive sets the active field.
+func (wu *WebhookUpdate) SetActive(b bool) *WebhookUpdate {
+	wu.mutation.SetActive(b)
+	return wu
+}
+
+// SetNillableActive sets the active field if the given value is not nil.
+func (wu *WebhookUpdate) SetNillableActive(b *bool) *WebhookUpdate {
+	if b != nil {
+		wu.SetActive(*b)
+	}
+	return wu
+}
+
+// SetUserID sets the user edge to User by id.
+func (wu *WebhookUpdate) SetUserID(id int) *WebhookUpdate {
+	wu.mutation.SetUserID(id)
+	return wu
+}
+
+// SetUser sets the user edge to User.
+func (wu *WebhookUpdate) SetUser(u *User) *WebhookUpdate {
+	return wu.SetUserID(u.ID)
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wu *WebhookUpdate) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.AddDeliveryIDs(ids...)
+	return wu
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wu *WebhookUpdate) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.AddDeliveryIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (wu *WebhookUpdate) ClearUser() *WebhookUpdate {
+	wu.mutation.ClearUser()
+	return wu
+}
+
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (wu *WebhookUpdate) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdate {
+	wu.mutation.RemoveDeliveryIDs(ids...)
+	return wu
+}
+
+// RemoveDeliveries removes deliveries edges to WebhookDelivery.
+func (wu *WebhookUpdate) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdate {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wu.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (wu *WebhookUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := wu.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"url\": %v", err)
+		}
+	}
+	if v, ok := wu.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"secret\": %v", err)
+		}
+	}
+
+	if _, ok := wu.mutation.UserID(); wu.mutation.UserCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err      error
+		affected int
+	)
+	if len(wu.hooks) == 0 {
+		affected, err = wu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wu.mutation = mutation
+			affected, err = wu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(wu.hooks) - 1; i >= 0; i-- {
+			mut = wu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wu *WebhookUpdate) SaveX(ctx context.Context) int {
+	affected, err := wu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wu *WebhookUpdate) Exec(ctx context.Context) error {
+	_, err := wu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wu *WebhookUpdate) ExecX(ctx context.Context) {
+	if err := wu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wu *WebhookUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhook.FieldID,
+			},
+		},
+	}
+	if ps := wu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wu.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+	}
+	if value, ok := wu.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if value, ok := wu.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if value, ok := wu.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhook.FieldActive,
+		})
+	}
+	if wu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.UserTable,
+			Columns: []string{webhook.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.UserTable,
+			Columns: []string{webhook.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := wu.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookUpdateOne is the builder for updating a single Webhook entity.
+type WebhookUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookMutation
+}
+
+// SetURL sets the url field.
+func (wuo *WebhookUpdateOne) SetURL(s string) *WebhookUpdateOne {
+	wuo.mutation.SetURL(s)
+	return wuo
+}
+
+// SetEvents sets the events field.
+func (wuo *WebhookUpdateOne) SetEvents(s []string) *WebhookUpdateOne {
+	wuo.mutation.SetEvents(s)
+	return wuo
+}
+
+// SetSecret sets the secret field.
+func (wuo *WebhookUpdateOne) SetSecret(s string) *WebhookUpdateOne {
+	wuo.mutation.SetSecret(s)
+	return wuo
+}
+
+// SetActive sets the active field.
+func (wuo *WebhookUpdateOne) SetActive(b bool) *WebhookUpdateOne {
+	wuo.mutation.SetActive(b)
+	return wuo
+}
+
+// SetNillableActive sets the active field if the given value is not nil.
+func (wuo *WebhookUpdateOne) SetNillableActive(b *bool) *WebhookUpdateOne {
+	if b != nil {
+		wuo.SetActive(*b)
+	}
+	return wuo
+}
+
+// SetUserID sets the user edge to User by id.
+func (wuo *WebhookUpdateOne) SetUserID(id int) *WebhookUpdateOne {
+	wuo.mutation.SetUserID(id)
+	return wuo
+}
+
+// SetUser sets the user edge to User.
+func (wuo *WebhookUpdateOne) SetUser(u *User) *WebhookUpdateOne {
+	return wuo.SetUserID(u.ID)
+}
+
+// AddDeliveryIDs adds the deliveries edge to WebhookDelivery by ids.
+func (wuo *WebhookUpdateOne) AddDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.AddDeliveryIDs(ids...)
+	return wuo
+}
+
+// AddDeliveries adds the deliveries edges to WebhookDelivery.
+func (wuo *WebhookUpdateOne) AddDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.AddDeliveryIDs(ids...)
+}
+
+// ClearUser clears the user edge to User.
+func (wuo *WebhookUpdateOne) ClearUser() *WebhookUpdateOne {
+	wuo.mutation.ClearUser()
+	return wuo
+}
+
+// RemoveDeliveryIDs removes the deliveries edge to WebhookDelivery by ids.
+func (wuo *WebhookUpdateOne) RemoveDeliveryIDs(ids ...uuid.UUID) *WebhookUpdateOne {
+	wuo.mutation.RemoveDeliveryIDs(ids...)
+	return wuo
+}
+
+// RemoveDeliveries removes deliveries edges to WebhookDelivery.
+func (wuo *WebhookUpdateOne) RemoveDeliveries(w ...*WebhookDelivery) *WebhookUpdateOne {
+	ids := make([]uuid.UUID, len(w))
+	for i := range w {
+		ids[i] = w[i].ID
+	}
+	return wuo.RemoveDeliveryIDs(ids...)
+}
+
+// Save executes the query and returns the updated entity.
+func (wuo *WebhookUpdateOne) Save(ctx context.Context) (*Webhook, error) {
+	if v, ok := wuo.mutation.URL(); ok {
+		if err := webhook.URLValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"url\": %v", err)
+		}
+	}
+	if v, ok := wuo.mutation.Secret(); ok {
+		if err := webhook.SecretValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"secret\": %v", err)
+		}
+	}
+
+	if _, ok := wuo.mutation.UserID(); wuo.mutation.UserCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"user\"")
+	}
+
+	var (
+		err  error
+		node *Webhook
+	)
+	if len(wuo.hooks) == 0 {
+		node, err = wuo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wuo.mutation = mutation
+			node, err = wuo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(wuo.hooks) - 1; i >= 0; i-- {
+			mut = wuo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wuo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) SaveX(ctx context.Context) *Webhook {
+	w, err := wuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+// Exec executes the query on the entity.
+func (wuo *WebhookUpdateOne) Exec(ctx context.Context) error {
+	_, err := wuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wuo *WebhookUpdateOne) ExecX(ctx context.Context) {
+	if err := wuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wuo *WebhookUpdateOne) sqlSave(ctx context.Context) (w *Webhook, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhook.Table,
+			Columns: webhook.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhook.FieldID,
+			},
+		},
+	}
+	id, ok := wuo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing Webhook.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := wuo.mutation.URL(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldURL,
+		})
+	}
+	if value, ok := wuo.mutation.Events(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhook.FieldEvents,
+		})
+	}
+	if value, ok := wuo.mutation.Secret(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhook.FieldSecret,
+		})
+	}
+	if value, ok := wuo.mutation.Active(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeBool,
+			Value:  value,
+			Column: webhook.FieldActive,
+		})
+	}
+	if wuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.UserTable,
+			Columns: []string{webhook.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhook.UserTable,
+			Columns: []string{webhook.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeInt,
+					Column: user.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   webhook.DeliveriesTable,
+			Columns: []string{webhook.DeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhookdelivery.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	w = &Webhook{config: wuo.config}
+	_spec.Assign = w.assignValues
+	_spec.ScanValues = w.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, wuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhook.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return w, nil
+}
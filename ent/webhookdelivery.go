@@ -0,0 +1,223 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookDelivery is the model entity for the WebhookDelivery schema.
+type WebhookDelivery struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Event holds the value of the "event" field.
+	Event string `json:"event,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload map[string]interface{} `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status webhookdelivery.Status `json:"status,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// ResponseStatus holds the value of the "response_status" field.
+	ResponseStatus int `json:"response_status,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WebhookDeliveryQuery when eager-loading is set.
+	Edges              WebhookDeliveryEdges `json:"edges"`
+	webhook_deliveries *uuid.UUID
+}
+
+// WebhookDeliveryEdges holds the relations/edges for other nodes in the graph.
+type WebhookDeliveryEdges struct {
+	// Webhook holds the value of the webhook edge.
+	Webhook *Webhook
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WebhookOrErr returns the Webhook value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WebhookDeliveryEdges) WebhookOrErr() (*Webhook, error) {
+	if e.loadedTypes[0] {
+		if e.Webhook == nil {
+			// The edge webhook was loaded in eager-loading,
+			// but was not found.
+			return nil, &NotFoundError{label: webhook.Label}
+		}
+		return e.Webhook, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookDelivery) scanValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{},      // id
+		&sql.NullString{}, // event
+		&[]byte{},         // payload
+		&sql.NullString{}, // status
+		&sql.NullInt64{},  // attempts
+		&sql.NullTime{},   // next_attempt_at
+		&sql.NullInt64{},  // response_status
+		&sql.NullString{}, // last_error
+		&sql.NullTime{},   // created_at
+		&sql.NullTime{},   // delivered_at
+	}
+}
+
+// fkValues returns the types for scanning foreign-keys values from sql.Rows.
+func (*WebhookDelivery) fkValues() []interface{} {
+	return []interface{}{
+		&uuid.UUID{}, // webhook_deliveries
+	}
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookDelivery fields.
+func (wd *WebhookDelivery) assignValues(values ...interface{}) error {
+	if m, n := len(values), len(webhookdelivery.Columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	if value, ok := values[0].(*uuid.UUID); !ok {
+		return fmt.Errorf("unexpected type %T for field id", values[0])
+	} else if value != nil {
+		wd.ID = *value
+	}
+	values = values[1:]
+	if value, ok := values[0].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field event", values[0])
+	} else if value.Valid {
+		wd.Event = value.String
+	}
+
+	if value, ok := values[1].(*[]byte); !ok {
+		return fmt.Errorf("unexpected type %T for field payload", values[1])
+	} else if value != nil && len(*value) > 0 {
+		if err := json.Unmarshal(*value, &wd.Payload); err != nil {
+			return fmt.Errorf("unmarshal field payload: %v", err)
+		}
+	}
+	if value, ok := values[2].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field status", values[2])
+	} else if value.Valid {
+		wd.Status = webhookdelivery.Status(value.String)
+	}
+	if value, ok := values[3].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field attempts", values[3])
+	} else if value.Valid {
+		wd.Attempts = int(value.Int64)
+	}
+	if value, ok := values[4].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field next_attempt_at", values[4])
+	} else if value.Valid {
+		wd.NextAttemptAt = value.Time
+	}
+	if value, ok := values[5].(*sql.NullInt64); !ok {
+		return fmt.Errorf("unexpected type %T for field response_status", values[5])
+	} else if value.Valid {
+		wd.ResponseStatus = int(value.Int64)
+	}
+	if value, ok := values[6].(*sql.NullString); !ok {
+		return fmt.Errorf("unexpected type %T for field last_error", values[6])
+	} else if value.Valid {
+		wd.LastError = value.String
+	}
+	if value, ok := values[7].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field created_at", values[7])
+	} else if value.Valid {
+		wd.CreatedAt = value.Time
+	}
+	if value, ok := values[8].(*sql.NullTime); !ok {
+		return fmt.Errorf("unexpected type %T for field delivered_at", values[8])
+	} else if value.Valid {
+		wd.DeliveredAt = new(time.Time)
+		*wd.DeliveredAt = value.Time
+	}
+	values = values[9:]
+	if len(values) == len(webhookdelivery.ForeignKeys) {
+		if value, ok := values[0].(*uuid.UUID); !ok {
+			return fmt.Errorf("unexpected type %T for field webhook_deliveries", values[0])
+		} else if value != nil {
+			wd.webhook_deliveries = value
+		}
+	}
+	return nil
+}
+
+// QueryWebhook queries the webhook edge of the WebhookDelivery.
+func (wd *WebhookDelivery) QueryWebhook() *WebhookQuery {
+	return (&WebhookDeliveryClient{config: wd.config}).QueryWebhook(wd)
+}
+
+// Update returns a builder for updating this WebhookDelivery.
+// Note that, you need to call WebhookDelivery.Unwrap() before calling this method, if this WebhookDelivery
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wd *WebhookDelivery) Update() *WebhookDeliveryUpdateOne {
+	return (&WebhookDeliveryClient{config: wd.config}).UpdateOne(wd)
+}
+
+// Unwrap unwraps the entity that was returned from a transaction after it was closed,
+// so that all next queries will be executed through the driver which created the transaction.
+func (wd *WebhookDelivery) Unwrap() *WebhookDelivery {
+	tx, ok := wd.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookDelivery is not a transactional entity")
+	}
+	wd.config.driver = tx.drv
+	return wd
+}
+
+// String implements the fmt.Stringer.
+func (wd *WebhookDelivery) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookDelivery(")
+	builder.WriteString(fmt.Sprintf("id=%v", wd.ID))
+	builder.WriteString(", event=")
+	builder.WriteString(wd.Event)
+	builder.WriteString(", payload=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Payload))
+	builder.WriteString(", status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Status))
+	builder.WriteString(", attempts=")
+	builder.WriteString(fmt.Sprintf("%v", wd.Attempts))
+	builder.WriteString(", next_attempt_at=")
+	builder.WriteString(wd.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", response_status=")
+	builder.WriteString(fmt.Sprintf("%v", wd.ResponseStatus))
+	builder.WriteString(", last_error=")
+	builder.WriteString(wd.LastError)
+	builder.WriteString(", created_at=")
+	builder.WriteString(wd.CreatedAt.Format(time.ANSIC))
+	if v := wd.DeliveredAt; v != nil {
+		builder.WriteString(", delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookDeliveries is a parsable slice of WebhookDelivery.
+type WebhookDeliveries []*WebhookDelivery
+
+func (wd WebhookDeliveries) config(cfg config) {
+	for _i := range wd {
+		wd[_i].config = cfg
+	}
+}
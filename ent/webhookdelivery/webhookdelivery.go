@@ -0,0 +1,96 @@
+// github.com/sthorer/api
+
+package webhookdelivery
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// Label holds the string label denoting the webhookdelivery type in the database.
+	Label = "webhook_delivery"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID             = "id"              // FieldEvent holds the string denoting the event vertex property in the database.
+	FieldEvent          = "event"           // FieldPayload holds the string denoting the payload vertex property in the database.
+	FieldPayload        = "payload"         // FieldStatus holds the string denoting the status vertex property in the database.
+	FieldStatus         = "status"          // FieldAttempts holds the string denoting the attempts vertex property in the database.
+	FieldAttempts       = "attempts"        // FieldNextAttemptAt holds the string denoting the next_attempt_at vertex property in the database.
+	FieldNextAttemptAt  = "next_attempt_at" // FieldResponseStatus holds the string denoting the response_status vertex property in the database.
+	FieldResponseStatus = "response_status" // FieldLastError holds the string denoting the last_error vertex property in the database.
+	FieldLastError      = "last_error"      // FieldCreatedAt holds the string denoting the created_at vertex property in the database.
+	FieldCreatedAt      = "created_at"      // FieldDeliveredAt holds the string denoting the delivered_at vertex property in the database.
+	FieldDeliveredAt    = "delivered_at"
+
+	// EdgeWebhook holds the string denoting the webhook edge name in mutations.
+	EdgeWebhook = "webhook"
+
+	// Table holds the table name of the webhookdelivery in the database.
+	Table = "webhook_deliveries"
+	// WebhookTable is the table the holds the webhook relation/edge.
+	WebhookTable = "webhook_deliveries"
+	// WebhookInverseTable is the table name for the Webhook entity.
+	// It exists in this package in order to avoid circular dependency with the "webhook" package.
+	WebhookInverseTable = "webhooks"
+	// WebhookColumn is the table column denoting the webhook relation/edge.
+	WebhookColumn = "webhook_deliveries"
+)
+
+// Columns holds all SQL columns for webhookdelivery fields.
+var Columns = []string{
+	FieldID,
+	FieldEvent,
+	FieldPayload,
+	FieldStatus,
+	FieldAttempts,
+	FieldNextAttemptAt,
+	FieldResponseStatus,
+	FieldLastError,
+	FieldCreatedAt,
+	FieldDeliveredAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the WebhookDelivery type.
+var ForeignKeys = []string{
+	"webhook_deliveries",
+}
+
+var (
+	// EventValidator is a validator for the "event" field. It is called by the builders before save.
+	EventValidator func(string) error
+	// DefaultAttempts holds the default value on creation for the attempts field.
+	DefaultAttempts int
+	// AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	AttemptsValidator func(int) error
+	// DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	DefaultNextAttemptAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the created_at field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the status enum field.
+type Status string
+
+// StatusPending is the default Status.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending   Status = "Pending"
+	StatusSucceeded Status = "Succeeded"
+	StatusFailed    Status = "Failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "s" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("webhookdelivery: invalid enum value for status field: %q", s)
+	}
+}
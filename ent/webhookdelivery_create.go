@@ -0,0 +1,342 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookDeliveryCreate is the builder for creating a WebhookDelivery entity.
+type WebhookDeliveryCreate struct {
+	config
+	mutation *WebhookDeliveryMutation
+	hooks    []Hook
+}
+
+// SetEvent sets the event field.
+func (wdc *WebhookDeliveryCreate) SetEvent(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetEvent(s)
+	return wdc
+}
+
+// SetPayload sets the payload field.
+func (wdc *WebhookDeliveryCreate) SetPayload(m map[string]interface{}) *WebhookDeliveryCreate {
+	wdc.mutation.SetPayload(m)
+	return wdc
+}
+
+// SetStatus sets the status field.
+func (wdc *WebhookDeliveryCreate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryCreate {
+	wdc.mutation.SetStatus(w)
+	return wdc
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryCreate {
+	if w != nil {
+		wdc.SetStatus(*w)
+	}
+	return wdc
+}
+
+// SetAttempts sets the attempts field.
+func (wdc *WebhookDeliveryCreate) SetAttempts(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetAttempts(i)
+	return wdc
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableAttempts(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetAttempts(*i)
+	}
+	return wdc
+}
+
+// SetNextAttemptAt sets the next_attempt_at field.
+func (wdc *WebhookDeliveryCreate) SetNextAttemptAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetNextAttemptAt(t)
+	return wdc
+}
+
+// SetNillableNextAttemptAt sets the next_attempt_at field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetNextAttemptAt(*t)
+	}
+	return wdc
+}
+
+// SetResponseStatus sets the response_status field.
+func (wdc *WebhookDeliveryCreate) SetResponseStatus(i int) *WebhookDeliveryCreate {
+	wdc.mutation.SetResponseStatus(i)
+	return wdc
+}
+
+// SetNillableResponseStatus sets the response_status field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableResponseStatus(i *int) *WebhookDeliveryCreate {
+	if i != nil {
+		wdc.SetResponseStatus(*i)
+	}
+	return wdc
+}
+
+// SetLastError sets the last_error field.
+func (wdc *WebhookDeliveryCreate) SetLastError(s string) *WebhookDeliveryCreate {
+	wdc.mutation.SetLastError(s)
+	return wdc
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableLastError(s *string) *WebhookDeliveryCreate {
+	if s != nil {
+		wdc.SetLastError(*s)
+	}
+	return wdc
+}
+
+// SetCreatedAt sets the created_at field.
+func (wdc *WebhookDeliveryCreate) SetCreatedAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetCreatedAt(t)
+	return wdc
+}
+
+// SetNillableCreatedAt sets the created_at field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableCreatedAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetCreatedAt(*t)
+	}
+	return wdc
+}
+
+// SetDeliveredAt sets the delivered_at field.
+func (wdc *WebhookDeliveryCreate) SetDeliveredAt(t time.Time) *WebhookDeliveryCreate {
+	wdc.mutation.SetDeliveredAt(t)
+	return wdc
+}
+
+// SetNillableDeliveredAt sets the delivered_at field if the given value is not nil.
+func (wdc *WebhookDeliveryCreate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryCreate {
+	if t != nil {
+		wdc.SetDeliveredAt(*t)
+	}
+	return wdc
+}
+
+// SetID sets the id field.
+func (wdc *WebhookDeliveryCreate) SetID(u uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetID(u)
+	return wdc
+}
+
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (wdc *WebhookDeliveryCreate) SetWebhookID(id uuid.UUID) *WebhookDeliveryCreate {
+	wdc.mutation.SetWebhookID(id)
+	return wdc
+}
+
+// SetWebhook sets the webhook edge to Webhook.
+func (wdc *WebhookDeliveryCreate) SetWebhook(w *Webhook) *WebhookDeliveryCreate {
+	return wdc.SetWebhookID(w.ID)
+}
+
+// Save creates the WebhookDelivery in the database.
+func (wdc *WebhookDeliveryCreate) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if _, ok := wdc.mutation.Event(); !ok {
+		return nil, errors.New("ent: missing required field \"event\"")
+	}
+	if v, ok := wdc.mutation.Event(); ok {
+		if err := webhookdelivery.EventValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"event\": %v", err)
+		}
+	}
+	if _, ok := wdc.mutation.Payload(); !ok {
+		return nil, errors.New("ent: missing required field \"payload\"")
+	}
+	if _, ok := wdc.mutation.Status(); !ok {
+		v := webhookdelivery.DefaultStatus
+		wdc.mutation.SetStatus(v)
+	}
+	if v, ok := wdc.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"status\": %v", err)
+		}
+	}
+	if _, ok := wdc.mutation.Attempts(); !ok {
+		v := webhookdelivery.DefaultAttempts
+		wdc.mutation.SetAttempts(v)
+	}
+	if v, ok := wdc.mutation.Attempts(); ok {
+		if err := webhookdelivery.AttemptsValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"attempts\": %v", err)
+		}
+	}
+	if _, ok := wdc.mutation.NextAttemptAt(); !ok {
+		v := webhookdelivery.DefaultNextAttemptAt()
+		wdc.mutation.SetNextAttemptAt(v)
+	}
+	if _, ok := wdc.mutation.CreatedAt(); !ok {
+		v := webhookdelivery.DefaultCreatedAt()
+		wdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := wdc.mutation.WebhookID(); !ok {
+		return nil, errors.New("ent: missing required edge \"webhook\"")
+	}
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	if len(wdc.hooks) == 0 {
+		node, err = wdc.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdc.mutation = mutation
+			node, err = wdc.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(wdc.hooks) - 1; i >= 0; i-- {
+			mut = wdc.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdc.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wdc *WebhookDeliveryCreate) SaveX(ctx context.Context) *WebhookDelivery {
+	v, err := wdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+func (wdc *WebhookDeliveryCreate) sqlSave(ctx context.Context) (*WebhookDelivery, error) {
+	var (
+		wd    = &WebhookDelivery{config: wdc.config}
+		_spec = &sqlgraph.CreateSpec{
+			Table: webhookdelivery.Table,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdelivery.FieldID,
+			},
+		}
+	)
+	if id, ok := wdc.mutation.ID(); ok {
+		wd.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wdc.mutation.Event(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldEvent,
+		})
+		wd.Event = value
+	}
+	if value, ok := wdc.mutation.Payload(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeJSON,
+			Value:  value,
+			Column: webhookdelivery.FieldPayload,
+		})
+		wd.Payload = value
+	}
+	if value, ok := wdc.mutation.Status(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: webhookdelivery.FieldStatus,
+		})
+		wd.Status = value
+	}
+	if value, ok := wdc.mutation.Attempts(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+		wd.Attempts = value
+	}
+	if value, ok := wdc.mutation.NextAttemptAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+		wd.NextAttemptAt = value
+	}
+	if value, ok := wdc.mutation.ResponseStatus(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+		wd.ResponseStatus = value
+	}
+	if value, ok := wdc.mutation.LastError(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldLastError,
+		})
+		wd.LastError = value
+	}
+	if value, ok := wdc.mutation.CreatedAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldCreatedAt,
+		})
+		wd.CreatedAt = value
+	}
+	if value, ok := wdc.mutation.DeliveredAt(); ok {
+		_spec.Fields = append(_spec.Fields, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+		wd.DeliveredAt = &value
+	}
+	if nodes := wdc.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if err := sqlgraph.CreateNode(ctx, wdc.driver, _spec); err != nil {
+		if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return wd, nil
+}
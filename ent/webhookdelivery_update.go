@@ -0,0 +1,710 @@
+// github.com/sthorer/api
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/facebookincubator/ent/dialect/sql"
+	"github.com/facebookincubator/ent/dialect/sql/sqlgraph"
+	"github.com/facebookincubator/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/sthorer/api/ent/predicate"
+	"github.com/sthorer/api/ent/webhook"
+	"github.com/sthorer/api/ent/webhookdelivery"
+)
+
+// WebhookDeliveryUpdate is the builder for updating WebhookDelivery entities.
+type WebhookDeliveryUpdate struct {
+	config
+	hooks      []Hook
+	mutation   *WebhookDeliveryMutation
+	predicates []predicate.WebhookDelivery
+}
+
+// Where adds a new predicate for the builder.
+func (wdu *WebhookDeliveryUpdate) Where(ps ...predicate.WebhookDelivery) *WebhookDeliveryUpdate {
+	wdu.predicates = append(wdu.predicates, ps...)
+	return wdu
+}
+
+// SetStatus sets the status field.
+func (wdu *WebhookDeliveryUpdate) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdate {
+	wdu.mutation.SetStatus(w)
+	return wdu
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdate {
+	if w != nil {
+		wdu.SetStatus(*w)
+	}
+	return wdu
+}
+
+// SetAttempts sets the attempts field.
+func (wdu *WebhookDeliveryUpdate) SetAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetAttempts()
+	wdu.mutation.SetAttempts(i)
+	return wdu
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableAttempts(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetAttempts(*i)
+	}
+	return wdu
+}
+
+// AddAttempts adds i to attempts.
+func (wdu *WebhookDeliveryUpdate) AddAttempts(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddAttempts(i)
+	return wdu
+}
+
+// SetNextAttemptAt sets the next_attempt_at field.
+func (wdu *WebhookDeliveryUpdate) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetNextAttemptAt(t)
+	return wdu
+}
+
+// SetNillableNextAttemptAt sets the next_attempt_at field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetNextAttemptAt(*t)
+	}
+	return wdu
+}
+
+// SetResponseStatus sets the response_status field.
+func (wdu *WebhookDeliveryUpdate) SetResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.ResetResponseStatus()
+	wdu.mutation.SetResponseStatus(i)
+	return wdu
+}
+
+// SetNillableResponseStatus sets the response_status field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdate {
+	if i != nil {
+		wdu.SetResponseStatus(*i)
+	}
+	return wdu
+}
+
+// AddResponseStatus adds i to response_status.
+func (wdu *WebhookDeliveryUpdate) AddResponseStatus(i int) *WebhookDeliveryUpdate {
+	wdu.mutation.AddResponseStatus(i)
+	return wdu
+}
+
+// ClearResponseStatus clears the value of response_status.
+func (wdu *WebhookDeliveryUpdate) ClearResponseStatus() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearResponseStatus()
+	return wdu
+}
+
+// SetLastError sets the last_error field.
+func (wdu *WebhookDeliveryUpdate) SetLastError(s string) *WebhookDeliveryUpdate {
+	wdu.mutation.SetLastError(s)
+	return wdu
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableLastError(s *string) *WebhookDeliveryUpdate {
+	if s != nil {
+		wdu.SetLastError(*s)
+	}
+	return wdu
+}
+
+// ClearLastError clears the value of last_error.
+func (wdu *WebhookDeliveryUpdate) ClearLastError() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearLastError()
+	return wdu
+}
+
+// SetDeliveredAt sets the delivered_at field.
+func (wdu *WebhookDeliveryUpdate) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdate {
+	wdu.mutation.SetDeliveredAt(t)
+	return wdu
+}
+
+// SetNillableDeliveredAt sets the delivered_at field if the given value is not nil.
+func (wdu *WebhookDeliveryUpdate) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdate {
+	if t != nil {
+		wdu.SetDeliveredAt(*t)
+	}
+	return wdu
+}
+
+// ClearDeliveredAt clears the value of delivered_at.
+func (wdu *WebhookDeliveryUpdate) ClearDeliveredAt() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearDeliveredAt()
+	return wdu
+}
+
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (wdu *WebhookDeliveryUpdate) SetWebhookID(id uuid.UUID) *WebhookDeliveryUpdate {
+	wdu.mutation.SetWebhookID(id)
+	return wdu
+}
+
+// SetWebhook sets the webhook edge to Webhook.
+func (wdu *WebhookDeliveryUpdate) SetWebhook(w *Webhook) *WebhookDeliveryUpdate {
+	return wdu.SetWebhookID(w.ID)
+}
+
+// ClearWebhook clears the webhook edge to Webhook.
+func (wdu *WebhookDeliveryUpdate) ClearWebhook() *WebhookDeliveryUpdate {
+	wdu.mutation.ClearWebhook()
+	return wdu
+}
+
+// Save executes the query and returns the number of rows/vertices matched by this operation.
+func (wdu *WebhookDeliveryUpdate) Save(ctx context.Context) (int, error) {
+	if v, ok := wdu.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"status\": %v", err)
+		}
+	}
+	if v, ok := wdu.mutation.Attempts(); ok {
+		if err := webhookdelivery.AttemptsValidator(v); err != nil {
+			return 0, fmt.Errorf("ent: validator failed for field \"attempts\": %v", err)
+		}
+	}
+
+	if _, ok := wdu.mutation.WebhookID(); wdu.mutation.WebhookCleared() && !ok {
+		return 0, errors.New("ent: clearing a unique edge \"webhook\"")
+	}
+	var (
+		err      error
+		affected int
+	)
+	if len(wdu.hooks) == 0 {
+		affected, err = wdu.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wdu.mutation = mutation
+			affected, err = wdu.sqlSave(ctx)
+			return affected, err
+		})
+		for i := len(wdu.hooks) - 1; i >= 0; i-- {
+			mut = wdu.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wdu.mutation); err != nil {
+			return 0, err
+		}
+	}
+	return affected, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) SaveX(ctx context.Context) int {
+	affected, err := wdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wdu *WebhookDeliveryUpdate) Exec(ctx context.Context) error {
+	_, err := wdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wdu *WebhookDeliveryUpdate) ExecX(ctx context.Context) {
+	if err := wdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wdu *WebhookDeliveryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	if ps := wdu.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wdu.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: webhookdelivery.FieldStatus,
+		})
+	}
+	if value, ok := wdu.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wdu.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := wdu.mutation.ResponseStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if value, ok := wdu.mutation.AddedResponseStatus(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if wdu.mutation.ResponseStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if value, ok := wdu.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldLastError,
+		})
+	}
+	if wdu.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldLastError,
+		})
+	}
+	if value, ok := wdu.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wdu.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wdu.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wdu.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, wdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return 0, err
+	}
+	return n, nil
+}
+
+// WebhookDeliveryUpdateOne is the builder for updating a single WebhookDelivery entity.
+type WebhookDeliveryUpdateOne struct {
+	config
+	hooks    []Hook
+	mutation *WebhookDeliveryMutation
+}
+
+// SetStatus sets the status field.
+func (wduo *WebhookDeliveryUpdateOne) SetStatus(w webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetStatus(w)
+	return wduo
+}
+
+// SetNillableStatus sets the status field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableStatus(w *webhookdelivery.Status) *WebhookDeliveryUpdateOne {
+	if w != nil {
+		wduo.SetStatus(*w)
+	}
+	return wduo
+}
+
+// SetAttempts sets the attempts field.
+func (wduo *WebhookDeliveryUpdateOne) SetAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetAttempts()
+	wduo.mutation.SetAttempts(i)
+	return wduo
+}
+
+// SetNillableAttempts sets the attempts field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableAttempts(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetAttempts(*i)
+	}
+	return wduo
+}
+
+// AddAttempts adds i to attempts.
+func (wduo *WebhookDeliveryUpdateOne) AddAttempts(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddAttempts(i)
+	return wduo
+}
+
+// SetNextAttemptAt sets the next_attempt_at field.
+func (wduo *WebhookDeliveryUpdateOne) SetNextAttemptAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetNextAttemptAt(t)
+	return wduo
+}
+
+// SetNillableNextAttemptAt sets the next_attempt_at field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableNextAttemptAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetNextAttemptAt(*t)
+	}
+	return wduo
+}
+
+// SetResponseStatus sets the response_status field.
+func (wduo *WebhookDeliveryUpdateOne) SetResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.ResetResponseStatus()
+	wduo.mutation.SetResponseStatus(i)
+	return wduo
+}
+
+// SetNillableResponseStatus sets the response_status field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableResponseStatus(i *int) *WebhookDeliveryUpdateOne {
+	if i != nil {
+		wduo.SetResponseStatus(*i)
+	}
+	return wduo
+}
+
+// AddResponseStatus adds i to response_status.
+func (wduo *WebhookDeliveryUpdateOne) AddResponseStatus(i int) *WebhookDeliveryUpdateOne {
+	wduo.mutation.AddResponseStatus(i)
+	return wduo
+}
+
+// ClearResponseStatus clears the value of response_status.
+func (wduo *WebhookDeliveryUpdateOne) ClearResponseStatus() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearResponseStatus()
+	return wduo
+}
+
+// SetLastError sets the last_error field.
+func (wduo *WebhookDeliveryUpdateOne) SetLastError(s string) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetLastError(s)
+	return wduo
+}
+
+// SetNillableLastError sets the last_error field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableLastError(s *string) *WebhookDeliveryUpdateOne {
+	if s != nil {
+		wduo.SetLastError(*s)
+	}
+	return wduo
+}
+
+// ClearLastError clears the value of last_error.
+func (wduo *WebhookDeliveryUpdateOne) ClearLastError() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearLastError()
+	return wduo
+}
+
+// SetDeliveredAt sets the delivered_at field.
+func (wduo *WebhookDeliveryUpdateOne) SetDeliveredAt(t time.Time) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetDeliveredAt(t)
+	return wduo
+}
+
+// SetNillableDeliveredAt sets the delivered_at field if the given value is not nil.
+func (wduo *WebhookDeliveryUpdateOne) SetNillableDeliveredAt(t *time.Time) *WebhookDeliveryUpdateOne {
+	if t != nil {
+		wduo.SetDeliveredAt(*t)
+	}
+	return wduo
+}
+
+// ClearDeliveredAt clears the value of delivered_at.
+func (wduo *WebhookDeliveryUpdateOne) ClearDeliveredAt() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearDeliveredAt()
+	return wduo
+}
+
+// SetWebhookID sets the webhook edge to Webhook by id.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhookID(id uuid.UUID) *WebhookDeliveryUpdateOne {
+	wduo.mutation.SetWebhookID(id)
+	return wduo
+}
+
+// SetWebhook sets the webhook edge to Webhook.
+func (wduo *WebhookDeliveryUpdateOne) SetWebhook(w *Webhook) *WebhookDeliveryUpdateOne {
+	return wduo.SetWebhookID(w.ID)
+}
+
+// ClearWebhook clears the webhook edge to Webhook.
+func (wduo *WebhookDeliveryUpdateOne) ClearWebhook() *WebhookDeliveryUpdateOne {
+	wduo.mutation.ClearWebhook()
+	return wduo
+}
+
+// Save executes the query and returns the updated entity.
+func (wduo *WebhookDeliveryUpdateOne) Save(ctx context.Context) (*WebhookDelivery, error) {
+	if v, ok := wduo.mutation.Status(); ok {
+		if err := webhookdelivery.StatusValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"status\": %v", err)
+		}
+	}
+	if v, ok := wduo.mutation.Attempts(); ok {
+		if err := webhookdelivery.AttemptsValidator(v); err != nil {
+			return nil, fmt.Errorf("ent: validator failed for field \"attempts\": %v", err)
+		}
+	}
+
+	if _, ok := wduo.mutation.WebhookID(); wduo.mutation.WebhookCleared() && !ok {
+		return nil, errors.New("ent: clearing a unique edge \"webhook\"")
+	}
+	var (
+		err  error
+		node *WebhookDelivery
+	)
+	if len(wduo.hooks) == 0 {
+		node, err = wduo.sqlSave(ctx)
+	} else {
+		var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			mutation, ok := m.(*WebhookDeliveryMutation)
+			if !ok {
+				return nil, fmt.Errorf("unexpected mutation type %T", m)
+			}
+			wduo.mutation = mutation
+			node, err = wduo.sqlSave(ctx)
+			return node, err
+		})
+		for i := len(wduo.hooks) - 1; i >= 0; i-- {
+			mut = wduo.hooks[i](mut)
+		}
+		if _, err := mut.Mutate(ctx, wduo.mutation); err != nil {
+			return nil, err
+		}
+	}
+	return node, err
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) SaveX(ctx context.Context) *WebhookDelivery {
+	wd, err := wduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return wd
+}
+
+// Exec executes the query on the entity.
+func (wduo *WebhookDeliveryUpdateOne) Exec(ctx context.Context) error {
+	_, err := wduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wduo *WebhookDeliveryUpdateOne) ExecX(ctx context.Context) {
+	if err := wduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (wduo *WebhookDeliveryUpdateOne) sqlSave(ctx context.Context) (wd *WebhookDelivery, err error) {
+	_spec := &sqlgraph.UpdateSpec{
+		Node: &sqlgraph.NodeSpec{
+			Table:   webhookdelivery.Table,
+			Columns: webhookdelivery.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeUUID,
+				Column: webhookdelivery.FieldID,
+			},
+		},
+	}
+	id, ok := wduo.mutation.ID()
+	if !ok {
+		return nil, fmt.Errorf("missing WebhookDelivery.ID for update")
+	}
+	_spec.Node.ID.Value = id
+	if value, ok := wduo.mutation.Status(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeEnum,
+			Value:  value,
+			Column: webhookdelivery.FieldStatus,
+		})
+	}
+	if value, ok := wduo.mutation.Attempts(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.AddedAttempts(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldAttempts,
+		})
+	}
+	if value, ok := wduo.mutation.NextAttemptAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldNextAttemptAt,
+		})
+	}
+	if value, ok := wduo.mutation.ResponseStatus(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if value, ok := wduo.mutation.AddedResponseStatus(); ok {
+		_spec.Fields.Add = append(_spec.Fields.Add, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Value:  value,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if wduo.mutation.ResponseStatusCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeInt,
+			Column: webhookdelivery.FieldResponseStatus,
+		})
+	}
+	if value, ok := wduo.mutation.LastError(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Value:  value,
+			Column: webhookdelivery.FieldLastError,
+		})
+	}
+	if wduo.mutation.LastErrorCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeString,
+			Column: webhookdelivery.FieldLastError,
+		})
+	}
+	if value, ok := wduo.mutation.DeliveredAt(); ok {
+		_spec.Fields.Set = append(_spec.Fields.Set, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Value:  value,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wduo.mutation.DeliveredAtCleared() {
+		_spec.Fields.Clear = append(_spec.Fields.Clear, &sqlgraph.FieldSpec{
+			Type:   field.TypeTime,
+			Column: webhookdelivery.FieldDeliveredAt,
+		})
+	}
+	if wduo.mutation.WebhookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wduo.mutation.WebhookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookdelivery.WebhookTable,
+			Columns: []string{webhookdelivery.WebhookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: &sqlgraph.FieldSpec{
+					Type:   field.TypeUUID,
+					Column: webhook.FieldID,
+				},
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	wd = &WebhookDelivery{config: wduo.config}
+	_spec.Assign = wd.assignValues
+	_spec.ScanValues = wd.scanValues()
+	if err = sqlgraph.UpdateNode(ctx, wduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookdelivery.Label}
+		} else if cerr, ok := isSQLConstraintError(err); ok {
+			err = cerr
+		}
+		return nil, err
+	}
+	return wd, nil
+}
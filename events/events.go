@@ -0,0 +1,135 @@
+package events
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	PinPinned      = "pin.pinned"
+	PinFailed      = "pin.failed"
+	PinUnpinned    = "pin.unpinned"
+	UploadProgress = "upload.progress"
+	QuotaUpdated   = "quota.updated"
+)
+
+// subscriptionBuffer is the number of events a subscriber can lag behind
+// before new events are dropped for it.
+const subscriptionBuffer = 64
+
+type Event struct {
+	ID     uint64      `json:"id"`
+	Type   string      `json:"type"`
+	UserID int         `json:"-"`
+	Time   time.Time   `json:"time"`
+	Data   interface{} `json:"data"`
+}
+
+// Progress is the data of UploadProgress events.
+type Progress struct {
+	Name  string `json:"name"`
+	Bytes int64  `json:"bytes"`
+	Total int64  `json:"total"`
+}
+
+// Bus is an in-process publish/subscribe hub of per-user events. Publishing
+// never blocks: events are dropped for subscribers that do not keep up.
+type Bus struct {
+	mu     sync.RWMutex
+	subs   map[int]map[*Subscription]struct{}
+	nextID uint64
+	closed chan struct{}
+	once   sync.Once
+}
+
+type Subscription struct {
+	bus    *Bus
+	userID int
+	ch     chan *Event
+	once   sync.Once
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs:   make(map[int]map[*Subscription]struct{}),
+		closed: make(chan struct{}),
+	}
+}
+
+// Close signals every subscriber that no more events will be published, so
+// long-lived streams can end before the server shuts down. Publishing after
+// Close is a no-op.
+func (b *Bus) Close() {
+	b.once.Do(func() {
+		close(b.closed)
+	})
+}
+
+// Publish sends an event of type typ to every subscriber of userID.
+func (b *Bus) Publish(userID int, typ string, data interface{}) {
+	e := &Event{
+		ID:     atomic.AddUint64(&b.nextID, 1),
+		Type:   typ,
+		UserID: userID,
+		Time:   time.Now(),
+		Data:   data,
+	}
+
+	select {
+	case <-b.closed:
+		return
+	default:
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for s := range b.subs[userID] {
+		select {
+		case s.ch <- e:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a subscription to the events of userID. It must be
+// closed once the subscriber is done.
+func (b *Bus) Subscribe(userID int) *Subscription {
+	s := &Subscription{
+		bus:    b,
+		userID: userID,
+		ch:     make(chan *Event, subscriptionBuffer),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.subs[userID] == nil {
+		b.subs[userID] = make(map[*Subscription]struct{})
+	}
+	b.subs[userID][s] = struct{}{}
+
+	return s
+}
+
+func (s *Subscription) Events() <-chan *Event {
+	return s.ch
+}
+
+// Done is closed once the bus is closed.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.bus.closed
+}
+
+func (s *Subscription) Close() {
+	s.once.Do(func() {
+		s.bus.mu.Lock()
+		defer s.bus.mu.Unlock()
+
+		delete(s.bus.subs[s.userID], s)
+		if len(s.bus.subs[s.userID]) == 0 {
+			delete(s.bus.subs, s.userID)
+		}
+	})
+}
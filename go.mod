@@ -5,18 +5,26 @@ go 1.14
 require (
 	github.com/dgrijalva/jwt-go v3.2.0+incompatible
 	github.com/facebookincubator/ent v0.2.1
+	github.com/getkin/kin-openapi v0.118.0
 	github.com/go-playground/validator/v10 v10.2.0
-	github.com/google/uuid v1.1.1
+	github.com/google/uuid v1.1.2
 	github.com/ipfs/go-ipfs-api v0.0.3
-	github.com/ipfs/go-ipfs-files v0.0.8 // indirect
+	github.com/ipfs/go-ipfs-files v0.0.8
 	github.com/labstack/echo/v4 v4.1.16
+	github.com/labstack/gommon v0.3.0
 	github.com/lib/pq v1.2.0
 	github.com/libp2p/go-libp2p-core v0.5.2 // indirect
 	github.com/mattn/go-sqlite3 v1.13.0
 	github.com/multiformats/go-multiaddr-net v0.1.4 // indirect
 	github.com/multiformats/go-multibase v0.0.2 // indirect
-	golang.org/x/crypto v0.0.0-20200423211502-4bdfaf469ed5
-	golang.org/x/net v0.0.0-20200425230154-ff2c4b7c35a0 // indirect
-	golang.org/x/sys v0.0.0-20200420163511-1957bb5e6d1f // indirect
+	github.com/prometheus/client_golang v1.7.1
+	go.opentelemetry.io/otel v1.0.0
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.0.0
+	go.opentelemetry.io/otel/sdk v1.0.0
+	go.opentelemetry.io/otel/trace v1.0.0
+	golang.org/x/crypto v0.0.0-20200622213623-75b288015ac9
+	golang.org/x/image v0.0.0-20200430140353-33d19683fad8
+	golang.org/x/net v0.0.0-20200822124328-c89045814202
 	golang.org/x/tools v0.0.0-20200426102838-f3a5411a4c3b // indirect
+	gopkg.in/yaml.v2 v2.4.0
 )
@@ -0,0 +1,117 @@
+package ipfs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	files "github.com/ipfs/go-ipfs-files"
+)
+
+// Stat describes the DAG of a CID.
+type Stat struct {
+	// Type is file or directory for UnixFS DAGs, empty for other DAGs
+	Type string
+
+	// Size is the size of the content of UnixFS files, the size of the
+	// blocks of the DAG otherwise
+	Size int64
+}
+
+// DagImportContext imports the blocks of the CAR file read from r and pins
+// its roots, returning their CIDs. Reading r is not bounded by the timeout,
+// the request is aborted when ctx is done.
+func (i *IPFS) DagImportContext(ctx context.Context, r io.Reader) ([]string, error) {
+	entries := files.NewSliceDirectory([]files.DirEntry{files.FileEntry("", files.NewReaderFile(r))})
+
+	res, err := i.stream.Request("dag/import").
+		Option("pin-roots", true).
+		Body(files.NewMultiFileReader(entries, true)).
+		Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	// The node streams an object per root, along with statistics
+	var roots []string
+	dec := json.NewDecoder(res.Output)
+	for {
+		var out struct {
+			Root *struct {
+				Cid struct {
+					Path string `json:"/"`
+				}
+				PinErrorMsg string
+			}
+		}
+		if err := dec.Decode(&out); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if out.Root == nil {
+			continue
+		}
+		if out.Root.PinErrorMsg != "" {
+			return nil, fmt.Errorf("failed to pin root %s: %s", out.Root.Cid.Path, out.Root.PinErrorMsg)
+		}
+		roots = append(roots, out.Root.Cid.Path)
+	}
+
+	return roots, nil
+}
+
+// DagExportContext streams the CAR file of the DAG of path. The caller must
+// close the returned reader.
+func (i *IPFS) DagExportContext(ctx context.Context, path string) (io.ReadCloser, error) {
+	res, err := i.stream.Request("dag/export", path).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Error != nil {
+		_ = res.Output.Close()
+		return nil, res.Error
+	}
+
+	return res.Output, nil
+}
+
+// StatContext describes the DAG of cid, from the blocks of the node only:
+// DAGs missing blocks are not fetched from the network but fail. DAGs which
+// are not UnixFS are walked to sum the size of their blocks.
+func (i *IPFS) StatContext(ctx context.Context, cid string) (*Stat, error) {
+	var stat struct {
+		Type           string
+		Size           int64
+		CumulativeSize int64
+	}
+	if err := i.Request("files/stat", "/ipfs/"+cid).Option("offline", true).Exec(ctx, &stat); err == nil {
+		if stat.Type == "file" {
+			return &Stat{Type: stat.Type, Size: stat.Size}, nil
+		}
+		return &Stat{Type: stat.Type, Size: stat.CumulativeSize}, nil
+	}
+
+	// Recent nodes report the total size of the arguments, older ones the
+	// size of the single one
+	var dag struct {
+		Size      int64
+		TotalSize int64
+	}
+	if err := i.Request("dag/stat", cid).Option("offline", true).Option("progress", false).Exec(ctx, &dag); err != nil {
+		return nil, err
+	}
+
+	if dag.TotalSize > 0 {
+		return &Stat{Size: dag.TotalSize}, nil
+	}
+	return &Stat{Size: dag.Size}, nil
+}
@@ -0,0 +1,58 @@
+package ipfs
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sthorer/api/metrics"
+	"github.com/sthorer/api/tracing"
+)
+
+const apiPrefix = "/api/v0/"
+
+// instrumentedTransport traces every RPC made to the node and records its
+// latency and failures, labeled by command.
+type instrumentedTransport struct {
+	http.RoundTripper
+}
+
+func (t *instrumentedTransport) RoundTrip(req *http.Request) (res *http.Response, err error) {
+	command := strings.TrimPrefix(req.URL.Path, apiPrefix)
+	start := time.Now()
+
+	ctx, span := tracing.Tracer().Start(req.Context(), "ipfs "+command,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("ipfs.command", command)),
+	)
+	defer func() {
+		metrics.IPFSRequestDuration.WithLabelValues(command).Observe(time.Since(start).Seconds())
+		if err != nil {
+			metrics.IPFSRequestErrors.WithLabelValues(command).Inc()
+		}
+		tracing.End(span, err)
+	}()
+
+	res, err = t.RoundTripper.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(semconv.HTTPStatusCodeKey.Int(res.StatusCode))
+	span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(res.StatusCode))
+	if res.StatusCode >= http.StatusBadRequest {
+		metrics.IPFSRequestErrors.WithLabelValues(command).Inc()
+	}
+
+	return res, nil
+}
+
+func (t *instrumentedTransport) CloseIdleConnections() {
+	if closer, ok := t.RoundTripper.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
@@ -2,40 +2,145 @@ package ipfs
 
 import (
 	"context"
-	"fmt"
-	"log"
+	"io"
 	"net/http"
-	"os"
 	"time"
 
 	shell "github.com/ipfs/go-ipfs-api"
+	files "github.com/ipfs/go-ipfs-files"
+
+	"github.com/sthorer/api/logging"
 )
 
 type IPFS struct {
 	*shell.Shell
+
+	client *http.Client
+
+	// stream sends uploaded bodies and serves responses streamed to
+	// clients, which may take longer than the timeout to be sent or read:
+	// only the response headers are bounded, and requests by their context
+	stream       *shell.Shell
+	streamClient *http.Client
 }
 
-const defaultIPFSNodeURL = "127.0.0.1:5001"
+// Settings configures the connection to the IPFS node.
+type Settings struct {
+	// URL is the address of the node HTTP API
+	URL string `yaml:"url" validate:"required"`
+
+	// Timeout bounds every request made to the node
+	Timeout time.Duration `yaml:"timeout" validate:"gt=0"`
+}
 
-func Initialize() (*IPFS, error) {
-	ipfsNodeURL := os.Getenv("STHORER_IPFS_NODE_URL")
-	if ipfsNodeURL == "" {
-		ipfsNodeURL = defaultIPFSNodeURL
+// NodeStatus describes the IPFS node.
+type NodeStatus struct {
+	ID           string `json:"id"`
+	AgentVersion string `json:"agent_version"`
+	Version      string `json:"version"`
+}
+
+// Initialize connects to the IPFS node. An unreachable node is not fatal:
+// the API starts degraded, reports it as not ready and requests needing
+// the node fail until it is back.
+func Initialize(ctx context.Context, settings *Settings) (*IPFS, error) {
+	client := &http.Client{
+		Timeout:   settings.Timeout,
+		Transport: &instrumentedTransport{http.DefaultTransport.(*http.Transport).Clone()},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = settings.Timeout
+	streamClient := &http.Client{Transport: &instrumentedTransport{transport}}
+	i := &IPFS{
+		Shell:        shell.NewShellWithClient(settings.URL, client),
+		client:       client,
+		stream:       shell.NewShellWithClient(settings.URL, streamClient),
+		streamClient: streamClient,
 	}
 
-	sh := shell.NewShellWithClient(ipfsNodeURL, &http.Client{Timeout: time.Second * 10})
-	for attempt := 0; ; attempt++ {
-		if attempt > 10 {
-			return nil, fmt.Errorf("failed to ping IPFS node at: %s", ipfsNodeURL)
+	if _, err := i.Status(ctx); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
 		}
 
-		if _, err := sh.Request("ping").Send(context.Background()); err == nil {
-			break
-		}
+		logging.Default().Warn("IPFS node is unreachable, starting degraded", "url", settings.URL, "error", err)
+	}
+
+	return i, nil
+}
 
-		log.Println("failed to connect to IPFS node. Retrying...")
-		time.Sleep(time.Second * 5)
+// Status queries the identity and version of the node.
+func (i *IPFS) Status(ctx context.Context) (*NodeStatus, error) {
+	var id struct {
+		ID           string
+		AgentVersion string
 	}
+	if err := i.Request("id").Exec(ctx, &id); err != nil {
+		return nil, err
+	}
+
+	var version struct {
+		Version string
+	}
+	if err := i.Request("version").Exec(ctx, &version); err != nil {
+		return nil, err
+	}
+
+	return &NodeStatus{ID: id.ID, AgentVersion: id.AgentVersion, Version: version.Version}, nil
+}
+
+// AddContext adds and pins the content of r, like Shell.Add, and returns
+// its hash. Reading r is not bounded by the timeout, the request is
+// aborted when ctx is done.
+func (i *IPFS) AddContext(ctx context.Context, r io.Reader) (string, error) {
+	entries := files.NewSliceDirectory([]files.DirEntry{files.FileEntry("", files.NewReaderFile(r))})
+
+	var out struct {
+		Hash string
+	}
+	if err := i.stream.Request("add").Body(files.NewMultiFileReader(entries, true)).Exec(ctx, &out); err != nil {
+		return "", err
+	}
+
+	return out.Hash, nil
+}
+
+// UnpinContext recursively unpins path, like Shell.Unpin. The request is
+// aborted when ctx is done.
+func (i *IPFS) UnpinContext(ctx context.Context, path string) error {
+	return i.Request("pin/rm", path).
+		Option("recursive", true).
+		Exec(ctx, nil)
+}
+
+// CatContext streams the content of path, starting at offset. At most
+// length bytes are read unless length is negative. The caller must close
+// the returned reader.
+func (i *IPFS) CatContext(ctx context.Context, path string, offset, length int64) (io.ReadCloser, error) {
+	req := i.stream.Request("cat", path)
+	if offset > 0 {
+		req.Option("offset", offset)
+	}
+	if length >= 0 {
+		req.Option("length", length)
+	}
+
+	res, err := req.Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Error != nil {
+		_ = res.Output.Close()
+		return nil, res.Error
+	}
+
+	return res.Output, nil
+}
 
-	return &IPFS{Shell: sh}, nil
+// Close releases the idle connections to the node.
+func (i *IPFS) Close() error {
+	i.client.CloseIdleConnections()
+	i.streamClient.CloseIdleConnections()
+	return nil
 }
@@ -0,0 +1,329 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+var levelNames = map[Level]string{
+	LevelDebug: "debug",
+	LevelInfo:  "info",
+	LevelWarn:  "warn",
+	LevelError: "error",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// ParseLevel returns the level named s.
+func ParseLevel(s string) (Level, error) {
+	for level, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return level, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown log level %q", s)
+}
+
+// Formats.
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Settings configures the logger.
+type Settings struct {
+	// Level is the minimum level of the logged entries
+	Level string `yaml:"level" validate:"required,oneof=debug info warn error"`
+
+	// Format is json, one object per line, or text for humans
+	Format string `yaml:"format" validate:"required,oneof=json text"`
+
+	// Redact lists field names whose values are never logged, in addition
+	// to the built-in ones such as password and secret
+	Redact []string `yaml:"redact"`
+}
+
+// Redacted replaces the value of sensitive fields.
+const Redacted = "REDACTED"
+
+// sensitiveFields are always redacted, at any depth. Names are matched
+// regardless of case.
+var sensitiveFields = []string{
+	"password",
+	"secret",
+	"token",
+	"access_token",
+	"authorization",
+	"cookie",
+	"set-cookie",
+	// Query parameters of presigned URLs
+	"signature",
+	"x-amz-signature",
+	"x-amz-credential",
+	"x-amz-security-token",
+}
+
+// Logger writes leveled entries made of a message and key/value fields.
+// Loggers derived with With share the output of their parent.
+type Logger struct {
+	out    *output
+	level  Level
+	format string
+	redact map[string]bool
+	fields []field
+}
+
+type output struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+// New returns a logger writing to w. An invalid level falls back to info
+// and an unknown format to JSON.
+func New(w io.Writer, settings *Settings) *Logger {
+	level, err := ParseLevel(settings.Level)
+	if err != nil {
+		level = LevelInfo
+	}
+
+	redact := make(map[string]bool)
+	for _, key := range append(sensitiveFields, settings.Redact...) {
+		redact[strings.ToLower(key)] = true
+	}
+
+	return &Logger{
+		out:    &output{w: w},
+		level:  level,
+		format: settings.Format,
+		redact: redact,
+	}
+}
+
+var (
+	defaultMu     sync.RWMutex
+	defaultLogger = New(os.Stderr, &Settings{Level: "info", Format: FormatJSON})
+)
+
+// Default returns the logger used outside of requests.
+func Default() *Logger {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultLogger
+}
+
+// SetDefault replaces the default logger.
+func SetDefault(l *Logger) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultLogger = l
+}
+
+// With returns a logger adding the key/value pairs kv to every entry.
+func (l *Logger) With(kv ...interface{}) *Logger {
+	child := *l
+	child.fields = append(append([]field{}, l.fields...), pairs(kv)...)
+	return &child
+}
+
+func (l *Logger) Debug(msg string, kv ...interface{}) { l.log(LevelDebug, msg, kv) }
+func (l *Logger) Info(msg string, kv ...interface{})  { l.log(LevelInfo, msg, kv) }
+func (l *Logger) Warn(msg string, kv ...interface{})  { l.log(LevelWarn, msg, kv) }
+func (l *Logger) Error(msg string, kv ...interface{}) { l.log(LevelError, msg, kv) }
+
+// Enabled reports whether entries of level are written.
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+// Writer returns a writer logging every line written to it at level, to
+// route the output of the standard library logger.
+func (l *Logger) Writer(level Level) io.Writer {
+	return writerFunc(func(p []byte) (int, error) {
+		for _, line := range strings.Split(strings.TrimRight(string(p), "\n"), "\n") {
+			l.log(level, line, nil)
+		}
+		return len(p), nil
+	})
+}
+
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
+
+func (l *Logger) log(level Level, msg string, kv []interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	fields := append(append([]field{}, l.fields...), pairs(kv)...)
+	for i, f := range fields {
+		fields[i].value = l.sanitize(f.key, f.value)
+	}
+
+	var buf bytes.Buffer
+	now := time.Now().UTC()
+	if l.format == FormatText {
+		fmt.Fprintf(&buf, "%s %-5s %s", now.Format("2006-01-02T15:04:05.000Z07:00"), strings.ToUpper(level.String()), msg)
+		for _, f := range fields {
+			fmt.Fprintf(&buf, " %s=%s", f.key, textValue(f.value))
+		}
+	} else {
+		buf.WriteString(`{"time":`)
+		writeJSON(&buf, now.Format(time.RFC3339Nano))
+		buf.WriteString(`,"level":`)
+		writeJSON(&buf, level.String())
+		buf.WriteString(`,"msg":`)
+		writeJSON(&buf, msg)
+		for _, f := range fields {
+			buf.WriteByte(',')
+			writeJSON(&buf, f.key)
+			buf.WriteByte(':')
+			writeJSON(&buf, f.value)
+		}
+		buf.WriteByte('}')
+	}
+	buf.WriteByte('\n')
+
+	l.out.mu.Lock()
+	defer l.out.mu.Unlock()
+	_, _ = l.out.w.Write(buf.Bytes())
+}
+
+// sanitize redacts value when key is sensitive and, for structured values,
+// the sensitive keys they contain.
+func (l *Logger) sanitize(key string, value interface{}) interface{} {
+	if l.redact[strings.ToLower(key)] {
+		return Redacted
+	}
+
+	switch v := value.(type) {
+	case nil, string, bool, int, int64, uint64, float64, time.Duration, time.Time:
+		return v
+	case error:
+		return v.Error()
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	// Go through JSON to apply the json tags of structs, which already
+	// hide fields such as ent sensitive ones, and find nested keys
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%+v", value)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return string(raw)
+	}
+
+	return l.redactNested(decoded)
+}
+
+func (l *Logger) redactNested(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, nested := range v {
+			if l.redact[strings.ToLower(key)] {
+				v[key] = Redacted
+			} else {
+				v[key] = l.redactNested(nested)
+			}
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = l.redactNested(nested)
+		}
+	}
+
+	return value
+}
+
+// RedactURL returns u as a request URI with the values of its sensitive
+// query parameters, such as access_token, redacted.
+func (l *Logger) RedactURL(u *url.URL) string {
+	query := u.Query()
+	redacted := false
+	for key := range query {
+		if l.redact[strings.ToLower(key)] {
+			query.Set(key, Redacted)
+			redacted = true
+		}
+	}
+
+	if !redacted {
+		return u.RequestURI()
+	}
+
+	clone := *u
+	clone.RawQuery = query.Encode()
+	return clone.RequestURI()
+}
+
+func pairs(kv []interface{}) []field {
+	fields := make([]field, 0, (len(kv)+1)/2)
+	for i := 0; i < len(kv); i += 2 {
+		key, ok := kv[i].(string)
+		if !ok {
+			key = fmt.Sprint(kv[i])
+		}
+
+		var value interface{} = "MISSING"
+		if i+1 < len(kv) {
+			value = kv[i+1]
+		}
+
+		fields = append(fields, field{key: key, value: value})
+	}
+
+	return fields
+}
+
+func writeJSON(buf *bytes.Buffer, v interface{}) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		raw, _ = json.Marshal(fmt.Sprint(v))
+	}
+	buf.Write(raw)
+}
+
+func textValue(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		if strings.ContainsAny(v, " \t\n\"=") {
+			return fmt.Sprintf("%q", v)
+		}
+		return v
+	case map[string]interface{}, []interface{}:
+		raw, _ := json.Marshal(v)
+		return string(raw)
+	default:
+		return fmt.Sprint(v)
+	}
+}
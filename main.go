@@ -1,24 +1,123 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 
 	_ "github.com/lib/pq"
 	_ "github.com/mattn/go-sqlite3"
 
 	"github.com/sthorer/api/api"
 	"github.com/sthorer/api/config"
+	"github.com/sthorer/api/logging"
 )
 
 func main() {
-	conf, err := config.Initialize()
+	settings, args, err := config.Load(os.Args[1:])
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	defer conf.Close()
+	logger := logging.New(os.Stderr, &settings.Logging)
+	logging.SetDefault(logger)
+
+	// Route the output of libraries using the standard logger
+	log.SetFlags(0)
+	log.SetOutput(logger.Writer(logging.LevelInfo))
+
+	if len(args) > 0 {
+		switch args[0] {
+		case "migrate":
+			err = migrate(&settings.Database, args[1:])
+		case "config":
+			err = configCommand(settings, args[1:])
+		default:
+			err = fmt.Errorf("unknown command %q", args[0])
+		}
+	} else {
+		err = serve(settings)
+	}
+
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// serve runs the API until it receives SIGINT or SIGTERM. In-flight
+// requests are then given ShutdownTimeout to complete, and the background
+// workers, the IPFS connection and the database as long again to stop.
+func serve(settings *config.Settings) error {
+	// Only serving needs every setting, the other commands run with
+	// partial configurations
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	// Canceled on the first signal, which also aborts a pending startup
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	conf, err := config.Initialize(ctx, settings)
+	if err != nil {
+		return err
+	}
 
 	e := api.New(conf)
-	e.Logger.Fatal(e.Start(fmt.Sprintf("%s:%d", conf.Host, conf.Port)))
+
+	// Event streams never end on their own and would hold the shutdown
+	e.Server.RegisterOnShutdown(conf.Events.Close)
+
+	addr := fmt.Sprintf("%s:%d", conf.Host, conf.Port)
+	conf.Log().Info("listening", "address", addr)
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- e.Start(addr)
+	}()
+
+	select {
+	case err = <-errs:
+		// The server failed to start or stopped unexpectedly
+	case <-ctx.Done():
+		conf.Log().Info("shutting down")
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
+	defer cancelShutdown()
+
+	if shutdownErr := e.Shutdown(shutdownCtx); shutdownErr != nil {
+		conf.Log().Error("failed to complete in-flight requests", "error", shutdownErr)
+	}
+
+	// The services get their own deadline, requests holding the shutdown
+	// would leave them none
+	closeCtx, cancelClose := context.WithTimeout(context.Background(), conf.ShutdownTimeout)
+	defer cancelClose()
+
+	if closeErr := conf.Close(closeCtx); closeErr != nil && err == nil {
+		err = closeErr
+	}
+
+	if err == http.ErrServerClosed {
+		return nil
+	}
+
+	return err
 }
@@ -0,0 +1,220 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// probeWAV reads the duration of a WAV file from its format and the size
+// of its data.
+func probeWAV(s *Sample, info *Info) {
+	head := s.Head
+	if len(head) < 12 || string(head[:4]) != "RIFF" || string(head[8:12]) != "WAVE" {
+		return
+	}
+
+	var byteRate uint32
+	for i := 12; i+8 <= len(head); {
+		id := string(head[i : i+4])
+		size := binary.LittleEndian.Uint32(head[i+4:])
+
+		switch id {
+		case "fmt ":
+			if i+20 <= len(head) {
+				byteRate = binary.LittleEndian.Uint32(head[i+16:])
+			}
+		case "data":
+			if byteRate == 0 {
+				return
+			}
+			// Recorders streaming the data leave its size unknown
+			data := int64(size)
+			if rest := s.Size - int64(i+8); data == 0 || data == 0xFFFFFFFF || data > rest {
+				data = rest
+			}
+			info.Duration = float64(data) / float64(byteRate)
+			return
+		}
+
+		// Chunks are aligned on 2 bytes
+		i += 8 + int(size) + int(size&1)
+	}
+}
+
+// probeFLAC reads the duration of a FLAC file from its STREAMINFO block,
+// which is always first.
+func probeFLAC(s *Sample, info *Info) {
+	head := s.Head
+	if len(head) < 42 || string(head[:4]) != "fLaC" || head[4]&0x7F != 0 {
+		return
+	}
+
+	streamInfo := head[8:]
+	sampleRate := uint32(streamInfo[10])<<12 | uint32(streamInfo[11])<<4 | uint32(streamInfo[12])>>4
+	samples := uint64(streamInfo[13]&0x0F)<<32 | uint64(binary.BigEndian.Uint32(streamInfo[14:]))
+	if sampleRate > 0 {
+		info.Duration = float64(samples) / float64(sampleRate)
+	}
+}
+
+// MPEG audio versions.
+const (
+	mpeg25 = 0
+	mpeg2  = 2
+	mpeg1  = 3
+)
+
+// mp3Bitrates are the bitrates in kbit/s by version 1 or 2, layer and
+// index.
+var mp3Bitrates = [2][4][16]int{
+	{
+		{},
+		{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 384},
+		{0, 32, 64, 96, 128, 160, 192, 224, 256, 288, 320, 352, 384, 416, 448},
+	},
+	{
+		{},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160},
+		{0, 32, 48, 56, 64, 80, 96, 112, 128, 144, 160, 176, 192, 224, 256},
+	},
+}
+
+// mp3SampleRates are the sample rates by version and index.
+var mp3SampleRates = map[int][3]int{
+	mpeg1:  {44100, 48000, 32000},
+	mpeg2:  {22050, 24000, 16000},
+	mpeg25: {11025, 12000, 8000},
+}
+
+// mp3Frame is the header of an MPEG audio frame.
+type mp3Frame struct {
+	version    int
+	layer      int
+	bitrate    int
+	sampleRate int
+	samples    int
+	length     int
+	mono       bool
+}
+
+// parseMP3Frame parses the frame header at the start of b.
+func parseMP3Frame(b []byte) (mp3Frame, bool) {
+	if len(b) < 4 || b[0] != 0xFF || b[1]&0xE0 != 0xE0 {
+		return mp3Frame{}, false
+	}
+
+	f := mp3Frame{
+		version: int(b[1] >> 3 & 3),
+		// Layers are numbered backwards: 3 is layer I
+		layer: 4 - int(b[1]>>1&3),
+		mono:  b[3]>>6 == 3,
+	}
+	bitrateIndex, rateIndex, padding := int(b[2]>>4), int(b[2]>>2&3), int(b[2]>>1&1)
+	if f.version == 1 || f.layer == 4 || bitrateIndex == 0 || bitrateIndex == 15 || rateIndex == 3 {
+		return mp3Frame{}, false
+	}
+
+	table := 0
+	if f.version != mpeg1 {
+		table = 1
+	}
+	f.bitrate = mp3Bitrates[table][4-f.layer][bitrateIndex] * 1000
+	f.sampleRate = mp3SampleRates[f.version][rateIndex]
+
+	switch {
+	case f.layer == 1:
+		f.samples = 384
+		f.length = (12*f.bitrate/f.sampleRate + padding) * 4
+	case f.layer == 3 && f.version != mpeg1:
+		f.samples = 576
+		f.length = 72*f.bitrate/f.sampleRate + padding
+	default:
+		f.samples = 1152
+		f.length = 144*f.bitrate/f.sampleRate + padding
+	}
+
+	return f, true
+}
+
+// isMP3Frame reports whether head starts with two MPEG audio frames, for
+// files without ID3 tag.
+func isMP3Frame(head []byte) bool {
+	f, ok := parseMP3Frame(head)
+	if !ok || f.length > len(head) {
+		return false
+	}
+
+	_, ok = parseMP3Frame(head[f.length:])
+	return ok
+}
+
+// id3Size returns the size of the ID3v2 tag at the start of head.
+func id3Size(head []byte) int {
+	if len(head) < 10 || string(head[:3]) != "ID3" {
+		return 0
+	}
+
+	// Sizes are stored on 7 bits bytes
+	size := int(head[6])<<21 | int(head[7])<<14 | int(head[8])<<7 | int(head[9])
+	if head[5]&0x10 != 0 {
+		size += 10
+	}
+
+	return 10 + size
+}
+
+// probeMP3 reads the duration of an MP3 file from the Xing or VBRI header
+// of variable bitrate files, or from the bitrate of its first frame.
+func probeMP3(s *Sample, info *Info) {
+	start := id3Size(s.Head)
+	if start >= len(s.Head) {
+		return
+	}
+
+	// Tags are padded with zeros before the first frame
+	offset := bytes.IndexByte(s.Head[start:], 0xFF)
+	if offset < 0 {
+		return
+	}
+	start += offset
+
+	frame := s.Head[start:]
+	f, ok := parseMP3Frame(frame)
+	if !ok {
+		return
+	}
+
+	sideInfo := 32
+	switch {
+	case f.version == mpeg1 && f.mono:
+		sideInfo = 17
+	case f.version != mpeg1 && !f.mono:
+		sideInfo = 17
+	case f.version != mpeg1:
+		sideInfo = 9
+	}
+
+	if xing := 4 + sideInfo; len(frame) >= xing+12 {
+		tag := string(frame[xing : xing+4])
+		if (tag == "Xing" || tag == "Info") && binary.BigEndian.Uint32(frame[xing+4:])&1 != 0 {
+			frames := binary.BigEndian.Uint32(frame[xing+8:])
+			info.Duration = float64(frames) * float64(f.samples) / float64(f.sampleRate)
+			return
+		}
+	}
+
+	if vbri := 4 + 32; len(frame) >= vbri+18 && string(frame[vbri:vbri+4]) == "VBRI" {
+		frames := binary.BigEndian.Uint32(frame[vbri+14:])
+		info.Duration = float64(frames) * float64(f.samples) / float64(f.sampleRate)
+		return
+	}
+
+	size := s.Size - int64(start)
+	// ID3v1 tags are at the end
+	if tail := s.At(s.Size-128, 3); string(tail) == "TAG" {
+		size -= 128
+	}
+	info.Duration = float64(size) * 8 / float64(f.bitrate)
+}
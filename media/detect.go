@@ -0,0 +1,99 @@
+package media
+
+import (
+	"bytes"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// generic are the media types sniffed from contents which could be refined
+// by the type declared by clients or the extension of the file name, and
+// the types declared by default by HTTP clients.
+var generic = map[string]bool{
+	"application/octet-stream":          true,
+	"text/plain":                        true,
+	"text/xml":                          true,
+	"application/zip":                   true,
+	"binary/octet-stream":               true,
+	"application/x-www-form-urlencoded": true,
+}
+
+// extensions complete the media types of the system for extensions with
+// no registered type on most of them.
+var extensions = map[string]string{
+	".md":       "text/markdown; charset=utf-8",
+	".markdown": "text/markdown; charset=utf-8",
+	".flac":     "audio/flac",
+	".m4a":      "audio/mp4",
+	".mkv":      "video/x-matroska",
+	".mov":      "video/quicktime",
+	".webp":     "image/webp",
+}
+
+// brands are the media types of the ISO base media files by major brand.
+var brands = map[string]string{
+	"M4A ": "audio/mp4",
+	"M4B ": "audio/mp4",
+	"qt  ": "video/quicktime",
+	"heic": "image/heic",
+	"heix": "image/heic",
+	"mif1": "image/heif",
+	"avif": "image/avif",
+}
+
+// Detect returns the content type of a content starting with head, of a
+// file named name and declared as declared by the client.
+//
+// The type is sniffed from the content as browsers do. When only a generic
+// type such as text/plain is found, the declared type is trusted, or the
+// type of the extension of name.
+func Detect(head []byte, name, declared string) string {
+	sniffed := sniff(head)
+	if mediaType, _, _ := mime.ParseMediaType(sniffed); !generic[mediaType] {
+		return sniffed
+	}
+
+	if mediaType, _, err := mime.ParseMediaType(declared); err == nil && !generic[mediaType] {
+		return declared
+	}
+
+	if byExtension := TypeByExtension(name); byExtension != "" {
+		return byExtension
+	}
+
+	return sniffed
+}
+
+// TypeByExtension returns the content type of a file named name from its
+// extension, or an empty string when unknown.
+func TypeByExtension(name string) string {
+	ext := strings.ToLower(path.Ext(name))
+	if ext == "" {
+		return ""
+	}
+
+	if contentType, ok := extensions[ext]; ok {
+		return contentType
+	}
+
+	return mime.TypeByExtension(ext)
+}
+
+// sniff completes http.DetectContentType with the formats it misses.
+func sniff(head []byte) string {
+	switch {
+	case bytes.HasPrefix(head, []byte("fLaC")):
+		return "audio/flac"
+	case len(head) >= 12 && string(head[4:8]) == "ftyp":
+		if contentType, ok := brands[string(head[8:12])]; ok {
+			return contentType
+		}
+		return "video/mp4"
+	case isMP3Frame(head):
+		return "audio/mpeg"
+	}
+
+	return http.DetectContentType(head)
+}
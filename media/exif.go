@@ -0,0 +1,220 @@
+package media
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+)
+
+// EXIF tags read from the TIFF structure of APP1 segments.
+const (
+	tagMake             = 0x010F
+	tagModel            = 0x0110
+	tagOrientation      = 0x0112
+	tagSoftware         = 0x0131
+	tagExifIFD          = 0x8769
+	tagGPSIFD           = 0x8825
+	tagExposureTime     = 0x829A
+	tagFNumber          = 0x829D
+	tagISO              = 0x8827
+	tagDateTimeOriginal = 0x9003
+	tagOffsetOriginal   = 0x9011
+	tagFocalLength      = 0x920A
+	tagLatitudeRef      = 0x0001
+	tagLatitude         = 0x0002
+	tagLongitudeRef     = 0x0003
+	tagLongitude        = 0x0004
+	tagAltitudeRef      = 0x0005
+	tagAltitude         = 0x0006
+)
+
+// exifTimeLayout is the layout of the EXIF dates, without time zone.
+const exifTimeLayout = "2006:01:02 15:04:05"
+
+// typeSizes are the sizes of the values of the TIFF types.
+var typeSizes = map[uint16]int{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8,
+}
+
+// tiff is the TIFF structure holding EXIF tags.
+type tiff struct {
+	data  []byte
+	order binary.ByteOrder
+}
+
+// ifd is an image file directory: values by tag.
+type ifd map[uint16]field
+
+type field struct {
+	kind  uint16
+	count int
+	value []byte
+}
+
+// readEXIF reads the main EXIF tags of the TIFF structure in data.
+func readEXIF(data []byte, info *Info) {
+	if len(data) < 8 {
+		return
+	}
+
+	t := &tiff{data: data}
+	switch string(data[:2]) {
+	case "II":
+		t.order = binary.LittleEndian
+	case "MM":
+		t.order = binary.BigEndian
+	default:
+		return
+	}
+
+	main := t.ifd(t.order.Uint32(data[4:]))
+	exif := t.ifd(main.uint(t, tagExifIFD))
+	gps := t.ifd(main.uint(t, tagGPSIFD))
+
+	tags := map[string]interface{}{}
+	for name, tag := range map[string]uint16{"make": tagMake, "model": tagModel, "software": tagSoftware} {
+		if value := main.string(tag); value != "" {
+			tags[name] = value
+		}
+	}
+	if orientation := main.uint(t, tagOrientation); orientation > 0 {
+		tags["orientation"] = orientation
+	}
+	if iso := exif.uint(t, tagISO); iso > 0 {
+		tags["iso"] = iso
+	}
+	for name, tag := range map[string]uint16{"exposure_time": tagExposureTime, "f_number": tagFNumber, "focal_length": tagFocalLength} {
+		if value := exif.rational(t, tag, 0); value > 0 {
+			tags[name] = value
+		}
+	}
+
+	if latitude, ok := gps.coordinate(t, tagLatitude, tagLatitudeRef, "S"); ok {
+		tags["latitude"] = latitude
+	}
+	if longitude, ok := gps.coordinate(t, tagLongitude, tagLongitudeRef, "W"); ok {
+		tags["longitude"] = longitude
+	}
+	if _, ok := gps[tagAltitude]; ok {
+		altitude := gps.rational(t, tagAltitude, 0)
+		if ref, ok := gps[tagAltitudeRef]; ok && len(ref.value) > 0 && ref.value[0] == 1 {
+			altitude = -altitude
+		}
+		tags["altitude"] = altitude
+	}
+
+	if len(tags) > 0 {
+		info.EXIF = tags
+	}
+
+	// The offset of the time zone is only recorded by recent cameras
+	layout, value := exifTimeLayout, exif.string(tagDateTimeOriginal)
+	if offset := exif.string(tagOffsetOriginal); offset != "" {
+		layout, value = layout+"-07:00", value+offset
+	}
+	if takenAt, err := time.Parse(layout, value); err == nil {
+		info.TakenAt = &takenAt
+	}
+}
+
+// ifd reads the directory at offset, ignoring the fields out of data.
+func (t *tiff) ifd(offset uint32) ifd {
+	fields := ifd{}
+	if offset == 0 || int64(offset)+2 > int64(len(t.data)) {
+		return fields
+	}
+
+	count := int(t.order.Uint16(t.data[offset:]))
+	for i := 0; i < count; i++ {
+		entry := int(offset) + 2 + i*12
+		if entry+12 > len(t.data) {
+			break
+		}
+
+		tag := t.order.Uint16(t.data[entry:])
+		kind := t.order.Uint16(t.data[entry+2:])
+		n := t.order.Uint32(t.data[entry+4:])
+		size, ok := typeSizes[kind]
+		if !ok || n > uint32(len(t.data)) {
+			continue
+		}
+
+		length := size * int(n)
+		start := entry + 8
+		// Values longer than 4 bytes are stored at an offset
+		if length > 4 {
+			start = int(t.order.Uint32(t.data[entry+8:]))
+		}
+		if start < 0 || start+length > len(t.data) {
+			continue
+		}
+
+		fields[tag] = field{kind: kind, count: int(n), value: t.data[start : start+length]}
+	}
+
+	return fields
+}
+
+func (d ifd) string(tag uint16) string {
+	f, ok := d[tag]
+	if !ok || f.kind != 2 {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimRight(string(f.value), "\x00"))
+}
+
+func (d ifd) uint(t *tiff, tag uint16) uint32 {
+	f, ok := d[tag]
+	if !ok || f.count == 0 {
+		return 0
+	}
+
+	switch f.kind {
+	case 1:
+		return uint32(f.value[0])
+	case 3:
+		return uint32(t.order.Uint16(f.value))
+	case 4:
+		return t.order.Uint32(f.value)
+	}
+
+	return 0
+}
+
+// rational returns the i-th rational value of tag.
+func (d ifd) rational(t *tiff, tag uint16, i int) float64 {
+	f, ok := d[tag]
+	if !ok || (f.kind != 5 && f.kind != 10) || i >= f.count {
+		return 0
+	}
+
+	numerator := t.order.Uint32(f.value[i*8:])
+	denominator := t.order.Uint32(f.value[i*8+4:])
+	if denominator == 0 {
+		return 0
+	}
+
+	if f.kind == 10 {
+		return float64(int32(numerator)) / float64(int32(denominator))
+	}
+
+	return float64(numerator) / float64(denominator)
+}
+
+// coordinate returns the GPS coordinate of tag in degrees, negative when
+// its reference tag is negative.
+func (d ifd) coordinate(t *tiff, tag, refTag uint16, negative string) (float64, bool) {
+	f, ok := d[tag]
+	if !ok || f.count < 3 {
+		return 0, false
+	}
+
+	degrees := d.rational(t, tag, 0) + d.rational(t, tag, 1)/60 + d.rational(t, tag, 2)/3600
+	if d.string(refTag) == negative {
+		degrees = -degrees
+	}
+
+	return math.Round(degrees*1e6) / 1e6, true
+}
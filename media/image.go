@@ -0,0 +1,100 @@
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	_ "image/gif"  // Register the GIF decoder
+	_ "image/jpeg" // Register the JPEG decoder
+	_ "image/png"  // Register the PNG decoder
+)
+
+func probeImage(s *Sample, info *Info) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(s.Head))
+	if err != nil {
+		return
+	}
+
+	info.Width, info.Height = config.Width, config.Height
+}
+
+// probeJPEG reads the dimensions of a JPEG image, and the EXIF tags of its
+// APP1 segment.
+func probeJPEG(s *Sample, info *Info) {
+	probeImage(s, info)
+
+	head := s.Head
+	if len(head) < 4 || head[0] != 0xFF || head[1] != 0xD8 {
+		return
+	}
+
+	for i := 2; i+4 <= len(head) && head[i] == 0xFF; {
+		marker := head[i+1]
+		length := int(binary.BigEndian.Uint16(head[i+2:]))
+		// The segments end with the start of scan
+		if marker == 0xDA || length < 2 || i+2+length > len(head) {
+			return
+		}
+
+		segment := head[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			readEXIF(segment[6:], info)
+			return
+		}
+
+		i += 2 + length
+	}
+}
+
+// probeWebP reads the dimensions of a WebP image from its first chunk.
+func probeWebP(s *Sample, info *Info) {
+	head := s.Head
+	if len(head) < 30 || string(head[:4]) != "RIFF" || string(head[8:12]) != "WEBP" {
+		return
+	}
+
+	switch string(head[12:16]) {
+	case "VP8 ":
+		// Lossy: the key frame header follows the start code
+		info.Width = int(binary.LittleEndian.Uint16(head[26:]) & 0x3FFF)
+		info.Height = int(binary.LittleEndian.Uint16(head[28:]) & 0x3FFF)
+	case "VP8L":
+		// Lossless: 14 bits for each dimension minus one
+		bits := binary.LittleEndian.Uint32(head[21:])
+		info.Width = int(bits&0x3FFF) + 1
+		info.Height = int(bits>>14&0x3FFF) + 1
+	case "VP8X":
+		// Extended: 24 bits for each dimension minus one
+		info.Width = int(uint24(head[24:])) + 1
+		info.Height = int(uint24(head[27:])) + 1
+	}
+}
+
+// probeBMP reads the dimensions of a BMP image from its DIB header.
+func probeBMP(s *Sample, info *Info) {
+	head := s.Head
+	if len(head) < 26 || string(head[:2]) != "BM" {
+		return
+	}
+
+	if binary.LittleEndian.Uint32(head[14:]) == 12 {
+		// OS/2 headers have 16 bits dimensions
+		info.Width = int(binary.LittleEndian.Uint16(head[18:]))
+		info.Height = int(binary.LittleEndian.Uint16(head[20:]))
+		return
+	}
+
+	width := int32(binary.LittleEndian.Uint32(head[18:]))
+	height := int32(binary.LittleEndian.Uint32(head[22:]))
+	// Negative heights are images stored top-down
+	if height < 0 {
+		height = -height
+	}
+	if width > 0 {
+		info.Width, info.Height = int(width), int(height)
+	}
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16
+}
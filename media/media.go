@@ -0,0 +1,144 @@
+// Package media detects the type of contents and reads the metadata of the
+// media they hold, such as the dimensions of images, the duration of audio
+// and video and the number of pages of documents.
+//
+// Contents are streamed to the IPFS node as they are uploaded, only their
+// beginning and end are kept to be inspected: metadata stored elsewhere,
+// such as the index of a video in the middle of a big file, is not read.
+package media
+
+import (
+	"mime"
+	"time"
+)
+
+// SampleSize is the size of the beginning and of the end of contents kept
+// by samples.
+const SampleSize = 256 << 10
+
+// Sample keeps the beginning and the end of the content written to it.
+type Sample struct {
+	// Head is the beginning of the content
+	Head []byte
+
+	// Size is the size of the whole content
+	Size int64
+
+	tail []byte
+}
+
+func (s *Sample) Write(p []byte) (int, error) {
+	n := len(p)
+	s.Size += int64(n)
+
+	if room := SampleSize - len(s.Head); room > 0 {
+		if room > len(p) {
+			room = len(p)
+		}
+		s.Head = append(s.Head, p[:room]...)
+		p = p[room:]
+	}
+
+	if len(p) > 0 {
+		s.tail = append(s.tail, p...)
+		if len(s.tail) > 2*SampleSize {
+			s.tail = append(s.tail[:0], s.tail[len(s.tail)-SampleSize:]...)
+		}
+	}
+
+	return n, nil
+}
+
+// Tail returns the end of the content following its head, and its offset
+// in the content.
+func (s *Sample) Tail() ([]byte, int64) {
+	if len(s.tail) > SampleSize {
+		s.tail = s.tail[len(s.tail)-SampleSize:]
+	}
+
+	return s.tail, s.Size - int64(len(s.tail))
+}
+
+// At returns the n bytes of the content at offset, or nil when they were
+// not kept.
+func (s *Sample) At(offset, n int64) []byte {
+	if offset < 0 || n < 0 || offset+n > s.Size {
+		return nil
+	}
+
+	if offset+n <= int64(len(s.Head)) {
+		return s.Head[offset : offset+n]
+	}
+
+	tail, start := s.Tail()
+	if offset >= start {
+		return tail[offset-start : offset-start+n]
+	}
+
+	return nil
+}
+
+// Info is the metadata of a media. Unknown values are zero.
+type Info struct {
+	Width  int `json:"width,omitempty"`
+	Height int `json:"height,omitempty"`
+
+	// Duration of audio and video, in seconds
+	Duration float64 `json:"duration,omitempty"`
+
+	// Pages of documents
+	Pages int `json:"pages,omitempty"`
+
+	// TakenAt is the time photos were taken at, in the time zone of the
+	// camera when unknown
+	TakenAt *time.Time `json:"taken_at,omitempty"`
+
+	// EXIF holds the main EXIF tags of photos, named after them
+	EXIF map[string]interface{} `json:"exif,omitempty"`
+}
+
+func (i *Info) empty() bool {
+	return i.Width == 0 && i.Height == 0 && i.Duration == 0 && i.Pages == 0 && i.TakenAt == nil && len(i.EXIF) == 0
+}
+
+// prober reads the metadata of the media in s into info.
+type prober func(s *Sample, info *Info)
+
+// probers by media type.
+var probers = map[string]prober{
+	"image/png":        probeImage,
+	"image/jpeg":       probeJPEG,
+	"image/gif":        probeImage,
+	"image/webp":       probeWebP,
+	"image/bmp":        probeBMP,
+	"audio/wave":       probeWAV,
+	"audio/wav":        probeWAV,
+	"audio/x-wav":      probeWAV,
+	"audio/mpeg":       probeMP3,
+	"audio/flac":       probeFLAC,
+	"audio/mp4":        probeMP4,
+	"video/mp4":        probeMP4,
+	"video/quicktime":  probeMP4,
+	"audio/webm":       probeMatroska,
+	"video/webm":       probeMatroska,
+	"video/x-matroska": probeMatroska,
+	"application/pdf":  probePDF,
+}
+
+// Probe returns the metadata of the media in s, of type contentType as
+// returned by Detect, or nil when none is known.
+func Probe(s *Sample, contentType string) *Info {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	probe, ok := probers[mediaType]
+	if !ok {
+		return nil
+	}
+
+	info := &Info{}
+	probe(s, info)
+	if info.empty() {
+		return nil
+	}
+
+	return info
+}
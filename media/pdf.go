@@ -0,0 +1,127 @@
+package media
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+)
+
+// linearizedSize is the size of the beginning of linearized documents
+// holding their parameters.
+const linearizedSize = 1024
+
+// maxObjectStream bounds the uncompressed size of the object streams read
+// for the page tree.
+const maxObjectStream = 4 << 20
+
+var (
+	pdfLinearized = regexp.MustCompile(`<<[^>]*/Linearized[^>]*/N\s+(\d+)`)
+	pdfPages      = regexp.MustCompile(`/Type\s*/Pages\b`)
+	pdfCount      = regexp.MustCompile(`/Count\s+(\d+)`)
+	pdfObjStm     = regexp.MustCompile(`<<[^>]*/Type\s*/ObjStm[^>]*>>\s*stream\r?\n`)
+)
+
+// probePDF reads the number of pages of a PDF document. Linearized
+// documents record it first, others in the root of their page tree, which
+// is usually at the beginning or the end of the document, and is possibly
+// compressed in an object stream.
+func probePDF(s *Sample, info *Info) {
+	head := s.Head
+	if !bytes.HasPrefix(head, []byte("%PDF-")) {
+		return
+	}
+
+	if len(head) > linearizedSize {
+		head = head[:linearizedSize]
+	}
+	if m := pdfLinearized.FindSubmatch(head); m != nil {
+		info.Pages, _ = strconv.Atoi(string(m[1]))
+		return
+	}
+
+	tail, _ := s.Tail()
+	for _, data := range [][]byte{s.Head, tail} {
+		if pages := pdfPageCount(data); pages > info.Pages {
+			info.Pages = pages
+		}
+
+		for _, stream := range pdfObjectStreams(data) {
+			if pages := pdfPageCount(stream); pages > info.Pages {
+				info.Pages = pages
+			}
+		}
+	}
+}
+
+// pdfPageCount returns the greatest count of pages of the nodes of the page
+// tree in data: the count of the root.
+func pdfPageCount(data []byte) int {
+	var pages int
+	for _, loc := range pdfPages.FindAllIndex(data, -1) {
+		dict := pdfDictionary(data, loc[0])
+		if dict == nil {
+			continue
+		}
+
+		if m := pdfCount.FindSubmatch(dict); m != nil {
+			if count, err := strconv.Atoi(string(m[1])); err == nil && count > pages {
+				pages = count
+			}
+		}
+	}
+
+	return pages
+}
+
+// pdfDictionary returns the dictionary of data holding the position pos.
+func pdfDictionary(data []byte, pos int) []byte {
+	start := bytes.LastIndex(data[:pos], []byte("<<"))
+	if start == -1 {
+		return nil
+	}
+
+	depth := 0
+	for i := start; i+1 < len(data); i++ {
+		switch {
+		case data[i] == '<' && data[i+1] == '<':
+			depth++
+			i++
+		case data[i] == '>' && data[i+1] == '>':
+			depth--
+			i++
+			if depth == 0 {
+				return data[start : i+1]
+			}
+		}
+	}
+
+	return nil
+}
+
+// pdfObjectStreams returns the inflated object streams in data, which
+// hold the objects of compressed documents.
+func pdfObjectStreams(data []byte) [][]byte {
+	var streams [][]byte
+	for _, loc := range pdfObjStm.FindAllIndex(data, -1) {
+		if !bytes.Contains(data[loc[0]:loc[1]], []byte("/FlateDecode")) {
+			continue
+		}
+
+		r, err := zlib.NewReader(bytes.NewReader(data[loc[1]:]))
+		if err != nil {
+			continue
+		}
+
+		// Streams truncated at the end of the sample are still read as far
+		// as possible
+		stream, err := ioutil.ReadAll(io.LimitReader(r, maxObjectStream))
+		if len(stream) > 0 && (err == nil || err == io.ErrUnexpectedEOF) {
+			streams = append(streams, stream)
+		}
+	}
+
+	return streams
+}
@@ -0,0 +1,308 @@
+package media
+
+import (
+	"encoding/binary"
+	"math"
+	"time"
+)
+
+// mp4Epoch is the origin of the times of ISO base media files.
+var mp4Epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// box is a box of an ISO base media file.
+type box struct {
+	kind   string
+	offset int64
+	header int64
+	size   int64
+}
+
+// readBox reads the header of the box at offset of s, among boxes ending
+// at end.
+func readBox(s *Sample, offset, end int64) (box, bool) {
+	header := s.At(offset, 8)
+	if header == nil {
+		return box{}, false
+	}
+
+	b := box{kind: string(header[4:8]), offset: offset, header: 8, size: int64(binary.BigEndian.Uint32(header))}
+	switch b.size {
+	case 0:
+		// The last box extends to the end
+		b.size = end - offset
+	case 1:
+		large := s.At(offset+8, 8)
+		if large == nil {
+			return box{}, false
+		}
+		b.header, b.size = 16, int64(binary.BigEndian.Uint64(large))
+	}
+
+	if b.size < b.header || offset+b.size > end {
+		return box{}, false
+	}
+
+	return b, true
+}
+
+// children returns the boxes in content, a container box.
+func children(content []byte) map[string][][]byte {
+	boxes := map[string][][]byte{}
+	for len(content) >= 8 {
+		size := int(binary.BigEndian.Uint32(content))
+		if size < 8 || size > len(content) {
+			break
+		}
+
+		kind := string(content[4:8])
+		boxes[kind] = append(boxes[kind], content[8:size])
+		content = content[size:]
+	}
+
+	return boxes
+}
+
+// probeMP4 reads the duration and the dimensions of an MP4 or QuickTime
+// file from its movie box, stored before or after the media data.
+func probeMP4(s *Sample, info *Info) {
+	var moov []byte
+	for offset := int64(0); offset < s.Size; {
+		b, ok := readBox(s, offset, s.Size)
+		if !ok {
+			return
+		}
+
+		if b.kind == "moov" {
+			moov = s.At(b.offset+b.header, b.size-b.header)
+			break
+		}
+		offset += b.size
+	}
+	if moov == nil {
+		return
+	}
+
+	boxes := children(moov)
+	if mvhd := boxes["mvhd"]; len(mvhd) > 0 {
+		readMovieHeader(mvhd[0], info)
+	}
+
+	for _, trak := range boxes["trak"] {
+		tkhd := children(trak)["tkhd"]
+		if len(tkhd) == 0 {
+			continue
+		}
+
+		// The dimensions are the last fields, in 16.16 fixed point
+		header := tkhd[0]
+		if len(header) < 84 {
+			continue
+		}
+		end := len(header)
+		width := binary.BigEndian.Uint32(header[end-8:]) >> 16
+		height := binary.BigEndian.Uint32(header[end-4:]) >> 16
+		// Audio tracks have no dimensions
+		if width > 0 && height > 0 {
+			info.Width, info.Height = int(width), int(height)
+			break
+		}
+	}
+}
+
+// readMovieHeader reads the creation time and the duration of a movie.
+func readMovieHeader(mvhd []byte, info *Info) {
+	var created, timescale, duration uint64
+	switch {
+	case len(mvhd) >= 20 && mvhd[0] == 0:
+		created = uint64(binary.BigEndian.Uint32(mvhd[4:]))
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[12:]))
+		duration = uint64(binary.BigEndian.Uint32(mvhd[16:]))
+	case len(mvhd) >= 32 && mvhd[0] == 1:
+		created = binary.BigEndian.Uint64(mvhd[4:])
+		timescale = uint64(binary.BigEndian.Uint32(mvhd[20:]))
+		duration = binary.BigEndian.Uint64(mvhd[24:])
+	default:
+		return
+	}
+
+	// Unknown durations are all ones
+	if timescale > 0 && duration != math.MaxUint32 && duration != math.MaxUint64 {
+		info.Duration = float64(duration) / float64(timescale)
+	}
+
+	// Encoders which do not know the time leave it empty, or at the epoch
+	// of their system
+	if created == 0 || created > math.MaxInt64/uint64(time.Second) {
+		return
+	}
+	if takenAt := mp4Epoch.Add(time.Duration(created) * time.Second); takenAt.Year() > 1970 {
+		info.TakenAt = &takenAt
+	}
+}
+
+// Matroska elements.
+const (
+	ebmlSegment        = 0x18538067
+	ebmlInfo           = 0x1549A966
+	ebmlTimecodeScale  = 0x2AD7B1
+	ebmlDuration       = 0x4489
+	ebmlDateUTC        = 0x4461
+	ebmlTracks         = 0x1654AE6B
+	ebmlTrackEntry     = 0xAE
+	ebmlVideo          = 0xE0
+	ebmlPixelWidth     = 0xB0
+	ebmlPixelHeight    = 0xBA
+	ebmlCluster        = 0x1F43B675
+	matroskaTimescale  = 1000000
+	matroskaEpochNanos = 978307200 * int64(time.Second)
+)
+
+// readVint reads a variable length integer of Matroska, keeping its length
+// marker for element IDs.
+func readVint(b []byte, marker bool) (value uint64, length int, ok bool) {
+	if len(b) == 0 || b[0] == 0 {
+		return 0, 0, false
+	}
+
+	length = 1
+	for mask := byte(0x80); b[0]&mask == 0; mask >>= 1 {
+		length++
+	}
+	if length > 8 || length > len(b) {
+		return 0, 0, false
+	}
+
+	value = uint64(b[0])
+	if !marker {
+		value &= uint64(0xFF >> uint(length))
+	}
+	for _, c := range b[1:length] {
+		value = value<<8 | uint64(c)
+	}
+
+	return value, length, true
+}
+
+// ebmlElement is an element of a Matroska file.
+type ebmlElement struct {
+	id   uint64
+	data []byte
+}
+
+// readElements reads the elements in b, stopping at the first cluster.
+func readElements(b []byte) []ebmlElement {
+	var elements []ebmlElement
+	for len(b) > 0 {
+		id, n, ok := readVint(b, true)
+		if !ok || id == ebmlCluster {
+			break
+		}
+		size, m, ok := readVint(b[n:], false)
+		if !ok {
+			break
+		}
+		b = b[n+m:]
+
+		e := ebmlElement{id: id}
+		if size == 1<<(7*uint(m))-1 || size > uint64(len(b)) {
+			// Elements of unknown size extend to the end of their parent,
+			// truncated ones are read as far as kept
+			e.data = b
+			b = nil
+		} else {
+			e.data = b[:size]
+			b = b[size:]
+		}
+		elements = append(elements, e)
+	}
+
+	return elements
+}
+
+func ebmlUint(data []byte) uint64 {
+	var value uint64
+	for _, c := range data {
+		value = value<<8 | uint64(c)
+	}
+	return value
+}
+
+func ebmlFloat(data []byte) float64 {
+	switch len(data) {
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(data)))
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(data))
+	}
+	return 0
+}
+
+// probeMatroska reads the duration, the date and the dimensions of a
+// Matroska or WebM file from its segment information and tracks, stored
+// before the clusters.
+func probeMatroska(s *Sample, info *Info) {
+	for _, segment := range readElements(s.Head) {
+		if segment.id != ebmlSegment {
+			continue
+		}
+
+		for _, e := range readElements(segment.data) {
+			switch e.id {
+			case ebmlInfo:
+				readSegmentInfo(e.data, info)
+			case ebmlTracks:
+				readTracks(e.data, info)
+			}
+		}
+		return
+	}
+}
+
+func readSegmentInfo(data []byte, info *Info) {
+	var (
+		timescale uint64 = matroskaTimescale
+		duration  float64
+	)
+	for _, e := range readElements(data) {
+		switch e.id {
+		case ebmlTimecodeScale:
+			timescale = ebmlUint(e.data)
+		case ebmlDuration:
+			duration = ebmlFloat(e.data)
+		case ebmlDateUTC:
+			// Dates are nanoseconds since 2001
+			if len(e.data) == 8 {
+				takenAt := time.Unix(0, int64(binary.BigEndian.Uint64(e.data))+matroskaEpochNanos).UTC()
+				info.TakenAt = &takenAt
+			}
+		}
+	}
+
+	if duration > 0 {
+		info.Duration = duration * float64(timescale) / float64(time.Second)
+	}
+}
+
+func readTracks(data []byte, info *Info) {
+	for _, entry := range readElements(data) {
+		if entry.id != ebmlTrackEntry {
+			continue
+		}
+
+		for _, e := range readElements(entry.data) {
+			if e.id != ebmlVideo {
+				continue
+			}
+
+			for _, dimension := range readElements(e.data) {
+				switch dimension.id {
+				case ebmlPixelWidth:
+					info.Width = int(ebmlUint(dimension.data))
+				case ebmlPixelHeight:
+					info.Height = int(ebmlUint(dimension.data))
+				}
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package metrics
+
+import (
+	"errors"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "sthorer"
+
+// Upload results.
+const (
+	ResultPinned = "pinned"
+	ResultFailed = "failed"
+)
+
+// Indexing results, besides failures.
+const (
+	IndexContent  = "content"
+	IndexMetadata = "metadata"
+)
+
+// Thumbnail generation results, besides failures.
+const (
+	ThumbnailGenerated   = "generated"
+	ThumbnailUndecodable = "undecodable"
+)
+
+// Authentication methods.
+const (
+	AuthToken = "token"
+	AuthJWT   = "jwt"
+	AuthSigV4 = "sigv4"
+)
+
+var (
+	HTTPRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by method, route and status.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by method, route and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	UploadBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "uploads",
+		Name:      "bytes_total",
+		Help:      "Number of uploaded bytes by result.",
+	}, []string{"result"})
+
+	UploadDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "uploads",
+		Name:      "duration_seconds",
+		Help:      "Time taken to add an uploaded file to IPFS by result.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+	}, []string{"result"})
+
+	IPFSRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ipfs",
+		Name:      "rpc_duration_seconds",
+		Help:      "Latency of IPFS node RPCs by command.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"command"})
+
+	IPFSRequestErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ipfs",
+		Name:      "rpc_errors_total",
+		Help:      "Number of failed IPFS node RPCs by command.",
+	}, []string{"command"})
+
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of database queries by operation.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	}, []string{"operation"})
+
+	IndexedFiles = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "search",
+		Name:      "indexed_files_total",
+		Help:      "Number of files indexed, with the text of their content or only their metadata, or failed.",
+	}, []string{"result"})
+
+	Thumbnails = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "thumbnails",
+		Name:      "images_total",
+		Help:      "Number of images whose thumbnails were generated, which could not be decoded, or failed.",
+	}, []string{"result"})
+
+	AuthFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "failures_total",
+		Help:      "Number of rejected credentials by authentication method.",
+	}, []string{"method"})
+)
+
+// PlanUsage is the storage pinned by the users of a plan.
+type PlanUsage struct {
+	Pins  int
+	Bytes int64
+}
+
+// UsageFunc returns the storage pinned by the users of every plan.
+type UsageFunc func() (map[string]*PlanUsage, error)
+
+var (
+	activePinsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "storage", "active_pins"),
+		"Number of files currently pinned by plan.",
+		[]string{"plan"}, nil,
+	)
+
+	storedBytesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "storage", "stored_bytes"),
+		"Size of the files currently pinned by plan.",
+		[]string{"plan"}, nil,
+	)
+)
+
+// usageCollector computes the storage gauges when scraped.
+type usageCollector struct {
+	usage UsageFunc
+}
+
+func (c *usageCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activePinsDesc
+	ch <- storedBytesDesc
+}
+
+func (c *usageCollector) Collect(ch chan<- prometheus.Metric) {
+	usage, err := c.usage()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(activePinsDesc, err)
+		return
+	}
+
+	for plan, u := range usage {
+		ch <- prometheus.MustNewConstMetric(activePinsDesc, prometheus.GaugeValue, float64(u.Pins), plan)
+		ch <- prometheus.MustNewConstMetric(storedBytesDesc, prometheus.GaugeValue, float64(u.Bytes), plan)
+	}
+}
+
+// RegisterUsage registers the storage gauges, computed with usage on every
+// scrape. Registering them again replaces the previous function.
+func RegisterUsage(usage UsageFunc) error {
+	c := &usageCollector{usage: usage}
+	if err := prometheus.Register(c); err != nil {
+		var registered prometheus.AlreadyRegisteredError
+		if !errors.As(err, &registered) {
+			return err
+		}
+
+		registered.ExistingCollector.(*usageCollector).usage = usage
+	}
+
+	return nil
+}
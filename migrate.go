@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/sthorer/api/database"
+)
+
+const migrateUsage = "usage: sthorer migrate up [steps] | down [steps] | status"
+
+// migrate runs the migrate command, which applies, reverts or lists the
+// database schema migrations.
+func migrate(settings *database.Settings, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New(migrateUsage)
+	}
+
+	steps := 0
+	if len(args) == 2 {
+		n, err := strconv.Atoi(args[1])
+		if err != nil || n < 1 {
+			return errors.New(migrateUsage)
+		}
+		steps = n
+	}
+
+	db, err := database.Open(settings)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	switch args[0] {
+	case "up":
+		applied, err := db.MigrateUp(ctx, steps)
+		for _, m := range applied {
+			fmt.Printf("applied %d (%s)\n", m.Version, m.Name)
+		}
+		return err
+	case "down":
+		if steps == 0 {
+			steps = 1
+		}
+
+		reverted, err := db.MigrateDown(ctx, steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %d (%s)\n", m.Version, m.Name)
+		}
+		return err
+	case "status":
+		status, err := db.MigrationStatus(ctx)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED AT")
+		for _, s := range status {
+			appliedAt := "pending"
+			if s.AppliedAt != nil {
+				appliedAt = s.AppliedAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		return db.CheckSchema(ctx)
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -0,0 +1,192 @@
+package search
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"path"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// MaxTextLength is the maximum length of the text extracted from a file, in
+// bytes. The rest is not indexed.
+const MaxTextLength = 256 << 10
+
+// extractor returns the text of a content.
+type extractor func(content []byte) (string, error)
+
+// extractors by media type.
+var extractors = map[string]extractor{
+	"text/plain":            extractPlain,
+	"text/markdown":         extractMarkdown,
+	"text/x-markdown":       extractMarkdown,
+	"text/html":             extractHTML,
+	"application/xhtml+xml": extractHTML,
+	"application/pdf":       extractPDF,
+}
+
+// extensions give the media type of files uploaded without a specific one.
+var extensions = map[string]string{
+	".txt":      "text/plain",
+	".text":     "text/plain",
+	".md":       "text/markdown",
+	".markdown": "text/markdown",
+	".htm":      "text/html",
+	".html":     "text/html",
+	".xhtml":    "application/xhtml+xml",
+	".pdf":      "application/pdf",
+}
+
+// MediaType returns the media type the text of a file named name with
+// contentType is extracted as, or an empty string when it has no text.
+func MediaType(contentType, name string) string {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if _, ok := extractors[mediaType]; ok {
+		return mediaType
+	}
+
+	if mediaType == "" || mediaType == "application/octet-stream" {
+		return extensions[strings.ToLower(path.Ext(name))]
+	}
+
+	return ""
+}
+
+// Extract returns the text of r, of mediaType as returned by MediaType,
+// shortened to MaxTextLength.
+func Extract(r io.Reader, mediaType string) (string, error) {
+	extract, ok := extractors[mediaType]
+	if !ok {
+		return "", errors.New("search: no text in " + mediaType)
+	}
+
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+
+	text, err := extract(content)
+	if err != nil {
+		return "", err
+	}
+
+	return clean(text), nil
+}
+
+func extractPlain(content []byte) (string, error) {
+	return string(content), nil
+}
+
+var (
+	markdownFence     = regexp.MustCompile("(?m)^ {0,3}(```|~~~).*$")
+	markdownReference = regexp.MustCompile(`(?m)^ {0,3}\[[^\]]+\]:\s*\S+.*$`)
+	markdownLink      = regexp.MustCompile(`!?\[([^\]]*)\]\([^)]*\)`)
+	markdownLine      = regexp.MustCompile(`(?m)^\s*(#{1,6}\s+|>+\s?|[-*+]\s+|\d+[.)]\s+)`)
+	markdownTag       = regexp.MustCompile(`<[^>\n]+>`)
+	markdownEmphasis  = regexp.MustCompile("[*`~]+")
+)
+
+// extractMarkdown removes the syntax of Markdown, keeping the text of links
+// and the content of code blocks.
+func extractMarkdown(content []byte) (string, error) {
+	content = markdownFence.ReplaceAll(content, nil)
+	content = markdownReference.ReplaceAll(content, nil)
+	content = markdownLink.ReplaceAll(content, []byte("$1"))
+	content = markdownLine.ReplaceAll(content, nil)
+	content = markdownTag.ReplaceAll(content, []byte(" "))
+	content = markdownEmphasis.ReplaceAll(content, nil)
+
+	return string(content), nil
+}
+
+// htmlSkipped are the elements whose content is not text.
+var htmlSkipped = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"template": true,
+	"svg":      true,
+}
+
+// htmlBlocks are the elements separating lines of text.
+var htmlBlocks = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"br": true, "dd": true, "div": true, "dl": true, "dt": true,
+	"figcaption": true, "footer": true, "form": true, "h1": true, "h2": true,
+	"h3": true, "h4": true, "h5": true, "h6": true, "header": true,
+	"hr": true, "li": true, "main": true, "nav": true, "ol": true, "p": true,
+	"pre": true, "section": true, "table": true, "td": true, "th": true,
+	"title": true, "tr": true, "ul": true,
+}
+
+// extractHTML returns the text of an HTML document, with its title.
+func extractHTML(content []byte) (string, error) {
+	var (
+		b       strings.Builder
+		skipped int
+	)
+
+	z := html.NewTokenizer(bytes.NewReader(content))
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return b.String(), nil
+			}
+			return b.String(), z.Err()
+		case html.TextToken:
+			if skipped == 0 {
+				b.Write(z.Text())
+			}
+		case html.StartTagToken, html.SelfClosingTagToken, html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if htmlSkipped[tag] {
+				if tt == html.StartTagToken {
+					skipped++
+				} else if tt == html.EndTagToken && skipped > 0 {
+					skipped--
+				}
+			}
+
+			if htmlBlocks[tag] {
+				b.WriteByte('\n')
+			}
+		}
+	}
+}
+
+var (
+	spaces = regexp.MustCompile(`[ \t\f\v\r]+`)
+	lines  = regexp.MustCompile(`\s*\n\s*`)
+)
+
+// clean returns text as valid UTF-8 without repeated spaces, shortened to
+// MaxTextLength.
+func clean(text string) string {
+	text = strings.ToValidUTF8(text, " ")
+	text = strings.Map(func(r rune) rune {
+		if r < ' ' && r != '\n' {
+			return ' '
+		}
+		return r
+	}, text)
+	text = spaces.ReplaceAllString(text, " ")
+	text = strings.TrimSpace(lines.ReplaceAllString(text, "\n"))
+
+	if len(text) > MaxTextLength {
+		text = text[:MaxTextLength]
+		for !utf8.ValidString(text) {
+			text = text[:len(text)-1]
+		}
+	}
+
+	return text
+}
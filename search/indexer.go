@@ -0,0 +1,206 @@
+// Package search indexes the text of files for full-text searches.
+package search
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ipfs"
+	"github.com/sthorer/api/logging"
+	"github.com/sthorer/api/metrics"
+)
+
+const (
+	pollInterval = time.Second * 30
+	batchSize    = 50
+)
+
+// Indexer indexes the text of the pinned files which are not indexed yet in
+// the background: their name and metadata, along with the text extracted
+// from their content.
+type Indexer struct {
+	client  *database.Database
+	shell   *ipfs.IPFS
+	maxSize int64
+	workers int
+	logger  *logging.Logger
+	notify  chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	// ctx is canceled to abort the in-flight indexing when stopping takes
+	// too long
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns an indexer indexing up to workers files concurrently. The
+// content of files bigger than maxSize bytes is not read.
+func New(client *database.Database, shell *ipfs.IPFS, maxSize int64, workers int, logger *logging.Logger) *Indexer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Indexer{
+		ctx:     ctx,
+		cancel:  cancel,
+		client:  client,
+		shell:   shell,
+		maxSize: maxSize,
+		workers: workers,
+		logger:  logger.With("component", "search"),
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start launches the indexing worker.
+func (i *Indexer) Start() {
+	i.wg.Add(1)
+	go i.run()
+}
+
+// Stop stops the indexing worker and waits for the files being indexed.
+// Their indexing is aborted when ctx is done before, and resumed on next
+// start.
+func (i *Indexer) Stop(ctx context.Context) error {
+	close(i.done)
+
+	stopped := make(chan struct{})
+	go func() {
+		i.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		i.cancel()
+		return nil
+	case <-ctx.Done():
+		i.cancel()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
+// Queue wakes the indexing worker up, to index the files just pinned.
+func (i *Indexer) Queue() {
+	select {
+	case i.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Index indexes the text of f. Its content is only read when it has text,
+// is not too big and is not encrypted.
+func (i *Indexer) Index(ctx context.Context, f *ent.File) error {
+	name := f.Name
+	if name == "" {
+		name = f.Key
+	}
+
+	// The content of encrypted files is only decrypted for their users
+	encrypted, err := i.client.Encrypted(ctx, f)
+	if err != nil {
+		metrics.IndexedFiles.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	var body string
+	if mediaType := MediaType(f.ContentType, name); mediaType != "" && f.Size > 0 && f.Size <= i.maxSize && !encrypted {
+		r, err := i.shell.CatContext(ctx, f.Hash, 0, f.Size)
+		if err != nil {
+			metrics.IndexedFiles.WithLabelValues(metrics.ResultFailed).Inc()
+			return err
+		}
+
+		body, err = Extract(r, mediaType)
+		r.Close()
+		if err != nil {
+			// The file is still found by its name and metadata
+			i.logger.Warn("failed to extract the text of a file", "file_id", f.ID, "media_type", mediaType, "error", err)
+		}
+	}
+
+	if err := i.client.IndexText(ctx, f, body); err != nil {
+		metrics.IndexedFiles.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	if body != "" {
+		metrics.IndexedFiles.WithLabelValues(metrics.IndexContent).Inc()
+	} else {
+		metrics.IndexedFiles.WithLabelValues(metrics.IndexMetadata).Inc()
+	}
+
+	return nil
+}
+
+func (i *Indexer) run() {
+	defer i.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Full batches are followed by others, to catch up quickly with
+		// the files pinned before indexing
+		if i.indexPending() == batchSize {
+			i.Queue()
+		}
+
+		select {
+		case <-i.done:
+			return
+		case <-ticker.C:
+		case <-i.notify:
+		}
+	}
+}
+
+// indexPending indexes a batch of files, and returns the number of files
+// indexed.
+func (i *Indexer) indexPending() int {
+	ctx := i.ctx
+	files, err := i.client.UnindexedFiles(ctx, batchSize)
+	if err != nil {
+		i.logger.Error("failed to load the files to index", "error", err)
+		return 0
+	}
+
+	var (
+		mu      sync.Mutex
+		indexed int
+		wg      sync.WaitGroup
+	)
+	queue := make(chan *ent.File)
+	for n := 0; n < i.workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range queue {
+				if err := i.Index(ctx, f); err != nil {
+					i.logger.Error("failed to index file", "file_id", f.ID, "error", err)
+					continue
+				}
+
+				mu.Lock()
+				indexed++
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, f := range files {
+		select {
+		case <-i.done:
+			break feed
+		case queue <- f:
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	return indexed
+}
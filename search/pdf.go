@@ -0,0 +1,468 @@
+package search
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// The text of PDFs is read from the text showing operators of their content
+// streams, either uncompressed or compressed with FlateDecode. Strings are
+// decoded as UTF-16 when marked so, and with the WinAnsi encoding otherwise,
+// which matches the standard encodings of fonts. Strings of glyph IDs, used
+// by fonts with custom encodings such as CID fonts, are skipped: decoding
+// them requires the ToUnicode maps of the fonts.
+
+// maxPDFContent bounds the uncompressed size of the streams of a PDF.
+const maxPDFContent = 64 << 20
+
+var (
+	pdfHeader    = []byte("%PDF-")
+	pdfStream    = []byte("stream")
+	pdfEndStream = []byte("endstream")
+	pdfObject    = []byte("obj")
+	pdfFilter    = []byte("/Filter")
+	pdfFlate     = []byte("/FlateDecode")
+)
+
+// pdfSkipped are the names found in the dictionaries of the streams which
+// have no text, such as images, fonts and the index of objects, or which
+// are encoded with another filter than FlateDecode.
+var pdfSkipped = [][]byte{
+	[]byte("/Image"),
+	[]byte("/Length1"),
+	[]byte("/Length2"),
+	[]byte("/Length3"),
+	[]byte("/ObjStm"),
+	[]byte("/XRef"),
+	[]byte("/XML"),
+	[]byte("/EmbeddedFile"),
+	[]byte("/ASCII85Decode"),
+	[]byte("/ASCIIHexDecode"),
+	[]byte("/LZWDecode"),
+	[]byte("/RunLengthDecode"),
+	[]byte("/CCITTFaxDecode"),
+	[]byte("/JBIG2Decode"),
+	[]byte("/DCTDecode"),
+	[]byte("/JPXDecode"),
+	[]byte("/Crypt"),
+}
+
+func extractPDF(content []byte) (string, error) {
+	if !bytes.HasPrefix(content, pdfHeader) {
+		return "", errors.New("search: not a PDF document")
+	}
+
+	var (
+		b      strings.Builder
+		budget int64 = maxPDFContent
+		pos    int
+	)
+	for b.Len() < MaxTextLength && budget > 0 {
+		i := bytes.Index(content[pos:], pdfStream)
+		if i == -1 {
+			break
+		}
+		i += pos
+
+		// endstream also holds the stream keyword
+		if i >= 3 && string(content[i-3:i]) == "end" {
+			pos = i + len(pdfStream)
+			continue
+		}
+
+		start := i + len(pdfStream)
+		if start < len(content) && content[start] == '\r' {
+			start++
+		}
+		if start < len(content) && content[start] == '\n' {
+			start++
+		}
+
+		end := bytes.Index(content[start:], pdfEndStream)
+		if end == -1 {
+			break
+		}
+		end += start
+
+		dict := content[pos:i]
+		if j := bytes.LastIndex(dict, pdfObject); j != -1 {
+			dict = dict[j:]
+		}
+		pos = end + len(pdfEndStream)
+
+		data, ok := pdfStreamData(dict, content[start:end], budget)
+		if !ok {
+			continue
+		}
+		budget -= int64(len(data))
+
+		pdfText(&b, data)
+	}
+
+	return b.String(), nil
+}
+
+// pdfStreamData returns the data of the stream of dict, decompressed, and
+// whether it may have text.
+func pdfStreamData(dict, raw []byte, budget int64) ([]byte, bool) {
+	for _, name := range pdfSkipped {
+		if bytes.Contains(dict, name) {
+			return nil, false
+		}
+	}
+
+	if !bytes.Contains(dict, pdfFilter) {
+		return raw, true
+	}
+
+	if !bytes.Contains(dict, pdfFlate) {
+		return nil, false
+	}
+
+	r, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer r.Close()
+
+	// Streams are often truncated or followed by garbage, their beginning
+	// is kept
+	data, _ := ioutil.ReadAll(io.LimitReader(r, budget))
+	return data, len(data) > 0
+}
+
+// pdfOperand is an operand of an operator of a content stream.
+type pdfOperand struct {
+	str    []byte
+	num    float64
+	array  []pdfOperand
+	isStr  bool
+	isNum  bool
+	isList bool
+}
+
+// pdfText writes the text shown by the operators of the content stream
+// data to b.
+func pdfText(b *strings.Builder, data []byte) {
+	var (
+		l        = &pdfLexer{data: data}
+		operands []pdfOperand
+		arrays   []int
+	)
+	for b.Len() < MaxTextLength {
+		kind, value := l.next()
+		switch kind {
+		case pdfEOF:
+			return
+		case pdfString:
+			operands = append(operands, pdfOperand{str: value, isStr: true})
+		case pdfNumber:
+			n, _ := strconv.ParseFloat(string(value), 64)
+			operands = append(operands, pdfOperand{num: n, isNum: true})
+		case pdfArrayStart:
+			arrays = append(arrays, len(operands))
+		case pdfArrayEnd:
+			if len(arrays) == 0 {
+				continue
+			}
+			start := arrays[len(arrays)-1]
+			arrays = arrays[:len(arrays)-1]
+			array := append([]pdfOperand{}, operands[start:]...)
+			operands = append(operands[:start], pdfOperand{array: array, isList: true})
+		case pdfOperator:
+			pdfShow(b, string(value), operands)
+			if string(value) == "ID" {
+				l.skipInlineImage()
+			}
+			operands = operands[:0]
+			arrays = arrays[:0]
+		}
+	}
+}
+
+// pdfShow writes the text shown by operator, called with operands, to b.
+func pdfShow(b *strings.Builder, operator string, operands []pdfOperand) {
+	last := pdfOperand{}
+	if len(operands) > 0 {
+		last = operands[len(operands)-1]
+	}
+
+	switch operator {
+	case "Tj":
+		if last.isStr {
+			b.WriteString(pdfDecode(last.str))
+		}
+	case "'", "\"":
+		b.WriteByte('\n')
+		if last.isStr {
+			b.WriteString(pdfDecode(last.str))
+		}
+	case "TJ":
+		for _, o := range last.array {
+			if o.isStr {
+				b.WriteString(pdfDecode(o.str))
+			} else if o.isNum && o.num < -250 {
+				// Wide negative adjustments separate words
+				b.WriteByte(' ')
+			}
+		}
+	case "Td", "TD":
+		if last.isNum && last.num != 0 {
+			b.WriteByte('\n')
+		} else {
+			b.WriteByte(' ')
+		}
+	case "T*", "ET":
+		b.WriteByte('\n')
+	case "Tm":
+		b.WriteByte(' ')
+	}
+}
+
+// winAnsi maps the bytes 0x80 to 0x9f of the WinAnsi encoding, which differs
+// from Latin-1 there.
+var winAnsi = [32]rune{
+	'€', ' ', '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', ' ', 'Ž', ' ',
+	' ', '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', ' ', 'ž', 'Ÿ',
+}
+
+// pdfDecode returns the text of a string of a content stream, or an empty
+// string for strings of glyph IDs.
+func pdfDecode(s []byte) string {
+	if len(s) >= 2 && s[0] == 0xfe && s[1] == 0xff {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	control := 0
+	for _, c := range s {
+		if c < ' ' && c != '\t' && c != '\n' && c != '\r' {
+			control++
+		}
+	}
+	if control > len(s)/4 {
+		return ""
+	}
+
+	runes := make([]rune, len(s))
+	for i, c := range s {
+		if c >= 0x80 && c < 0xa0 {
+			runes[i] = winAnsi[c-0x80]
+		} else {
+			runes[i] = rune(c)
+		}
+	}
+
+	return string(runes)
+}
+
+// Kinds of the tokens of content streams.
+const (
+	pdfEOF = iota
+	pdfString
+	pdfNumber
+	pdfName
+	pdfOperator
+	pdfArrayStart
+	pdfArrayEnd
+	pdfDict
+)
+
+// pdfLexer splits content streams in tokens.
+type pdfLexer struct {
+	data []byte
+	pos  int
+}
+
+func pdfSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func pdfDelimiter(c byte) bool {
+	return pdfSpace(c) || strings.IndexByte("()<>[]{}/%", c) != -1
+}
+
+// next returns the next token and its value: the decoded content of
+// strings, and the text of other tokens.
+func (l *pdfLexer) next() (int, []byte) {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		switch {
+		case pdfSpace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		case c == '(':
+			return pdfString, l.literal()
+		case c == '<':
+			if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+				l.pos += 2
+				return pdfDict, nil
+			}
+			return pdfString, l.hex()
+		case c == '>':
+			l.pos++
+			if l.pos < len(l.data) && l.data[l.pos] == '>' {
+				l.pos++
+			}
+			return pdfDict, nil
+		case c == '[':
+			l.pos++
+			return pdfArrayStart, nil
+		case c == ']':
+			l.pos++
+			return pdfArrayEnd, nil
+		case c == '{' || c == '}' || c == ')':
+			l.pos++
+		case c == '/':
+			l.pos++
+			return pdfName, l.regular()
+		case c >= '0' && c <= '9' || c == '-' || c == '+' || c == '.':
+			return pdfNumber, l.regular()
+		default:
+			return pdfOperator, l.regular()
+		}
+	}
+
+	return pdfEOF, nil
+}
+
+// regular returns the run of regular characters at the position.
+func (l *pdfLexer) regular() []byte {
+	start := l.pos
+	for l.pos < len(l.data) && !pdfDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+
+	return l.data[start:l.pos]
+}
+
+// literal returns the content of the literal string at the position.
+func (l *pdfLexer) literal() []byte {
+	var (
+		s     []byte
+		depth = 0
+	)
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+
+		switch c {
+		case '(':
+			depth++
+			if depth == 1 {
+				continue
+			}
+		case ')':
+			depth--
+			if depth == 0 {
+				return s
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return s
+			}
+
+			c = l.data[l.pos]
+			l.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if c >= '0' && c <= '7' {
+					n := int(c - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						n = n*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					c = byte(n)
+				}
+			}
+		}
+
+		s = append(s, c)
+	}
+
+	return s
+}
+
+// hex returns the content of the hexadecimal string at the position.
+func (l *pdfLexer) hex() []byte {
+	l.pos++
+
+	var (
+		s    []byte
+		high = -1
+	)
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+
+		var v int
+		switch {
+		case c == '>':
+			if high >= 0 {
+				s = append(s, byte(high<<4))
+			}
+			return s
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c >= 'a' && c <= 'f':
+			v = int(c-'a') + 10
+		case c >= 'A' && c <= 'F':
+			v = int(c-'A') + 10
+		default:
+			continue
+		}
+
+		if high < 0 {
+			high = v
+		} else {
+			s = append(s, byte(high<<4|v))
+			high = -1
+		}
+	}
+
+	return s
+}
+
+// skipInlineImage skips the data of an inline image, up to the EI operator
+// ending it.
+func (l *pdfLexer) skipInlineImage() {
+	for l.pos+2 < len(l.data) {
+		if pdfSpace(l.data[l.pos]) && l.data[l.pos+1] == 'E' && l.data[l.pos+2] == 'I' &&
+			(l.pos+3 == len(l.data) || pdfDelimiter(l.data[l.pos+3])) {
+			l.pos += 3
+			return
+		}
+		l.pos++
+	}
+
+	l.pos = len(l.data)
+}
@@ -0,0 +1,318 @@
+// Package thumbnails generates the thumbnails of the images pinned by
+// users, to show them without fetching their whole content.
+package thumbnails
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	_ "image/gif" // Register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/ipfs"
+	"github.com/sthorer/api/logging"
+	"github.com/sthorer/api/metrics"
+
+	_ "golang.org/x/image/webp" // Register the WebP decoder
+)
+
+const (
+	pollInterval = time.Second * 30
+	batchSize    = 20
+	jpegQuality  = 85
+)
+
+// ContentTypes are the content types of the images thumbnails are
+// generated for.
+var ContentTypes = []string{"image/jpeg", "image/png", "image/gif", "image/webp"}
+
+// errUndecodable is returned for images which cannot be decoded, or are
+// too big to be.
+var errUndecodable = errors.New("thumbnails: image cannot be decoded")
+
+// Generator generates the thumbnails of the pinned images which have none
+// yet in the background. Thumbnails are pinned on the IPFS node, as JPEG
+// images for JPEG images and opaque WebP images, and PNG images for the
+// others.
+type Generator struct {
+	client    *database.Database
+	shell     *ipfs.IPFS
+	sizes     []int
+	maxSize   int64
+	maxPixels int64
+	workers   int
+	logger    *logging.Logger
+	notify    chan struct{}
+	done      chan struct{}
+	wg        sync.WaitGroup
+
+	// ctx is canceled to abort the in-flight generations when stopping
+	// takes too long
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns a generator generating the thumbnails of up to workers
+// images concurrently, fitting in squares of sizes pixels. Images bigger
+// than maxSize bytes or maxPixels pixels have none.
+func New(client *database.Database, shell *ipfs.IPFS, sizes []int, maxSize int64, maxPixels int64, workers int, logger *logging.Logger) *Generator {
+	sorted := append([]int(nil), sizes...)
+	sort.Ints(sorted)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Generator{
+		ctx:       ctx,
+		cancel:    cancel,
+		client:    client,
+		shell:     shell,
+		sizes:     sorted,
+		maxSize:   maxSize,
+		maxPixels: maxPixels,
+		workers:   workers,
+		logger:    logger.With("component", "thumbnails"),
+		notify:    make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+// Start launches the generation worker.
+func (g *Generator) Start() {
+	g.wg.Add(1)
+	go g.run()
+}
+
+// Stop stops the generation worker and waits for the images being
+// processed. Their generation is aborted when ctx is done before, and
+// resumed on next start.
+func (g *Generator) Stop(ctx context.Context) error {
+	close(g.done)
+
+	stopped := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		g.cancel()
+		return nil
+	case <-ctx.Done():
+		g.cancel()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
+// Queue wakes the generation worker up, to process the images just pinned.
+func (g *Generator) Queue() {
+	select {
+	case g.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Pending returns the number of images waiting for their thumbnails.
+func (g *Generator) Pending(ctx context.Context) (int, error) {
+	return g.client.CountUnthumbnailedFiles(ctx, ContentTypes, g.maxSize)
+}
+
+// Generate generates and records the thumbnails of f. Images which cannot
+// be decoded are recorded with a single empty thumbnail of size 0.
+func (g *Generator) Generate(ctx context.Context, f *ent.File) error {
+	r, err := g.shell.CatContext(ctx, f.Hash, 0, f.Size)
+	if err != nil {
+		metrics.Thumbnails.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	content, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		metrics.Thumbnails.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	thumbnails, err := g.render(ctx, f, content)
+	result := metrics.ThumbnailGenerated
+	switch {
+	case err == errUndecodable:
+		g.logger.Warn("failed to decode image", "file_id", f.ID, "content_type", f.ContentType)
+		thumbnails = []*database.NewThumbnail{{}}
+		result = metrics.ThumbnailUndecodable
+	case err != nil:
+		metrics.Thumbnails.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	if err := g.client.SetThumbnails(ctx, f, thumbnails); err != nil {
+		metrics.Thumbnails.WithLabelValues(metrics.ResultFailed).Inc()
+		return err
+	}
+
+	metrics.Thumbnails.WithLabelValues(result).Inc()
+	return nil
+}
+
+// render returns the thumbnails of f, of content, pinning those which are
+// smaller than the image. Images fitting in a size are their own thumbnail
+// of this size.
+func (g *Generator) render(ctx context.Context, f *ent.File, content []byte) ([]*database.NewThumbnail, error) {
+	config, format, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil || config.Width == 0 || config.Height == 0 || int64(config.Width)*int64(config.Height) > g.maxPixels {
+		return nil, errUndecodable
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, errUndecodable
+	}
+	src := toRGBA(img)
+
+	orientation := exifOrientation(f)
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	if rotated(orientation) {
+		width, height = height, width
+	}
+
+	var thumbnails []*database.NewThumbnail
+	for _, size := range g.sizes {
+		if width <= size && height <= size {
+			thumbnails = append(thumbnails, &database.NewThumbnail{
+				Size:        size,
+				Width:       width,
+				Height:      height,
+				Hash:        f.Hash,
+				ContentType: f.ContentType,
+				Bytes:       f.Size,
+			})
+			continue
+		}
+
+		w, h := fit(width, height, size)
+		// The image is scaled before being oriented
+		sw, sh := w, h
+		if rotated(orientation) {
+			sw, sh = h, w
+		}
+		thumbnail := orient(resize(src, sw, sh), orientation)
+
+		var (
+			buf         bytes.Buffer
+			contentType string
+		)
+		if format == "jpeg" || format == "webp" && thumbnail.Opaque() {
+			contentType = "image/jpeg"
+			err = jpeg.Encode(&buf, thumbnail, &jpeg.Options{Quality: jpegQuality})
+		} else {
+			contentType = "image/png"
+			err = png.Encode(&buf, thumbnail)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		n := int64(buf.Len())
+		hash, err := g.shell.AddContext(ctx, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		thumbnails = append(thumbnails, &database.NewThumbnail{
+			Size:        size,
+			Width:       w,
+			Height:      h,
+			Hash:        hash,
+			ContentType: contentType,
+			Bytes:       n,
+		})
+	}
+
+	return thumbnails, nil
+}
+
+// exifOrientation returns the EXIF orientation of f, recorded in its media
+// metadata, or 0.
+func exifOrientation(f *ent.File) int {
+	info, _ := f.Metadata[database.MediaKey].(map[string]interface{})
+	exif, _ := info["exif"].(map[string]interface{})
+	orientation, _ := exif["orientation"].(float64)
+	return int(orientation)
+}
+
+func (g *Generator) run() {
+	defer g.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Full batches are followed by others, to catch up quickly with
+		// the images pinned before
+		if g.generatePending() == batchSize {
+			g.Queue()
+		}
+
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+		case <-g.notify:
+		}
+	}
+}
+
+// generatePending generates the thumbnails of a batch of images, and
+// returns the number of images processed.
+func (g *Generator) generatePending() int {
+	ctx := g.ctx
+	files, err := g.client.UnthumbnailedFiles(ctx, ContentTypes, g.maxSize, batchSize)
+	if err != nil {
+		g.logger.Error("failed to load the images to generate thumbnails for", "error", err)
+		return 0
+	}
+
+	var (
+		mu        sync.Mutex
+		processed int
+		wg        sync.WaitGroup
+	)
+	queue := make(chan *ent.File)
+	for n := 0; n < g.workers; n++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range queue {
+				if err := g.Generate(ctx, f); err != nil {
+					g.logger.Error("failed to generate thumbnails", "file_id", f.ID, "error", err)
+					continue
+				}
+
+				mu.Lock()
+				processed++
+				mu.Unlock()
+			}
+		}()
+	}
+
+feed:
+	for _, f := range files {
+		select {
+		case <-g.done:
+			break feed
+		case queue <- f:
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	return processed
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exporters.
+const (
+	ExporterNone = "none"
+	ExporterOTLP = "otlp"
+)
+
+const instrumentationName = "github.com/sthorer/api"
+
+// Settings configures the export of traces.
+type Settings struct {
+	// Exporter is none to disable tracing or otlp to export spans to an
+	// OTLP/HTTP collector
+	Exporter string `yaml:"exporter" validate:"required,oneof=none otlp"`
+
+	// Endpoint is the host:port of the collector
+	Endpoint string `yaml:"endpoint"`
+
+	// Insecure disables TLS when connecting to the collector
+	Insecure bool `yaml:"insecure"`
+
+	// ServiceName identifies the API in the traces
+	ServiceName string `yaml:"service_name" validate:"required"`
+
+	// SampleRatio is the fraction of traces started by the API which are
+	// recorded, traces started by callers follow their sampling decision
+	SampleRatio float64 `yaml:"sample_ratio" validate:"gte=0,lte=1"`
+}
+
+// Initialize installs the global tracer provider and the W3C trace context
+// propagator. The returned function flushes and stops the exporter.
+func Initialize(ctx context.Context, settings *Settings) (func(ctx context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if settings.Exporter == ExporterNone {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(settings.Endpoint)}
+	if settings.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(settings.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(settings.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the API.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
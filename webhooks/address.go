@@ -0,0 +1,116 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when a webhook URL points to the API
+// itself, the IPFS node or other internal services: loopback, private,
+// link-local and unspecified addresses.
+var ErrForbiddenAddress = errors.New("webhooks: destination address is not allowed")
+
+// CheckURL returns an error unless raw is an http or https URL which is
+// not an internal address. Host names are checked once resolved, when
+// deliveries are sent.
+func CheckURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("webhooks: unsupported URL scheme %q", u.Scheme)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("webhooks: URL has no host")
+	}
+
+	if ip := net.ParseIP(host); ip != nil && !allowed(ip) {
+		return ErrForbiddenAddress
+	}
+
+	return nil
+}
+
+// privateNetworks are the private and shared IPv4 networks, the current
+// network and IPv6 unique local addresses.
+var privateNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),
+	mustParseCIDR("10.0.0.0/8"),
+	mustParseCIDR("100.64.0.0/10"),
+	mustParseCIDR("172.16.0.0/12"),
+	mustParseCIDR("192.168.0.0/16"),
+	mustParseCIDR("fc00::/7"),
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, network, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// allowed tells whether deliveries may be sent to ip.
+func allowed(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+
+	for _, network := range privateNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newHTTPClient returns the client deliveries are sent with. The addresses
+// are checked as connections are made, once host names are resolved, so
+// that names resolving to internal addresses, even after the webhook was
+// created, are refused. Requests are never proxied, and only redirected
+// to http and https URLs.
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: time.Second * 10,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			if ip := net.ParseIP(host); ip == nil || !allowed(ip) {
+				return ErrForbiddenAddress
+			}
+
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, address)
+	}
+
+	return &http.Client{
+		Timeout:   time.Second * 10,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("webhooks: too many redirects")
+			}
+			return CheckURL(req.URL.String())
+		},
+	}
+}
@@ -0,0 +1,291 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/sthorer/api/database"
+	"github.com/sthorer/api/ent"
+	"github.com/sthorer/api/logging"
+)
+
+const (
+	EventPinned    = "pin.pinned"
+	EventPinFailed = "pin.failed"
+	EventUnpinned  = "pin.unpinned"
+
+	// EventPing is only sent by the test endpoint.
+	EventPing = "ping"
+)
+
+const (
+	SignatureHeader = "X-Sthorer-Signature"
+	EventHeader     = "X-Sthorer-Event"
+	DeliveryHeader  = "X-Sthorer-Delivery"
+)
+
+const (
+	maxAttempts  = 8
+	baseBackoff  = time.Second * 30
+	pollInterval = time.Second * 10
+	batchSize    = 50
+)
+
+var (
+	errTimeout = errors.New("webhooks: request timed out")
+	errSend    = errors.New("webhooks: request failed")
+)
+
+// Events lists the events webhooks can subscribe to.
+var Events = []string{EventPinned, EventPinFailed, EventUnpinned}
+
+// Dispatcher queues webhook deliveries in the database and sends them in
+// the background, retrying failed ones with an exponential backoff.
+type Dispatcher struct {
+	client  *database.Database
+	workers int
+	logger  *logging.Logger
+	http    *http.Client
+	notify  chan struct{}
+	done    chan struct{}
+	wg      sync.WaitGroup
+
+	// ctx is canceled to abort the in-flight deliveries when stopping
+	// takes too long
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// New returns a dispatcher sending up to workers deliveries concurrently.
+func New(client *database.Database, workers int, logger *logging.Logger) *Dispatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Dispatcher{
+		ctx:     ctx,
+		cancel:  cancel,
+		client:  client,
+		workers: workers,
+		logger:  logger.With("component", "webhooks"),
+		http:    newHTTPClient(),
+		notify:  make(chan struct{}, 1),
+		done:    make(chan struct{}),
+	}
+}
+
+// Start launches the delivery worker.
+func (d *Dispatcher) Start() {
+	d.wg.Add(1)
+	go d.run()
+}
+
+// Stop stops the delivery worker and waits for the in-flight deliveries to
+// complete. They are aborted when ctx is done before. Pending deliveries
+// are kept in the database and sent on next start.
+func (d *Dispatcher) Stop(ctx context.Context) error {
+	close(d.done)
+
+	stopped := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		d.cancel()
+		return nil
+	case <-ctx.Done():
+		d.cancel()
+		<-stopped
+		return ctx.Err()
+	}
+}
+
+// Publish queues event for every webhook of u subscribed to it.
+func (d *Dispatcher) Publish(ctx context.Context, u *ent.User, event string, data interface{}) error {
+	payload, err := toMap(data)
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := d.client.NewWebhookDeliveries(ctx, u, event, payload)
+	if err != nil {
+		return err
+	}
+
+	if len(deliveries) > 0 {
+		d.wake()
+	}
+
+	return nil
+}
+
+// Ping sends a ping event to w right away and returns its delivery. The
+// delivery is only due once sent, so that the workers do not send it too.
+func (d *Dispatcher) Ping(ctx context.Context, w *ent.Webhook) (*ent.WebhookDelivery, error) {
+	delivery, err := d.client.NewWebhookDelivery(ctx, w, EventPing, map[string]interface{}{
+		"webhook_id": w.ID,
+	}, time.Now().Add(baseBackoff))
+	if err != nil {
+		return nil, err
+	}
+
+	return d.Deliver(ctx, delivery)
+}
+
+// Deliver sends delivery to its webhook and records the outcome. The
+// webhook edge of delivery must be loaded.
+func (d *Dispatcher) Deliver(ctx context.Context, delivery *ent.WebhookDelivery) (*ent.WebhookDelivery, error) {
+	w := delivery.Edges.Webhook
+	body, err := json.Marshal(map[string]interface{}{
+		"id":         delivery.ID,
+		"event":      delivery.Event,
+		"created_at": delivery.CreatedAt,
+		"data":       delivery.Payload,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := d.send(ctx, w, delivery, body)
+	if err == nil {
+		return d.client.WebhookDeliverySucceeded(ctx, delivery, status)
+	}
+
+	var next time.Time
+	if attempts := delivery.Attempts + 1; attempts < maxAttempts {
+		next = time.Now().Add(baseBackoff << uint(attempts-1))
+	}
+
+	return d.client.WebhookDeliveryFailed(ctx, delivery, status, err.Error(), next)
+}
+
+func (d *Dispatcher) send(ctx context.Context, w *ent.Webhook, delivery *ent.WebhookDelivery, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Sthorer-Webhooks")
+	req.Header.Set(EventHeader, delivery.Event)
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+
+	res, err := d.http.Do(req.WithContext(ctx))
+	if err != nil {
+		// Deliveries are shown to users, the errors of the transport
+		// would tell them about the network of the API
+		d.logger.Warn("failed to send webhook delivery", "delivery_id", delivery.ID, "error", err)
+
+		var netErr net.Error
+		switch {
+		case errors.Is(err, ErrForbiddenAddress):
+			return 0, ErrForbiddenAddress
+		case errors.As(err, &netErr) && netErr.Timeout():
+			return 0, errTimeout
+		}
+		return 0, errSend
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, 1<<16))
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("unexpected response status: %s", res.Status)
+	}
+
+	return res.StatusCode, nil
+}
+
+func (d *Dispatcher) run() {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.deliverPending()
+
+		select {
+		case <-d.done:
+			return
+		case <-ticker.C:
+		case <-d.notify:
+		}
+	}
+}
+
+func (d *Dispatcher) deliverPending() {
+	ctx := d.ctx
+	deliveries, err := d.client.PendingWebhookDeliveries(ctx, batchSize)
+	if err != nil {
+		d.logger.Error("failed to load pending webhook deliveries", "error", err)
+		return
+	}
+
+	queue := make(chan *ent.WebhookDelivery)
+	var wg sync.WaitGroup
+	for i := 0; i < d.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range queue {
+				if _, err := d.Deliver(ctx, delivery); err != nil {
+					d.logger.Error("failed to record webhook delivery", "delivery_id", delivery.ID, "error", err)
+				}
+			}
+		}()
+	}
+
+	defer wg.Wait()
+	defer close(queue)
+
+	for _, delivery := range deliveries {
+		select {
+		case <-d.done:
+			return
+		case queue <- delivery:
+		}
+	}
+}
+
+func (d *Dispatcher) wake() {
+	select {
+	case d.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Sign returns the value of the signature header for body, the hex encoded
+// HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func toMap(data interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}